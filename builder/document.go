@@ -0,0 +1,356 @@
+// Copyright 2023 Princess B33f Heavy Industries / Dave Shanley
+// SPDX-License-Identifier: MIT
+
+// Package builder contains a fluent API for building new OpenAPI 3 documents from scratch, in code.
+//
+// A DocumentBuilder creates a high-level v3.Document that is not backed by a low-level model (the same as a
+// document created by the converter). Components are added to the builder by name, and references to them are
+// generated by the builder, so there is no need to write out a $ref by hand:
+//
+//	b := builder.NewDocumentBuilder().Info("Pet Store", "1.0.0")
+//	b.Schema("Pet", &base.Schema{Type: []string{"object"}})
+//	b.AddPath("/pets/{petId}").
+//		Get("getPet").
+//		PathParameter("petId", builder.StringSchema()).
+//		JSONResponse(200, "a pet", b.SchemaRef("Pet"))
+//	doc, errs := b.Build()
+//
+// Build checks the required fields of the document (and every path and operation in it), and that every generated
+// reference points to a component that exists, so the document can be rendered and reloaded cleanly. A builder
+// creates a single document, it should not be used again once Build has returned the document.
+package builder
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/pb33f/libopenapi/datamodel/high/base"
+	v3 "github.com/pb33f/libopenapi/datamodel/high/v3"
+	"github.com/pb33f/libopenapi/datamodel/low"
+	v3low "github.com/pb33f/libopenapi/datamodel/low/v3"
+	"github.com/pb33f/libopenapi/orderedmap"
+)
+
+// DefaultVersion is the version of OpenAPI used by a new DocumentBuilder.
+const DefaultVersion = "3.1.0"
+
+// component sections that references can be generated for.
+const (
+	schemas       = "schemas"
+	parameters    = "parameters"
+	responses     = "responses"
+	requestBodies = "requestBodies"
+)
+
+// DocumentBuilder builds a new OpenAPI 3 document. Every method returns the builder, so calls can be chained.
+// Problems are collected as the document is built, and returned by Build.
+type DocumentBuilder struct {
+	doc   *v3.Document
+	paths []*PathBuilder
+	refs  []componentRef
+	errs  []error
+}
+
+// componentRef is a reference generated by the builder, that must point to a component when the document is built.
+type componentRef struct {
+	section string
+	name    string
+}
+
+// String returns the reference as a JSON Pointer, the name of the component is escaped (RFC 6901).
+func (r componentRef) String() string {
+	return fmt.Sprintf("#/components/%s/%s", r.section, pointerEscaper.Replace(r.name))
+}
+
+// pointerEscaper and pointerUnescaper escape a component name for use in a JSON Pointer, and back again.
+var (
+	pointerEscaper   = strings.NewReplacer("~", "~0", "/", "~1")
+	pointerUnescaper = strings.NewReplacer("~1", "/", "~0", "~")
+)
+
+// NewDocumentBuilder creates a new DocumentBuilder, for a document using DefaultVersion.
+func NewDocumentBuilder() *DocumentBuilder {
+	return &DocumentBuilder{doc: &v3.Document{Version: DefaultVersion, Info: &base.Info{}}}
+}
+
+// Version sets the version of OpenAPI used by the document, for example 3.0.3.
+func (b *DocumentBuilder) Version(version string) *DocumentBuilder {
+	b.doc.Version = version
+	return b
+}
+
+// Info sets the (required) title and version of the API.
+func (b *DocumentBuilder) Info(title, version string) *DocumentBuilder {
+	b.doc.Info.Title = title
+	b.doc.Info.Version = version
+	return b
+}
+
+// Description sets the description of the API.
+func (b *DocumentBuilder) Description(description string) *DocumentBuilder {
+	b.doc.Info.Description = description
+	return b
+}
+
+// Server adds a server to the document.
+func (b *DocumentBuilder) Server(url, description string) *DocumentBuilder {
+	b.doc.Servers = append(b.doc.Servers, &v3.Server{URL: url, Description: description})
+	return b
+}
+
+// Tag adds a tag to the document.
+func (b *DocumentBuilder) Tag(name, description string) *DocumentBuilder {
+	b.doc.Tags = append(b.doc.Tags, &base.Tag{Name: name, Description: description})
+	return b
+}
+
+// Extension sets an extension of the document, the key must start with 'x-'.
+func (b *DocumentBuilder) Extension(key string, value any) *DocumentBuilder {
+	if !strings.HasPrefix(key, "x-") {
+		b.errs = append(b.errs, fmt.Errorf("extension '%s' must start with 'x-'", key))
+		return b
+	}
+	if b.doc.Extensions == nil {
+		b.doc.Extensions = make(map[string]any)
+	}
+	b.doc.Extensions[key] = value
+	return b
+}
+
+// Security adds a global security requirement, using the security scheme called name.
+func (b *DocumentBuilder) Security(name string, scopes ...string) *DocumentBuilder {
+	b.doc.Security = append(b.doc.Security, securityRequirement(name, scopes))
+	return b
+}
+
+// SecurityScheme adds a security scheme to the components of the document.
+func (b *DocumentBuilder) SecurityScheme(name string, scheme *v3.SecurityScheme) *DocumentBuilder {
+	c := b.components()
+	if c.SecuritySchemes == nil {
+		c.SecuritySchemes = orderedmap.New[string, *v3.SecurityScheme]()
+	}
+	c.SecuritySchemes.Set(name, scheme)
+	return b
+}
+
+// Schema adds a schema to the components of the document. Use SchemaRef to refer to it.
+func (b *DocumentBuilder) Schema(name string, schema *base.Schema) *DocumentBuilder {
+	c := b.components()
+	if c.Schemas == nil {
+		c.Schemas = orderedmap.New[string, *base.SchemaProxy]()
+	}
+	c.Schemas.Set(name, base.CreateSchemaProxy(schema))
+	return b
+}
+
+// SchemaRef returns a reference to the schema called name. The schema does not have to exist yet, but it must exist
+// when the document is built.
+func (b *DocumentBuilder) SchemaRef(name string) *base.SchemaProxy {
+	return base.CreateSchemaProxyRef(b.ref(schemas, name))
+}
+
+// Parameter adds a parameter to the components of the document. Use ParameterRef to refer to it.
+func (b *DocumentBuilder) Parameter(name string, parameter *v3.Parameter) *DocumentBuilder {
+	c := b.components()
+	if c.Parameters == nil {
+		c.Parameters = orderedmap.New[string, *v3.Parameter]()
+	}
+	c.Parameters.Set(name, parameter)
+	return b
+}
+
+// ParameterRef returns a reference to the parameter called name, it must exist when the document is built.
+func (b *DocumentBuilder) ParameterRef(name string) *v3.Parameter {
+	return v3.NewParameter(&v3low.Parameter{Reference: &low.Reference{Reference: b.ref(parameters, name)}})
+}
+
+// Response adds a response to the components of the document. Use ResponseRef to refer to it.
+func (b *DocumentBuilder) Response(name string, response *v3.Response) *DocumentBuilder {
+	c := b.components()
+	if c.Responses == nil {
+		c.Responses = orderedmap.New[string, *v3.Response]()
+	}
+	c.Responses.Set(name, response)
+	return b
+}
+
+// ResponseRef returns a reference to the response called name, it must exist when the document is built.
+func (b *DocumentBuilder) ResponseRef(name string) *v3.Response {
+	return v3.NewResponse(&v3low.Response{Reference: &low.Reference{Reference: b.ref(responses, name)}})
+}
+
+// RequestBody adds a request body to the components of the document. Use RequestBodyRef to refer to it.
+func (b *DocumentBuilder) RequestBody(name string, requestBody *v3.RequestBody) *DocumentBuilder {
+	c := b.components()
+	if c.RequestBodies == nil {
+		c.RequestBodies = orderedmap.New[string, *v3.RequestBody]()
+	}
+	c.RequestBodies.Set(name, requestBody)
+	return b
+}
+
+// RequestBodyRef returns a reference to the request body called name, it must exist when the document is built.
+func (b *DocumentBuilder) RequestBodyRef(name string) *v3.RequestBody {
+	return v3.NewRequestBody(&v3low.RequestBody{Reference: &low.Reference{Reference: b.ref(requestBodies, name)}})
+}
+
+// AddPath adds a path to the document, and returns a PathBuilder for it. If the path has already been added, the
+// existing PathBuilder is returned.
+func (b *DocumentBuilder) AddPath(path string) *PathBuilder {
+	for _, p := range b.paths {
+		if p.path == path {
+			return p
+		}
+	}
+	if b.doc.Paths == nil {
+		b.doc.Paths = &v3.Paths{PathItems: orderedmap.New[string, *v3.PathItem]()}
+	}
+	p := &PathBuilder{document: b, path: path, item: &v3.PathItem{}}
+	b.doc.Paths.PathItems.Set(path, p.item)
+	b.paths = append(b.paths, p)
+	return p
+}
+
+// Build checks the document and returns it. If there are any problems with the document, they are returned and the
+// document is nil.
+//
+// The document has no low-level model, it can be rendered (using Render) and then re-loaded as a regular document.
+//
+// The document returned is the one held by the builder, not a copy. A builder must not be used again after Build
+// returns a document, as any further changes made by the builder would also change the document.
+func (b *DocumentBuilder) Build() (*v3.Document, []error) {
+	errs := append([]error(nil), b.errs...)
+	if b.doc.Version == "" {
+		errs = append(errs, fmt.Errorf("the OpenAPI version is required"))
+	}
+	if b.doc.Info.Title == "" {
+		errs = append(errs, fmt.Errorf("info: a title is required"))
+	}
+	if b.doc.Info.Version == "" {
+		errs = append(errs, fmt.Errorf("info: a version is required"))
+	}
+	for _, p := range b.paths {
+		errs = append(errs, p.validate()...)
+	}
+	errs = append(errs, b.validateSecurity("security", b.doc.Security)...)
+
+	operationIds := make(map[string]string)
+	for _, p := range b.paths {
+		for _, o := range p.operations {
+			if o.operation.OperationId == "" {
+				continue
+			}
+			if seen, ok := operationIds[o.operation.OperationId]; ok {
+				errs = append(errs, fmt.Errorf("%s: the operationId '%s' is already used by %s",
+					o.location(), o.operation.OperationId, seen))
+				continue
+			}
+			operationIds[o.operation.OperationId] = o.location()
+			errs = append(errs, b.validateSecurity(o.location()+": security", o.operation.Security)...)
+		}
+	}
+
+	seen := make(map[componentRef]bool)
+	for _, r := range b.refs {
+		if seen[r] {
+			continue
+		}
+		seen[r] = true
+		if !b.hasComponent(r) {
+			errs = append(errs, fmt.Errorf("reference '%s' does not point to a component, '%s' has not been added",
+				r, r.name))
+		}
+	}
+	if len(errs) > 0 {
+		return nil, errs
+	}
+	return b.doc, nil
+}
+
+// ref records a generated reference, so it can be checked when the document is built.
+func (b *DocumentBuilder) ref(section, name string) string {
+	r := componentRef{section: section, name: name}
+	b.refs = append(b.refs, r)
+	return r.String()
+}
+
+func (b *DocumentBuilder) components() *v3.Components {
+	if b.doc.Components == nil {
+		b.doc.Components = &v3.Components{}
+	}
+	return b.doc.Components
+}
+
+func (b *DocumentBuilder) hasComponent(r componentRef) bool {
+	c := b.doc.Components
+	if c == nil {
+		return false
+	}
+	switch r.section {
+	case schemas:
+		_, ok := c.Schemas.Get(r.name)
+		return ok
+	case parameters:
+		_, ok := c.Parameters.Get(r.name)
+		return ok
+	case responses:
+		_, ok := c.Responses.Get(r.name)
+		return ok
+	case requestBodies:
+		_, ok := c.RequestBodies.Get(r.name)
+		return ok
+	}
+	return false
+}
+
+// resolveParameter returns the component a parameter refers to, or the parameter itself if it's not a reference.
+func (b *DocumentBuilder) resolveParameter(p *v3.Parameter) *v3.Parameter {
+	if p.GoLow() == nil || !p.GoLow().IsReference() || b.doc.Components == nil {
+		return p
+	}
+	name := pointerUnescaper.Replace(strings.TrimPrefix(p.GoLow().GetReference(), "#/components/parameters/"))
+	if resolved, ok := b.doc.Components.Parameters.Get(name); ok {
+		return resolved
+	}
+	return p
+}
+
+func (b *DocumentBuilder) validateSecurity(location string, requirements []*base.SecurityRequirement) []error {
+	var errs []error
+	for _, r := range requirements {
+		for _, name := range r.Requirements.Keys() {
+			var ok bool
+			if b.doc.Components != nil {
+				_, ok = b.doc.Components.SecuritySchemes.Get(name)
+			}
+			if !ok {
+				errs = append(errs, fmt.Errorf("%s: the security scheme '%s' has not been added", location, name))
+			}
+		}
+	}
+	return errs
+}
+
+func securityRequirement(name string, scopes []string) *base.SecurityRequirement {
+	if scopes == nil {
+		scopes = []string{}
+	}
+	return &base.SecurityRequirement{Requirements: orderedmap.FromPairs(orderedmap.Pair[string, []string]{
+		Key: name, Value: scopes})}
+}
+
+// StringSchema returns a new schema with a type of string.
+func StringSchema() *base.SchemaProxy {
+	return base.CreateSchemaProxy(&base.Schema{Type: []string{"string"}})
+}
+
+// IntegerSchema returns a new schema with a type of integer.
+func IntegerSchema() *base.SchemaProxy {
+	return base.CreateSchemaProxy(&base.Schema{Type: []string{"integer"}})
+}
+
+// ArraySchema returns a new schema with a type of array, containing items.
+func ArraySchema(items *base.SchemaProxy) *base.SchemaProxy {
+	return base.CreateSchemaProxy(&base.Schema{Type: []string{"array"},
+		Items: &base.DynamicValue[*base.SchemaProxy, bool]{A: items}})
+}
@@ -0,0 +1,268 @@
+// Copyright 2023 Princess B33f Heavy Industries / Dave Shanley
+// SPDX-License-Identifier: MIT
+
+package builder
+
+import (
+	"testing"
+
+	"github.com/pb33f/libopenapi"
+	"github.com/pb33f/libopenapi/datamodel"
+	"github.com/pb33f/libopenapi/datamodel/high/base"
+	v3 "github.com/pb33f/libopenapi/datamodel/high/v3"
+	"github.com/pb33f/libopenapi/orderedmap"
+	"github.com/stretchr/testify/assert"
+)
+
+func petStore() *DocumentBuilder {
+	b := NewDocumentBuilder().
+		Info("Pet Store", "1.0.0").
+		Description("all the pets").
+		Server("https://pets.pb33f.io", "production").
+		Tag("pets", "everything about pets").
+		SecurityScheme("apiKey", &v3.SecurityScheme{Type: "apiKey", Name: "X-API-Key", In: "header"}).
+		Security("apiKey").
+		Schema("Pet", &base.Schema{
+			Type:     []string{"object"},
+			Required: []string{"name"},
+			Properties: orderedmap.FromPairs(
+				orderedmap.Pair[string, *base.SchemaProxy]{Key: "name", Value: StringSchema()},
+				orderedmap.Pair[string, *base.SchemaProxy]{Key: "age", Value: IntegerSchema()},
+			),
+		}).
+		Parameter("petId", &v3.Parameter{Name: "petId", In: "path", Required: true, Schema: StringSchema()}).
+		Response("NotFound", &v3.Response{Description: "the pet does not exist"})
+
+	b.AddPath("/pets").
+		Get("listPets").
+		Summary("list all the pets").
+		Tags("pets").
+		QueryParameter("limit", false, IntegerSchema()).
+		JSONResponse(200, "all the pets", ArraySchema(b.SchemaRef("Pet"))).
+		Path().
+		Post("createPet").
+		Tags("pets").
+		JSONRequestBody(b.SchemaRef("Pet")).
+		JSONResponse(201, "the new pet", b.SchemaRef("Pet"))
+
+	b.AddPath("/pets/{petId}").
+		Parameter(b.ParameterRef("petId")).
+		Get("getPet").
+		JSONResponse(200, "a pet", b.SchemaRef("Pet")).
+		Response(404, b.ResponseRef("NotFound")).
+		Path().
+		Delete("deletePet").
+		Deprecated().
+		JSONResponse(204, "the pet has been deleted", nil).
+		Response(404, b.ResponseRef("NotFound"))
+	return b
+}
+
+func TestDocumentBuilder(t *testing.T) {
+	doc, errs := petStore().Build()
+	assert.Empty(t, errs)
+
+	rendered, err := doc.Render()
+	assert.NoError(t, err)
+	assert.Equal(t, `openapi: 3.1.0
+info:
+    title: Pet Store
+    description: all the pets
+    version: 1.0.0
+servers:
+    - url: https://pets.pb33f.io
+      description: production
+paths:
+    /pets:
+        get:
+            tags:
+                - pets
+            summary: list all the pets
+            operationId: listPets
+            parameters:
+                - name: limit
+                  in: query
+                  schema:
+                    type: integer
+            responses:
+                "200":
+                    description: all the pets
+                    content:
+                        application/json:
+                            schema:
+                                type: array
+                                items:
+                                    $ref: '#/components/schemas/Pet'
+        post:
+            tags:
+                - pets
+            operationId: createPet
+            requestBody:
+                content:
+                    application/json:
+                        schema:
+                            $ref: '#/components/schemas/Pet'
+                required: true
+            responses:
+                "201":
+                    description: the new pet
+                    content:
+                        application/json:
+                            schema:
+                                $ref: '#/components/schemas/Pet'
+    /pets/{petId}:
+        get:
+            operationId: getPet
+            responses:
+                "200":
+                    description: a pet
+                    content:
+                        application/json:
+                            schema:
+                                $ref: '#/components/schemas/Pet'
+                "404":
+                    $ref: '#/components/responses/NotFound'
+        delete:
+            operationId: deletePet
+            responses:
+                "204":
+                    description: the pet has been deleted
+                "404":
+                    $ref: '#/components/responses/NotFound'
+            deprecated: true
+        parameters:
+            - $ref: '#/components/parameters/petId'
+components:
+    schemas:
+        Pet:
+            type: object
+            properties:
+                name:
+                    type: string
+                age:
+                    type: integer
+            required:
+                - name
+    responses:
+        NotFound:
+            description: the pet does not exist
+    parameters:
+        petId:
+            name: petId
+            in: path
+            required: true
+            schema:
+                type: string
+    securitySchemes:
+        apiKey:
+            type: apiKey
+            name: X-API-Key
+            in: header
+security:
+    - apiKey: []
+tags:
+    - name: pets
+      description: everything about pets
+`, string(rendered))
+
+	// the rendered document is valid, and can be reloaded.
+	info, _ := datamodel.ExtractSpecInfo(rendered)
+	violations, err := datamodel.ValidateSpecInfo(info)
+	assert.NoError(t, err)
+	assert.Empty(t, violations)
+
+	reloaded, err := libopenapi.NewDocument(rendered)
+	assert.NoError(t, err)
+	model, errs := reloaded.BuildV3Model()
+	assert.Empty(t, errs)
+	pet := model.Model.Paths.PathItems.GetOrZero("/pets/{petId}").Get.Responses.Codes.GetOrZero("200").
+		Content.GetOrZero(JSONMediaType).Schema.Schema()
+	assert.Equal(t, []string{"name", "age"}, pet.Properties.Keys())
+}
+
+func TestDocumentBuilder_Version(t *testing.T) {
+	b := NewDocumentBuilder().Version("3.0.3").Info("pets", "1")
+	b.AddPath("/pets").Operation("options", "petOptions").JSONResponse(204, "no content", nil)
+	doc, errs := b.Build()
+	assert.Empty(t, errs)
+	assert.Equal(t, "3.0.3", doc.Version)
+	assert.Equal(t, "petOptions", doc.Paths.PathItems.GetOrZero("/pets").Options.OperationId)
+
+	// adding a path again returns the same builder.
+	assert.Same(t, b.AddPath("/pets"), b.AddPath("/pets").Get("getPets").Document().AddPath("/pets"))
+}
+
+func TestDocumentBuilder_EscapedReferences(t *testing.T) {
+	b := NewDocumentBuilder().Info("pets", "1").
+		Schema("pets/Dog", &base.Schema{Type: []string{"object"}}).
+		Parameter("pet/id", &v3.Parameter{Name: "petId", In: "path", Required: true, Schema: StringSchema()})
+	b.AddPath("/pets/{petId}").
+		Parameter(b.ParameterRef("pet/id")).
+		Get("getPet").
+		JSONResponse(200, "a dog", b.SchemaRef("pets/Dog"))
+	assert.Equal(t, "#/components/schemas/Dog~0Cat~1Bird", b.SchemaRef("Dog~Cat/Bird").GetReference())
+	b.Schema("Dog~Cat/Bird", &base.Schema{})
+
+	doc, errs := b.Build()
+	assert.Empty(t, errs)
+	assert.Equal(t, "#/components/schemas/pets~1Dog", doc.Paths.PathItems.GetOrZero("/pets/{petId}").Get.
+		Responses.Codes.GetOrZero("200").Content.GetOrZero(JSONMediaType).Schema.GetReference())
+
+	// the escaped references resolve when the document is reloaded.
+	rendered, err := doc.Render()
+	assert.NoError(t, err)
+	reloaded, err := libopenapi.NewDocument(rendered)
+	assert.NoError(t, err)
+	model, errs := reloaded.BuildV3Model()
+	assert.Empty(t, errs)
+	item := model.Model.Paths.PathItems.GetOrZero("/pets/{petId}")
+	assert.Equal(t, "petId", item.Parameters[0].Name)
+	assert.Equal(t, []string{"object"}, item.Get.Responses.Codes.GetOrZero("200").Content.GetOrZero(JSONMediaType).
+		Schema.Schema().Type)
+}
+
+func TestDocumentBuilder_Build_Errors(t *testing.T) {
+	b := NewDocumentBuilder().Version("").Security("oauth").Extension("nope", true)
+	b.AddPath("pets/{petId}/{toyId}").
+		Parameter(&v3.Parameter{Name: "toyId", In: "path"}).
+		Get("getPet").
+		Parameter(&v3.Parameter{Name: "petId"}).
+		Security("basic").
+		Response(200, &v3.Response{}).
+		DefaultResponse(b.ResponseRef("Error"))
+	b.AddPath("/pets").
+		Get("getPet").
+		Path().
+		Operation("fetch", "fetchPets")
+	b.AddPath("/dogs").Get("").JSONResponse(200, "dogs", b.SchemaRef("Dog")).Response(404, nil)
+	b.AddPath("/cats").Get("").Response(200, b.ResponseRef("Cat")).DefaultResponse(&v3.Response{})
+
+	doc, errs := b.Build()
+	assert.Nil(t, doc)
+
+	var messages []string
+	for _, err := range errs {
+		messages = append(messages, err.Error())
+	}
+	assert.Equal(t, []string{
+		"extension 'nope' must start with 'x-'",
+		"/pets: 'fetch' is not an HTTP method that can be used by an operation",
+		"the OpenAPI version is required",
+		"info: a title is required",
+		"info: a version is required",
+		"pets/{petId}/{toyId}: a path must start with a '/'",
+		"pets/{petId}/{toyId}: path parameter 'toyId' must be required",
+		"GET pets/{petId}/{toyId}: parameter 0 requires a name and a location ('in')",
+		"GET pets/{petId}/{toyId}: path parameter 'petId' has not been defined",
+		"GET pets/{petId}/{toyId}: response '200' requires a description",
+		"GET /pets: at least one response is required",
+		"GET /dogs: response '404' is nil",
+		"GET /cats: the default response requires a description",
+		"security: the security scheme 'oauth' has not been added",
+		"GET pets/{petId}/{toyId}: security: the security scheme 'basic' has not been added",
+		"GET /pets: the operationId 'getPet' is already used by GET pets/{petId}/{toyId}",
+		"reference '#/components/responses/Error' does not point to a component, 'Error' has not been added",
+		"reference '#/components/schemas/Dog' does not point to a component, 'Dog' has not been added",
+		"reference '#/components/responses/Cat' does not point to a component, 'Cat' has not been added",
+	}, messages)
+}
@@ -0,0 +1,294 @@
+// Copyright 2023 Princess B33f Heavy Industries / Dave Shanley
+// SPDX-License-Identifier: MIT
+
+package builder
+
+import (
+	"fmt"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/pb33f/libopenapi/datamodel/high/base"
+	v3 "github.com/pb33f/libopenapi/datamodel/high/v3"
+	"github.com/pb33f/libopenapi/orderedmap"
+)
+
+// JSONMediaType is the media type used by JSONRequestBody and JSONResponse.
+const JSONMediaType = "application/json"
+
+var pathTemplate = regexp.MustCompile(`{([^{}]+)}`)
+
+// PathBuilder builds a single path of a document, created using DocumentBuilder.AddPath.
+type PathBuilder struct {
+	document   *DocumentBuilder
+	path       string
+	item       *v3.PathItem
+	operations []*OperationBuilder
+}
+
+// Summary sets the summary of the path.
+func (p *PathBuilder) Summary(summary string) *PathBuilder {
+	p.item.Summary = summary
+	return p
+}
+
+// Description sets the description of the path.
+func (p *PathBuilder) Description(description string) *PathBuilder {
+	p.item.Description = description
+	return p
+}
+
+// Parameter adds a parameter that is shared by every operation of the path.
+func (p *PathBuilder) Parameter(parameter *v3.Parameter) *PathBuilder {
+	p.item.Parameters = append(p.item.Parameters, parameter)
+	return p
+}
+
+// Get adds a GET operation to the path, and returns an OperationBuilder for it.
+func (p *PathBuilder) Get(operationId string) *OperationBuilder {
+	return p.Operation(http.MethodGet, operationId)
+}
+
+// Put adds a PUT operation to the path, and returns an OperationBuilder for it.
+func (p *PathBuilder) Put(operationId string) *OperationBuilder {
+	return p.Operation(http.MethodPut, operationId)
+}
+
+// Post adds a POST operation to the path, and returns an OperationBuilder for it.
+func (p *PathBuilder) Post(operationId string) *OperationBuilder {
+	return p.Operation(http.MethodPost, operationId)
+}
+
+// Delete adds a DELETE operation to the path, and returns an OperationBuilder for it.
+func (p *PathBuilder) Delete(operationId string) *OperationBuilder {
+	return p.Operation(http.MethodDelete, operationId)
+}
+
+// Patch adds a PATCH operation to the path, and returns an OperationBuilder for it.
+func (p *PathBuilder) Patch(operationId string) *OperationBuilder {
+	return p.Operation(http.MethodPatch, operationId)
+}
+
+// Operation adds an operation for an HTTP method (like GET or OPTIONS) to the path, and returns an
+// OperationBuilder for it. If the path already has an operation for the method, it is replaced.
+func (p *PathBuilder) Operation(method, operationId string) *OperationBuilder {
+	o := &OperationBuilder{path: p, method: strings.ToUpper(method),
+		operation: &v3.Operation{OperationId: operationId}}
+	switch o.method {
+	case http.MethodGet:
+		p.item.Get = o.operation
+	case http.MethodPut:
+		p.item.Put = o.operation
+	case http.MethodPost:
+		p.item.Post = o.operation
+	case http.MethodDelete:
+		p.item.Delete = o.operation
+	case http.MethodOptions:
+		p.item.Options = o.operation
+	case http.MethodHead:
+		p.item.Head = o.operation
+	case http.MethodPatch:
+		p.item.Patch = o.operation
+	case http.MethodTrace:
+		p.item.Trace = o.operation
+	default:
+		p.document.errs = append(p.document.errs, fmt.Errorf("%s: '%s' is not an HTTP method that can be used by "+
+			"an operation", p.path, method))
+		return o
+	}
+	for i := range p.operations {
+		if p.operations[i].method == o.method {
+			p.operations[i] = o
+			return o
+		}
+	}
+	p.operations = append(p.operations, o)
+	return o
+}
+
+// Document returns the DocumentBuilder the path belongs to.
+func (p *PathBuilder) Document() *DocumentBuilder {
+	return p.document
+}
+
+func (p *PathBuilder) validate() []error {
+	var errs []error
+	if !strings.HasPrefix(p.path, "/") {
+		errs = append(errs, fmt.Errorf("%s: a path must start with a '/'", p.path))
+	}
+	errs = append(errs, p.validateParameters(p.path, p.item.Parameters)...)
+	for _, o := range p.operations {
+		errs = append(errs, o.validate()...)
+	}
+	return errs
+}
+
+// validateParameters checks every parameter has a name and location, and that path parameters are required.
+func (p *PathBuilder) validateParameters(location string, params []*v3.Parameter) []error {
+	var errs []error
+	for i := range params {
+		param := p.document.resolveParameter(params[i])
+		if param.GoLow() != nil && param.GoLow().IsReference() {
+			continue // the reference is checked when the document is built.
+		}
+		if param.Name == "" || param.In == "" {
+			errs = append(errs, fmt.Errorf("%s: parameter %d requires a name and a location ('in')", location, i))
+			continue
+		}
+		if param.In == "path" && !param.Required {
+			errs = append(errs, fmt.Errorf("%s: path parameter '%s' must be required", location, param.Name))
+		}
+	}
+	return errs
+}
+
+// OperationBuilder builds a single operation of a path, created using one of the methods of a PathBuilder.
+type OperationBuilder struct {
+	path      *PathBuilder
+	method    string
+	operation *v3.Operation
+}
+
+// Summary sets the summary of the operation.
+func (o *OperationBuilder) Summary(summary string) *OperationBuilder {
+	o.operation.Summary = summary
+	return o
+}
+
+// Description sets the description of the operation.
+func (o *OperationBuilder) Description(description string) *OperationBuilder {
+	o.operation.Description = description
+	return o
+}
+
+// Tags adds tags to the operation.
+func (o *OperationBuilder) Tags(tags ...string) *OperationBuilder {
+	o.operation.Tags = append(o.operation.Tags, tags...)
+	return o
+}
+
+// Deprecated marks the operation as deprecated.
+func (o *OperationBuilder) Deprecated() *OperationBuilder {
+	deprecated := true
+	o.operation.Deprecated = &deprecated
+	return o
+}
+
+// Parameter adds a parameter to the operation.
+func (o *OperationBuilder) Parameter(parameter *v3.Parameter) *OperationBuilder {
+	o.operation.Parameters = append(o.operation.Parameters, parameter)
+	return o
+}
+
+// PathParameter adds a (required) path parameter to the operation.
+func (o *OperationBuilder) PathParameter(name string, schema *base.SchemaProxy) *OperationBuilder {
+	return o.Parameter(&v3.Parameter{Name: name, In: "path", Required: true, Schema: schema})
+}
+
+// QueryParameter adds a query parameter to the operation.
+func (o *OperationBuilder) QueryParameter(name string, required bool, schema *base.SchemaProxy) *OperationBuilder {
+	return o.Parameter(&v3.Parameter{Name: name, In: "query", Required: required, Schema: schema})
+}
+
+// RequestBody sets the request body of the operation.
+func (o *OperationBuilder) RequestBody(requestBody *v3.RequestBody) *OperationBuilder {
+	o.operation.RequestBody = requestBody
+	return o
+}
+
+// JSONRequestBody sets a required request body for the operation, using the JSONMediaType.
+func (o *OperationBuilder) JSONRequestBody(schema *base.SchemaProxy) *OperationBuilder {
+	required := true
+	return o.RequestBody(&v3.RequestBody{Required: &required, Content: jsonContent(schema)})
+}
+
+// Response adds a response to the operation for an HTTP status code.
+func (o *OperationBuilder) Response(code int, response *v3.Response) *OperationBuilder {
+	if o.operation.Responses == nil {
+		o.operation.Responses = &v3.Responses{Codes: orderedmap.New[string, *v3.Response]()}
+	}
+	o.operation.Responses.Codes.Set(strconv.Itoa(code), response)
+	return o
+}
+
+// JSONResponse adds a response to the operation for an HTTP status code, using the JSONMediaType. If schema is nil,
+// the response has no content.
+func (o *OperationBuilder) JSONResponse(code int, description string, schema *base.SchemaProxy) *OperationBuilder {
+	response := &v3.Response{Description: description}
+	if schema != nil {
+		response.Content = jsonContent(schema)
+	}
+	return o.Response(code, response)
+}
+
+// DefaultResponse sets the default response of the operation, used for status codes without a response.
+func (o *OperationBuilder) DefaultResponse(response *v3.Response) *OperationBuilder {
+	if o.operation.Responses == nil {
+		o.operation.Responses = &v3.Responses{Codes: orderedmap.New[string, *v3.Response]()}
+	}
+	o.operation.Responses.Default = response
+	return o
+}
+
+// Security adds a security requirement to the operation, using the security scheme called name.
+func (o *OperationBuilder) Security(name string, scopes ...string) *OperationBuilder {
+	o.operation.Security = append(o.operation.Security, securityRequirement(name, scopes))
+	return o
+}
+
+// Path returns the PathBuilder the operation belongs to.
+func (o *OperationBuilder) Path() *PathBuilder {
+	return o.path
+}
+
+// Document returns the DocumentBuilder the operation belongs to.
+func (o *OperationBuilder) Document() *DocumentBuilder {
+	return o.path.document
+}
+
+func (o *OperationBuilder) location() string {
+	return fmt.Sprintf("%s %s", o.method, o.path.path)
+}
+
+func (o *OperationBuilder) validate() []error {
+	location := o.location()
+	errs := o.path.validateParameters(location, o.operation.Parameters)
+
+	// every parameter in the path template must be defined by the path, or the operation.
+	defined := make(map[string]bool)
+	for _, params := range [][]*v3.Parameter{o.path.item.Parameters, o.operation.Parameters} {
+		for _, p := range params {
+			if p = o.path.document.resolveParameter(p); p.In == "path" {
+				defined[p.Name] = true
+			}
+		}
+	}
+	for _, m := range pathTemplate.FindAllStringSubmatch(o.path.path, -1) {
+		if !defined[m[1]] {
+			errs = append(errs, fmt.Errorf("%s: path parameter '%s' has not been defined", location, m[1]))
+		}
+	}
+
+	responses := o.operation.Responses
+	if responses == nil || (responses.Codes.Len() == 0 && responses.Default == nil) {
+		return append(errs, fmt.Errorf("%s: at least one response is required", location))
+	}
+	for _, pair := range responses.Codes.Pairs() {
+		if r := pair.Value; r == nil {
+			errs = append(errs, fmt.Errorf("%s: response '%s' is nil", location, pair.Key))
+		} else if r.Description == "" && (r.GoLow() == nil || !r.GoLow().IsReference()) {
+			errs = append(errs, fmt.Errorf("%s: response '%s' requires a description", location, pair.Key))
+		}
+	}
+	if r := responses.Default; r != nil && r.Description == "" && (r.GoLow() == nil || !r.GoLow().IsReference()) {
+		errs = append(errs, fmt.Errorf("%s: the default response requires a description", location))
+	}
+	return errs
+}
+
+func jsonContent(schema *base.SchemaProxy) *orderedmap.Map[string, *v3.MediaType] {
+	return orderedmap.FromPairs(orderedmap.Pair[string, *v3.MediaType]{Key: JSONMediaType,
+		Value: &v3.MediaType{Schema: schema}})
+}
@@ -0,0 +1,595 @@
+// Copyright 2023 Princess B33f Heavy Industries / Dave Shanley
+// SPDX-License-Identifier: MIT
+
+// Package bundler turns a specification that is spread across multiple files (or remote locations) into a single,
+// self-contained document.
+//
+// Every object that is referenced from an external file is moved into the components of the root document (or
+// definitions, parameters and responses for Swagger) and every $ref is re-written to point to the new local
+// component. Circular references are kept as references, so bundling works with recursive schemas. Objects that
+// cannot live in components (like path items) are inlined where they are referenced.
+package bundler
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/pb33f/libopenapi/datamodel"
+	"github.com/pb33f/libopenapi/index"
+	"github.com/pb33f/libopenapi/utils"
+	"gopkg.in/yaml.v3"
+)
+
+// component sections that bundled objects can be moved into.
+const (
+	schemasSection         = "schemas"
+	parametersSection      = "parameters"
+	responsesSection       = "responses"
+	examplesSection        = "examples"
+	requestBodiesSection   = "requestBodies"
+	headersSection         = "headers"
+	securitySchemesSection = "securitySchemes"
+	linksSection           = "links"
+	callbacksSection       = "callbacks"
+)
+
+var openAPISections = map[string]bool{
+	schemasSection: true, parametersSection: true, responsesSection: true, examplesSection: true,
+	requestBodiesSection: true, headersSection: true, securitySchemesSection: true, linksSection: true,
+	callbacksSection: true,
+}
+
+// swagger only supports schemas, parameters and responses as re-usable objects, all at the root of the document.
+var swaggerSections = map[string]string{
+	schemasSection:    "definitions",
+	parametersSection: "parameters",
+	responsesSection:  "responses",
+}
+
+var invalidNameChars = regexp.MustCompile(`[^a-zA-Z0-9._-]`)
+
+// BundleBytes will index a specification using the supplied DocumentConfiguration, and then bundle every external
+// reference into a single document. The bundled document is returned as YAML, unless the specification was
+// supplied as JSON, in which case JSON is returned.
+//
+// File and remote references are only followed if AllowFileReferences and AllowRemoteReferences are enabled. If
+// no BasePath is set, then the current working directory (or the root of the FS) is used to resolve relative file
+// references.
+func BundleBytes(spec []byte, configuration *datamodel.DocumentConfiguration) ([]byte, error) {
+	info, err := datamodel.ExtractSpecInfo(spec)
+	if err != nil {
+		return nil, err
+	}
+	if configuration == nil {
+		configuration = datamodel.NewClosedDocumentConfiguration()
+	}
+	basePath := configuration.BasePath
+	if basePath == "" && configuration.FS == nil {
+		basePath, _ = os.Getwd()
+	}
+	idx := index.NewSpecIndexWithConfig(info.RootNode, &index.SpecIndexConfig{
+		BaseURL:           configuration.BaseURL,
+		BasePath:          basePath,
+		AllowFileLookup:   configuration.AllowFileReferences,
+		AllowRemoteLookup: configuration.AllowRemoteReferences,
+		FS:                configuration.FS,
+		RemoteFetcher:     configuration.RemoteFetcher,
+		ReferenceLimits:   configuration.ReferenceLimits,
+	})
+	bundled, err := BundleIndex(idx)
+	if err != nil {
+		return nil, err
+	}
+	if info.SpecFileType == datamodel.JSONFileType {
+		return utils.ConvertYAMLNodeToJSON(bundled, "")
+	}
+	return yaml.Marshal(bundled)
+}
+
+// BundleIndex will bundle the specification that was used to create the SpecIndex into a single document, and
+// returns the root node of the new document. The index (and the nodes it holds) are not modified, the bundled
+// document is a copy.
+//
+// The external files must have been located by the index (meaning file and/or remote lookups need to be allowed
+// in the configuration of the index), an error is returned for any reference that can't be found.
+func BundleIndex(idx *index.SpecIndex) (*yaml.Node, error) {
+	if idx == nil || idx.GetRootNode() == nil || len(idx.GetRootNode().Content) == 0 {
+		return nil, errors.New("unable to bundle, the index has no specification")
+	}
+	rootNode := copyNode(idx.GetRootNode())
+	docNode := rootNode
+	if docNode.Kind == yaml.DocumentNode {
+		docNode = docNode.Content[0]
+	}
+	if !utils.IsNodeMap(docNode) {
+		return nil, errors.New("unable to bundle, the specification is not an object")
+	}
+	b := &bundler{
+		swagger:  isSwagger(docNode),
+		used:     make(map[string]map[string]bool),
+		bundled:  make(map[string]string),
+		inlining: make(map[string]bool),
+		inPlace:  make(map[string]bool),
+	}
+	root := &location{index: idx}
+	b.collectExistingNames(docNode)
+	b.registerRootComponents(docNode, root)
+	if err := b.walk(docNode, root, nil); err != nil {
+		return nil, err
+	}
+	b.addComponents(docNode)
+	return rootNode, nil
+}
+
+// location is a file (or remote document) that is being bundled, along with the index that was created for it.
+type location struct {
+	index *index.SpecIndex
+	path  string // the location relative to the root document, empty for the root document itself.
+}
+
+// component is a bundled object waiting to be added to the root document.
+type component struct {
+	section string
+	name    string
+	node    *yaml.Node
+}
+
+type bundler struct {
+	swagger    bool
+	used       map[string]map[string]bool // component names (by section) that are taken.
+	bundled    map[string]string          // bundled targets, mapped to their new local reference.
+	inlining   map[string]bool            // targets currently being inlined, used to detect circular inlining.
+	inPlace    map[string]bool            // targets that replace the root component referencing them.
+	components []*component
+}
+
+// collectExistingNames records the names of components that already exist in the root document.
+func (b *bundler) collectExistingNames(docNode *yaml.Node) {
+	for section := range openAPISections {
+		if sectionNode := b.sectionNode(docNode, section); sectionNode != nil {
+			for i := 0; i < len(sectionNode.Content); i += 2 {
+				b.markUsed(section, sectionNode.Content[i].Value)
+			}
+		}
+	}
+}
+
+// registerRootComponents finds components in the root document that are nothing more than a reference to an
+// external object. That object replaces the reference, so it keeps the name of the component, and every other
+// reference to the same object points to it. This is how an exploded specification is put back together.
+func (b *bundler) registerRootComponents(docNode *yaml.Node, loc *location) {
+	for section := range openAPISections {
+		sectionNode := b.sectionNode(docNode, section)
+		if sectionNode == nil {
+			continue
+		}
+		for i := 0; i+1 < len(sectionNode.Content); i += 2 {
+			isRef, _, ref := utils.IsNodeRefValue(sectionNode.Content[i+1])
+			file, fragment := splitReference(ref)
+			if !isRef || file == "" || loc.index.GetAllExternalIndexes()[file] == nil {
+				continue
+			}
+			key := joinLocation(loc.path, file) + "#" + fragment
+			if _, ok := b.bundled[key]; !ok {
+				b.bundled[key] = b.localReference(section, sectionNode.Content[i].Value)
+				b.inPlace[key] = true
+			}
+		}
+	}
+}
+
+// sectionNode returns the map of components for a section in the root document, or nil if there isn't one.
+func (b *bundler) sectionNode(docNode *yaml.Node, section string) *yaml.Node {
+	var sectionNode *yaml.Node
+	if b.swagger {
+		if key, ok := swaggerSections[section]; ok {
+			_, sectionNode = utils.FindKeyNodeTop(key, docNode.Content)
+		}
+	} else if _, components := utils.FindKeyNodeTop("components", docNode.Content); components != nil {
+		_, sectionNode = utils.FindKeyNodeTop(section, components.Content)
+	}
+	if sectionNode == nil || !utils.IsNodeMap(sectionNode) {
+		return nil
+	}
+	return sectionNode
+}
+
+func (b *bundler) markUsed(section, name string) {
+	if b.used[section] == nil {
+		b.used[section] = make(map[string]bool)
+	}
+	b.used[section][name] = true
+}
+
+// walk visits every node, re-writing any references found along the way. The path is the list of keys (and
+// sequence indexes) that lead to the node, it's used to work out what kind of object a reference points to.
+func (b *bundler) walk(node *yaml.Node, loc *location, nodePath []string) error {
+	switch node.Kind {
+	case yaml.MappingNode:
+		// siblings of a $ref are walked first, as the reference may be replaced by an inlined object that has
+		// already been walked.
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			if node.Content[i].Value == "$ref" {
+				continue
+			}
+			if err := b.walk(node.Content[i+1], loc, appendPath(nodePath, node.Content[i].Value)); err != nil {
+				return err
+			}
+		}
+		if isRef, _, ref := utils.IsNodeRefValue(node); isRef {
+			return b.bundleReference(node, ref, loc, nodePath)
+		}
+	case yaml.SequenceNode:
+		for i, n := range node.Content {
+			if err := b.walk(n, loc, appendPath(nodePath, strconv.Itoa(i))); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// bundleReference re-writes a single reference. References local to the root document are left alone, anything
+// else is moved into components (or inlined if it can't be a component).
+func (b *bundler) bundleReference(node *yaml.Node, ref string, loc *location, nodePath []string) error {
+	file, fragment := splitReference(ref)
+	if file == "" && loc.path == "" {
+		return nil
+	}
+
+	target := loc
+	if file != "" {
+		external := loc.index.GetAllExternalIndexes()[file]
+		if external == nil {
+			return b.referenceError(ref, loc)
+		}
+		target = &location{index: external, path: joinLocation(loc.path, file)}
+	}
+	targetNode, err := locateFragment(target.index.GetRootNode(), fragment)
+	if err != nil {
+		return fmt.Errorf("unable to bundle reference '%s' (%s): %s", ref, describeLocation(loc), err.Error())
+	}
+	key := target.path + "#" + fragment
+
+	refValue := refValueNode(node)
+	if local, ok := b.bundled[key]; ok {
+		if b.inPlace[key] && local == "#/"+strings.Join(nodePath, "/") {
+			// this is the root component that references the object, so the object takes its place.
+			delete(b.inPlace, key)
+			inlined := copyNode(targetNode)
+			if err = b.walk(inlined, target, nodePath); err != nil {
+				return err
+			}
+			replaceReference(node, inlined)
+			return nil
+		}
+		refValue.Value = local
+		return nil
+	}
+
+	section, name := b.componentFor(fragment, target.path, nodePath)
+	if section == "" {
+		// this object can't be a component, so it has to be inlined.
+		if b.inlining[key] {
+			return fmt.Errorf("unable to bundle reference '%s' (%s): circular reference cannot be inlined",
+				ref, describeLocation(loc))
+		}
+		b.inlining[key] = true
+		defer delete(b.inlining, key)
+
+		inlined := copyNode(targetNode)
+		if err = b.walk(inlined, target, nodePath); err != nil {
+			return err
+		}
+		replaceReference(node, inlined)
+		return nil
+	}
+
+	name = b.uniqueName(section, name)
+	local := b.localReference(section, name)
+	b.bundled[key] = local
+	refValue.Value = local
+
+	// the copy is walked after the reference is recorded, so circular references resolve to the new component.
+	c := &component{section: section, name: name, node: copyNode(targetNode)}
+	b.components = append(b.components, c)
+	return b.walk(c.node, target, b.componentPath(section, name))
+}
+
+// referenceError explains why an external reference could not be bundled, using the index errors if available.
+func (b *bundler) referenceError(ref string, loc *location) error {
+	for _, e := range loc.index.GetReferenceIndexErrors() {
+		var ie *index.IndexingError
+		if errors.As(e, &ie) && (ie.Path == ref || strings.HasPrefix(ie.Path, ref)) {
+			return fmt.Errorf("unable to bundle reference '%s' (%s): %s", ref, describeLocation(loc), ie.Err.Error())
+		}
+	}
+	return fmt.Errorf("unable to bundle reference '%s' (%s): the reference has not been located by the index, "+
+		"check file and remote references are allowed", ref, describeLocation(loc))
+}
+
+// componentFor works out the section and name of a new component. If the fragment points to a component, then the
+// same section and name is used. Otherwise, the location of the reference is used to determine the section, and the
+// name comes from the last segment of the fragment (or the file name).
+func (b *bundler) componentFor(fragment, file string, nodePath []string) (string, string) {
+	segments := strings.Split(strings.TrimPrefix(fragment, "/"), "/")
+	var section, name string
+	switch {
+	case len(segments) == 3 && segments[0] == "components" && openAPISections[segments[1]]:
+		section, name = segments[1], unescapeSegment(segments[2])
+	case len(segments) == 2 && segments[0] == "definitions":
+		section, name = schemasSection, unescapeSegment(segments[1])
+	default:
+		section = referenceSection(nodePath)
+		if fragment != "" {
+			name = unescapeSegment(segments[len(segments)-1])
+		}
+	}
+	if name == "" {
+		base := path.Base(strings.ReplaceAll(file, "\\", "/"))
+		name = strings.TrimSuffix(base, path.Ext(base))
+	}
+	if b.swagger {
+		if _, ok := swaggerSections[section]; !ok {
+			section = ""
+		}
+	}
+	name = invalidNameChars.ReplaceAllString(name, "_")
+	if name == "" {
+		name = "component"
+	}
+	return section, name
+}
+
+// uniqueName returns a name that does not collide with any other component in the same section.
+func (b *bundler) uniqueName(section, name string) string {
+	candidate := name
+	for i := 1; b.used[section][candidate]; i++ {
+		candidate = fmt.Sprintf("%s_%d", name, i)
+	}
+	b.markUsed(section, candidate)
+	return candidate
+}
+
+func (b *bundler) componentPath(section, name string) []string {
+	if b.swagger {
+		return []string{swaggerSections[section], name}
+	}
+	return []string{"components", section, name}
+}
+
+func (b *bundler) localReference(section, name string) string {
+	return "#/" + strings.Join(b.componentPath(section, name), "/")
+}
+
+// addComponents adds every bundled component to the root document, creating sections as required.
+func (b *bundler) addComponents(docNode *yaml.Node) {
+	if len(b.components) == 0 {
+		return
+	}
+	parent := docNode
+	if !b.swagger {
+		parent = findOrCreateMap(docNode, "components")
+	}
+	for _, c := range b.components {
+		sectionKey := c.section
+		if b.swagger {
+			sectionKey = swaggerSections[c.section]
+		}
+		section := findOrCreateMap(parent, sectionKey)
+		section.Content = append(section.Content, utils.CreateStringNode(c.name), c.node)
+	}
+}
+
+// referenceSection uses the path to a reference to work out what kind of object it points to, returns an empty
+// string if the object can't be moved into components.
+func referenceSection(nodePath []string) string {
+	var last, parent string
+	if len(nodePath) > 0 {
+		last = nodePath[len(nodePath)-1]
+	}
+	if len(nodePath) > 1 {
+		parent = nodePath[len(nodePath)-2]
+	}
+	if len(nodePath) == 3 && nodePath[0] == "components" && openAPISections[nodePath[1]] {
+		return nodePath[1]
+	}
+	if len(nodePath) == 2 {
+		for section, key := range swaggerSections {
+			if nodePath[0] == key {
+				return section
+			}
+		}
+	}
+	switch parent {
+	case "properties", "patternProperties", "dependentSchemas", "$defs", "definitions",
+		"allOf", "anyOf", "oneOf", "prefixItems":
+		return schemasSection
+	}
+	switch last {
+	case "schema", "items", "not", "additionalProperties", "contains", "if", "then", "else", "propertyNames",
+		"unevaluatedItems", "unevaluatedProperties", "additionalItems", "contentSchema":
+		return schemasSection
+	case "requestBody":
+		return requestBodiesSection
+	}
+	switch parent {
+	case "parameters":
+		return parametersSection
+	case "responses":
+		return responsesSection
+	case "headers":
+		return headersSection
+	case "examples":
+		return examplesSection
+	case "links":
+		return linksSection
+	case "callbacks":
+		return callbacksSection
+	case "securitySchemes":
+		return securitySchemesSection
+	}
+	return ""
+}
+
+// splitReference splits a reference into the file (or URL) and the JSON Pointer fragment.
+func splitReference(ref string) (string, string) {
+	file, fragment, _ := strings.Cut(ref, "#")
+	return file, fragment
+}
+
+// joinLocation resolves a file reference against the location of the document it was found in.
+func joinLocation(base, file string) string {
+	if strings.HasPrefix(file, "http://") || strings.HasPrefix(file, "https://") {
+		return file
+	}
+	if strings.HasPrefix(base, "http://") || strings.HasPrefix(base, "https://") {
+		if b, err := url.Parse(base); err == nil {
+			if f, err := url.Parse(file); err == nil {
+				return b.ResolveReference(f).String()
+			}
+		}
+		return file
+	}
+	file = strings.TrimPrefix(file, "file:")
+	if filepath.IsAbs(file) {
+		return filepath.ToSlash(filepath.Clean(file))
+	}
+	return filepath.ToSlash(filepath.Clean(filepath.Join(filepath.Dir(base), file)))
+}
+
+func describeLocation(loc *location) string {
+	if loc.path == "" {
+		return "root document"
+	}
+	return loc.path
+}
+
+// locateFragment follows a JSON Pointer fragment from the root of a document.
+func locateFragment(root *yaml.Node, fragment string) (*yaml.Node, error) {
+	if root == nil {
+		return nil, errors.New("the document is empty")
+	}
+	node := root
+	if node.Kind == yaml.DocumentNode && len(node.Content) > 0 {
+		node = node.Content[0]
+	}
+	if fragment == "" || fragment == "/" {
+		return node, nil
+	}
+	for _, segment := range strings.Split(strings.TrimPrefix(fragment, "/"), "/") {
+		segment = unescapeSegment(segment)
+		var next *yaml.Node
+		switch node.Kind {
+		case yaml.MappingNode:
+			for i := 0; i+1 < len(node.Content); i += 2 {
+				if node.Content[i].Value == segment {
+					next = node.Content[i+1]
+					break
+				}
+			}
+		case yaml.SequenceNode:
+			if i, err := strconv.Atoi(segment); err == nil && i >= 0 && i < len(node.Content) {
+				next = node.Content[i]
+			}
+		}
+		if next == nil {
+			return nil, fmt.Errorf("'%s' cannot be found", fragment)
+		}
+		node = next
+	}
+	return node, nil
+}
+
+// unescapeSegment decodes a single JSON Pointer segment.
+func unescapeSegment(segment string) string {
+	if s, err := url.PathUnescape(segment); err == nil {
+		segment = s
+	}
+	segment = strings.ReplaceAll(segment, "~1", "/")
+	return strings.ReplaceAll(segment, "~0", "~")
+}
+
+// refValueNode returns the value node of the $ref key in a mapping node.
+func refValueNode(node *yaml.Node) *yaml.Node {
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value == "$ref" {
+			return node.Content[i+1]
+		}
+	}
+	return nil
+}
+
+// replaceReference replaces a reference with an inlined object. Any siblings of the $ref are kept, and take
+// precedence over the inlined values.
+func replaceReference(node, inlined *yaml.Node) {
+	siblings := make(map[string]bool)
+	var content []*yaml.Node
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value != "$ref" {
+			siblings[node.Content[i].Value] = true
+			content = append(content, node.Content[i], node.Content[i+1])
+		}
+	}
+	if inlined.Kind != yaml.MappingNode {
+		*node = *inlined
+		return
+	}
+	var merged []*yaml.Node
+	for i := 0; i+1 < len(inlined.Content); i += 2 {
+		if !siblings[inlined.Content[i].Value] {
+			merged = append(merged, inlined.Content[i], inlined.Content[i+1])
+		}
+	}
+	node.Content = append(merged, content...)
+	node.Style = inlined.Style
+	node.Tag = inlined.Tag
+}
+
+// findOrCreateMap locates a map by key inside a mapping node, creating it (at the end) if it does not exist.
+func findOrCreateMap(parent *yaml.Node, key string) *yaml.Node {
+	for i := 0; i+1 < len(parent.Content); i += 2 {
+		if parent.Content[i].Value == key {
+			if parent.Content[i+1].Kind != yaml.MappingNode {
+				parent.Content[i+1] = utils.CreateEmptyMapNode()
+			}
+			return parent.Content[i+1]
+		}
+	}
+	m := utils.CreateEmptyMapNode()
+	parent.Content = append(parent.Content, utils.CreateStringNode(key), m)
+	return m
+}
+
+// copyNode creates a deep copy of a yaml.Node, so the nodes held by the index are never modified.
+func copyNode(node *yaml.Node) *yaml.Node {
+	if node == nil {
+		return nil
+	}
+	c := *node
+	if node.Content != nil {
+		c.Content = make([]*yaml.Node, len(node.Content))
+		for i := range node.Content {
+			c.Content[i] = copyNode(node.Content[i])
+		}
+	}
+	return &c
+}
+
+func isSwagger(docNode *yaml.Node) bool {
+	k, _ := utils.FindKeyNodeTop("swagger", docNode.Content)
+	return k != nil
+}
+
+func appendPath(nodePath []string, segment string) []string {
+	p := make([]string, len(nodePath), len(nodePath)+1)
+	copy(p, nodePath)
+	return append(p, segment)
+}
@@ -0,0 +1,260 @@
+// Copyright 2023 Princess B33f Heavy Industries / Dave Shanley
+// SPDX-License-Identifier: MIT
+
+package bundler
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/pb33f/libopenapi/datamodel"
+	v3 "github.com/pb33f/libopenapi/datamodel/low/v3"
+	"github.com/pb33f/libopenapi/index"
+	"github.com/pb33f/libopenapi/utils"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/yaml.v3"
+)
+
+// writeFiles creates a temporary directory containing the supplied files, and returns the directory.
+func writeFiles(t *testing.T, files map[string]string) string {
+	dir := t.TempDir()
+	for name, content := range files {
+		p := filepath.Join(dir, name)
+		assert.NoError(t, os.MkdirAll(filepath.Dir(p), 0o755))
+		assert.NoError(t, os.WriteFile(p, []byte(content), 0o644))
+	}
+	return dir
+}
+
+// collectRefs returns every $ref value found in a node.
+func collectRefs(node *yaml.Node) []string {
+	var refs []string
+	if isRef, _, ref := utils.IsNodeRefValue(node); isRef && node.Kind == yaml.MappingNode {
+		refs = append(refs, ref)
+	}
+	for _, n := range node.Content {
+		refs = append(refs, collectRefs(n)...)
+	}
+	return refs
+}
+
+func bundleFixture(t *testing.T) *yaml.Node {
+	spec, _ := os.ReadFile("../test_specs/bundle/openapi.yaml")
+	basePath, _ := filepath.Abs("../test_specs/bundle")
+	bundled, err := BundleBytes(spec, &datamodel.DocumentConfiguration{
+		BasePath:            basePath,
+		AllowFileReferences: true,
+	})
+	assert.NoError(t, err)
+	var node yaml.Node
+	assert.NoError(t, yaml.Unmarshal(bundled, &node))
+	return &node
+}
+
+func TestBundleBytes(t *testing.T) {
+	node := bundleFixture(t)
+
+	refs := collectRefs(node)
+	assert.Equal(t, []string{
+		"#/components/schemas/burger_1",
+		"#/components/parameters/BurgerId",
+		"#/components/schemas/burger_1",
+		"#/components/responses/NotFound",
+		"#/components/schemas/Fries",
+		"#/components/schemas/ingredient",
+		"#/components/schemas/burger_1",
+		"#/components/schemas/Error",
+	}, refs)
+
+	// the path item was inlined.
+	_, _, paths := utils.FindKeyNodeFullTop("paths", node.Content[0].Content)
+	_, _, burgers := utils.FindKeyNodeFullTop("/burgers", paths.Content)
+	_, _, get := utils.FindKeyNodeFullTop("get", burgers.Content)
+	_, _, opId := utils.FindKeyNodeFullTop("operationId", get.Content)
+	assert.Equal(t, "listBurgers", opId.Value)
+
+	// the existing 'burger' schema is not touched.
+	_, _, components := utils.FindKeyNodeFullTop("components", node.Content[0].Content)
+	_, _, schemas := utils.FindKeyNodeFullTop("schemas", components.Content)
+	_, _, burger := utils.FindKeyNodeFullTop("burger", schemas.Content)
+	_, _, burgerType := utils.FindKeyNodeFullTop("type", burger.Content)
+	assert.Equal(t, "string", burgerType.Value)
+
+	// the result is a valid, self-contained document.
+	info, _ := datamodel.ExtractSpecInfo(mustMarshal(t, node))
+	doc, errs := v3.CreateDocumentFromConfig(info, datamodel.NewClosedDocumentConfiguration())
+	assert.Empty(t, errs)
+	assert.Len(t, doc.Components.Value.Schemas.Value, 5)
+}
+
+func TestBundleIndex_DoesNotModifyIndex(t *testing.T) {
+	spec, _ := os.ReadFile("../test_specs/bundle/openapi.yaml")
+	basePath, _ := filepath.Abs("../test_specs/bundle")
+	var root yaml.Node
+	_ = yaml.Unmarshal(spec, &root)
+
+	idx := index.NewSpecIndexWithConfig(&root, &index.SpecIndexConfig{BasePath: basePath, AllowFileLookup: true})
+	bundled, err := BundleIndex(idx)
+	assert.NoError(t, err)
+	assert.NotEqual(t, &root, bundled)
+	assert.Contains(t, collectRefs(&root), "paths/burgers.yaml")
+	assert.NotContains(t, collectRefs(bundled), "paths/burgers.yaml")
+}
+
+func TestBundleBytes_FileReferencesNotAllowed(t *testing.T) {
+	spec, _ := os.ReadFile("../test_specs/bundle/openapi.yaml")
+	_, err := BundleBytes(spec, nil)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "unable to bundle reference 'paths/burgers.yaml' (root document)")
+}
+
+func TestBundleBytes_MissingFile(t *testing.T) {
+	dir := writeFiles(t, map[string]string{
+		"schemas.yaml": `Pet:
+  type: object
+  properties:
+    owner:
+      $ref: 'nope.yaml#/Owner'`,
+	})
+	spec := `openapi: 3.0.3
+paths: {}
+components:
+  schemas:
+    Pet:
+      $ref: 'schemas.yaml#/Pet'`
+	_, err := BundleBytes([]byte(spec), &datamodel.DocumentConfiguration{BasePath: dir, AllowFileReferences: true})
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "unable to bundle reference 'nope.yaml#/Owner' (schemas.yaml)")
+}
+
+func TestBundleBytes_MissingFragment(t *testing.T) {
+	dir := writeFiles(t, map[string]string{"schemas.yaml": `Pet:
+  type: object`})
+	spec := `openapi: 3.0.3
+paths: {}
+components:
+  schemas:
+    Pet:
+      $ref: 'schemas.yaml#/Cat'`
+	_, err := BundleBytes([]byte(spec), &datamodel.DocumentConfiguration{BasePath: dir, AllowFileReferences: true})
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "'/Cat' cannot be found")
+}
+
+func TestBundleBytes_ComponentNames(t *testing.T) {
+	dir := writeFiles(t, map[string]string{
+		"shared/models.yaml": `components:
+  schemas:
+    Pet:
+      type: object
+      properties:
+        tag:
+          $ref: '#/components/schemas/Tag'
+    Tag:
+      type: string
+  responses:
+    Error:
+      description: an error
+  requestBodies:
+    NewPet:
+      content:
+        application/json:
+          schema:
+            $ref: '#/components/schemas/Pet'`,
+		"shared/pet+name.yaml": `type: object`,
+	})
+	spec := `openapi: 3.0.3
+paths:
+  /pets:
+    post:
+      requestBody:
+        $ref: 'shared/models.yaml#/components/requestBodies/NewPet'
+      responses:
+        default:
+          $ref: 'shared/models.yaml#/components/responses/Error'
+        '200':
+          description: ok
+          content:
+            application/json:
+              schema:
+                $ref: 'shared/pet+name.yaml'
+components:
+  schemas:
+    Pet:
+      type: string
+    Pet_1:
+      type: string`
+	bundled, err := BundleBytes([]byte(spec), &datamodel.DocumentConfiguration{BasePath: dir,
+		AllowFileReferences: true})
+	assert.NoError(t, err)
+
+	var node yaml.Node
+	_ = yaml.Unmarshal(bundled, &node)
+	assert.Equal(t, []string{
+		"#/components/requestBodies/NewPet",
+		"#/components/responses/Error",
+		"#/components/schemas/pet_name",
+		"#/components/schemas/Tag",
+		"#/components/schemas/Pet_2",
+	}, collectRefs(&node))
+}
+
+func TestBundleBytes_CircularPathItem(t *testing.T) {
+	dir := writeFiles(t, map[string]string{
+		"a.yaml": `get:
+  callbacks:
+    onEvent:
+      '{$request.body#/url}':
+        $ref: 'a.yaml'`,
+	})
+	spec := `openapi: 3.0.3
+paths:
+  /a:
+    $ref: 'a.yaml'`
+	_, err := BundleBytes([]byte(spec), &datamodel.DocumentConfiguration{BasePath: dir, AllowFileReferences: true})
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "circular reference cannot be inlined")
+}
+
+func TestBundleBytes_Swagger(t *testing.T) {
+	dir := writeFiles(t, map[string]string{
+		"definitions.json": `{"Pet": {"type": "object", "properties": {"friend": {"$ref": "#/Pet"}}}}`,
+	})
+	spec := `{"swagger": "2.0", "info": {"title": "pets", "version": "1"}, "paths": {"/pets": {"get": {
+"responses": {"200": {"description": "ok", "schema": {"$ref": "definitions.json#/Pet"}}}}}}}`
+
+	bundled, err := BundleBytes([]byte(spec), &datamodel.DocumentConfiguration{BasePath: dir,
+		AllowFileReferences: true})
+	assert.NoError(t, err)
+	assert.Equal(t, byte('{'), bundled[0])
+
+	var node yaml.Node
+	_ = yaml.Unmarshal(bundled, &node)
+	assert.Equal(t, []string{"#/definitions/Pet", "#/definitions/Pet"}, collectRefs(&node))
+	_, _, definitions := utils.FindKeyNodeFullTop("definitions", node.Content[0].Content)
+	assert.NotNil(t, definitions)
+
+	// the keys are in the same order as the specification, with the bundled definitions last.
+	var keys []string
+	for i := 0; i < len(node.Content[0].Content); i += 2 {
+		keys = append(keys, node.Content[0].Content[i].Value)
+	}
+	assert.Equal(t, []string{"swagger", "info", "paths", "definitions"}, keys)
+}
+
+func TestBundleIndex_Empty(t *testing.T) {
+	_, err := BundleIndex(nil)
+	assert.Error(t, err)
+
+	var root yaml.Node
+	_ = yaml.Unmarshal([]byte(`- not an object`), &root)
+	_, err = BundleIndex(index.NewSpecIndexWithConfig(&root, index.CreateClosedAPIIndexConfig()))
+	assert.Error(t, err)
+}
+
+func mustMarshal(t *testing.T, node *yaml.Node) []byte {
+	b, err := yaml.Marshal(node)
+	assert.NoError(t, err)
+	return b
+}
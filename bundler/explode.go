@@ -0,0 +1,212 @@
+// Copyright 2023 Princess B33f Heavy Industries / Dave Shanley
+// SPDX-License-Identifier: MIT
+
+package bundler
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/pb33f/libopenapi/datamodel"
+	v3 "github.com/pb33f/libopenapi/datamodel/high/v3"
+	"github.com/pb33f/libopenapi/utils"
+	"gopkg.in/yaml.v3"
+)
+
+// ExplodedRootFile is the name of the root document created when exploding a specification.
+const ExplodedRootFile = "openapi.yaml"
+
+// ExplodeDocument renders a high-level OpenAPI 3+ Document and then explodes it into multiple files,
+// see ExplodeBytes for details of the layout.
+func ExplodeDocument(document *v3.Document) (map[string][]byte, error) {
+	if document == nil {
+		return nil, errors.New("unable to explode, the document is nil")
+	}
+	rendered, err := document.Render()
+	if err != nil {
+		return nil, err
+	}
+	return ExplodeBytes(rendered)
+}
+
+// ExplodeBytes is the inverse of bundling, it splits a single OpenAPI 3+ specification into multiple files.
+// Every path item is written to paths/<path>.yaml and every component is written to
+// components/<section>/<name>.yaml. The root document (openapi.yaml) keeps the paths and components, but they
+// become file references, and every local reference is re-written as a relative file reference.
+//
+// The result is a map of file names (relative, using forward slashes) to YAML bytes, ready to be written to disk
+// using WriteExploded. Loading openapi.yaml from that directory (with BasePath set and AllowFileReferences enabled)
+// produces the same model as the original specification.
+//
+// The specification must be self-contained (bundle it first if it's not), references to other files are re-written
+// relative to their new location, but remote references are left alone.
+func ExplodeBytes(spec []byte) (map[string][]byte, error) {
+	info, err := datamodel.ExtractSpecInfo(spec)
+	if err != nil {
+		return nil, err
+	}
+	if info.SpecType != utils.OpenApi3 {
+		return nil, errors.New("unable to explode, only OpenAPI 3+ specifications are supported")
+	}
+	docNode := copyNode(info.RootNode)
+	if docNode.Kind == yaml.DocumentNode {
+		docNode = docNode.Content[0]
+	}
+
+	e := &exploder{
+		files:      map[string]*yaml.Node{ExplodedRootFile: docNode},
+		taken:      map[string]bool{ExplodedRootFile: true},
+		paths:      make(map[string]string),
+		components: make(map[string]map[string]string),
+	}
+	if _, paths := utils.FindKeyNodeTop("paths", docNode.Content); paths != nil && utils.IsNodeMap(paths) {
+		for i := 0; i+1 < len(paths.Content); i += 2 {
+			if strings.HasPrefix(paths.Content[i].Value, "x-") {
+				continue
+			}
+			name := strings.NewReplacer("{", "", "}", "").Replace(strings.Trim(paths.Content[i].Value, "/"))
+			if name == "" {
+				name = "root"
+			}
+			if file := e.extract(paths.Content, i+1, "paths", name); file != "" {
+				e.paths[paths.Content[i].Value] = file
+			}
+		}
+	}
+	if _, components := utils.FindKeyNodeTop("components", docNode.Content); components != nil {
+		for i := 0; i+1 < len(components.Content); i += 2 {
+			section := components.Content[i].Value
+			if !openAPISections[section] || !utils.IsNodeMap(components.Content[i+1]) {
+				continue
+			}
+			e.components[section] = make(map[string]string)
+			entries := components.Content[i+1].Content
+			for j := 0; j+1 < len(entries); j += 2 {
+				if file := e.extract(entries, j+1, path.Join("components", section), entries[j].Value); file != "" {
+					e.components[section][entries[j].Value] = file
+				}
+			}
+		}
+	}
+
+	exploded := make(map[string][]byte, len(e.files))
+	for file, node := range e.files {
+		e.rewrite(node, file)
+		b, mErr := yaml.Marshal(node)
+		if mErr != nil {
+			return nil, fmt.Errorf("unable to explode '%s': %s", file, mErr.Error())
+		}
+		exploded[file] = b
+	}
+	return exploded, nil
+}
+
+// WriteExploded writes exploded files (as returned by ExplodeBytes) into a directory, creating any directories
+// that are required.
+func WriteExploded(files map[string][]byte, dir string) error {
+	names := make([]string, 0, len(files))
+	for name := range files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		p := filepath.Join(dir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(p), 0o755); err != nil {
+			return err
+		}
+		if err := os.WriteFile(p, files[name], 0o644); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type exploder struct {
+	files      map[string]*yaml.Node        // every file that is created, mapped to its content.
+	taken      map[string]bool              // lower case file names, so names don't clash on case-insensitive systems.
+	paths      map[string]string            // path items, mapped to their files.
+	components map[string]map[string]string // components (by section), mapped to their files.
+}
+
+// extract moves a value into its own file and replaces it with a reference to that file. Values that are already
+// references are left where they are, an empty string is returned for them.
+func (e *exploder) extract(content []*yaml.Node, i int, dir, name string) string {
+	if isRef, _, _ := utils.IsNodeRefValue(content[i]); isRef {
+		return ""
+	}
+	name = invalidNameChars.ReplaceAllString(name, "_")
+	file := path.Join(dir, name+".yaml")
+	for n := 1; e.taken[strings.ToLower(file)]; n++ {
+		file = path.Join(dir, fmt.Sprintf("%s_%d.yaml", name, n))
+	}
+	e.taken[strings.ToLower(file)] = true
+	e.files[file] = content[i]
+	content[i] = utils.CreateRefNode(file)
+	return file
+}
+
+// rewrite re-writes every reference found in a file, so it's relative to the location of that file.
+func (e *exploder) rewrite(node *yaml.Node, file string) {
+	if node.Kind == yaml.MappingNode {
+		if isRef, _, ref := utils.IsNodeRefValue(node); isRef {
+			refValueNode(node).Value = e.relocate(ref, file)
+		}
+	}
+	for _, n := range node.Content {
+		e.rewrite(n, file)
+	}
+}
+
+// relocate works out where a reference points to once the specification has been exploded.
+func (e *exploder) relocate(ref, file string) string {
+	target, fragment := splitReference(ref)
+	if target != "" {
+		// the root file stays where it is, so its references are already correct.
+		if file == ExplodedRootFile || strings.HasPrefix(target, "http://") ||
+			strings.HasPrefix(target, "https://") || filepath.IsAbs(target) {
+			return ref
+		}
+		return relativeReference(file, strings.TrimPrefix(target, "file:"), fragment)
+	}
+	var segments []string
+	for _, s := range strings.Split(strings.TrimPrefix(fragment, "/"), "/") {
+		segments = append(segments, unescapeSegment(s))
+	}
+	switch {
+	case len(segments) >= 2 && segments[0] == "paths" && e.paths[segments[1]] != "":
+		return relativeReference(file, e.paths[segments[1]], pointer(segments[2:]))
+	case len(segments) >= 3 && segments[0] == "components" && e.components[segments[1]][segments[2]] != "":
+		return relativeReference(file, e.components[segments[1]][segments[2]], pointer(segments[3:]))
+	case file == ExplodedRootFile:
+		return ref
+	}
+	return relativeReference(file, ExplodedRootFile, fragment)
+}
+
+// relativeReference creates a reference to a file (and fragment) from another file, both relative to the root.
+func relativeReference(from, to, fragment string) string {
+	rel, err := filepath.Rel(filepath.Dir(filepath.FromSlash(from)), filepath.FromSlash(to))
+	if err != nil {
+		rel = to
+	}
+	ref := filepath.ToSlash(rel)
+	if fragment != "" {
+		ref += "#" + fragment
+	}
+	return ref
+}
+
+// pointer creates a JSON Pointer from a list of segments, an empty list returns an empty string.
+func pointer(segments []string) string {
+	var sb strings.Builder
+	for _, s := range segments {
+		sb.WriteString("/")
+		sb.WriteString(strings.ReplaceAll(strings.ReplaceAll(s, "~", "~0"), "/", "~1"))
+	}
+	return sb.String()
+}
@@ -0,0 +1,166 @@
+// Copyright 2023 Princess B33f Heavy Industries / Dave Shanley
+// SPDX-License-Identifier: MIT
+
+package bundler
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/pb33f/libopenapi/datamodel"
+	v3high "github.com/pb33f/libopenapi/datamodel/high/v3"
+	v3 "github.com/pb33f/libopenapi/datamodel/low/v3"
+	whatChanged "github.com/pb33f/libopenapi/what-changed"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/yaml.v3"
+)
+
+func explodedRefs(t *testing.T, files map[string][]byte, file string) []string {
+	assert.Contains(t, files, file)
+	var node yaml.Node
+	assert.NoError(t, yaml.Unmarshal(files[file], &node))
+	return collectRefs(&node)
+}
+
+func TestExplodeBytes(t *testing.T) {
+	spec := `openapi: 3.1.0
+paths:
+  /pets/{petId}:
+    get:
+      parameters:
+        - $ref: '#/components/parameters/PetId'
+      responses:
+        '200':
+          description: a pet
+          content:
+            application/json:
+              schema:
+                $ref: '#/components/schemas/Pet'
+        default:
+          $ref: '#/components/responses/Error'
+  /:
+    $ref: '#/paths/~1pets~1%7BpetId%7D'
+  x-cake: yummy
+components:
+  schemas:
+    Pet:
+      type: object
+      properties:
+        friend:
+          $ref: '#/components/schemas/Pet'
+        tag:
+          $ref: '#/components/schemas/Tag'
+        name:
+          $ref: '#/components/schemas/pet/properties/name'
+    pet:
+      properties:
+        name:
+          type: string
+    Tag:
+      $ref: 'tags.yaml#/Tag'
+  parameters:
+    PetId:
+      name: petId
+      in: path
+      required: true
+      schema:
+        type: string
+  responses:
+    Error:
+      description: an error
+      content:
+        application/json:
+          schema:
+            $ref: '#/x-error'
+x-error:
+  type: string`
+
+	files, err := ExplodeBytes([]byte(spec))
+	assert.NoError(t, err)
+	assert.Len(t, files, 6)
+
+	assert.Equal(t, []string{
+		"paths/pets_petId.yaml",
+		"paths/pets_petId.yaml",
+		"components/schemas/Pet.yaml",
+		"components/schemas/pet_1.yaml",
+		"tags.yaml#/Tag",
+		"components/parameters/PetId.yaml",
+		"components/responses/Error.yaml",
+	}, explodedRefs(t, files, ExplodedRootFile))
+
+	assert.Equal(t, []string{
+		"../components/parameters/PetId.yaml",
+		"../components/schemas/Pet.yaml",
+		"../components/responses/Error.yaml",
+	}, explodedRefs(t, files, "paths/pets_petId.yaml"))
+
+	assert.Equal(t, []string{
+		"Pet.yaml",
+		"../../openapi.yaml#/components/schemas/Tag",
+		"pet_1.yaml#/properties/name",
+	}, explodedRefs(t, files, "components/schemas/Pet.yaml"))
+
+	assert.Equal(t, []string{"../../openapi.yaml#/x-error"},
+		explodedRefs(t, files, "components/responses/Error.yaml"))
+}
+
+func TestExplodeBytes_RoundTrip(t *testing.T) {
+	spec, _ := os.ReadFile("../test_specs/burgershop.openapi.yaml")
+	files, err := ExplodeBytes(spec)
+	assert.NoError(t, err)
+
+	dir := t.TempDir()
+	assert.NoError(t, WriteExploded(files, dir))
+	_, err = os.Stat(filepath.Join(dir, "components", "schemas", "Burger.yaml"))
+	assert.NoError(t, err)
+
+	info, _ := datamodel.ExtractSpecInfo(spec)
+	original, errs := v3.CreateDocumentFromConfig(info, datamodel.NewClosedDocumentConfiguration())
+	assert.Empty(t, errs)
+
+	// load the exploded specification back from disk.
+	root, _ := os.ReadFile(filepath.Join(dir, ExplodedRootFile))
+	config := &datamodel.DocumentConfiguration{BasePath: dir, AllowFileReferences: true}
+	explodedInfo, _ := datamodel.ExtractSpecInfo(root)
+	exploded, errs := v3.CreateDocumentFromConfig(explodedInfo, config)
+	assert.Empty(t, errs)
+
+	// inline schemas are now references to files, which is the only thing what-changed reports.
+	changes := whatChanged.CompareOpenAPIDocuments(original, exploded)
+	assert.NotNil(t, changes)
+	for _, c := range changes.GetAllChanges() {
+		assert.Equal(t, "$ref", c.Property)
+	}
+
+	// bundling the exploded specification puts it back together, with the same component names, so the
+	// references resolve to the same schemas.
+	bundled, err := BundleBytes(root, config)
+	assert.NoError(t, err)
+	bundledInfo, _ := datamodel.ExtractSpecInfo(bundled)
+	rebuilt, errs := v3.CreateDocumentFromConfig(bundledInfo, datamodel.NewClosedDocumentConfiguration())
+	assert.Empty(t, errs)
+	assert.Nil(t, whatChanged.CompareOpenAPIDocuments(original, rebuilt))
+	assert.Len(t, rebuilt.Components.Value.Schemas.Value, len(original.Components.Value.Schemas.Value))
+}
+
+func TestExplodeDocument(t *testing.T) {
+	spec, _ := os.ReadFile("../test_specs/burgershop.openapi.yaml")
+	info, _ := datamodel.ExtractSpecInfo(spec)
+	lowDoc, _ := v3.CreateDocumentFromConfig(info, datamodel.NewClosedDocumentConfiguration())
+
+	files, err := ExplodeDocument(v3high.NewDocument(lowDoc))
+	assert.NoError(t, err)
+	assert.Contains(t, files, "paths/burgers.yaml")
+	assert.Contains(t, files, "components/schemas/Burger.yaml")
+
+	_, err = ExplodeDocument(nil)
+	assert.Error(t, err)
+}
+
+func TestExplodeBytes_Swagger(t *testing.T) {
+	_, err := ExplodeBytes([]byte(`swagger: 2.0`))
+	assert.Error(t, err)
+	assert.Equal(t, "unable to explode, only OpenAPI 3+ specifications are supported", err.Error())
+}
@@ -0,0 +1,168 @@
+// Copyright 2023 Princess B33f Heavy Industries / Dave Shanley
+// SPDX-License-Identifier: MIT
+
+package converter
+
+import (
+	"strings"
+
+	"github.com/pb33f/libopenapi/datamodel/high/base"
+	"github.com/pb33f/libopenapi/orderedmap"
+	"gopkg.in/yaml.v3"
+)
+
+// swagger references are re-written to point to the components that replace them.
+var swaggerReferences = []struct{ from, to string }{
+	{"#/definitions/", "#/components/schemas/"},
+	{"#/parameters/", "#/components/parameters/"},
+	{"#/responses/", "#/components/responses/"},
+}
+
+// convertReference re-writes a Swagger reference, so it points to the equivalent OpenAPI 3 component.
+func convertReference(ref string) string {
+	for _, r := range swaggerReferences {
+		if i := strings.Index(ref, r.from); i >= 0 {
+			return ref[:i] + r.to + ref[i+len(r.from):]
+		}
+	}
+	return ref
+}
+
+// convertSchemaProxy creates a copy of a Swagger schema that is valid for the target version of OpenAPI.
+// References are kept as references, but point to the new location.
+func (c *swaggerConverter) convertSchemaProxy(sp *base.SchemaProxy) *base.SchemaProxy {
+	if sp == nil {
+		return nil
+	}
+	if sp.IsReference() {
+		return base.CreateSchemaProxyRef(convertReference(sp.GetReference()))
+	}
+	s := sp.Schema()
+	if s == nil {
+		return nil
+	}
+	return base.CreateSchemaProxy(c.convertSchema(s))
+}
+
+// convertSchema copies a schema, converting every schema it contains along the way.
+func (c *swaggerConverter) convertSchema(s *base.Schema) *base.Schema {
+	n := *s
+	n.ParentProxy = nil
+	n.AllOf = c.convertSchemaProxies(s.AllOf)
+	n.OneOf = c.convertSchemaProxies(s.OneOf)
+	n.AnyOf = c.convertSchemaProxies(s.AnyOf)
+	n.PrefixItems = c.convertSchemaProxies(s.PrefixItems)
+	n.Not = c.convertSchemaProxy(s.Not)
+	n.Contains = c.convertSchemaProxy(s.Contains)
+	n.If = c.convertSchemaProxy(s.If)
+	n.Else = c.convertSchemaProxy(s.Else)
+	n.Then = c.convertSchemaProxy(s.Then)
+	n.PropertyNames = c.convertSchemaProxy(s.PropertyNames)
+	n.UnevaluatedItems = c.convertSchemaProxy(s.UnevaluatedItems)
+	n.UnevaluatedProperties = c.convertSchemaProxy(s.UnevaluatedProperties)
+	n.Properties = c.convertSchemaMap(s.Properties)
+	n.PatternProperties = c.convertSchemaMap(s.PatternProperties)
+	n.DependentSchemas = c.convertSchemaMap(s.DependentSchemas)
+	if s.Items != nil && s.Items.IsA() {
+		n.Items = &base.DynamicValue[*base.SchemaProxy, bool]{A: c.convertSchemaProxy(s.Items.A)}
+	}
+	if additional, ok := s.AdditionalProperties.(*base.SchemaProxy); ok {
+		n.AdditionalProperties = c.convertSchemaProxy(additional)
+	}
+
+	// 'file' is not a type in OpenAPI 3, it's a binary string.
+	if len(s.Type) == 1 && s.Type[0] == "file" {
+		n.Type = []string{"string"}
+		n.Format = "binary"
+	}
+
+	// swagger discriminators are just the name of a property.
+	if (s.Discriminator == nil || s.Discriminator.PropertyName == "") && s.GoLow() != nil {
+		if d := s.GoLow().Discriminator.ValueNode; d != nil && d.Kind == yaml.ScalarNode && d.Value != "" {
+			n.Discriminator = &base.Discriminator{PropertyName: d.Value}
+		}
+	}
+
+	// x-nullable is a popular extension, that has been made official.
+	if len(s.Extensions) > 0 {
+		n.Extensions = make(map[string]any, len(s.Extensions))
+		for k, v := range s.Extensions {
+			if nullable, ok := v.(bool); ok && k == "x-nullable" {
+				n.Nullable = &nullable
+				continue
+			}
+			n.Extensions[k] = v
+		}
+	}
+	if c.openAPI31 {
+		upgradeSchema(&n)
+	}
+	return &n
+}
+
+func (c *swaggerConverter) convertSchemaProxies(proxies []*base.SchemaProxy) []*base.SchemaProxy {
+	if proxies == nil {
+		return nil
+	}
+	converted := make([]*base.SchemaProxy, len(proxies))
+	for i := range proxies {
+		converted[i] = c.convertSchemaProxy(proxies[i])
+	}
+	return converted
+}
+
+func (c *swaggerConverter) convertSchemaMap(
+	proxies *orderedmap.Map[string, *base.SchemaProxy]) *orderedmap.Map[string, *base.SchemaProxy] {
+	if proxies == nil {
+		return nil
+	}
+	converted := orderedmap.New[string, *base.SchemaProxy]()
+	for _, pair := range proxies.Pairs() {
+		converted.Set(pair.Key, c.convertSchemaProxy(pair.Value))
+	}
+	return converted
+}
+
+// upgradeSchema changes the properties of a schema that are not compatible with OpenAPI 3.1 (JSON Schema).
+// nullable becomes a 'null' type, boolean exclusiveMinimum / exclusiveMaximum values become numbers and
+// the example becomes the examples array.
+func upgradeSchema(s *base.Schema) {
+	if s.Nullable != nil {
+		if *s.Nullable && len(s.Type) > 0 {
+			s.Type = append(append([]string{}, s.Type...), "null")
+			if len(s.Enum) > 0 && !containsNil(s.Enum) {
+				s.Enum = append(append([]any{}, s.Enum...), nil)
+			}
+		}
+		s.Nullable = nil
+	}
+	if s.Example != nil && len(s.Examples) == 0 {
+		s.Examples = []any{s.Example}
+		s.Example = nil
+	}
+	if s.ExclusiveMaximum != nil && s.ExclusiveMaximum.IsA() {
+		if s.ExclusiveMaximum.A && s.Maximum != nil {
+			s.ExclusiveMaximum = &base.DynamicValue[bool, int64]{N: 1, B: *s.Maximum}
+			s.Maximum = nil
+		} else {
+			s.ExclusiveMaximum = nil
+		}
+	}
+	if s.ExclusiveMinimum != nil && s.ExclusiveMinimum.IsA() {
+		if s.ExclusiveMinimum.A && s.Minimum != nil {
+			s.ExclusiveMinimum = &base.DynamicValue[bool, int64]{N: 1, B: *s.Minimum}
+			s.Minimum = nil
+		} else {
+			s.ExclusiveMinimum = nil
+		}
+	}
+}
+
+func containsNil(values []any) bool {
+	for i := range values {
+		if values[i] == nil {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,626 @@
+// Copyright 2023 Princess B33f Heavy Industries / Dave Shanley
+// SPDX-License-Identifier: MIT
+
+// Package converter converts specifications between versions of OpenAPI.
+//
+// Swagger (OpenAPI 2) documents are converted into brand-new high-level OpenAPI 3 Document models. The new models
+// are not backed by any low-level model, they are built from scratch, which means they can be rendered (using Render)
+// and then re-loaded as a regular OpenAPI 3 document, with correct line and column numbers.
+//
+// OpenAPI 3.0 documents can be upgraded to OpenAPI 3.1, and OpenAPI 3.1 documents can be downgraded (as far as
+// possible) to OpenAPI 3.0. Both change the high-level Document in place.
+package converter
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/pb33f/libopenapi/datamodel/high/base"
+	v2high "github.com/pb33f/libopenapi/datamodel/high/v2"
+	v3high "github.com/pb33f/libopenapi/datamodel/high/v3"
+	"github.com/pb33f/libopenapi/datamodel/low"
+	v2low "github.com/pb33f/libopenapi/datamodel/low/v2"
+	v3low "github.com/pb33f/libopenapi/datamodel/low/v3"
+	"github.com/pb33f/libopenapi/orderedmap"
+)
+
+// Versions of OpenAPI that Swagger documents can be converted into.
+const (
+	OpenAPI30 = "3.0.3"
+	OpenAPI31 = "3.1.0"
+)
+
+const (
+	defaultMediaType = "application/json"
+	formMediaType    = "application/x-www-form-urlencoded"
+	multipartType    = "multipart/form-data"
+)
+
+// ConvertSwagger converts a high-level Swagger document into a new high-level OpenAPI 3 Document, using the
+// version supplied (OpenAPI30 or OpenAPI31). If the version is empty, OpenAPI30 is used.
+//
+//   - definitions become components/schemas, parameters and responses become components too.
+//   - body and formData parameters become a requestBody.
+//   - consumes and produces become the media types of the content of request bodies and responses.
+//   - host, basePath and schemes become servers.
+//   - securityDefinitions become components/securitySchemes.
+//
+// Every reference is re-written to point to the new location of the component, and all extensions are kept.
+func ConvertSwagger(swagger *v2high.Swagger, version string) (*v3high.Document, error) {
+	if swagger == nil {
+		return nil, errors.New("unable to convert, the swagger document is nil")
+	}
+	if version == "" {
+		version = OpenAPI30
+	}
+	if !strings.HasPrefix(version, "3.0") && !strings.HasPrefix(version, "3.1") {
+		return nil, fmt.Errorf("unable to convert, version '%s' is not supported, use 3.0.x or 3.1.x", version)
+	}
+	c := &swaggerConverter{
+		swagger:   swagger,
+		openAPI31: strings.HasPrefix(version, "3.1"),
+		consumes:  swagger.Consumes,
+		produces:  swagger.Produces,
+	}
+	return c.convert(version), nil
+}
+
+// ConvertLowSwagger is the same as ConvertSwagger, but accepts a low-level Swagger document.
+func ConvertLowSwagger(swagger *v2low.Swagger, version string) (*v3high.Document, error) {
+	if swagger == nil {
+		return nil, errors.New("unable to convert, the swagger document is nil")
+	}
+	return ConvertSwagger(v2high.NewSwaggerDocument(swagger), version)
+}
+
+type swaggerConverter struct {
+	swagger   *v2high.Swagger
+	openAPI31 bool
+	consumes  []string
+	produces  []string
+}
+
+func (c *swaggerConverter) convert(version string) *v3high.Document {
+	s := c.swagger
+	doc := &v3high.Document{
+		Version:      version,
+		Info:         s.Info,
+		Servers:      c.convertServers(s.Schemes),
+		Security:     s.Security,
+		Tags:         s.Tags,
+		ExternalDocs: s.ExternalDocs,
+		Extensions:   s.Extensions,
+		Components:   c.convertComponents(),
+	}
+	if s.Paths != nil {
+		doc.Paths = &v3high.Paths{
+			PathItems:  orderedmap.New[string, *v3high.PathItem](),
+			Extensions: s.Paths.Extensions,
+		}
+		for _, pair := range s.Paths.PathItems.Pairs() {
+			doc.Paths.PathItems.Set(pair.Key, c.convertPathItem(pair.Value))
+		}
+	}
+	return doc
+}
+
+// convertServers creates a server for every scheme, using the host and basePath of the swagger document.
+func (c *swaggerConverter) convertServers(schemes []string) []*v3high.Server {
+	host, basePath := c.swagger.Host, c.swagger.BasePath
+	if host == "" {
+		if basePath == "" {
+			return nil
+		}
+		return []*v3high.Server{{URL: basePath}}
+	}
+	if len(schemes) == 0 {
+		// without a scheme, the scheme used to access the specification is used.
+		return []*v3high.Server{{URL: "//" + host + basePath}}
+	}
+	servers := make([]*v3high.Server, len(schemes))
+	for i := range schemes {
+		servers[i] = &v3high.Server{URL: schemes[i] + "://" + host + basePath}
+	}
+	return servers
+}
+
+func (c *swaggerConverter) convertComponents() *v3high.Components {
+	s := c.swagger
+	components := new(v3high.Components)
+	empty := true
+	if s.Definitions != nil && s.Definitions.Definitions.Len() > 0 {
+		components.Schemas = orderedmap.New[string, *base.SchemaProxy]()
+		for _, pair := range s.Definitions.Definitions.Pairs() {
+			components.Schemas.Set(pair.Key, c.convertSchemaProxy(pair.Value))
+		}
+		empty = false
+	}
+	if s.Parameters != nil {
+		for _, pair := range s.Parameters.Definitions.Pairs() {
+			name, param := pair.Key, pair.Value
+			switch param.In {
+			case "body":
+				if components.RequestBodies == nil {
+					components.RequestBodies = orderedmap.New[string, *v3high.RequestBody]()
+				}
+				components.RequestBodies.Set(name, c.convertBody(param, c.consumes))
+			case "formData":
+				// form parameters are merged into a single request body, so they are inlined where they are used.
+				continue
+			default:
+				if components.Parameters == nil {
+					components.Parameters = orderedmap.New[string, *v3high.Parameter]()
+				}
+				components.Parameters.Set(name, c.convertParameter(param))
+			}
+			empty = false
+		}
+	}
+	if s.Responses != nil && s.Responses.Definitions.Len() > 0 {
+		components.Responses = orderedmap.New[string, *v3high.Response]()
+		for _, pair := range s.Responses.Definitions.Pairs() {
+			components.Responses.Set(pair.Key, c.convertResponse(pair.Value, c.produces))
+		}
+		empty = false
+	}
+	if s.SecurityDefinitions != nil && s.SecurityDefinitions.Definitions.Len() > 0 {
+		components.SecuritySchemes = orderedmap.New[string, *v3high.SecurityScheme]()
+		for _, pair := range s.SecurityDefinitions.Definitions.Pairs() {
+			components.SecuritySchemes.Set(pair.Key, convertSecurityScheme(pair.Value))
+		}
+		empty = false
+	}
+	if empty {
+		return nil
+	}
+	return components
+}
+
+func (c *swaggerConverter) convertPathItem(pathItem *v2high.PathItem) *v3high.PathItem {
+	var lowParams []low.ValueReference[*v2low.Parameter]
+	if pathItem.GoLow() != nil {
+		lowParams = pathItem.GoLow().Parameters.Value
+	}
+	params, body, form := c.splitParameters(pathItem.Parameters, lowParams)
+	p := &v3high.PathItem{
+		Parameters: params,
+		Extensions: pathItem.Extensions,
+	}
+	operations := []struct {
+		source *v2high.Operation
+		target **v3high.Operation
+	}{
+		{pathItem.Get, &p.Get}, {pathItem.Put, &p.Put}, {pathItem.Post, &p.Post}, {pathItem.Delete, &p.Delete},
+		{pathItem.Options, &p.Options}, {pathItem.Head, &p.Head}, {pathItem.Patch, &p.Patch},
+	}
+	for _, op := range operations {
+		if op.source != nil {
+			*op.target = c.convertOperation(op.source, body, form)
+		}
+	}
+	return p
+}
+
+// convertOperation converts an operation. Any body or form parameters defined by the path item are used if the
+// operation does not define its own.
+func (c *swaggerConverter) convertOperation(op *v2high.Operation, pathBody *parameterRef,
+	pathForm []*v2high.Parameter,
+) *v3high.Operation {
+	var lowParams []low.ValueReference[*v2low.Parameter]
+	if op.GoLow() != nil {
+		lowParams = op.GoLow().Parameters.Value
+	}
+	params, body, form := c.splitParameters(op.Parameters, lowParams)
+	if body == nil && len(form) == 0 {
+		body, form = pathBody, pathForm
+	}
+	consumes := c.consumes
+	if len(op.Consumes) > 0 {
+		consumes = op.Consumes
+	}
+	produces := c.produces
+	if len(op.Produces) > 0 {
+		produces = op.Produces
+	}
+
+	o := &v3high.Operation{
+		Tags:         op.Tags,
+		Summary:      op.Summary,
+		Description:  op.Description,
+		ExternalDocs: op.ExternalDocs,
+		OperationId:  op.OperationId,
+		Parameters:   params,
+		Security:     op.Security,
+		Extensions:   op.Extensions,
+	}
+	if op.Deprecated {
+		deprecated := true
+		o.Deprecated = &deprecated
+	}
+	if len(op.Schemes) > 0 && !reflect.DeepEqual(op.Schemes, c.swagger.Schemes) {
+		o.Servers = c.convertServers(op.Schemes)
+	}
+	switch {
+	case body != nil && body.ref != "":
+		o.RequestBody = referenced(c.convertBody(body.param, consumes),
+			v3high.NewRequestBody(&v3low.RequestBody{Reference: &low.Reference{Reference: body.ref}}))
+	case body != nil:
+		o.RequestBody = c.convertBody(body.param, consumes)
+	case len(form) > 0:
+		o.RequestBody = c.convertForm(form, consumes)
+	}
+	if op.Responses != nil {
+		o.Responses = c.convertResponses(op.Responses, produces)
+	}
+	return o
+}
+
+// parameterRef is a Swagger parameter, along with the reference to its new location (if it was a reference).
+type parameterRef struct {
+	param *v2high.Parameter
+	ref   string
+}
+
+// splitParameters converts regular parameters, and separates out the body and form parameters that become a
+// request body.
+func (c *swaggerConverter) splitParameters(params []*v2high.Parameter,
+	lowParams []low.ValueReference[*v2low.Parameter],
+) ([]*v3high.Parameter, *parameterRef, []*v2high.Parameter) {
+	var converted []*v3high.Parameter
+	var body *parameterRef
+	var form []*v2high.Parameter
+	for i, param := range params {
+		var ref string
+		if i < len(lowParams) && lowParams[i].Reference != "" {
+			ref = convertReference(lowParams[i].Reference)
+		}
+		switch param.In {
+		case "body":
+			body = &parameterRef{param: param}
+			if ref != "" {
+				body.ref = strings.Replace(ref, "#/components/parameters/", "#/components/requestBodies/", 1)
+			}
+		case "formData":
+			form = append(form, param)
+		case "":
+			// without a location, the parameter is not valid in either version, so it's dropped.
+			continue
+		default:
+			p := c.convertParameter(param)
+			if ref != "" {
+				p = referenced(p, v3high.NewParameter(&v3low.Parameter{Reference: &low.Reference{Reference: ref}}))
+			}
+			converted = append(converted, p)
+		}
+	}
+	return converted, body, form
+}
+
+func (c *swaggerConverter) convertParameter(param *v2high.Parameter) *v3high.Parameter {
+	p := &v3high.Parameter{
+		Name:        param.Name,
+		In:          param.In,
+		Description: param.Description,
+		Extensions:  param.Extensions,
+	}
+	if param.Required != nil {
+		p.Required = *param.Required
+	}
+	if param.AllowEmptyValue != nil {
+		p.AllowEmptyValue = *param.AllowEmptyValue
+	}
+	if param.Type == "array" {
+		p.Style, p.Explode = collectionStyle(param.CollectionFormat, param.In)
+		if param.CollectionFormat == "tsv" {
+			// there is no equivalent style, so the format is recorded as an extension.
+			p.Extensions = withExtension(param.Extensions, "x-collectionFormat", "tsv")
+		}
+	}
+	p.Schema = c.simpleSchema(parameterType(param))
+	return p
+}
+
+// collectionStyle converts a Swagger collectionFormat into an OpenAPI 3 style and explode value.
+func collectionStyle(format, in string) (string, *bool) {
+	explode := false
+	switch format {
+	case "multi":
+		explode = true
+		return "form", &explode
+	case "ssv":
+		return "spaceDelimited", &explode
+	case "pipes":
+		return "pipeDelimited", &explode
+	}
+	// csv is the default.
+	if in == "query" || in == "cookie" {
+		return "form", &explode
+	}
+	return "simple", &explode
+}
+
+// convertBody converts a body parameter into a request body, with content for every media type consumed.
+func (c *swaggerConverter) convertBody(param *v2high.Parameter, consumes []string) *v3high.RequestBody {
+	rb := &v3high.RequestBody{
+		Description: param.Description,
+		Content:     orderedmap.New[string, *v3high.MediaType](),
+		Extensions:  param.Extensions,
+	}
+	if param.Required != nil {
+		rb.Required = param.Required
+	}
+	if len(consumes) == 0 {
+		consumes = []string{defaultMediaType}
+	}
+	for _, mediaType := range consumes {
+		rb.Content.Set(mediaType, &v3high.MediaType{Schema: c.convertSchemaProxy(param.Schema)})
+	}
+	return rb
+}
+
+// convertForm merges form parameters into a single object schema, used as the request body.
+func (c *swaggerConverter) convertForm(params []*v2high.Parameter, consumes []string) *v3high.RequestBody {
+	schema := &base.Schema{
+		Type:       []string{"object"},
+		Properties: orderedmap.New[string, *base.SchemaProxy](),
+	}
+	var mediaTypes []string
+	hasFile := false
+	for _, param := range params {
+		prop := c.simpleSchema(parameterType(param))
+		prop.Schema().Description = param.Description
+		schema.Properties.Set(param.Name, prop)
+		if param.Required != nil && *param.Required {
+			schema.Required = append(schema.Required, param.Name)
+		}
+		hasFile = hasFile || param.Type == "file"
+	}
+	for _, mediaType := range consumes {
+		if mediaType == formMediaType || mediaType == multipartType {
+			mediaTypes = append(mediaTypes, mediaType)
+		}
+	}
+	if len(mediaTypes) == 0 {
+		mediaTypes = []string{formMediaType}
+		if hasFile {
+			mediaTypes = []string{multipartType}
+		}
+	}
+	rb := &v3high.RequestBody{Content: orderedmap.New[string, *v3high.MediaType]()}
+	if len(schema.Required) > 0 {
+		required := true
+		rb.Required = &required
+	}
+	for _, mediaType := range mediaTypes {
+		rb.Content.Set(mediaType, &v3high.MediaType{Schema: base.CreateSchemaProxy(schema)})
+	}
+	return rb
+}
+
+func (c *swaggerConverter) convertResponses(responses *v2high.Responses, produces []string) *v3high.Responses {
+	r := &v3high.Responses{
+		Codes:      orderedmap.New[string, *v3high.Response](),
+		Extensions: responses.Extensions,
+	}
+	references := make(map[string]string)
+	var defaultRef string
+	if responses.GoLow() != nil {
+		for k, v := range responses.GoLow().Codes {
+			if v.Reference != "" {
+				references[k.Value] = convertReference(v.Reference)
+			}
+		}
+		if responses.GoLow().Default.Reference != "" {
+			defaultRef = convertReference(responses.GoLow().Default.Reference)
+		}
+	}
+	for _, pair := range responses.Codes.Pairs() {
+		r.Codes.Set(pair.Key, c.convertReferencedResponse(pair.Value, references[pair.Key], produces))
+	}
+	if responses.Default != nil {
+		r.Default = c.convertReferencedResponse(responses.Default, defaultRef, produces)
+	}
+	return r
+}
+
+func (c *swaggerConverter) convertReferencedResponse(response *v2high.Response, ref string,
+	produces []string,
+) *v3high.Response {
+	converted := c.convertResponse(response, produces)
+	if ref == "" {
+		return converted
+	}
+	return referenced(converted, v3high.NewResponse(&v3low.Response{Reference: &low.Reference{Reference: ref}}))
+}
+
+func (c *swaggerConverter) convertResponse(response *v2high.Response, produces []string) *v3high.Response {
+	r := &v3high.Response{
+		Description: response.Description,
+		Extensions:  response.Extensions,
+	}
+	if response.Headers.Len() > 0 {
+		r.Headers = orderedmap.New[string, *v3high.Header]()
+		for _, pair := range response.Headers.Pairs() {
+			r.Headers.Set(pair.Key, &v3high.Header{
+				Description: pair.Value.Description,
+				Schema:      c.simpleSchema(headerType(pair.Value)),
+				Extensions:  pair.Value.Extensions,
+			})
+		}
+	}
+	if len(produces) == 0 {
+		produces = []string{defaultMediaType}
+	}
+	content := orderedmap.New[string, *v3high.MediaType]()
+	if response.Schema != nil {
+		for _, mediaType := range produces {
+			content.Set(mediaType, &v3high.MediaType{Schema: c.convertSchemaProxy(response.Schema)})
+		}
+	}
+	if response.Examples != nil {
+		for _, pair := range response.Examples.Values.Pairs() {
+			if content.GetOrZero(pair.Key) == nil {
+				content.Set(pair.Key, &v3high.MediaType{Schema: c.convertSchemaProxy(response.Schema)})
+			}
+			content.GetOrZero(pair.Key).Example = pair.Value
+		}
+	}
+	if content.Len() > 0 {
+		r.Content = content
+	}
+	return r
+}
+
+func convertSecurityScheme(scheme *v2high.SecurityScheme) *v3high.SecurityScheme {
+	ss := &v3high.SecurityScheme{
+		Type:        scheme.Type,
+		Description: scheme.Description,
+		Extensions:  scheme.Extensions,
+	}
+	switch scheme.Type {
+	case "basic":
+		ss.Type = "http"
+		ss.Scheme = "basic"
+	case "apiKey":
+		ss.Name = scheme.Name
+		ss.In = scheme.In
+	case "oauth2":
+		flow := &v3high.OAuthFlow{
+			AuthorizationUrl: scheme.AuthorizationUrl,
+			TokenUrl:         scheme.TokenUrl,
+			Scopes:           orderedmap.New[string, string](),
+		}
+		if scheme.Scopes != nil {
+			for _, pair := range scheme.Scopes.Values.Pairs() {
+				flow.Scopes.Set(pair.Key, pair.Value)
+			}
+		}
+		ss.Flows = new(v3high.OAuthFlows)
+		switch scheme.Flow {
+		case "implicit":
+			flow.TokenUrl = ""
+			ss.Flows.Implicit = flow
+		case "password":
+			flow.AuthorizationUrl = ""
+			ss.Flows.Password = flow
+		case "application":
+			flow.AuthorizationUrl = ""
+			ss.Flows.ClientCredentials = flow
+		case "accessCode":
+			ss.Flows.AuthorizationCode = flow
+		}
+	}
+	return ss
+}
+
+// simpleType holds the properties of a Swagger parameter, header or items object that describe a value.
+type simpleType struct {
+	typ, format, pattern                     string
+	items                                    *v2high.Items
+	def                                      any
+	enum                                     []any
+	maximum, minimum, multipleOf             *int64
+	maxLength, minLength, maxItems, minItems *int64
+	exclusiveMaximum, exclusiveMinimum       bool
+}
+
+func parameterType(p *v2high.Parameter) simpleType {
+	st := simpleType{typ: p.Type, format: p.Format, pattern: p.Pattern, items: p.Items, def: p.Default, enum: p.Enum,
+		maximum: int64Ptr(p.Maximum), minimum: int64Ptr(p.Minimum), multipleOf: int64Ptr(p.MultipleOf),
+		maxLength: int64Ptr(p.MaxLength), minLength: int64Ptr(p.MinLength), maxItems: int64Ptr(p.MaxItems),
+		minItems: int64Ptr(p.MinItems)}
+	st.exclusiveMaximum = p.ExclusiveMaximum != nil && *p.ExclusiveMaximum
+	st.exclusiveMinimum = p.ExclusiveMinimum != nil && *p.ExclusiveMinimum
+	return st
+}
+
+func headerType(h *v2high.Header) simpleType {
+	return simpleType{typ: h.Type, format: h.Format, pattern: h.Pattern, items: h.Items, def: h.Default,
+		enum: h.Enum, maximum: nonZero(h.Maximum), minimum: nonZero(h.Minimum), multipleOf: nonZero(h.MultipleOf),
+		maxLength: nonZero(h.MaxLength), minLength: nonZero(h.MinLength), maxItems: nonZero(h.MaxItems),
+		minItems: nonZero(h.MinItems), exclusiveMaximum: h.ExclusiveMaximum, exclusiveMinimum: h.ExclusiveMinimum}
+}
+
+func itemsType(i *v2high.Items) simpleType {
+	return simpleType{typ: i.Type, format: i.Format, pattern: i.Pattern, items: i.Items, def: i.Default,
+		enum: i.Enum, maximum: nonZero(i.Maximum), minimum: nonZero(i.Minimum), multipleOf: nonZero(i.MultipleOf),
+		maxLength: nonZero(i.MaxLength), minLength: nonZero(i.MinLength), maxItems: nonZero(i.MaxItems),
+		minItems: nonZero(i.MinItems), exclusiveMaximum: i.ExclusiveMaximum, exclusiveMinimum: i.ExclusiveMinimum}
+}
+
+// simpleSchema creates a schema from the properties of a Swagger parameter, header or items object.
+func (c *swaggerConverter) simpleSchema(st simpleType) *base.SchemaProxy {
+	s := &base.Schema{
+		Format:     st.format,
+		Pattern:    st.pattern,
+		Default:    st.def,
+		Enum:       st.enum,
+		Maximum:    st.maximum,
+		Minimum:    st.minimum,
+		MultipleOf: st.multipleOf,
+		MaxLength:  st.maxLength,
+		MinLength:  st.minLength,
+		MaxItems:   st.maxItems,
+		MinItems:   st.minItems,
+	}
+	if st.typ != "" {
+		s.Type = []string{st.typ}
+	}
+	if st.typ == "file" {
+		s.Type = []string{"string"}
+		s.Format = "binary"
+	}
+	if st.exclusiveMaximum {
+		s.ExclusiveMaximum = &base.DynamicValue[bool, int64]{A: true}
+	}
+	if st.exclusiveMinimum {
+		s.ExclusiveMinimum = &base.DynamicValue[bool, int64]{A: true}
+	}
+	if st.items != nil {
+		s.Items = &base.DynamicValue[*base.SchemaProxy, bool]{A: c.simpleSchema(itemsType(st.items))}
+	}
+	if c.openAPI31 {
+		upgradeSchema(s)
+	}
+	return base.CreateSchemaProxy(s)
+}
+
+// referenced copies the exported fields of a converted object into an object that renders as a reference.
+// The converted values remain available in the model, but the reference is what is rendered.
+func referenced[T any](converted *T, reference *T) *T {
+	src, dst := reflect.ValueOf(converted).Elem(), reflect.ValueOf(reference).Elem()
+	for i := 0; i < dst.NumField(); i++ {
+		if dst.Type().Field(i).IsExported() {
+			dst.Field(i).Set(src.Field(i))
+		}
+	}
+	return reference
+}
+
+// withExtension returns a copy of a map of extensions, with an extra extension added.
+func withExtension(extensions map[string]any, key string, value any) map[string]any {
+	ext := make(map[string]any, len(extensions)+1)
+	for k, v := range extensions {
+		ext[k] = v
+	}
+	ext[key] = value
+	return ext
+}
+
+func int64Ptr(i *int) *int64 {
+	if i == nil {
+		return nil
+	}
+	v := int64(*i)
+	return &v
+}
+
+func nonZero(i int) *int64 {
+	if i == 0 {
+		return nil
+	}
+	v := int64(i)
+	return &v
+}
@@ -0,0 +1,295 @@
+// Copyright 2023 Princess B33f Heavy Industries / Dave Shanley
+// SPDX-License-Identifier: MIT
+
+package converter
+
+import (
+	"os"
+	"testing"
+
+	"github.com/pb33f/libopenapi/datamodel"
+	v2high "github.com/pb33f/libopenapi/datamodel/high/v2"
+	v3high "github.com/pb33f/libopenapi/datamodel/high/v3"
+	v2low "github.com/pb33f/libopenapi/datamodel/low/v2"
+	v3low "github.com/pb33f/libopenapi/datamodel/low/v3"
+	"github.com/stretchr/testify/assert"
+)
+
+func loadSwagger(t *testing.T, spec []byte) *v2low.Swagger {
+	info, err := datamodel.ExtractSpecInfo(spec)
+	assert.NoError(t, err)
+	swagger, errs := v2low.CreateDocument(info)
+	assert.Empty(t, errs)
+	return swagger
+}
+
+// renderAndReload renders a converted document and then builds it again as a new high-level model.
+func renderAndReload(t *testing.T, doc *v3high.Document) *v3high.Document {
+	rendered, err := doc.Render()
+	assert.NoError(t, err)
+	info, err := datamodel.ExtractSpecInfo(rendered)
+	assert.NoError(t, err)
+	lowDoc, errs := v3low.CreateDocument(info)
+	assert.Empty(t, errs)
+	return v3high.NewDocument(lowDoc)
+}
+
+const swaggerSpec = `swagger: "2.0"
+info:
+  title: pets
+  version: "1.0"
+host: pets.pb33f.io
+basePath: /v1
+schemes:
+  - https
+  - http
+consumes:
+  - application/json
+produces:
+  - application/json
+  - application/xml
+x-pet: true
+paths:
+  /pets:
+    x-path: item
+    get:
+      operationId: listPets
+      parameters:
+        - $ref: '#/parameters/limit'
+        - name: tags
+          in: query
+          type: array
+          collectionFormat: pipes
+          items:
+            type: string
+      responses:
+        "200":
+          description: ok
+          headers:
+            X-Rate-Limit:
+              type: integer
+              format: int32
+          schema:
+            type: array
+            items:
+              $ref: '#/definitions/Pet'
+          examples:
+            application/json:
+              - name: fido
+        default:
+          $ref: '#/responses/Error'
+    post:
+      operationId: createPet
+      schemes:
+        - https
+      parameters:
+        - name: pet
+          in: body
+          required: true
+          schema:
+            $ref: '#/definitions/Pet'
+      responses:
+        "201":
+          description: created
+  /pets/{petId}/photo:
+    parameters:
+      - name: petId
+        in: path
+        required: true
+        type: string
+    put:
+      consumes:
+        - multipart/form-data
+      parameters:
+        - name: photo
+          in: formData
+          type: file
+          required: true
+        - name: caption
+          in: formData
+          type: string
+      responses:
+        "204":
+          description: updated
+parameters:
+  limit:
+    name: limit
+    in: query
+    type: integer
+    maximum: 100
+    x-limit: yes
+  NewPet:
+    name: pet
+    in: body
+    schema:
+      $ref: '#/definitions/Pet'
+responses:
+  Error:
+    description: an error
+    schema:
+      $ref: '#/definitions/Error'
+definitions:
+  Pet:
+    type: object
+    discriminator: kind
+    required:
+      - name
+    properties:
+      name:
+        type: string
+      kind:
+        type: string
+      age:
+        type: integer
+        minimum: 0
+        exclusiveMinimum: true
+      owner:
+        type: string
+        x-nullable: true
+  Error:
+    type: object
+    properties:
+      message:
+        type: string
+securityDefinitions:
+  basic:
+    type: basic
+  key:
+    type: apiKey
+    name: X-API-Key
+    in: header
+  oauth:
+    type: oauth2
+    flow: accessCode
+    authorizationUrl: https://pb33f.io/auth
+    tokenUrl: https://pb33f.io/token
+    scopes:
+      read: read things
+security:
+  - key: []`
+
+func TestConvertLowSwagger(t *testing.T) {
+	converted, err := ConvertLowSwagger(loadSwagger(t, []byte(swaggerSpec)), "")
+	assert.NoError(t, err)
+	doc := renderAndReload(t, converted)
+
+	assert.Equal(t, OpenAPI30, doc.Version)
+	assert.Equal(t, "pets", doc.Info.Title)
+	assert.Equal(t, true, doc.Extensions["x-pet"])
+	assert.Equal(t, "item", doc.Paths.PathItems.GetOrZero("/pets").Extensions["x-path"])
+	assert.Len(t, doc.Security, 1)
+
+	// servers
+	assert.Len(t, doc.Servers, 2)
+	assert.Equal(t, "https://pets.pb33f.io/v1", doc.Servers[0].URL)
+	assert.Equal(t, "http://pets.pb33f.io/v1", doc.Servers[1].URL)
+
+	// components
+	assert.Equal(t, 2, doc.Components.Schemas.Len())
+	pet := doc.Components.Schemas.GetOrZero("Pet").Schema()
+	assert.Equal(t, "kind", pet.Discriminator.PropertyName)
+	assert.True(t, *pet.Properties.GetOrZero("owner").Schema().Nullable)
+	assert.Nil(t, pet.Properties.GetOrZero("owner").Schema().Extensions["x-nullable"])
+	assert.True(t, pet.Properties.GetOrZero("age").Schema().ExclusiveMinimum.A)
+	assert.Equal(t, "#/components/schemas/Pet", doc.Components.RequestBodies.GetOrZero("NewPet").
+		Content.GetOrZero(defaultMediaType).Schema.GetReference())
+	assert.Equal(t, "yes", doc.Components.Parameters.GetOrZero("limit").Extensions["x-limit"])
+	assert.Equal(t, int64(100), *doc.Components.Parameters.GetOrZero("limit").Schema.Schema().Maximum)
+	assert.Equal(t, "#/components/schemas/Error", doc.Components.Responses.GetOrZero("Error").
+		Content.GetOrZero(defaultMediaType).Schema.GetReference())
+
+	// security schemes
+	assert.Equal(t, "http", doc.Components.SecuritySchemes.GetOrZero("basic").Type)
+	assert.Equal(t, "basic", doc.Components.SecuritySchemes.GetOrZero("basic").Scheme)
+	assert.Equal(t, "header", doc.Components.SecuritySchemes.GetOrZero("key").In)
+	oauth := doc.Components.SecuritySchemes.GetOrZero("oauth").Flows.AuthorizationCode
+	assert.Equal(t, "https://pb33f.io/token", oauth.TokenUrl)
+	assert.Equal(t, "read things", oauth.Scopes.GetOrZero("read"))
+
+	// parameters and responses
+	list := doc.Paths.PathItems.GetOrZero("/pets").Get
+	assert.Len(t, list.Parameters, 2)
+	assert.Equal(t, "#/components/parameters/limit", list.GoLow().Parameters.Value[0].Value.GetReference())
+	assert.Equal(t, "pipeDelimited", list.Parameters[1].Style)
+	ok := list.Responses.Codes.GetOrZero("200")
+	assert.Equal(t, 2, ok.Content.Len())
+	assert.Equal(t, "#/components/schemas/Pet", ok.Content.GetOrZero("application/xml").Schema.Schema().Items.A.GetReference())
+	assert.Equal(t, "int32", ok.Headers.GetOrZero("X-Rate-Limit").Schema.Schema().Format)
+	assert.NotNil(t, ok.Content.GetOrZero(defaultMediaType).Example)
+	assert.Equal(t, "#/components/responses/Error", list.Responses.GoLow().Default.Value.GetReference())
+
+	// body parameter
+	create := doc.Paths.PathItems.GetOrZero("/pets").Post
+	assert.True(t, *create.RequestBody.Required)
+	assert.Equal(t, "#/components/schemas/Pet", create.RequestBody.Content.GetOrZero(defaultMediaType).Schema.GetReference())
+	assert.Len(t, create.Servers, 1)
+
+	// form parameters
+	photo := doc.Paths.PathItems.GetOrZero("/pets/{petId}/photo")
+	assert.Len(t, photo.Parameters, 1)
+	form := photo.Put.RequestBody.Content.GetOrZero(multipartType).Schema.Schema()
+	assert.Equal(t, []string{"photo"}, form.Required)
+	assert.Equal(t, "binary", form.Properties.GetOrZero("photo").Schema().Format)
+	assert.Equal(t, []string{"string"}, form.Properties.GetOrZero("caption").Schema().Type)
+}
+
+func TestConvertSwagger_OpenAPI31(t *testing.T) {
+	swagger := v2high.NewSwaggerDocument(loadSwagger(t, []byte(swaggerSpec)))
+	converted, err := ConvertSwagger(swagger, OpenAPI31)
+	assert.NoError(t, err)
+	doc := renderAndReload(t, converted)
+
+	assert.Equal(t, OpenAPI31, doc.Version)
+	pet := doc.Components.Schemas.GetOrZero("Pet").Schema()
+	owner := pet.Properties.GetOrZero("owner").Schema()
+	assert.Nil(t, owner.Nullable)
+	assert.Equal(t, []string{"string", "null"}, owner.Type)
+	age := pet.Properties.GetOrZero("age").Schema()
+	assert.Nil(t, age.Minimum)
+	assert.Equal(t, int64(0), age.ExclusiveMinimum.B)
+}
+
+func TestConvertSwagger_Petstore(t *testing.T) {
+	spec, _ := os.ReadFile("../test_specs/petstorev2-complete.yaml")
+	converted, err := ConvertLowSwagger(loadSwagger(t, spec), OpenAPI30)
+	assert.NoError(t, err)
+	doc := renderAndReload(t, converted)
+
+	assert.Len(t, doc.Servers, 2)
+	assert.Equal(t, 6, doc.Components.Schemas.Len())
+	assert.Equal(t, 3, doc.Components.SecuritySchemes.Len())
+	assert.Equal(t, "fresh", doc.Paths.Extensions["x-minty"])
+	upload := doc.Paths.PathItems.GetOrZero("/pet/{petId}/uploadImage").Post
+	assert.Equal(t, "binary", upload.RequestBody.Content.GetOrZero(multipartType).Schema.Schema().
+		Properties.GetOrZero("file").Schema().Format)
+	assert.NotNil(t, doc.Paths.PathItems.GetOrZero("/pet/{petId}").Post.RequestBody.Content.GetOrZero(formMediaType))
+}
+
+func TestConvertSwagger_Servers(t *testing.T) {
+	c := &swaggerConverter{swagger: &v2high.Swagger{BasePath: "/api"}}
+	assert.Equal(t, "/api", c.convertServers(nil)[0].URL)
+
+	c.swagger.Host = "pb33f.io"
+	assert.Equal(t, "//pb33f.io/api", c.convertServers(nil)[0].URL)
+
+	c.swagger = &v2high.Swagger{}
+	assert.Nil(t, c.convertServers([]string{"https"}))
+}
+
+func TestConvertReference(t *testing.T) {
+	assert.Equal(t, "#/components/schemas/Pet", convertReference("#/definitions/Pet"))
+	assert.Equal(t, "models.yaml#/components/responses/Error", convertReference("models.yaml#/responses/Error"))
+	assert.Equal(t, "models.yaml", convertReference("models.yaml"))
+}
+
+func TestConvertSwagger_Errors(t *testing.T) {
+	_, err := ConvertSwagger(nil, OpenAPI30)
+	assert.Error(t, err)
+
+	_, err = ConvertLowSwagger(nil, OpenAPI30)
+	assert.Error(t, err)
+
+	_, err = ConvertSwagger(&v2high.Swagger{}, "2.0")
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "version '2.0' is not supported")
+}
@@ -0,0 +1,204 @@
+// Copyright 2023 Princess B33f Heavy Industries / Dave Shanley
+// SPDX-License-Identifier: MIT
+
+package converter
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/pb33f/libopenapi/datamodel/high/base"
+	v3high "github.com/pb33f/libopenapi/datamodel/high/v3"
+	"github.com/pb33f/libopenapi/orderedmap"
+)
+
+// DefaultJSONSchemaDialect is the dialect used by OpenAPI 3.1 schemas, it's set on documents that are upgraded.
+const DefaultJSONSchemaDialect = "https://spec.openapis.org/oas/3.1/dialect/base"
+
+// DowngradeWarning describes something in an OpenAPI 3.1 document that cannot be expressed in OpenAPI 3.0, which
+// was changed or removed when the document was downgraded.
+type DowngradeWarning struct {
+	Path    string // JSON Pointer to the object that was changed, for example /components/schemas/Pet
+	Message string // what was changed, and why.
+}
+
+// Error returns a string representation of the warning, so it can be used as an error.
+func (w *DowngradeWarning) Error() string {
+	return fmt.Sprintf("%s: %s", w.Path, w.Message)
+}
+
+// UpgradeDocument re-writes an OpenAPI 3.0 document into OpenAPI 3.1 form. Everything in OpenAPI 3.0 can be
+// expressed in OpenAPI 3.1, so nothing is lost.
+//
+//   - nullable becomes a 'null' type, which is added to the type array (and the enum, if there is one).
+//   - boolean exclusiveMinimum / exclusiveMaximum values become numbers, replacing minimum / maximum.
+//   - the example of a schema becomes the examples array.
+//   - jsonSchemaDialect is set to DefaultJSONSchemaDialect, unless it's already set.
+//
+// The document is changed in place, render it (or use RenderAndReload) to produce the new specification.
+func UpgradeDocument(document *v3high.Document) error {
+	if document == nil {
+		return errors.New("unable to upgrade, the document is nil")
+	}
+	if !strings.HasPrefix(document.Version, "3.0") {
+		return fmt.Errorf("unable to upgrade, version '%s' is not OpenAPI 3.0", document.Version)
+	}
+	document.Version = OpenAPI31
+	if document.JsonSchemaDialect == "" {
+		document.JsonSchemaDialect = DefaultJSONSchemaDialect
+	}
+	walkSchemas(document, func(schema *base.Schema, _ string) {
+		upgradeSchema(schema)
+	})
+	return nil
+}
+
+// DowngradeDocument re-writes an OpenAPI 3.1 document into OpenAPI 3.0 form. This is a best-effort conversion,
+// OpenAPI 3.1 schemas are full JSON Schema, and not everything can be expressed in OpenAPI 3.0.
+//
+//   - 'null' types become nullable, multiple types cannot be expressed, so they are removed.
+//   - numeric exclusiveMinimum / exclusiveMaximum values become minimum / maximum, with a boolean flag.
+//   - the first of the examples array becomes the example of a schema.
+//   - keywords that only exist in JSON Schema (prefixItems, if / then / else, patternProperties etc.) are removed.
+//   - webhooks, jsonSchemaDialect and the summary of the info object are removed.
+//
+// Anything that could not be expressed is returned as a DowngradeWarning. The document is changed in place, render
+// it (or use RenderAndReload) to produce the new specification.
+func DowngradeDocument(document *v3high.Document) ([]*DowngradeWarning, error) {
+	if document == nil {
+		return nil, errors.New("unable to downgrade, the document is nil")
+	}
+	if !strings.HasPrefix(document.Version, "3.1") {
+		return nil, fmt.Errorf("unable to downgrade, version '%s' is not OpenAPI 3.1", document.Version)
+	}
+	var warnings []*DowngradeWarning
+	warn := func(path, message string, args ...any) {
+		warnings = append(warnings, &DowngradeWarning{Path: path, Message: fmt.Sprintf(message, args...)})
+	}
+
+	// schemas are downgraded first, webhooks are walked (and reported) before they are removed.
+	walkSchemas(document, func(schema *base.Schema, path string) {
+		downgradeSchema(schema, func(message string, args ...any) {
+			warn(path, message, args...)
+		})
+	})
+
+	document.Version = OpenAPI30
+	if document.JsonSchemaDialect != "" && document.JsonSchemaDialect != DefaultJSONSchemaDialect {
+		warn("/jsonSchemaDialect", "the JSON Schema dialect '%s' cannot be used, it has been removed",
+			document.JsonSchemaDialect)
+	}
+	document.JsonSchemaDialect = ""
+	for _, name := range document.Webhooks.Keys() {
+		warn(appendPointer("/webhooks", name),
+			"webhooks are not supported by OpenAPI 3.0, the webhook has been removed")
+	}
+	document.Webhooks = nil
+	if document.Info != nil && document.Info.Summary != "" {
+		warn("/info/summary", "summary is not supported by OpenAPI 3.0, it has been removed")
+		document.Info.Summary = ""
+	}
+	if document.Paths == nil {
+		// paths are optional in 3.1, but required in 3.0.
+		document.Paths = &v3high.Paths{PathItems: orderedmap.New[string, *v3high.PathItem]()}
+	}
+	return warnings, nil
+}
+
+// downgradeSchema changes the properties of a schema that are not compatible with OpenAPI 3.0. Anything that
+// cannot be expressed is removed, and reported using warn.
+func downgradeSchema(s *base.Schema, warn func(message string, args ...any)) {
+	if len(s.Type) > 1 || (len(s.Type) == 1 && s.Type[0] == "null") {
+		var types []string
+		for _, t := range s.Type {
+			if t == "null" {
+				nullable := true
+				s.Nullable = &nullable
+				continue
+			}
+			types = append(types, t)
+		}
+		switch len(types) {
+		case 0:
+			warn("a 'null' type cannot be expressed in OpenAPI 3.0, the type has been removed")
+			s.Type = nil
+		case 1:
+			s.Type = types
+		default:
+			warn("multiple types (%s) cannot be expressed in OpenAPI 3.0, the type has been removed",
+				strings.Join(types, ", "))
+			s.Type = nil
+		}
+	}
+
+	// the stricter of the two limits is kept, if both are set.
+	if s.ExclusiveMaximum != nil && s.ExclusiveMaximum.IsB() {
+		limit := s.ExclusiveMaximum.B
+		if s.Maximum != nil && *s.Maximum < limit {
+			s.ExclusiveMaximum = nil
+		} else {
+			s.Maximum = &limit
+			s.ExclusiveMaximum = &base.DynamicValue[bool, int64]{A: true}
+		}
+	}
+	if s.ExclusiveMinimum != nil && s.ExclusiveMinimum.IsB() {
+		limit := s.ExclusiveMinimum.B
+		if s.Minimum != nil && *s.Minimum > limit {
+			s.ExclusiveMinimum = nil
+		} else {
+			s.Minimum = &limit
+			s.ExclusiveMinimum = &base.DynamicValue[bool, int64]{A: true}
+		}
+	}
+
+	if len(s.Examples) > 0 {
+		if s.Example == nil {
+			s.Example = s.Examples[0]
+			if len(s.Examples) > 1 {
+				warn("only one example can be used in OpenAPI 3.0, %d examples have been removed", len(s.Examples)-1)
+			}
+		} else {
+			warn("examples are not supported by OpenAPI 3.0, %d examples have been removed", len(s.Examples))
+		}
+		s.Examples = nil
+	}
+
+	// a boolean 'items' value is either meaningless (true) or means there cannot be any items (false).
+	if s.Items != nil && s.Items.IsB() {
+		if !s.Items.B {
+			var none int64
+			s.MaxItems = &none
+		}
+		s.Items = nil
+	}
+
+	for _, k := range []struct {
+		keyword string
+		present bool
+	}{
+		{"$schema", s.SchemaTypeRef != ""},
+		{"prefixItems", s.PrefixItems != nil},
+		{"contains", s.Contains != nil},
+		{"minContains", s.MinContains != nil},
+		{"maxContains", s.MaxContains != nil},
+		{"if", s.If != nil},
+		{"then", s.Then != nil},
+		{"else", s.Else != nil},
+		{"dependentSchemas", s.DependentSchemas != nil},
+		{"patternProperties", s.PatternProperties != nil},
+		{"propertyNames", s.PropertyNames != nil},
+		{"unevaluatedItems", s.UnevaluatedItems != nil},
+		{"unevaluatedProperties", s.UnevaluatedProperties != nil},
+	} {
+		if k.present {
+			warn("'%s' is not supported by OpenAPI 3.0, it has been removed", k.keyword)
+		}
+	}
+	s.SchemaTypeRef = ""
+	s.PrefixItems = nil
+	s.Contains, s.MinContains, s.MaxContains = nil, nil, nil
+	s.If, s.Then, s.Else = nil, nil, nil
+	s.DependentSchemas, s.PatternProperties, s.PropertyNames = nil, nil, nil
+	s.UnevaluatedItems, s.UnevaluatedProperties = nil, nil
+}
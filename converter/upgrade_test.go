@@ -0,0 +1,257 @@
+// Copyright 2023 Princess B33f Heavy Industries / Dave Shanley
+// SPDX-License-Identifier: MIT
+
+package converter
+
+import (
+	"os"
+	"testing"
+
+	"github.com/pb33f/libopenapi/datamodel"
+	v3high "github.com/pb33f/libopenapi/datamodel/high/v3"
+	v3low "github.com/pb33f/libopenapi/datamodel/low/v3"
+	whatchanged "github.com/pb33f/libopenapi/what-changed"
+	"github.com/stretchr/testify/assert"
+)
+
+func loadDocument(t *testing.T, spec []byte) *v3high.Document {
+	info, err := datamodel.ExtractSpecInfo(spec)
+	assert.NoError(t, err)
+	lowDoc, errs := v3low.CreateDocument(info)
+	assert.Empty(t, errs)
+	return v3high.NewDocument(lowDoc)
+}
+
+func TestUpgradeDocument(t *testing.T) {
+	spec := `openapi: 3.0.3
+info:
+  title: pets
+  version: "1"
+paths:
+  /pets:
+    get:
+      parameters:
+        - name: limit
+          in: query
+          schema:
+            type: integer
+            minimum: 1
+            exclusiveMinimum: true
+            maximum: 100
+            exclusiveMaximum: false
+      responses:
+        "200":
+          description: ok
+          content:
+            application/json:
+              schema:
+                type: array
+                items:
+                  $ref: '#/components/schemas/Pet'
+      callbacks:
+        onPet:
+          '{$request.body#/url}':
+            post:
+              requestBody:
+                content:
+                  application/json:
+                    schema:
+                      type: string
+                      nullable: true
+              responses:
+                "200":
+                  description: ok
+components:
+  schemas:
+    Pet:
+      type: object
+      example:
+        name: fido
+      properties:
+        name:
+          type: string
+        status:
+          type: string
+          nullable: true
+          enum: [available, sold]
+        age:
+          nullable: false
+          type: integer`
+
+	doc := loadDocument(t, []byte(spec))
+	assert.NoError(t, UpgradeDocument(doc))
+
+	// null is added to the enum, so it's still allowed.
+	status := doc.Components.Schemas.GetOrZero("Pet").Schema().Properties.GetOrZero("status").Schema()
+	assert.Equal(t, []any{"available", "sold", nil}, status.Enum)
+
+	rendered, err := doc.Render()
+	assert.NoError(t, err)
+	info, _ := datamodel.ExtractSpecInfo(rendered)
+	violations, err := datamodel.ValidateSpecInfo(info)
+	assert.NoError(t, err)
+	assert.Empty(t, violations)
+
+	doc = renderAndReload(t, doc)
+	assert.Equal(t, OpenAPI31, doc.Version)
+	assert.Equal(t, DefaultJSONSchemaDialect, doc.JsonSchemaDialect)
+
+	limit := doc.Paths.PathItems.GetOrZero("/pets").Get.Parameters[0].Schema.Schema()
+	assert.Nil(t, limit.Minimum)
+	assert.Equal(t, int64(1), limit.ExclusiveMinimum.B)
+	assert.Equal(t, int64(100), *limit.Maximum)
+	assert.Nil(t, limit.ExclusiveMaximum)
+
+	pet := doc.Components.Schemas.GetOrZero("Pet").Schema()
+	assert.Nil(t, pet.Example)
+	assert.Equal(t, []any{map[string]any{"name": "fido"}}, pet.Examples)
+	status = pet.Properties.GetOrZero("status").Schema()
+	assert.Nil(t, status.Nullable)
+	assert.Equal(t, []string{"string", "null"}, status.Type)
+	assert.Len(t, status.Enum, 3)
+	age := pet.Properties.GetOrZero("age").Schema()
+	assert.Nil(t, age.Nullable)
+	assert.Equal(t, []string{"integer"}, age.Type)
+
+	callback := doc.Paths.PathItems.GetOrZero("/pets").Get.Callbacks.GetOrZero("onPet").Expression.GetOrZero("{$request.body#/url}")
+	assert.Equal(t, []string{"string", "null"}, callback.Post.RequestBody.Content.GetOrZero("application/json").
+		Schema.Schema().Type)
+}
+
+func TestDowngradeDocument(t *testing.T) {
+	spec := `openapi: 3.1.0
+jsonSchemaDialect: https://json-schema.org/draft/2020-12/schema
+info:
+  title: pets
+  summary: all the pets
+  version: "1"
+webhooks:
+  newPet:
+    post:
+      requestBody:
+        content:
+          application/json:
+            schema:
+              $ref: '#/components/schemas/Pet'
+      responses:
+        "200":
+          description: ok
+components:
+  schemas:
+    Pet:
+      type: object
+      examples:
+        - name: fido
+        - name: rover
+      properties:
+        name:
+          type: [string, "null"]
+        tag:
+          type: [string, integer]
+        nothing:
+          type: "null"
+        age:
+          type: integer
+          exclusiveMinimum: 0
+          minimum: 5
+          exclusiveMaximum: 20
+        tags:
+          type: array
+          prefixItems:
+            - type: string
+          items: false
+      patternProperties:
+        "^x-":
+          type: string`
+
+	doc := loadDocument(t, []byte(spec))
+	warnings, err := DowngradeDocument(doc)
+	assert.NoError(t, err)
+
+	var messages []string
+	for _, w := range warnings {
+		messages = append(messages, w.Error())
+	}
+	assert.Equal(t, []string{
+		"/components/schemas/Pet: only one example can be used in OpenAPI 3.0, 1 examples have been removed",
+		"/components/schemas/Pet: 'patternProperties' is not supported by OpenAPI 3.0, it has been removed",
+		"/components/schemas/Pet/properties/tag: multiple types (string, integer) cannot be expressed in " +
+			"OpenAPI 3.0, the type has been removed",
+		"/components/schemas/Pet/properties/nothing: a 'null' type cannot be expressed in OpenAPI 3.0, " +
+			"the type has been removed",
+		"/components/schemas/Pet/properties/tags: 'prefixItems' is not supported by OpenAPI 3.0, it has been removed",
+		"/jsonSchemaDialect: the JSON Schema dialect 'https://json-schema.org/draft/2020-12/schema' cannot be " +
+			"used, it has been removed",
+		"/webhooks/newPet: webhooks are not supported by OpenAPI 3.0, the webhook has been removed",
+		"/info/summary: summary is not supported by OpenAPI 3.0, it has been removed",
+	}, messages)
+
+	rendered, err := doc.Render()
+	assert.NoError(t, err)
+	info, _ := datamodel.ExtractSpecInfo(rendered)
+	violations, err := datamodel.ValidateSpecInfo(info)
+	assert.NoError(t, err)
+	assert.Empty(t, violations)
+
+	doc = renderAndReload(t, doc)
+	assert.Equal(t, OpenAPI30, doc.Version)
+	assert.Empty(t, doc.JsonSchemaDialect)
+	assert.Nil(t, doc.Webhooks)
+	assert.Empty(t, doc.Info.Summary)
+	assert.NotNil(t, doc.Paths)
+
+	pet := doc.Components.Schemas.GetOrZero("Pet").Schema()
+	assert.Equal(t, map[string]any{"name": "fido"}, pet.Example)
+	assert.Nil(t, pet.PatternProperties)
+	name := pet.Properties.GetOrZero("name").Schema()
+	assert.Equal(t, []string{"string"}, name.Type)
+	assert.True(t, *name.Nullable)
+	assert.Nil(t, pet.Properties.GetOrZero("tag").Schema().Type)
+	assert.True(t, *pet.Properties.GetOrZero("nothing").Schema().Nullable)
+
+	age := pet.Properties.GetOrZero("age").Schema()
+	assert.Equal(t, int64(5), *age.Minimum)
+	assert.Nil(t, age.ExclusiveMinimum)
+	assert.Equal(t, int64(20), *age.Maximum)
+	assert.True(t, age.ExclusiveMaximum.A)
+
+	tags := pet.Properties.GetOrZero("tags").Schema()
+	assert.Nil(t, tags.PrefixItems)
+	assert.Nil(t, tags.Items)
+	assert.Equal(t, int64(0), *tags.MaxItems)
+}
+
+func TestUpgradeDocument_RoundTrip(t *testing.T) {
+	spec, _ := os.ReadFile("../test_specs/petstorev3.json")
+	// rendering drops empty values, so the original is rendered too.
+	original := renderAndReload(t, loadDocument(t, spec))
+	doc := loadDocument(t, spec)
+
+	assert.NoError(t, UpgradeDocument(doc))
+	doc = renderAndReload(t, doc)
+	assert.Equal(t, OpenAPI31, doc.Version)
+
+	warnings, err := DowngradeDocument(doc)
+	assert.NoError(t, err)
+	assert.Empty(t, warnings)
+	doc = renderAndReload(t, doc)
+
+	// only the version is different.
+	changes := whatchanged.CompareOpenAPIDocuments(original.GoLow(), doc.GoLow())
+	assert.Equal(t, 1, changes.TotalChanges())
+	assert.Equal(t, "openapi", changes.Changes[0].Property)
+	assert.Equal(t, OpenAPI30, changes.Changes[0].New)
+}
+
+func TestUpgradeDocument_Errors(t *testing.T) {
+	assert.Error(t, UpgradeDocument(nil))
+	err := UpgradeDocument(&v3high.Document{Version: "3.1.0"})
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "version '3.1.0' is not OpenAPI 3.0")
+
+	_, err = DowngradeDocument(nil)
+	assert.Error(t, err)
+	_, err = DowngradeDocument(&v3high.Document{Version: "3.0.1"})
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "version '3.0.1' is not OpenAPI 3.1")
+}
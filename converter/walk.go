@@ -0,0 +1,210 @@
+// Copyright 2023 Princess B33f Heavy Industries / Dave Shanley
+// SPDX-License-Identifier: MIT
+
+package converter
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/pb33f/libopenapi/datamodel/high/base"
+	v3high "github.com/pb33f/libopenapi/datamodel/high/v3"
+	"github.com/pb33f/libopenapi/orderedmap"
+)
+
+// schemaVisitor is called for every inline schema found when walking a document, along with a JSON Pointer
+// to the location of the schema. Any schemas the visitor removes from the schema are not walked.
+type schemaVisitor func(schema *base.Schema, path string)
+
+// schemaWalker walks every object in an OpenAPI 3 document that can contain a schema. References are not
+// followed, the schemas they point to are visited where they are defined.
+type schemaWalker struct {
+	visit schemaVisitor
+}
+
+func walkSchemas(document *v3high.Document, visit schemaVisitor) {
+	w := &schemaWalker{visit: visit}
+	if c := document.Components; c != nil {
+		for _, name := range c.Schemas.Keys() {
+			w.schemaProxy(c.Schemas.GetOrZero(name), appendPointer("/components/schemas", name))
+		}
+		for _, name := range c.Responses.Keys() {
+			w.response(c.Responses.GetOrZero(name), appendPointer("/components/responses", name))
+		}
+		for _, name := range c.Parameters.Keys() {
+			w.parameter(c.Parameters.GetOrZero(name), appendPointer("/components/parameters", name))
+		}
+		for _, name := range c.RequestBodies.Keys() {
+			w.requestBody(c.RequestBodies.GetOrZero(name), appendPointer("/components/requestBodies", name))
+		}
+		for _, name := range c.Headers.Keys() {
+			w.header(c.Headers.GetOrZero(name), appendPointer("/components/headers", name))
+		}
+		for _, name := range c.Callbacks.Keys() {
+			w.callback(c.Callbacks.GetOrZero(name), appendPointer("/components/callbacks", name))
+		}
+	}
+	if document.Paths != nil {
+		for _, path := range document.Paths.PathItems.Keys() {
+			w.pathItem(document.Paths.PathItems.GetOrZero(path), appendPointer("/paths", path))
+		}
+	}
+	for _, name := range document.Webhooks.Keys() {
+		w.pathItem(document.Webhooks.GetOrZero(name), appendPointer("/webhooks", name))
+	}
+}
+
+func (w *schemaWalker) pathItem(pathItem *v3high.PathItem, path string) {
+	if pathItem == nil {
+		return
+	}
+	w.parameters(pathItem.Parameters, appendPointer(path, "parameters"))
+	operations := []struct {
+		method    string
+		operation *v3high.Operation
+	}{
+		{"get", pathItem.Get}, {"put", pathItem.Put}, {"post", pathItem.Post}, {"delete", pathItem.Delete},
+		{"options", pathItem.Options}, {"head", pathItem.Head}, {"patch", pathItem.Patch}, {"trace", pathItem.Trace},
+	}
+	for _, op := range operations {
+		if op.operation != nil {
+			w.operation(op.operation, appendPointer(path, op.method))
+		}
+	}
+}
+
+func (w *schemaWalker) operation(op *v3high.Operation, path string) {
+	w.parameters(op.Parameters, appendPointer(path, "parameters"))
+	w.requestBody(op.RequestBody, appendPointer(path, "requestBody"))
+	if op.Responses != nil {
+		for _, code := range op.Responses.Codes.Keys() {
+			w.response(op.Responses.Codes.GetOrZero(code), appendPointer(path, "responses", code))
+		}
+		w.response(op.Responses.Default, appendPointer(path, "responses", "default"))
+	}
+	for _, name := range op.Callbacks.Keys() {
+		w.callback(op.Callbacks.GetOrZero(name), appendPointer(path, "callbacks", name))
+	}
+}
+
+func (w *schemaWalker) callback(callback *v3high.Callback, path string) {
+	if callback == nil {
+		return
+	}
+	for _, expression := range callback.Expression.Keys() {
+		w.pathItem(callback.Expression.GetOrZero(expression), appendPointer(path, expression))
+	}
+}
+
+func (w *schemaWalker) parameters(params []*v3high.Parameter, path string) {
+	for i := range params {
+		w.parameter(params[i], appendPointer(path, strconv.Itoa(i)))
+	}
+}
+
+func (w *schemaWalker) parameter(param *v3high.Parameter, path string) {
+	if param == nil {
+		return
+	}
+	w.schemaProxy(param.Schema, appendPointer(path, "schema"))
+	w.content(param.Content, appendPointer(path, "content"))
+}
+
+func (w *schemaWalker) header(header *v3high.Header, path string) {
+	if header == nil {
+		return
+	}
+	w.schemaProxy(header.Schema, appendPointer(path, "schema"))
+	w.content(header.Content, appendPointer(path, "content"))
+}
+
+func (w *schemaWalker) requestBody(requestBody *v3high.RequestBody, path string) {
+	if requestBody == nil {
+		return
+	}
+	w.content(requestBody.Content, appendPointer(path, "content"))
+}
+
+func (w *schemaWalker) response(response *v3high.Response, path string) {
+	if response == nil {
+		return
+	}
+	for _, name := range response.Headers.Keys() {
+		w.header(response.Headers.GetOrZero(name), appendPointer(path, "headers", name))
+	}
+	w.content(response.Content, appendPointer(path, "content"))
+}
+
+func (w *schemaWalker) content(content *orderedmap.Map[string, *v3high.MediaType], path string) {
+	for _, mediaType := range content.Keys() {
+		mt := content.GetOrZero(mediaType)
+		if mt == nil {
+			continue
+		}
+		w.schemaProxy(mt.Schema, appendPointer(path, mediaType, "schema"))
+		for _, property := range mt.Encoding.Keys() {
+			if mt.Encoding.GetOrZero(property) == nil {
+				continue
+			}
+			headers := mt.Encoding.GetOrZero(property).Headers
+			for _, name := range headers.Keys() {
+				w.header(headers.GetOrZero(name), appendPointer(path, mediaType, "encoding", property, "headers", name))
+			}
+		}
+	}
+}
+
+func (w *schemaWalker) schemaProxy(sp *base.SchemaProxy, path string) {
+	if sp == nil || sp.IsReference() {
+		return
+	}
+	s := sp.Schema()
+	if s == nil {
+		return
+	}
+	w.visit(s, path)
+
+	for _, k := range []struct {
+		keyword string
+		proxies []*base.SchemaProxy
+	}{{"allOf", s.AllOf}, {"oneOf", s.OneOf}, {"anyOf", s.AnyOf}, {"prefixItems", s.PrefixItems}} {
+		for i := range k.proxies {
+			w.schemaProxy(k.proxies[i], appendPointer(path, k.keyword, strconv.Itoa(i)))
+		}
+	}
+	for _, k := range []struct {
+		keyword string
+		proxy   *base.SchemaProxy
+	}{
+		{"not", s.Not}, {"contains", s.Contains}, {"if", s.If}, {"then", s.Then}, {"else", s.Else},
+		{"propertyNames", s.PropertyNames}, {"unevaluatedItems", s.UnevaluatedItems},
+		{"unevaluatedProperties", s.UnevaluatedProperties},
+	} {
+		w.schemaProxy(k.proxy, appendPointer(path, k.keyword))
+	}
+	for _, k := range []struct {
+		keyword string
+		proxies *orderedmap.Map[string, *base.SchemaProxy]
+	}{{"properties", s.Properties}, {"patternProperties", s.PatternProperties}, {"dependentSchemas", s.DependentSchemas}} {
+		for _, name := range k.proxies.Keys() {
+			w.schemaProxy(k.proxies.GetOrZero(name), appendPointer(path, k.keyword, name))
+		}
+	}
+	if s.Items != nil && s.Items.IsA() {
+		w.schemaProxy(s.Items.A, appendPointer(path, "items"))
+	}
+	if additional, ok := s.AdditionalProperties.(*base.SchemaProxy); ok {
+		w.schemaProxy(additional, appendPointer(path, "additionalProperties"))
+	}
+}
+
+// appendPointer adds segments to a JSON Pointer, escaping them as it goes.
+func appendPointer(pointer string, segments ...string) string {
+	var sb strings.Builder
+	sb.WriteString(pointer)
+	for _, s := range segments {
+		sb.WriteString("/")
+		sb.WriteString(strings.ReplaceAll(strings.ReplaceAll(s, "~", "~0"), "/", "~1"))
+	}
+	return sb.String()
+}
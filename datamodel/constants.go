@@ -57,3 +57,8 @@ var OAS2Format = []string{OAS2}
 
 // AllFormats defines all versions of OpenAPI
 var AllFormats = []string{OAS3, OAS31, OAS2}
+
+// JSONSchemaDraft04Data is an embedded version of the JSON Schema draft-04 meta-schema, it's referenced by both
+// the Swagger and OpenAPI 3.0 schemas.
+//go:embed schemas/draft04-schema.json
+var JSONSchemaDraft04Data string // embedded JSON Schema draft-04 meta-schema
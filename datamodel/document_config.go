@@ -3,7 +3,12 @@
 
 package datamodel
 
-import "net/url"
+import (
+	"io/fs"
+	"net/url"
+
+	"github.com/pb33f/libopenapi/index"
+)
 
 // DocumentConfiguration is used to configure the document creation process. It was added in v0.6.0 to allow
 // for more fine-grained control over controls and new features.
@@ -24,6 +29,29 @@ type DocumentConfiguration struct {
 
 	// AllowRemoteReferences will allow the index to lookup remote references. This is disabled by default.
 	AllowRemoteReferences bool
+
+	// FS is the file system that file references are read from, instead of the local file system. This allows
+	// specifications to be loaded from an embed.FS, an in-memory fstest.MapFS, a zip archive or anything else
+	// that implements fs.FS. AllowFileReferences must still be enabled.
+	//
+	// When FS is set, the BasePath is a slash separated path inside the FS, rather than a path on disk. If there is
+	// no BasePath, references are resolved from the root of the FS.
+	FS fs.FS
+
+	// RemoteFetcher is used to fetch remote references, when AllowRemoteReferences is enabled. Use it to add
+	// authentication, a custom transport or a retry policy. If it's not set, remote references are fetched with
+	// a default HTTP client.
+	RemoteFetcher index.RemoteFetcher
+
+	// ReferenceLimits protect against untrusted specifications when resolving file and remote references. They
+	// can restrict the hosts that are used, block private networks, and limit the size, number and depth of
+	// external documents. There are no limits by default.
+	ReferenceLimits *index.ReferenceLimits
+
+	// KeepInputFormat will render documents in the format they were supplied in, when using RenderAndReload or
+	// RenderAndReloadSwagger. JSON specifications are rendered as JSON (indented using two spaces). By default,
+	// documents are always rendered as YAML.
+	KeepInputFormat bool
 }
 
 func NewOpenDocumentConfiguration() *DocumentConfiguration {
@@ -43,6 +43,26 @@ func (c *Contact) Render() ([]byte, error) {
 	return yaml.Marshal(c)
 }
 
+// RenderJSON will return a JSON representation of the Contact object as a byte slice, indented using indent.
+func (c *Contact) RenderJSON(indent string) ([]byte, error) {
+	return low2.RenderJSON(c, indent)
+}
+
+// MarshalJSON will create a ready to render JSON representation of the Contact object.
+func (c *Contact) MarshalJSON() ([]byte, error) {
+	return c.RenderJSON("")
+}
+
+// UnmarshalYAML will build the Contact object from a YAML node, see high.UnmarshalNode.
+func (c *Contact) UnmarshalYAML(node *yaml.Node) error {
+	return low2.UnmarshalNode(c, node, NewContact)
+}
+
+// UnmarshalJSON will build the Contact object from YAML or JSON, see high.UnmarshalBytes.
+func (c *Contact) UnmarshalJSON(data []byte) error {
+	return low2.UnmarshalBytes(c, data, NewContact)
+}
+
 func (c *Contact) MarshalYAML() (interface{}, error) {
 	nb := low2.NewNodeBuilder(c, c.low)
 	return nb.Render(), nil
@@ -6,6 +6,7 @@ package base
 import (
 	low2 "github.com/pb33f/libopenapi/datamodel/high"
 	low "github.com/pb33f/libopenapi/datamodel/low/base"
+	"github.com/pb33f/libopenapi/orderedmap"
 	"gopkg.in/yaml.v3"
 )
 
@@ -19,7 +20,7 @@ import (
 //  v3 - https://spec.openapis.org/oas/v3.1.0#discriminator-object
 type Discriminator struct {
 	PropertyName string            `json:"propertyName,omitempty" yaml:"propertyName,omitempty"`
-	Mapping      map[string]string `json:"mapping,omitempty" yaml:"mapping,omitempty"`
+	Mapping      *orderedmap.Map[string, string] `json:"mapping,omitempty" yaml:"mapping,omitempty"`
 	low          *low.Discriminator
 }
 
@@ -32,7 +33,7 @@ func NewDiscriminator(disc *low.Discriminator) *Discriminator {
 	for k, v := range disc.Mapping.Value {
 		mapping[k.Value] = v.Value
 	}
-	d.Mapping = mapping
+	d.Mapping = low2.ToOrderedMap(mapping, disc.Mapping.Value)
 	return d
 }
 
@@ -51,6 +52,16 @@ func (d *Discriminator) Render() ([]byte, error) {
 	return yaml.Marshal(d)
 }
 
+// RenderJSON will return a JSON representation of the Discriminator object as a byte slice, indented using indent.
+func (d *Discriminator) RenderJSON(indent string) ([]byte, error) {
+	return low2.RenderJSON(d, indent)
+}
+
+// MarshalJSON will create a ready to render JSON representation of the Discriminator object.
+func (d *Discriminator) MarshalJSON() ([]byte, error) {
+	return d.RenderJSON("")
+}
+
 // MarshalYAML will create a ready to render YAML representation of the Discriminator object.
 func (d *Discriminator) MarshalYAML() (interface{}, error) {
 	nb := low2.NewNodeBuilder(d, d.low)
@@ -31,7 +31,7 @@ mapping:
 	highDiscriminator := NewDiscriminator(&lowDiscriminator)
 
 	assert.Equal(t, "coffee", highDiscriminator.PropertyName)
-	assert.Equal(t, "in the morning", highDiscriminator.Mapping["fogCleaner"])
+	assert.Equal(t, "in the morning", highDiscriminator.Mapping.GetOrZero("fogCleaner"))
 	assert.Equal(t, 3, highDiscriminator.GoLow().FindMappingValue("fogCleaner").ValueNode.Line)
 
 	// render the example as YAML
@@ -59,6 +59,6 @@ mapping:
 	highDiscriminator := NewDiscriminator(&lowDiscriminator)
 
 	// print out a mapping defined for the discriminator.
-	fmt.Print(highDiscriminator.Mapping["coffee"])
+	fmt.Print(highDiscriminator.Mapping.GetOrZero("coffee"))
 	// Output: in the morning
 }
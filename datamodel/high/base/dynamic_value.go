@@ -37,6 +37,16 @@ func (d *DynamicValue[A, B]) Render() ([]byte, error) {
     return yaml.Marshal(d)
 }
 
+// RenderJSON will return a JSON representation of the DynamicValue object as a byte slice, indented using indent.
+func (d *DynamicValue[A, B]) RenderJSON(indent string) ([]byte, error) {
+    return high.RenderJSON(d, indent)
+}
+
+// MarshalJSON will create a ready to render JSON representation of the DynamicValue object.
+func (d *DynamicValue[A, B]) MarshalJSON() ([]byte, error) {
+    return d.RenderJSON("")
+}
+
 // MarshalYAML will create a ready to render YAML representation of the DynamicValue object.
 func (d *DynamicValue[A, B]) MarshalYAML() (interface{}, error) {
     // this is a custom renderer, we can't use the NodeBuilder out of the gate.
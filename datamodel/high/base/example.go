@@ -7,6 +7,7 @@ import (
 	"github.com/pb33f/libopenapi/datamodel/high"
 	lowmodel "github.com/pb33f/libopenapi/datamodel/low"
 	low "github.com/pb33f/libopenapi/datamodel/low/base"
+	"github.com/pb33f/libopenapi/orderedmap"
 	"gopkg.in/yaml.v3"
 )
 
@@ -48,6 +49,26 @@ func (e *Example) Render() ([]byte, error) {
 	return yaml.Marshal(e)
 }
 
+// RenderJSON will return a JSON representation of the Example object as a byte slice, indented using indent.
+func (e *Example) RenderJSON(indent string) ([]byte, error) {
+	return high.RenderJSON(e, indent)
+}
+
+// MarshalJSON will create a ready to render JSON representation of the Example object.
+func (e *Example) MarshalJSON() ([]byte, error) {
+	return e.RenderJSON("")
+}
+
+// UnmarshalYAML will build the Example object from a YAML node, see high.UnmarshalNode.
+func (e *Example) UnmarshalYAML(node *yaml.Node) error {
+	return high.UnmarshalNode(e, node, NewExample)
+}
+
+// UnmarshalJSON will build the Example object from YAML or JSON, see high.UnmarshalBytes.
+func (e *Example) UnmarshalJSON(data []byte) error {
+	return high.UnmarshalBytes(e, data, NewExample)
+}
+
 // MarshalYAML will create a ready to render YAML representation of the Example object.
 func (e *Example) MarshalYAML() (interface{}, error) {
 	nb := high.NewNodeBuilder(e, e.low)
@@ -56,10 +77,10 @@ func (e *Example) MarshalYAML() (interface{}, error) {
 
 // ExtractExamples will convert a low-level example map, into a high level one that is simple to navigate.
 // no fidelity is lost, everything is still available via GoLow()
-func ExtractExamples(elements map[lowmodel.KeyReference[string]]lowmodel.ValueReference[*low.Example]) map[string]*Example {
+func ExtractExamples(elements map[lowmodel.KeyReference[string]]lowmodel.ValueReference[*low.Example]) *orderedmap.Map[string, *Example] {
 	extracted := make(map[string]*Example)
 	for k, v := range elements {
 		extracted[k.Value] = NewExample(v.Value)
 	}
-	return extracted
+	return high.ToOrderedMap(extracted, elements)
 }
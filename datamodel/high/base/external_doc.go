@@ -54,6 +54,26 @@ func (e *ExternalDoc) Render() ([]byte, error) {
 	return yaml.Marshal(e)
 }
 
+// RenderJSON will return a JSON representation of the ExternalDoc object as a byte slice, indented using indent.
+func (e *ExternalDoc) RenderJSON(indent string) ([]byte, error) {
+	return high.RenderJSON(e, indent)
+}
+
+// MarshalJSON will create a ready to render JSON representation of the ExternalDoc object.
+func (e *ExternalDoc) MarshalJSON() ([]byte, error) {
+	return e.RenderJSON("")
+}
+
+// UnmarshalYAML will build the ExternalDoc object from a YAML node, see high.UnmarshalNode.
+func (e *ExternalDoc) UnmarshalYAML(node *yaml.Node) error {
+	return high.UnmarshalNode(e, node, NewExternalDoc)
+}
+
+// UnmarshalJSON will build the ExternalDoc object from YAML or JSON, see high.UnmarshalBytes.
+func (e *ExternalDoc) UnmarshalJSON(data []byte) error {
+	return high.UnmarshalBytes(e, data, NewExternalDoc)
+}
+
 // MarshalYAML will create a ready to render YAML representation of the ExternalDoc object.
 func (e *ExternalDoc) MarshalYAML() (interface{}, error) {
 	nb := high.NewNodeBuilder(e, e.low)
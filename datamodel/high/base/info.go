@@ -74,6 +74,26 @@ func (i *Info) Render() ([]byte, error) {
 	return yaml.Marshal(i)
 }
 
+// RenderJSON will return a JSON representation of the Info object as a byte slice, indented using indent.
+func (i *Info) RenderJSON(indent string) ([]byte, error) {
+	return high.RenderJSON(i, indent)
+}
+
+// MarshalJSON will create a ready to render JSON representation of the Info object.
+func (i *Info) MarshalJSON() ([]byte, error) {
+	return i.RenderJSON("")
+}
+
+// UnmarshalYAML will build the Info object from a YAML node, see high.UnmarshalNode.
+func (i *Info) UnmarshalYAML(node *yaml.Node) error {
+	return high.UnmarshalNode(i, node, NewInfo)
+}
+
+// UnmarshalJSON will build the Info object from YAML or JSON, see high.UnmarshalBytes.
+func (i *Info) UnmarshalJSON(data []byte) error {
+	return high.UnmarshalBytes(i, data, NewInfo)
+}
+
 // MarshalYAML will create a ready to render YAML representation of the Info object.
 func (i *Info) MarshalYAML() (interface{}, error) {
 	nb := high.NewNodeBuilder(i, i.low)
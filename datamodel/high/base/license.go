@@ -46,6 +46,26 @@ func (l *License) Render() ([]byte, error) {
 	return yaml.Marshal(l)
 }
 
+// RenderJSON will return a JSON representation of the License object as a byte slice, indented using indent.
+func (l *License) RenderJSON(indent string) ([]byte, error) {
+	return low2.RenderJSON(l, indent)
+}
+
+// MarshalJSON will create a ready to render JSON representation of the License object.
+func (l *License) MarshalJSON() ([]byte, error) {
+	return l.RenderJSON("")
+}
+
+// UnmarshalYAML will build the License object from a YAML node, see high.UnmarshalNode.
+func (l *License) UnmarshalYAML(node *yaml.Node) error {
+	return low2.UnmarshalNode(l, node, NewLicense)
+}
+
+// UnmarshalJSON will build the License object from YAML or JSON, see high.UnmarshalBytes.
+func (l *License) UnmarshalJSON(data []byte) error {
+	return low2.UnmarshalBytes(l, data, NewLicense)
+}
+
 // MarshalYAML will create a ready to render YAML representation of the License object.
 func (l *License) MarshalYAML() (interface{}, error) {
 	nb := low2.NewNodeBuilder(l, l.low)
@@ -11,6 +11,7 @@ import (
 	"github.com/pb33f/libopenapi/datamodel/high"
 	lowmodel "github.com/pb33f/libopenapi/datamodel/low"
 	"github.com/pb33f/libopenapi/datamodel/low/base"
+	"github.com/pb33f/libopenapi/orderedmap"
 )
 
 // Schema represents a JSON Schema that support Swagger, OpenAPI 3 and OpenAPI 3.1
@@ -59,8 +60,8 @@ type Schema struct {
 	If                    *SchemaProxy            `json:"if,omitempty" yaml:"if,omitempty"`
 	Else                  *SchemaProxy            `json:"else,omitempty" yaml:"else,omitempty"`
 	Then                  *SchemaProxy            `json:"then,omitempty" yaml:"then,omitempty"`
-	DependentSchemas      map[string]*SchemaProxy `json:"dependentSchemas,omitempty" yaml:"dependentSchemas,omitempty"`
-	PatternProperties     map[string]*SchemaProxy `json:"patternProperties,omitempty" yaml:"patternProperties,omitempty"`
+	DependentSchemas      *orderedmap.Map[string, *SchemaProxy] `json:"dependentSchemas,omitempty" yaml:"dependentSchemas,omitempty"`
+	PatternProperties     *orderedmap.Map[string, *SchemaProxy] `json:"patternProperties,omitempty" yaml:"patternProperties,omitempty"`
 	PropertyNames         *SchemaProxy            `json:"propertyNames,omitempty" yaml:"propertyNames,omitempty"`
 	UnevaluatedItems      *SchemaProxy            `json:"unevaluatedItems,omitempty" yaml:"unevaluatedItems,omitempty"`
 	UnevaluatedProperties *SchemaProxy            `json:"unevaluatedProperties,omitempty" yaml:"unevaluatedProperties,omitempty"`
@@ -70,7 +71,7 @@ type Schema struct {
 
 	// Compatible with all versions
 	Not                  *SchemaProxy            `json:"not,omitempty" yaml:"not,omitempty"`
-	Properties           map[string]*SchemaProxy `json:"properties,omitempty" yaml:"properties,omitempty"`
+	Properties           *orderedmap.Map[string, *SchemaProxy] `json:"properties,omitempty" yaml:"properties,omitempty"`
 	Title                string                  `json:"title,omitempty" yaml:"title,omitempty"`
 	MultipleOf           *int64                  `json:"multipleOf,omitempty" yaml:"multipleOf,omitempty"`
 	Maximum              *int64                  `json:"maximum,omitempty" yaml:"maximum,omitempty"`
@@ -329,7 +330,7 @@ func NewSchema(schema *base.Schema) *Schema {
 	// props async
 	var plock sync.Mutex
 	buildProps := func(k lowmodel.KeyReference[string], v lowmodel.ValueReference[*base.SchemaProxy], c chan bool,
-		props map[string]*SchemaProxy, sw int,
+		props map[string]*SchemaProxy,
 	) {
 		plock.Lock()
 		props[k.Value] = &SchemaProxy{
@@ -340,30 +341,21 @@ func NewSchema(schema *base.Schema) *Schema {
 			},
 		}
 		plock.Unlock()
-
-		switch sw {
-		case 0:
-			s.Properties = props
-		case 1:
-			s.DependentSchemas = props
-		case 2:
-			s.PatternProperties = props
-		}
 		c <- true
 	}
 
 	props := make(map[string]*SchemaProxy)
 	for k, v := range schema.Properties.Value {
-		go buildProps(k, v, propsChan, props, 0)
+		go buildProps(k, v, propsChan, props)
 	}
 
 	dependents := make(map[string]*SchemaProxy)
 	for k, v := range schema.DependentSchemas.Value {
-		go buildProps(k, v, propsChan, dependents, 1)
+		go buildProps(k, v, propsChan, dependents)
 	}
 	patternProps := make(map[string]*SchemaProxy)
 	for k, v := range schema.PatternProperties.Value {
-		go buildProps(k, v, propsChan, patternProps, 2)
+		go buildProps(k, v, propsChan, patternProps)
 	}
 
 	var allOf []*SchemaProxy
@@ -429,6 +421,15 @@ func NewSchema(schema *base.Schema) *Schema {
 			}
 		}
 	}
+	if len(props) > 0 {
+		s.Properties = high.ToOrderedMap(props, schema.Properties.Value)
+	}
+	if len(dependents) > 0 {
+		s.DependentSchemas = high.ToOrderedMap(dependents, schema.DependentSchemas.Value)
+	}
+	if len(patternProps) > 0 {
+		s.PatternProperties = high.ToOrderedMap(patternProps, schema.PatternProperties.Value)
+	}
 	s.OneOf = oneOf
 	s.AnyOf = anyOf
 	s.AllOf = allOf
@@ -453,6 +454,26 @@ func (s *Schema) Render() ([]byte, error) {
 	return yaml.Marshal(s)
 }
 
+// RenderJSON will return a JSON representation of the Schema object as a byte slice, indented using indent.
+func (s *Schema) RenderJSON(indent string) ([]byte, error) {
+	return high.RenderJSON(s, indent)
+}
+
+// MarshalJSON will create a ready to render JSON representation of the Schema object.
+func (s *Schema) MarshalJSON() ([]byte, error) {
+	return s.RenderJSON("")
+}
+
+// UnmarshalYAML will build the Schema object from a YAML node, see high.UnmarshalNode.
+func (s *Schema) UnmarshalYAML(node *yaml.Node) error {
+	return high.UnmarshalNode(s, node, NewSchema)
+}
+
+// UnmarshalJSON will build the Schema object from YAML or JSON, see high.UnmarshalBytes.
+func (s *Schema) UnmarshalJSON(data []byte) error {
+	return high.UnmarshalBytes(s, data, NewSchema)
+}
+
 // MarshalYAML will create a ready to render YAML representation of the ExternalDoc object.
 func (s *Schema) MarshalYAML() (interface{}, error) {
 	nb := high.NewNodeBuilder(s, s.low)
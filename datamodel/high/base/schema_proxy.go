@@ -135,15 +135,32 @@ func (sp *SchemaProxy) GoLowUntyped() any {
     return sp.schema.Value
 }
 
-// Render will return a YAML representation of the Schema object as a byte slice.
+// Render will return a YAML representation of the SchemaProxy as a byte slice, see MarshalYAML.
 func (sp *SchemaProxy) Render() ([]byte, error) {
     return yaml.Marshal(sp)
 }
 
-// MarshalYAML will create a ready to render YAML representation of the ExternalDoc object.
+// RenderJSON will return a JSON representation of the SchemaProxy as a byte slice, indented using indent. See
+// MarshalYAML for how references are rendered.
+func (sp *SchemaProxy) RenderJSON(indent string) ([]byte, error) {
+    return high.RenderJSON(sp, indent)
+}
+
+// MarshalJSON will create a ready to render JSON representation of the SchemaProxy, using compact JSON.
+func (sp *SchemaProxy) MarshalJSON() ([]byte, error) {
+    return sp.RenderJSON("")
+}
+
+// MarshalYAML will create a ready to render YAML representation of the SchemaProxy. A reference is rendered as a
+// $ref to the Schema (it is not built), a boolean schema (3.1) is rendered as the boolean, anything else builds the
+// Schema and renders it.
 func (sp *SchemaProxy) MarshalYAML() (interface{}, error) {
 	var s *Schema
 	var err error
+	// boolean schemas (3.1) are rendered as they were written, not as an empty schema.
+	if _, ok := proxyBooleanValue(sp); ok {
+		return sp.schema.Value.GetValueNode(), nil
+	}
 	// if this schema isn't a reference, then build it out.
 	if !sp.IsReference() {
 		s, err = sp.BuildSchema()
@@ -66,3 +66,27 @@ func TestCreateSchemaProxyRef(t *testing.T) {
     assert.True(t, sp.IsReference())
 }
 
+
+func TestSchemaProxy_MarshalYAML_BooleanSchemas(t *testing.T) {
+    sp := buildValidationSchema(t, `schema:
+  type: array
+  items: false
+  not: true
+  unevaluatedItems: false`)
+
+    rend, err := sp.Render()
+    assert.NoError(t, err)
+    assert.Equal(t, `type: array
+items: false
+not: true
+unevaluatedItems: false`, strings.TrimSpace(string(rend)))
+
+    // render again, the schemas are now built and cached.
+    rend, err = sp.Render()
+    assert.NoError(t, err)
+    assert.Contains(t, string(rend), "items: false")
+
+    rendJSON, err := sp.RenderJSON("")
+    assert.NoError(t, err)
+    assert.Contains(t, string(rendJSON), `"not":true`)
+}
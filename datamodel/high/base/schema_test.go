@@ -4,6 +4,7 @@
 package base
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 	"testing"
@@ -301,8 +302,8 @@ minProperties: 1`
 	assert.Equal(t, "string", compiled.If.Schema().Type[0])
 	assert.Equal(t, "integer", compiled.Else.Schema().Type[0])
 	assert.Equal(t, "boolean", compiled.Then.Schema().Type[0])
-	assert.Equal(t, "string", compiled.PatternProperties["patternOne"].Schema().Type[0])
-	assert.Equal(t, "string", compiled.DependentSchemas["schemaOne"].Schema().Type[0])
+	assert.Equal(t, "string", compiled.PatternProperties.GetOrZero("patternOne").Schema().Type[0])
+	assert.Equal(t, "string", compiled.DependentSchemas.GetOrZero("schemaOne").Schema().Type[0])
 	assert.Equal(t, "string", compiled.PropertyNames.Schema().Type[0])
 	assert.Equal(t, "boolean", compiled.UnevaluatedItems.Schema().Type[0])
 	assert.Equal(t, "integer", compiled.UnevaluatedProperties.Schema().Type[0])
@@ -498,9 +499,9 @@ required: [cake, fish]`
 	assert.Nil(t, schemaProxy.GetBuildError())
 
 	assert.True(t, compiled.ExclusiveMaximum.A)
-	assert.Equal(t, int64(123), compiled.Properties["somethingB"].Schema().ExclusiveMinimum.B)
-	assert.Equal(t, int64(334), compiled.Properties["somethingB"].Schema().ExclusiveMaximum.B)
-	assert.Len(t, compiled.Properties["somethingB"].Schema().Properties["somethingBProp"].Schema().Type, 2)
+	assert.Equal(t, int64(123), compiled.Properties.GetOrZero("somethingB").Schema().ExclusiveMinimum.B)
+	assert.Equal(t, int64(334), compiled.Properties.GetOrZero("somethingB").Schema().ExclusiveMaximum.B)
+	assert.Len(t, compiled.Properties.GetOrZero("somethingB").Schema().Properties.GetOrZero("somethingBProp").Schema().Type, 2)
 
 	assert.Equal(t, "nice", compiled.AdditionalProperties.(*SchemaProxy).Schema().Description)
 
@@ -710,7 +711,7 @@ properties:
 	highSchema := NewSchema(&lowSchema)
 
 	// print out the description of 'aProperty'
-	fmt.Print(highSchema.Properties["aProperty"].Schema().Description)
+	fmt.Print(highSchema.Properties.GetOrZero("aProperty").Schema().Description)
 	// Output: this is an integer property
 }
 
@@ -741,7 +742,7 @@ properties:
 	})
 
 	// print out the description of 'aProperty'
-	fmt.Print(highSchema.Schema().Properties["aProperty"].Schema().Description)
+	fmt.Print(highSchema.Schema().Properties.GetOrZero("aProperty").Schema().Description)
 	// Output: this is an integer property
 }
 
@@ -1109,3 +1110,77 @@ components:
 	assert.Equal(t, testSpec, strings.TrimSpace(string(schemaBytes)))
 }
 
+func TestSchema_MarshalJSON(t *testing.T) {
+	idxYaml := `openapi: 3.1.0
+components:
+    schemas:
+        balance_transaction:
+          description: A balance transaction`
+
+	testSpec := `type: object
+x-money: true
+exclusiveMaximum: 100
+properties:
+    transaction:
+        $ref: '#/components/schemas/balance_transaction'
+    history:
+        type: array
+        items:
+            $ref: '#/components/schemas/balance_transaction'
+    amount:
+        type: integer
+        exclusiveMinimum: true`
+
+	var compNode, idxNode yaml.Node
+	_ = yaml.Unmarshal([]byte(testSpec), &compNode)
+	_ = yaml.Unmarshal([]byte(idxYaml), &idxNode)
+
+	idx := index.NewSpecIndexWithConfig(&idxNode, index.CreateOpenAPIIndexConfig())
+
+	sp := new(lowbase.SchemaProxy)
+	err := sp.Build(compNode.Content[0], idx)
+	assert.NoError(t, err)
+
+	lowproxy := low.NodeReference[*lowbase.SchemaProxy]{
+		Value:     sp,
+		ValueNode: idxNode.Content[0],
+	}
+
+	sch1 := SchemaProxy{schema: &lowproxy}
+	compiled := sch1.Schema()
+
+	// extensions are inlined, references are rendered as references and dynamic values are flattened.
+	out, err := json.Marshal(compiled)
+	assert.NoError(t, err)
+	assert.Equal(t, `{"type":"object","x-money":true,"exclusiveMaximum":100,"properties":{"transaction":`+
+		`{"$ref":"#/components/schemas/balance_transaction"},"history":{"type":"array","items":`+
+		`{"$ref":"#/components/schemas/balance_transaction"}},"amount":{"type":"integer","exclusiveMinimum":true}}}`,
+		string(out))
+
+	// maps of schema proxies are rendered in the same way.
+	out, err = json.Marshal(compiled.Properties)
+	assert.NoError(t, err)
+	assert.Equal(t, `{"transaction":{"$ref":"#/components/schemas/balance_transaction"},"history":{"type":"array",`+
+		`"items":{"$ref":"#/components/schemas/balance_transaction"}},"amount":{"type":"integer","exclusiveMinimum":true}}`,
+		string(out))
+}
+
+func TestSchema_UnmarshalJSON(t *testing.T) {
+	var s Schema
+	err := json.Unmarshal([]byte(`{"type":"object","x-money":true,"properties":{"amount":{"type":"integer"},
+"total":{"$ref":"#/properties/amount"}}}`), &s)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"object"}, s.Type)
+	assert.Equal(t, true, s.Extensions["x-money"])
+	assert.Equal(t, []string{"integer"}, s.Properties.GetOrZero("total").Schema().Type)
+
+	out, err := json.Marshal(&s)
+	assert.NoError(t, err)
+	// everything is on the same line, so extensions are rendered last.
+	assert.Equal(t, `{"type":"object","properties":{"amount":{"type":"integer"},`+
+		`"total":{"$ref":"#/properties/amount"}},"x-money":true}`, string(out))
+
+	var y Schema
+	assert.NoError(t, yaml.Unmarshal([]byte("type: string\nminLength: 2"), &y))
+	assert.Equal(t, int64(2), *y.MinLength)
+}
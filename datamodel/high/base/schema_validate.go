@@ -0,0 +1,1057 @@
+// Copyright 2023 Princess B33f Heavy Industries / Dave Shanley
+// SPDX-License-Identifier: MIT
+
+package base
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"unicode/utf8"
+
+	lowbase "github.com/pb33f/libopenapi/datamodel/low/base"
+	"github.com/pb33f/libopenapi/utils"
+	"gopkg.in/yaml.v3"
+)
+
+// ValidationContext controls how readOnly and writeOnly properties are treated when validating a value.
+type ValidationContext int
+
+const (
+	// ValidateAny ignores readOnly and writeOnly when validating.
+	ValidateAny ValidationContext = iota
+
+	// ValidateRequest treats the value as being sent in a request. readOnly properties are not allowed, and are not
+	// required even if listed as required.
+	ValidateRequest
+
+	// ValidateResponse treats the value as being sent in a response. writeOnly properties are not allowed, and are not
+	// required even if listed as required.
+	ValidateResponse
+)
+
+// maxValidationDepth prevents runaway validation of deeply nested (or circular) schemas.
+const maxValidationDepth = 512
+
+// ValidationError represents a single failure found when validating a value against a Schema.
+type ValidationError struct {
+	// Reason explains why the value failed to validate.
+	Reason string
+
+	// Location is a JSON Pointer to the failing value, an empty string is the root of the value.
+	Location string
+
+	// Keyword is the schema keyword that failed, for example 'minLength' or 'required'.
+	Keyword string
+
+	// Line and Column locate the failing keyword in the specification, they are zero if the schema was not
+	// built from a specification.
+	Line   int
+	Column int
+
+	// Node is the yaml.Node of the failing keyword in the specification (if known).
+	Node *yaml.Node `json:"-" yaml:"-"`
+}
+
+// Error returns a string representation of the ValidationError, this makes it compatible with the error interface.
+func (v ValidationError) Error() string {
+	location := v.Location
+	if location == "" {
+		location = "/"
+	}
+	if v.Line > 0 {
+		return fmt.Sprintf("%s: %s (line %d, column %d)", location, v.Reason, v.Line, v.Column)
+	}
+	return fmt.Sprintf("%s: %s", location, v.Reason)
+}
+
+// Validate will check a value against the Schema, using every keyword the Schema defines. References are followed
+// through the SchemaProxy, and circular schemas are handled by never evaluating the same schema twice against the
+// same part of the value.
+//
+// The value is expected to be in the shape produced by encoding/json or yaml.v3 (nil, bool, numbers, string,
+// slices and maps with string keys). Other values (like structs) are converted by marshalling them to JSON first.
+//
+// readOnly and writeOnly are ignored, use ValidateWithContext to validate a request or response value. The 'format'
+// keyword is treated as an annotation, it is not validated. An empty slice is returned if the value is valid.
+func (s *Schema) Validate(value any) []ValidationError {
+	return s.ValidateWithContext(value, ValidateAny)
+}
+
+// ValidateWithContext operates the same as Validate, except readOnly and writeOnly properties are handled
+// using the supplied ValidationContext.
+func (s *Schema) ValidateWithContext(value any, context ValidationContext) []ValidationError {
+	sv := &schemaValidator{context: context, visited: make(map[visitKey]bool)}
+	res := sv.validate(s, normalizeValue(value), "", 0)
+	return res.errors
+}
+
+// Validate will build the Schema behind the SchemaProxy and validate the value against it, see Schema.Validate.
+// If the Schema cannot be built, the build error is returned as a ValidationError.
+func (sp *SchemaProxy) Validate(value any) []ValidationError {
+	return sp.ValidateWithContext(value, ValidateAny)
+}
+
+// ValidateWithContext operates the same as Validate, except readOnly and writeOnly properties are handled
+// using the supplied ValidationContext.
+func (sp *SchemaProxy) ValidateWithContext(value any, context ValidationContext) []ValidationError {
+	sv := &schemaValidator{context: context, visited: make(map[visitKey]bool)}
+	res := sv.validateProxy(sp, normalizeValue(value), "", 0)
+	return res.errors
+}
+
+// visitKey identifies a schema being evaluated at a location in the value, used to break circular schemas.
+type visitKey struct {
+	schema   any
+	location string
+}
+
+// schemaValidator holds the state of a single validation run.
+type schemaValidator struct {
+	context ValidationContext
+	visited map[visitKey]bool
+}
+
+// validationResult holds the errors and annotations produced when evaluating a schema. The annotations are the
+// properties and items that were evaluated, which are needed for unevaluatedProperties and unevaluatedItems.
+type validationResult struct {
+	errors []ValidationError
+	props  map[string]bool
+	items  map[int]bool
+}
+
+func (r *validationResult) merge(o *validationResult, annotations bool) {
+	if o == nil {
+		return
+	}
+	r.errors = append(r.errors, o.errors...)
+	if annotations {
+		r.mergeAnnotations(o)
+	}
+}
+
+func (r *validationResult) mergeAnnotations(o *validationResult) {
+	if o == nil {
+		return
+	}
+	for k := range o.props {
+		if r.props == nil {
+			r.props = make(map[string]bool)
+		}
+		r.props[k] = true
+	}
+	for k := range o.items {
+		if r.items == nil {
+			r.items = make(map[int]bool)
+		}
+		r.items[k] = true
+	}
+}
+
+func (r *validationResult) valid() bool {
+	return len(r.errors) == 0
+}
+
+// validateProxy evaluates the schema behind a proxy, boolean schemas ('true' and 'false') are supported.
+func (sv *schemaValidator) validateProxy(proxy *SchemaProxy, value any, location string, depth int) *validationResult {
+	res := new(validationResult)
+	if proxy == nil {
+		return res
+	}
+	if b, ok := proxyBooleanValue(proxy); ok {
+		if !b {
+			res.errors = append(res.errors, ValidationError{
+				Reason:   "no value is allowed by the 'false' schema",
+				Location: location,
+			})
+		}
+		return res
+	}
+	schema, err := proxy.BuildSchema()
+	if err != nil || schema == nil {
+		reason := "schema could not be built"
+		if err != nil {
+			reason = fmt.Sprintf("schema could not be built: %s", err.Error())
+		}
+		res.errors = append(res.errors, ValidationError{Reason: reason, Location: location, Keyword: "$ref"})
+		return res
+	}
+	return sv.validate(schema, value, location, depth)
+}
+
+// validate evaluates a value against a schema.
+func (sv *schemaValidator) validate(schema *Schema, value any, location string, depth int) *validationResult {
+	res := new(validationResult)
+	if schema == nil || depth > maxValidationDepth {
+		return res
+	}
+
+	// circular schemas are only evaluated once against each location.
+	key := visitKey{schema: schemaIdentity(schema), location: location}
+	if sv.visited[key] {
+		return res
+	}
+	sv.visited[key] = true
+	defer delete(sv.visited, key)
+
+	fail := func(keyword, format string, args ...any) {
+		res.errors = append(res.errors, newValidationError(schema, keyword, location, fmt.Sprintf(format, args...)))
+	}
+
+	// type and nullable.
+	if value == nil {
+		if len(schema.Type) > 0 && !schemaAllowsNull(schema) {
+			fail("type", "expected type '%s', got 'null'", strings.Join(schema.Type, "' or '"))
+			return res
+		}
+	} else if len(schema.Type) > 0 && !valueMatchesTypes(value, schema.Type) {
+		fail("type", "expected type '%s', got '%s'", strings.Join(schema.Type, "' or '"), valueType(value))
+		return res
+	}
+
+	sv.validateEnumAndConst(schema, value, fail)
+
+	switch v := value.(type) {
+	case string:
+		sv.validateString(schema, v, fail)
+	case []any:
+		sv.validateArray(schema, v, location, depth, res, fail)
+	case map[string]any:
+		sv.validateObject(schema, v, location, depth, res, fail)
+	default:
+		if n, ok := valueNumber(value); ok {
+			sv.validateNumber(schema, n, fail)
+		}
+	}
+
+	sv.validateComposition(schema, value, location, depth, res, fail)
+	sv.validateUnevaluated(schema, value, location, depth, res, fail)
+	return res
+}
+
+// validateEnumAndConst checks the 'enum' and 'const' keywords.
+func (sv *schemaValidator) validateEnumAndConst(schema *Schema, value any, fail func(string, string, ...any)) {
+	if len(schema.Enum) > 0 {
+		found := false
+		lowSchema := schema.GoLow()
+		if lowSchema != nil && len(lowSchema.Enum.Value) == len(schema.Enum) {
+			for _, e := range lowSchema.Enum.Value {
+				if e.ValueNode != nil && valuesEqual(nodeValue(e.ValueNode), value) {
+					found = true
+					break
+				}
+			}
+		} else {
+			for _, e := range schema.Enum {
+				if valuesEqual(normalizeValue(e), value) {
+					found = true
+					break
+				}
+			}
+		}
+		if !found {
+			values := make([]string, len(schema.Enum))
+			for i := range schema.Enum {
+				values[i] = fmt.Sprint(schema.Enum[i])
+			}
+			fail("enum", "value '%v' is not one of the allowed values [%s]", describeValue(value),
+				strings.Join(values, ", "))
+		}
+	}
+	if n := keywordValueNode(schema, "const"); n != nil {
+		if !valuesEqual(nodeValue(n), value) {
+			fail("const", "value '%v' does not match the constant value '%v'", describeValue(value),
+				describeValue(nodeValue(n)))
+		}
+	}
+}
+
+// validateString checks the string keywords.
+func (sv *schemaValidator) validateString(schema *Schema, v string, fail func(string, string, ...any)) {
+	length := int64(utf8.RuneCountInString(v))
+	if schema.MinLength != nil && length < *schema.MinLength {
+		fail("minLength", "string length %d is less than the minimum length of %d", length, *schema.MinLength)
+	}
+	if schema.MaxLength != nil && length > *schema.MaxLength {
+		fail("maxLength", "string length %d is greater than the maximum length of %d", length, *schema.MaxLength)
+	}
+	if schema.Pattern != "" {
+		r, err := compilePattern(schema.Pattern)
+		if err != nil {
+			fail("pattern", "pattern '%s' is not a valid regular expression: %s", schema.Pattern, err.Error())
+		} else if !r.MatchString(v) {
+			fail("pattern", "string '%s' does not match pattern '%s'", v, schema.Pattern)
+		}
+	}
+}
+
+// validateNumber checks the numeric keywords. Numeric keywords are read from the specification when possible,
+// so fractional values are not truncated.
+func (sv *schemaValidator) validateNumber(schema *Schema, n float64, fail func(string, string, ...any)) {
+	if containsType(schema.Type, "integer") && !containsType(schema.Type, "number") && n != math.Trunc(n) {
+		fail("type", "expected type 'integer', got 'number'")
+	}
+	exclusiveMin, exclusiveMax := false, false
+	if schema.ExclusiveMinimum != nil && schema.ExclusiveMinimum.IsA() {
+		exclusiveMin = schema.ExclusiveMinimum.A
+	} else if min, ok := numericKeyword(schema, "exclusiveMinimum", schema.ExclusiveMinimum); ok && n <= min {
+		fail("exclusiveMinimum", "value %v must be greater than %v", n, min)
+	}
+	if schema.ExclusiveMaximum != nil && schema.ExclusiveMaximum.IsA() {
+		exclusiveMax = schema.ExclusiveMaximum.A
+	} else if max, ok := numericKeyword(schema, "exclusiveMaximum", schema.ExclusiveMaximum); ok && n >= max {
+		fail("exclusiveMaximum", "value %v must be less than %v", n, max)
+	}
+	if min, ok := numericKeyword(schema, "minimum", schema.Minimum); ok {
+		if exclusiveMin && n <= min {
+			fail("minimum", "value %v must be greater than %v", n, min)
+		} else if n < min {
+			fail("minimum", "value %v is less than the minimum of %v", n, min)
+		}
+	}
+	if max, ok := numericKeyword(schema, "maximum", schema.Maximum); ok {
+		if exclusiveMax && n >= max {
+			fail("maximum", "value %v must be less than %v", n, max)
+		} else if n > max {
+			fail("maximum", "value %v is greater than the maximum of %v", n, max)
+		}
+	}
+	if multiple, ok := numericKeyword(schema, "multipleOf", schema.MultipleOf); ok && multiple > 0 {
+		q := n / multiple
+		if math.Abs(q-math.Round(q)) > 1e-9 {
+			fail("multipleOf", "value %v is not a multiple of %v", n, multiple)
+		}
+	}
+}
+
+// validateArray checks the array keywords, recording every evaluated item.
+func (sv *schemaValidator) validateArray(schema *Schema, v []any, location string, depth int,
+	res *validationResult, fail func(string, string, ...any)) {
+
+	count := int64(len(v))
+	if schema.MinItems != nil && count < *schema.MinItems {
+		fail("minItems", "array has %d items, the minimum is %d", count, *schema.MinItems)
+	}
+	if schema.MaxItems != nil && count > *schema.MaxItems {
+		fail("maxItems", "array has %d items, the maximum is %d", count, *schema.MaxItems)
+	}
+	if schemaUniqueItems(schema) {
+		for i := 0; i < len(v); i++ {
+			for j := i + 1; j < len(v); j++ {
+				if valuesEqual(v[i], v[j]) {
+					fail("uniqueItems", "array items at index %d and %d are not unique", i, j)
+				}
+			}
+		}
+	}
+
+	evaluated := make(map[int]bool)
+	for i, p := range schema.PrefixItems {
+		if i >= len(v) {
+			break
+		}
+		res.merge(sv.validateProxy(p, v[i], appendPointer(location, strconv.Itoa(i)), depth+1), false)
+		evaluated[i] = true
+	}
+	if schema.Items != nil {
+		for i := len(schema.PrefixItems); i < len(v); i++ {
+			itemLocation := appendPointer(location, strconv.Itoa(i))
+			if schema.Items.IsA() && schema.Items.A != nil {
+				res.merge(sv.validateProxy(schema.Items.A, v[i], itemLocation, depth+1), false)
+			} else if schema.Items.IsB() && !schema.Items.B {
+				res.errors = append(res.errors, newValidationError(schema, "items", itemLocation,
+					fmt.Sprintf("array item at index %d is not allowed", i)))
+			}
+			evaluated[i] = true
+		}
+	}
+	if schema.Contains != nil {
+		matches := 0
+		for i := range v {
+			r := sv.validateProxy(schema.Contains, v[i], appendPointer(location, strconv.Itoa(i)), depth+1)
+			if r.valid() {
+				matches++
+				evaluated[i] = true
+			}
+		}
+		min := int64(1)
+		if schema.MinContains != nil {
+			min = *schema.MinContains
+		}
+		if int64(matches) < min {
+			fail("contains", "array contains %d matching items, at least %d are required", matches, min)
+		}
+		if schema.MaxContains != nil && int64(matches) > *schema.MaxContains {
+			fail("maxContains", "array contains %d matching items, the maximum is %d", matches, *schema.MaxContains)
+		}
+	}
+	res.mergeAnnotations(&validationResult{items: evaluated})
+}
+
+// validateObject checks the object keywords, recording every evaluated property.
+func (sv *schemaValidator) validateObject(schema *Schema, v map[string]any, location string, depth int,
+	res *validationResult, fail func(string, string, ...any)) {
+
+	count := int64(len(v))
+	if schema.MinProperties != nil && count < *schema.MinProperties {
+		fail("minProperties", "object has %d properties, the minimum is %d", count, *schema.MinProperties)
+	}
+	if schema.MaxProperties != nil && count > *schema.MaxProperties {
+		fail("maxProperties", "object has %d properties, the maximum is %d", count, *schema.MaxProperties)
+	}
+	for _, req := range schema.Required {
+		if _, ok := v[req]; ok {
+			continue
+		}
+		if prop, ok := schema.Properties.Get(req); ok && sv.skipRequired(prop) {
+			continue
+		}
+		fail("required", "required property '%s' is missing", req)
+	}
+
+	keys := make([]string, 0, len(v))
+	for k := range v {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	evaluated := make(map[string]bool)
+	for _, k := range keys {
+		propLocation := appendPointer(location, k)
+		matched := false
+		if prop, ok := schema.Properties.Get(k); ok {
+			matched = true
+			res.merge(sv.validateProxy(prop, v[k], propLocation, depth+1), false)
+			if reason := sv.directionViolation(prop, k); reason != "" {
+				res.errors = append(res.errors, newValidationError(schema, "properties", propLocation, reason))
+			}
+		}
+		for _, pattern := range schema.PatternProperties.Pairs() {
+			r, err := compilePattern(pattern.Key)
+			if err != nil || !r.MatchString(k) {
+				continue
+			}
+			matched = true
+			res.merge(sv.validateProxy(pattern.Value, v[k], propLocation, depth+1), false)
+		}
+		if !matched {
+			switch ap := schema.AdditionalProperties.(type) {
+			case bool:
+				if !ap {
+					res.errors = append(res.errors, newValidationError(schema, "additionalProperties", propLocation,
+						fmt.Sprintf("property '%s' is not allowed", k)))
+				}
+				matched = true
+			case *SchemaProxy:
+				res.merge(sv.validateProxy(ap, v[k], propLocation, depth+1), false)
+				matched = true
+			case nil:
+			default:
+				// additionalProperties is defined as something other than a schema or a boolean, allow anything.
+				matched = true
+			}
+		}
+		if matched {
+			evaluated[k] = true
+		}
+		if schema.PropertyNames != nil {
+			for _, e := range sv.validateProxy(schema.PropertyNames, k, propLocation, depth+1).errors {
+				e.Reason = fmt.Sprintf("property name '%s' is invalid: %s", k, e.Reason)
+				res.errors = append(res.errors, e)
+			}
+		}
+	}
+	res.mergeAnnotations(&validationResult{props: evaluated})
+
+	for _, dep := range schema.DependentSchemas.Pairs() {
+		if _, ok := v[dep.Key]; ok {
+			res.merge(sv.validateProxy(dep.Value, v, location, depth+1), true)
+		}
+	}
+}
+
+// validateComposition checks allOf, anyOf, oneOf, not, if/then/else and the discriminator.
+func (sv *schemaValidator) validateComposition(schema *Schema, value any, location string, depth int,
+	res *validationResult, fail func(string, string, ...any)) {
+
+	for _, s := range schema.AllOf {
+		res.merge(sv.validateProxy(s, value, location, depth+1), true)
+	}
+
+	// a discriminator picks a single schema from oneOf or anyOf, which replaces checking every branch.
+	discriminated := false
+	if schema.Discriminator != nil && schema.Discriminator.PropertyName != "" &&
+		(len(schema.OneOf) > 0 || len(schema.AnyOf) > 0) {
+		if obj, ok := value.(map[string]any); ok {
+			sv.validateDiscriminator(schema, obj, location, depth, res, fail)
+			discriminated = true
+		}
+	}
+
+	if len(schema.AnyOf) > 0 && !discriminated {
+		var branches []*validationResult
+		matched := false
+		for _, s := range schema.AnyOf {
+			r := sv.validateProxy(s, value, location, depth+1)
+			if r.valid() {
+				matched = true
+				res.mergeAnnotations(r)
+			}
+			branches = append(branches, r)
+		}
+		if !matched {
+			sv.failBranches(schema, "anyOf", location, branches, res)
+		}
+	}
+	if len(schema.OneOf) > 0 && !discriminated {
+		var branches []*validationResult
+		matches := 0
+		for _, s := range schema.OneOf {
+			r := sv.validateProxy(s, value, location, depth+1)
+			if r.valid() {
+				matches++
+				res.mergeAnnotations(r)
+			}
+			branches = append(branches, r)
+		}
+		if matches == 0 {
+			sv.failBranches(schema, "oneOf", location, branches, res)
+		}
+		if matches > 1 {
+			fail("oneOf", "value matches %d 'oneOf' schemas, only one is allowed", matches)
+		}
+	}
+	if schema.Not != nil {
+		if sv.validateProxy(schema.Not, value, location, depth+1).valid() {
+			fail("not", "value must not match the 'not' schema")
+		}
+	}
+	if schema.If != nil {
+		r := sv.validateProxy(schema.If, value, location, depth+1)
+		if r.valid() {
+			res.mergeAnnotations(r)
+			if schema.Then != nil {
+				res.merge(sv.validateProxy(schema.Then, value, location, depth+1), true)
+			}
+		} else if schema.Else != nil {
+			res.merge(sv.validateProxy(schema.Else, value, location, depth+1), true)
+		}
+	}
+}
+
+// failBranches reports a failed anyOf or oneOf. Branches that failed because the value is the wrong type entirely
+// are ignored, of the rest, the branch with the fewest errors (and then the one that got the deepest into the value)
+// is the one most likely intended, so its errors are reported. If every branch has the wrong type, a single error
+// is reported.
+func (sv *schemaValidator) failBranches(schema *Schema, keyword, location string, branches []*validationResult,
+	res *validationResult) {
+
+	var best *validationResult
+	bestDepth := -1
+	for _, b := range branches {
+		depth, wrongType := 0, false
+		for _, e := range b.errors {
+			if e.Keyword == "type" && e.Location == location {
+				wrongType = true
+				break
+			}
+			if d := strings.Count(e.Location, "/"); d > depth {
+				depth = d
+			}
+		}
+		if wrongType || len(b.errors) == 0 {
+			continue
+		}
+		if best == nil || len(b.errors) < len(best.errors) || (len(b.errors) == len(best.errors) && depth > bestDepth) {
+			best, bestDepth = b, depth
+		}
+	}
+	if best != nil {
+		res.errors = append(res.errors, best.errors...)
+		return
+	}
+	res.errors = append(res.errors, newValidationError(schema, keyword, location,
+		fmt.Sprintf("value does not match any of the '%s' schemas", keyword)))
+}
+
+// validateDiscriminator uses the discriminator property to pick a single schema to validate against. The mapping
+// is used first, then the name of referenced schemas is compared with the discriminator value.
+func (sv *schemaValidator) validateDiscriminator(schema *Schema, obj map[string]any, location string, depth int,
+	res *validationResult, fail func(string, string, ...any)) {
+
+	name := schema.Discriminator.PropertyName
+	raw, ok := obj[name]
+	if !ok {
+		fail("discriminator", "discriminator property '%s' is missing", name)
+		return
+	}
+	discriminator := fmt.Sprint(raw)
+	candidates := append(append([]*SchemaProxy{}, schema.OneOf...), schema.AnyOf...)
+
+	target := discriminator
+	if mapped, ok := schema.Discriminator.Mapping.Get(discriminator); ok {
+		target = mapped
+	}
+	for _, c := range candidates {
+		ref := proxyReference(c)
+		if ref == "" {
+			continue
+		}
+		if ref == target || ref[strings.LastIndex(ref, "/")+1:] == target {
+			res.merge(sv.validateProxy(c, obj, location, depth+1), true)
+			return
+		}
+	}
+	fail("discriminator", "discriminator value '%s' does not match any schema", discriminator)
+}
+
+// validateUnevaluated checks unevaluatedProperties and unevaluatedItems against the annotations collected so far.
+func (sv *schemaValidator) validateUnevaluated(schema *Schema, value any, location string, depth int,
+	res *validationResult, fail func(string, string, ...any)) {
+
+	switch v := value.(type) {
+	case map[string]any:
+		if schema.UnevaluatedProperties == nil {
+			return
+		}
+		keys := make([]string, 0, len(v))
+		for k := range v {
+			if !res.props[k] {
+				keys = append(keys, k)
+			}
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			if b, ok := proxyBooleanValue(schema.UnevaluatedProperties); ok {
+				if !b {
+					res.errors = append(res.errors, newValidationError(schema, "unevaluatedProperties",
+						appendPointer(location, k), fmt.Sprintf("property '%s' has not been evaluated and is not allowed", k)))
+				}
+			} else {
+				res.merge(sv.validateProxy(schema.UnevaluatedProperties, v[k], appendPointer(location, k), depth+1), false)
+			}
+			res.mergeAnnotations(&validationResult{props: map[string]bool{k: true}})
+		}
+	case []any:
+		if schema.UnevaluatedItems == nil {
+			return
+		}
+		for i := range v {
+			if res.items[i] {
+				continue
+			}
+			if b, ok := proxyBooleanValue(schema.UnevaluatedItems); ok {
+				if !b {
+					res.errors = append(res.errors, newValidationError(schema, "unevaluatedItems",
+						appendPointer(location, strconv.Itoa(i)),
+						fmt.Sprintf("array item at index %d has not been evaluated and is not allowed", i)))
+				}
+			} else {
+				res.merge(sv.validateProxy(schema.UnevaluatedItems, v[i],
+					appendPointer(location, strconv.Itoa(i)), depth+1), false)
+			}
+			res.mergeAnnotations(&validationResult{items: map[int]bool{i: true}})
+		}
+	}
+}
+
+// skipRequired returns true if a required property should be skipped, because of the validation context.
+func (sv *schemaValidator) skipRequired(prop *SchemaProxy) bool {
+	if sv.context == ValidateAny {
+		return false
+	}
+	s := proxySchema(prop)
+	if s == nil {
+		return false
+	}
+	return (sv.context == ValidateRequest && s.ReadOnly) || (sv.context == ValidateResponse && s.WriteOnly)
+}
+
+// directionViolation returns a reason if a property is not allowed in the validation context.
+func (sv *schemaValidator) directionViolation(prop *SchemaProxy, name string) string {
+	if sv.context == ValidateAny {
+		return ""
+	}
+	s := proxySchema(prop)
+	if s == nil {
+		return ""
+	}
+	if sv.context == ValidateRequest && s.ReadOnly {
+		return fmt.Sprintf("property '%s' is readOnly and must not be sent in a request", name)
+	}
+	if sv.context == ValidateResponse && s.WriteOnly {
+		return fmt.Sprintf("property '%s' is writeOnly and must not be sent in a response", name)
+	}
+	return ""
+}
+
+// proxyReference returns the reference of a SchemaProxy, or an empty string if there is no reference (or the
+// proxy has no low-level model).
+func proxyReference(sp *SchemaProxy) string {
+	if sp.refStr != "" {
+		return sp.refStr
+	}
+	if sp.schema == nil || sp.schema.Value == nil {
+		return ""
+	}
+	return sp.schema.Value.GetSchemaReference()
+}
+
+// proxySchema builds a proxy, ignoring boolean schemas and errors.
+func proxySchema(proxy *SchemaProxy) *Schema {
+	if proxy == nil {
+		return nil
+	}
+	if _, ok := proxyBooleanValue(proxy); ok {
+		return nil
+	}
+	s, _ := proxy.BuildSchema()
+	return s
+}
+
+// proxyBooleanValue checks if a SchemaProxy represents a boolean schema (3.1), returning the boolean value. The
+// low-level value node decides, as a boolean schema still builds (and caches) an empty Schema.
+func proxyBooleanValue(proxy *SchemaProxy) (bool, bool) {
+	if proxy == nil || proxy.schema == nil || proxy.schema.Value == nil {
+		return false, false
+	}
+	n := proxy.schema.Value.GetValueNode()
+	if n == nil || !utils.IsNodeBoolValue(n) {
+		return false, false
+	}
+	b, _ := strconv.ParseBool(n.Value)
+	return b, true
+}
+
+// schemaIdentity returns something unique for a schema, so circular schemas can be detected.
+func schemaIdentity(schema *Schema) any {
+	if l := schema.GoLow(); l != nil && l.GetRootNode() != nil {
+		return l.GetRootNode()
+	}
+	return schema
+}
+
+// schemaAllowsNull returns true if the schema is nullable (3.0) or lists 'null' as a type (3.1).
+func schemaAllowsNull(schema *Schema) bool {
+	if schema.Nullable != nil && *schema.Nullable {
+		return true
+	}
+	return containsType(schema.Type, "null")
+}
+
+// schemaUniqueItems returns true if uniqueItems is enabled.
+func schemaUniqueItems(schema *Schema) bool {
+	if n := keywordValueNode(schema, "uniqueItems"); n != nil {
+		return n.Value == "true"
+	}
+	return schema.UniqueItems != nil && *schema.UniqueItems > 0
+}
+
+// numericKeyword returns the value of a numeric keyword. The raw value node from the specification is used when
+// available, as the model only holds integer values.
+func numericKeyword[T *int64 | *DynamicValue[bool, int64]](schema *Schema, keyword string, value T) (float64, bool) {
+	if n := keywordValueNode(schema, keyword); n != nil {
+		if f, err := strconv.ParseFloat(n.Value, 64); err == nil {
+			return f, true
+		}
+		return 0, false
+	}
+	switch v := any(value).(type) {
+	case *int64:
+		if v != nil {
+			return float64(*v), true
+		}
+	case *DynamicValue[bool, int64]:
+		if v != nil && v.IsB() {
+			return float64(v.B), true
+		}
+	}
+	return 0, false
+}
+
+// keywordNode returns the key node of a keyword in the specification, or nil if the keyword is not present.
+func keywordNode(schema *Schema, keyword string) *yaml.Node {
+	key, _ := keywordNodes(schema.GoLow(), keyword)
+	return key
+}
+
+// keywordValueNode returns the value node of a keyword in the specification, or nil if the keyword is not present.
+func keywordValueNode(schema *Schema, keyword string) *yaml.Node {
+	_, value := keywordNodes(schema.GoLow(), keyword)
+	return value
+}
+
+func keywordNodes(lowSchema *lowbase.Schema, keyword string) (*yaml.Node, *yaml.Node) {
+	if lowSchema == nil || lowSchema.GetRootNode() == nil {
+		return nil, nil
+	}
+	_, k, v := utils.FindKeyNodeFullTop(keyword, lowSchema.GetRootNode().Content)
+	return k, v
+}
+
+// newValidationError creates a ValidationError, locating the keyword in the specification.
+func newValidationError(schema *Schema, keyword, location, reason string) ValidationError {
+	ve := ValidationError{Reason: reason, Location: location, Keyword: keyword}
+	if n := keywordNode(schema, keyword); n != nil {
+		ve.Node = n
+		ve.Line = n.Line
+		ve.Column = n.Column
+	}
+	return ve
+}
+
+var patternCache sync.Map
+
+// compilePattern compiles (and caches) a schema pattern.
+func compilePattern(pattern string) (*regexp.Regexp, error) {
+	if r, ok := patternCache.Load(pattern); ok {
+		return r.(*regexp.Regexp), nil
+	}
+	r, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, err
+	}
+	patternCache.Store(pattern, r)
+	return r, nil
+}
+
+func containsType(types []string, t string) bool {
+	for _, x := range types {
+		if x == t {
+			return true
+		}
+	}
+	return false
+}
+
+// valueMatchesTypes checks if a value matches any of the supplied JSON Schema types.
+func valueMatchesTypes(value any, types []string) bool {
+	for _, t := range types {
+		switch t {
+		case "string":
+			if _, ok := value.(string); ok {
+				return true
+			}
+		case "boolean":
+			if _, ok := value.(bool); ok {
+				return true
+			}
+		case "number":
+			if _, ok := valueNumber(value); ok {
+				return true
+			}
+		case "integer":
+			if n, ok := valueNumber(value); ok && n == math.Trunc(n) {
+				return true
+			}
+		case "array":
+			if _, ok := value.([]any); ok {
+				return true
+			}
+		case "object":
+			if _, ok := value.(map[string]any); ok {
+				return true
+			}
+		case "null":
+			if value == nil {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// valueType returns the JSON Schema type name of a value.
+func valueType(value any) string {
+	switch value.(type) {
+	case nil:
+		return "null"
+	case string:
+		return "string"
+	case bool:
+		return "boolean"
+	case []any:
+		return "array"
+	case map[string]any:
+		return "object"
+	}
+	if n, ok := valueNumber(value); ok {
+		if n == math.Trunc(n) {
+			return "integer"
+		}
+		return "number"
+	}
+	return fmt.Sprintf("%T", value)
+}
+
+// valueNumber converts any numeric value into a float64.
+func valueNumber(value any) (float64, bool) {
+	switch n := value.(type) {
+	case float64:
+		return n, true
+	case float32:
+		return float64(n), true
+	case int:
+		return float64(n), true
+	case int8:
+		return float64(n), true
+	case int16:
+		return float64(n), true
+	case int32:
+		return float64(n), true
+	case int64:
+		return float64(n), true
+	case uint:
+		return float64(n), true
+	case uint8:
+		return float64(n), true
+	case uint16:
+		return float64(n), true
+	case uint32:
+		return float64(n), true
+	case uint64:
+		return float64(n), true
+	case json.Number:
+		f, err := n.Float64()
+		return f, err == nil
+	}
+	return 0, false
+}
+
+// valuesEqual compares two values using JSON semantics, numbers are equal if their values are equal regardless
+// of type.
+func valuesEqual(a, b any) bool {
+	if na, ok := valueNumber(a); ok {
+		nb, ok := valueNumber(b)
+		return ok && na == nb
+	}
+	switch x := a.(type) {
+	case []any:
+		y, ok := b.([]any)
+		if !ok || len(x) != len(y) {
+			return false
+		}
+		for i := range x {
+			if !valuesEqual(x[i], y[i]) {
+				return false
+			}
+		}
+		return true
+	case map[string]any:
+		y, ok := b.(map[string]any)
+		if !ok || len(x) != len(y) {
+			return false
+		}
+		for k := range x {
+			if yv, ok := y[k]; !ok || !valuesEqual(x[k], yv) {
+				return false
+			}
+		}
+		return true
+	}
+	return reflect.DeepEqual(a, b)
+}
+
+// nodeValue decodes a yaml.Node into a value that can be compared with valuesEqual.
+func nodeValue(node *yaml.Node) any {
+	var v any
+	if err := node.Decode(&v); err != nil {
+		return node.Value
+	}
+	return normalizeValue(v)
+}
+
+// describeValue renders a value for use in an error message.
+func describeValue(value any) any {
+	switch value.(type) {
+	case []any, map[string]any:
+		if b, err := json.Marshal(value); err == nil {
+			return string(b)
+		}
+	}
+	return value
+}
+
+// normalizeValue converts a value into the shape produced by encoding/json, so it can be validated.
+func normalizeValue(value any) any {
+	switch v := value.(type) {
+	case nil, bool, string, json.Number:
+		return v
+	case []any:
+		out := make([]any, len(v))
+		for i := range v {
+			out[i] = normalizeValue(v[i])
+		}
+		return out
+	case map[string]any:
+		out := make(map[string]any, len(v))
+		for k := range v {
+			out[k] = normalizeValue(v[k])
+		}
+		return out
+	case map[any]any:
+		out := make(map[string]any, len(v))
+		for k := range v {
+			out[fmt.Sprint(k)] = normalizeValue(v[k])
+		}
+		return out
+	}
+	if _, ok := valueNumber(value); ok {
+		return value
+	}
+	rv := reflect.ValueOf(value)
+	switch rv.Kind() {
+	case reflect.Slice, reflect.Array:
+		if rv.Kind() == reflect.Slice && rv.IsNil() {
+			return nil
+		}
+		out := make([]any, rv.Len())
+		for i := 0; i < rv.Len(); i++ {
+			out[i] = normalizeValue(rv.Index(i).Interface())
+		}
+		return out
+	case reflect.Map:
+		if rv.Type().Key().Kind() == reflect.String {
+			if rv.IsNil() {
+				return nil
+			}
+			out := make(map[string]any, rv.Len())
+			iter := rv.MapRange()
+			for iter.Next() {
+				out[iter.Key().String()] = normalizeValue(iter.Value().Interface())
+			}
+			return out
+		}
+	case reflect.Pointer:
+		if rv.IsNil() {
+			return nil
+		}
+	case reflect.String:
+		return rv.String()
+	case reflect.Bool:
+		return rv.Bool()
+	}
+	// anything else (structs, pointers) is converted via JSON.
+	b, err := json.Marshal(value)
+	if err != nil {
+		return value
+	}
+	var out any
+	if err = json.Unmarshal(b, &out); err != nil {
+		return value
+	}
+	return out
+}
+
+// appendPointer appends an escaped segment to a JSON Pointer.
+func appendPointer(pointer, segment string) string {
+	segment = strings.ReplaceAll(segment, "~", "~0")
+	segment = strings.ReplaceAll(segment, "/", "~1")
+	return pointer + "/" + segment
+}
@@ -0,0 +1,407 @@
+// Copyright 2023 Princess B33f Heavy Industries / Dave Shanley
+// SPDX-License-Identifier: MIT
+
+package base
+
+import (
+	"testing"
+
+	"github.com/pb33f/libopenapi/datamodel/low"
+	lowbase "github.com/pb33f/libopenapi/datamodel/low/base"
+	"github.com/pb33f/libopenapi/index"
+	"github.com/pb33f/libopenapi/utils"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/yaml.v3"
+)
+
+// buildValidationSchema builds a schema from a document containing components, the schema under 'schema' is returned.
+func buildValidationSchema(t *testing.T, yml string) *SchemaProxy {
+	var idxNode yaml.Node
+	assert.NoError(t, yaml.Unmarshal([]byte(yml), &idxNode))
+	idx := index.NewSpecIndex(&idxNode)
+
+	_, _, schemaNode := utils.FindKeyNodeFullTop("schema", idxNode.Content[0].Content)
+	assert.NotNil(t, schemaNode)
+
+	sp := new(lowbase.SchemaProxy)
+	assert.NoError(t, sp.Build(schemaNode, idx))
+	return NewSchemaProxy(&low.NodeReference[*lowbase.SchemaProxy]{
+		Value:     sp,
+		ValueNode: schemaNode,
+	})
+}
+
+func reasons(errs []ValidationError) []string {
+	var r []string
+	for _, e := range errs {
+		r = append(r, e.Reason)
+	}
+	return r
+}
+
+func TestSchema_Validate_Types(t *testing.T) {
+	sp := buildValidationSchema(t, `schema:
+  type: object
+  required: [name, age]
+  properties:
+    name:
+      type: string
+      minLength: 2
+      maxLength: 5
+      pattern: '^[a-z]+$'
+    age:
+      type: integer
+      minimum: 0
+    tags:
+      type: array
+      maxItems: 2
+      uniqueItems: true
+      items:
+        type: string
+    nick:
+      type: string
+      nullable: true`)
+
+	schema := sp.Schema()
+	assert.Empty(t, schema.Validate(map[string]any{"name": "pizza", "age": 3, "tags": []string{"a", "b"},
+		"nick": nil}))
+
+	errs := schema.Validate(map[string]any{"name": "P", "tags": []any{"a", "a", "b"}, "nick": 1})
+	assert.Equal(t, []string{
+		"required property 'age' is missing",
+		"string length 1 is less than the minimum length of 2",
+		"string 'P' does not match pattern '^[a-z]+$'",
+		"expected type 'string', got 'integer'",
+		"array has 3 items, the maximum is 2",
+		"array items at index 0 and 1 are not unique",
+	}, reasons(errs))
+	assert.Equal(t, "/name", errs[1].Location)
+	assert.Equal(t, "minLength", errs[1].Keyword)
+	assert.Equal(t, 7, errs[1].Line)
+	assert.Equal(t, "/: required property 'age' is missing (line 3, column 3)", errs[0].Error())
+
+	errs = schema.Validate("burger")
+	assert.Len(t, errs, 1)
+	assert.Equal(t, "expected type 'object', got 'string'", errs[0].Reason)
+}
+
+func TestSchema_Validate_Numbers(t *testing.T) {
+	sp := buildValidationSchema(t, `schema:
+  type: number
+  minimum: 1.5
+  maximum: 10
+  exclusiveMaximum: true
+  multipleOf: 0.5`)
+
+	schema := sp.Schema()
+	assert.Empty(t, schema.Validate(2.5))
+	assert.Equal(t, []string{"value 1.25 is less than the minimum of 1.5", "value 1.25 is not a multiple of 0.5"},
+		reasons(schema.Validate(1.25)))
+	assert.Equal(t, []string{"value 10 must be less than 10"}, reasons(schema.Validate(10)))
+
+	sp = buildValidationSchema(t, `schema:
+  type: integer
+  exclusiveMinimum: 0.5
+  exclusiveMaximum: 5`)
+	assert.Empty(t, sp.Validate(1))
+	assert.Equal(t, []string{"value 0 must be greater than 0.5"}, reasons(sp.Validate(0)))
+	assert.Equal(t, []string{"value 5 must be less than 5"}, reasons(sp.Validate(5)))
+	assert.Equal(t, []string{"expected type 'integer', got 'number'"}, reasons(sp.Validate(1.5)))
+}
+
+func TestSchema_Validate_EnumConst(t *testing.T) {
+	sp := buildValidationSchema(t, `schema:
+  type: object
+  properties:
+    size:
+      enum: [1, small, true]
+    kind:
+      const: burger`)
+
+	assert.Empty(t, sp.Validate(map[string]any{"size": 1.0, "kind": "burger"}))
+	assert.Equal(t, []string{
+		"value 'fries' does not match the constant value 'burger'",
+		"value 'large' is not one of the allowed values [1, small, true]",
+	}, reasons(sp.Validate(map[string]any{"size": "large", "kind": "fries"})))
+
+	// values of a different type are never equal, even if they are rendered the same.
+	assert.Equal(t, []string{
+		"value '1' is not one of the allowed values [1, small, true]",
+	}, reasons(sp.Validate(map[string]any{"size": "1", "kind": "burger"})))
+	assert.Len(t, sp.Validate(map[string]any{"size": "true", "kind": "burger"}), 1)
+
+	// a schema that was not built from a document compares the values in the same way.
+	schema := &Schema{Enum: []any{1, "small", true}}
+	assert.Empty(t, schema.Validate(1.0))
+	assert.Empty(t, schema.Validate(true))
+	assert.Len(t, schema.Validate("1"), 1)
+	assert.Len(t, schema.Validate("true"), 1)
+}
+
+func TestSchema_Validate_Composition(t *testing.T) {
+	sp := buildValidationSchema(t, `schema:
+  oneOf:
+    - type: string
+    - type: integer
+    - type: number
+  not:
+    type: boolean`)
+
+	assert.Empty(t, sp.Validate("burger"))
+	assert.Equal(t, []string{"value matches 2 'oneOf' schemas, only one is allowed"}, reasons(sp.Validate(1)))
+	assert.Equal(t, []string{"value does not match any of the 'oneOf' schemas",
+		"value must not match the 'not' schema"}, reasons(sp.Validate(true)))
+
+	sp = buildValidationSchema(t, `schema:
+  anyOf:
+    - type: string
+      maxLength: 2
+    - type: integer
+  allOf:
+    - minLength: 1`)
+	assert.Empty(t, sp.Validate("ab"))
+	assert.Equal(t, []string{"string length 3 is greater than the maximum length of 2"},
+		reasons(sp.Validate("abc")))
+	assert.Equal(t, []string{"string length 0 is less than the minimum length of 1"}, reasons(sp.Validate("")))
+}
+
+func TestSchema_Validate_IfThenElse(t *testing.T) {
+	sp := buildValidationSchema(t, `schema:
+  type: object
+  if:
+    properties:
+      country:
+        const: US
+  then:
+    required: [zip]
+  else:
+    required: [postcode]`)
+
+	assert.Empty(t, sp.Validate(map[string]any{"country": "US", "zip": "90210"}))
+	assert.Empty(t, sp.Validate(map[string]any{"country": "UK", "postcode": "SW1"}))
+	assert.Equal(t, []string{"required property 'zip' is missing"}, reasons(sp.Validate(map[string]any{"country": "US"})))
+	assert.Equal(t, []string{"required property 'postcode' is missing"},
+		reasons(sp.Validate(map[string]any{"country": "UK"})))
+}
+
+func TestSchema_Validate_Arrays(t *testing.T) {
+	sp := buildValidationSchema(t, `schema:
+  type: array
+  prefixItems:
+    - type: string
+    - type: integer
+  items: false
+  contains:
+    type: integer
+  maxContains: 1`)
+
+	assert.Empty(t, sp.Validate([]any{"a", 1}))
+	assert.Equal(t, []string{"expected type 'integer', got 'string'", "array item at index 2 is not allowed",
+		"array contains 0 matching items, at least 1 are required"}, reasons(sp.Validate([]any{"a", "b", "c"})))
+
+	sp = buildValidationSchema(t, `schema:
+  type: array
+  prefixItems:
+    - type: string
+  unevaluatedItems: false`)
+	assert.Empty(t, sp.Validate([]any{"a"}))
+	assert.Equal(t, []string{"array item at index 1 has not been evaluated and is not allowed"},
+		reasons(sp.Validate([]any{"a", "b"})))
+}
+
+func TestSchema_Validate_Objects(t *testing.T) {
+	sp := buildValidationSchema(t, `schema:
+  type: object
+  properties:
+    name:
+      type: string
+  patternProperties:
+    '^x-':
+      type: integer
+  additionalProperties: false
+  propertyNames:
+    maxLength: 5
+  minProperties: 1`)
+
+	assert.Empty(t, sp.Validate(map[string]any{"name": "a", "x-a": 1}))
+	assert.Equal(t, []string{
+		"object has 0 properties, the minimum is 1",
+	}, reasons(sp.Validate(map[string]any{})))
+	assert.Equal(t, []string{
+		"property 'extra' is not allowed",
+		"expected type 'integer', got 'string'",
+		"property name 'x-long' is invalid: string length 6 is greater than the maximum length of 5",
+	}, reasons(sp.Validate(map[string]any{"extra": 1, "x-long": "a"})))
+
+	sp = buildValidationSchema(t, `schema:
+  type: object
+  properties:
+    name:
+      type: string
+  allOf:
+    - properties:
+        age:
+          type: integer
+  dependentSchemas:
+    name:
+      required: [age]
+  unevaluatedProperties: false`)
+	assert.Empty(t, sp.Validate(map[string]any{"name": "a", "age": 1}))
+	assert.Equal(t, []string{"required property 'age' is missing",
+		"property 'other' has not been evaluated and is not allowed"},
+		reasons(sp.Validate(map[string]any{"name": "a", "other": true})))
+}
+
+func TestSchema_Validate_BooleanSchemas_Rendered(t *testing.T) {
+	sp := buildValidationSchema(t, `schema:
+  type: object
+  properties:
+    tags:
+      type: array
+      items: false
+  unevaluatedProperties: false`)
+
+	value := map[string]any{"x": 1, "tags": []any{1}}
+	expected := []string{"array item at index 0 is not allowed",
+		"property 'x' has not been evaluated and is not allowed"}
+	assert.Equal(t, expected, reasons(sp.Validate(value)))
+
+	// rendering builds every schema (including boolean schemas), which must not change the result.
+	_, err := sp.Render()
+	assert.NoError(t, err)
+	assert.Equal(t, expected, reasons(sp.Validate(value)))
+}
+
+func TestSchema_Validate_Discriminator(t *testing.T) {
+	sp := buildValidationSchema(t, `components:
+  schemas:
+    Burger:
+      type: object
+      required: [patties]
+      properties:
+        patties:
+          type: integer
+    Fries:
+      type: object
+      required: [salted]
+      properties:
+        salted:
+          type: boolean
+schema:
+  oneOf:
+    - $ref: '#/components/schemas/Burger'
+    - $ref: '#/components/schemas/Fries'
+  discriminator:
+    propertyName: kind
+    mapping:
+      chips: '#/components/schemas/Fries'`)
+
+	assert.Empty(t, sp.Validate(map[string]any{"kind": "Burger", "patties": 2}))
+	assert.Empty(t, sp.Validate(map[string]any{"kind": "chips", "salted": true}))
+	assert.Equal(t, []string{"required property 'patties' is missing"},
+		reasons(sp.Validate(map[string]any{"kind": "Burger", "salted": true})))
+	assert.Equal(t, []string{"discriminator value 'Salad' does not match any schema"},
+		reasons(sp.Validate(map[string]any{"kind": "Salad"})))
+	assert.Equal(t, []string{"discriminator property 'kind' is missing"},
+		reasons(sp.Validate(map[string]any{"patties": 2})))
+}
+
+func TestSchema_Validate_Discriminator_NotIfThenElse(t *testing.T) {
+	sp := buildValidationSchema(t, `components:
+  schemas:
+    Burger:
+      type: object
+      required: [patties]
+    Fries:
+      type: object
+      required: [salted]
+schema:
+  oneOf:
+    - $ref: '#/components/schemas/Burger'
+    - $ref: '#/components/schemas/Fries'
+  discriminator:
+    propertyName: kind
+  not:
+    required: [salad]
+  if:
+    properties:
+      kind:
+        const: Burger
+  then:
+    required: [bun]`)
+
+	// the discriminator picks the schema, 'not' and 'if' are still checked.
+	assert.Empty(t, sp.Validate(map[string]any{"kind": "Burger", "patties": 2, "bun": "brioche"}))
+	assert.Equal(t, []string{"value must not match the 'not' schema"},
+		reasons(sp.Validate(map[string]any{"kind": "Fries", "salted": true, "salad": true})))
+	assert.Equal(t, []string{"required property 'bun' is missing"},
+		reasons(sp.Validate(map[string]any{"kind": "Burger", "patties": 2})))
+}
+
+func TestSchema_Validate_ReadWriteOnly(t *testing.T) {
+	sp := buildValidationSchema(t, `schema:
+  type: object
+  required: [id, password]
+  properties:
+    id:
+      type: string
+      readOnly: true
+    password:
+      type: string
+      writeOnly: true`)
+
+	assert.Empty(t, sp.Validate(map[string]any{"id": "1", "password": "x"}))
+	assert.Empty(t, sp.ValidateWithContext(map[string]any{"password": "x"}, ValidateRequest))
+	assert.Empty(t, sp.ValidateWithContext(map[string]any{"id": "1"}, ValidateResponse))
+	assert.Equal(t, []string{"property 'id' is readOnly and must not be sent in a request"},
+		reasons(sp.ValidateWithContext(map[string]any{"id": "1", "password": "x"}, ValidateRequest)))
+	assert.Equal(t, []string{"property 'password' is writeOnly and must not be sent in a response"},
+		reasons(sp.ValidateWithContext(map[string]any{"id": "1", "password": "x"}, ValidateResponse)))
+}
+
+func TestSchema_Validate_Circular(t *testing.T) {
+	sp := buildValidationSchema(t, `components:
+  schemas:
+    Node:
+      type: object
+      required: [name]
+      properties:
+        name:
+          type: string
+        children:
+          type: array
+          items:
+            $ref: '#/components/schemas/Node'
+schema:
+  $ref: '#/components/schemas/Node'`)
+
+	assert.Empty(t, sp.Validate(map[string]any{"name": "a", "children": []any{
+		map[string]any{"name": "b", "children": []any{map[string]any{"name": "c"}}},
+	}}))
+	errs := sp.Validate(map[string]any{"name": "a", "children": []any{
+		map[string]any{"children": []any{map[string]any{"name": 1}}},
+	}})
+	assert.Equal(t, []string{"required property 'name' is missing", "expected type 'string', got 'integer'"},
+		reasons(errs))
+	assert.Equal(t, "/children/0", errs[0].Location)
+	assert.Equal(t, "/children/0/children/0/name", errs[1].Location)
+}
+
+func TestSchema_Validate_Structs(t *testing.T) {
+	type burger struct {
+		Name    string `json:"name"`
+		Patties int    `json:"patties"`
+	}
+	sp := buildValidationSchema(t, `schema:
+  type: object
+  properties:
+    name:
+      type: string
+    patties:
+      type: integer
+      maximum: 3`)
+
+	assert.Empty(t, sp.Validate(&burger{Name: "big", Patties: 2}))
+	assert.Equal(t, []string{"value 4 is greater than the maximum of 3"},
+		reasons(sp.Validate(burger{Name: "big", Patties: 4})))
+}
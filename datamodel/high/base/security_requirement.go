@@ -4,8 +4,10 @@
 package base
 
 import (
+	"github.com/pb33f/libopenapi/datamodel/high"
 	"github.com/pb33f/libopenapi/datamodel/low"
 	"github.com/pb33f/libopenapi/datamodel/low/base"
+	"github.com/pb33f/libopenapi/orderedmap"
 	"github.com/pb33f/libopenapi/utils"
 	"gopkg.in/yaml.v3"
 	"sort"
@@ -19,7 +21,7 @@ import (
 // The name used for each property MUST correspond to a security scheme declared in the Security Definitions
 //  - https://swagger.io/specification/v2/#securityDefinitionsObject
 type SecurityRequirement struct {
-	Requirements map[string][]string `json:"-" yaml:"-"`
+	Requirements *orderedmap.Map[string, []string] `json:"-" yaml:"-"`
 	low          *base.SecurityRequirement
 }
 
@@ -36,7 +38,7 @@ func NewSecurityRequirement(req *base.SecurityRequirement) *SecurityRequirement
 		}
 		values[reqK.Value] = vals
 	}
-	r.Requirements = values
+	r.Requirements = high.ToOrderedMap(values, req.Requirements.Value)
 	return r
 }
 
@@ -55,6 +57,26 @@ func (s *SecurityRequirement) Render() ([]byte, error) {
 	return yaml.Marshal(s)
 }
 
+// RenderJSON will return a JSON representation of the SecurityRequirement object as a byte slice, indented using indent.
+func (s *SecurityRequirement) RenderJSON(indent string) ([]byte, error) {
+	return high.RenderJSON(s, indent)
+}
+
+// MarshalJSON will create a ready to render JSON representation of the SecurityRequirement object.
+func (s *SecurityRequirement) MarshalJSON() ([]byte, error) {
+	return s.RenderJSON("")
+}
+
+// UnmarshalYAML will build the SecurityRequirement object from a YAML node, see high.UnmarshalNode.
+func (s *SecurityRequirement) UnmarshalYAML(node *yaml.Node) error {
+	return high.UnmarshalNode(s, node, NewSecurityRequirement)
+}
+
+// UnmarshalJSON will build the SecurityRequirement object from YAML or JSON, see high.UnmarshalBytes.
+func (s *SecurityRequirement) UnmarshalJSON(data []byte) error {
+	return high.UnmarshalBytes(s, data, NewSecurityRequirement)
+}
+
 // MarshalYAML will create a ready to render YAML representation of the SecurityRequirement object.
 func (s *SecurityRequirement) MarshalYAML() (interface{}, error) {
 
@@ -62,37 +84,26 @@ func (s *SecurityRequirement) MarshalYAML() (interface{}, error) {
 		line   int
 		key    string
 		val    []string
-		lowKey *low.KeyReference[string]
 		lowVal *low.ValueReference[[]low.ValueReference[string]]
 	}
 
 	m := utils.CreateEmptyMapNode()
-	keys := make([]*req, len(s.Requirements))
-
-	i := 0
 
-	for k := range s.Requirements {
-		keys[i] = &req{key: k, val: s.Requirements[k]}
-		i++
-	}
-	i = 0
-	if s.low != nil {
-		for o := range keys {
-			kv := keys[o].key
+	// requirements are already in order, the low-level values are used to order the scopes of each one.
+	keys := make([]*req, 0, s.Requirements.Len())
+	for _, pair := range s.Requirements.Pairs() {
+		r := &req{key: pair.Key, val: pair.Value}
+		if s.low != nil {
 			for k := range s.low.Requirements.Value {
-				if k.Value == kv {
+				if k.Value == pair.Key {
 					gh := s.low.Requirements.Value[k]
-					keys[o].line = k.KeyNode.Line
-					keys[o].lowKey = &k
-					keys[o].lowVal = &gh
+					r.line = k.KeyNode.Line
+					r.lowVal = &gh
 				}
-				i++
 			}
 		}
+		keys = append(keys, r)
 	}
-	sort.Slice(keys, func(i, j int) bool {
-		return keys[i].line < keys[j].line
-	})
 
 	for k := range keys {
 		l := utils.CreateStringNode(keys[k].key)
@@ -131,5 +142,8 @@ func (s *SecurityRequirement) MarshalYAML() (interface{}, error) {
 
 		m.Content = append(m.Content, l, sn)
 	}
+	if s.low != nil {
+		high.RetainFormatting(nil, m, nil, s.low.Requirements.ValueNode)
+	}
 	return m, nil
 }
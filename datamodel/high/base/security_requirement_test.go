@@ -32,8 +32,8 @@ cake:
 
 	highExt := NewSecurityRequirement(&lowExt)
 
-	assert.Len(t, highExt.Requirements["pizza"], 2)
-	assert.Len(t, highExt.Requirements["cake"], 2)
+	assert.Len(t, highExt.Requirements.GetOrZero("pizza"), 2)
+	assert.Len(t, highExt.Requirements.GetOrZero("cake"), 2)
 
 	wentLow := highExt.GoLow()
 	assert.Len(t, wentLow.Requirements.Value, 2)
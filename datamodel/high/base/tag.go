@@ -55,6 +55,26 @@ func (t *Tag) Render() ([]byte, error) {
     return yaml.Marshal(t)
 }
 
+// RenderJSON will return a JSON representation of the Info object as a byte slice, indented using indent.
+func (t *Tag) RenderJSON(indent string) ([]byte, error) {
+    return high.RenderJSON(t, indent)
+}
+
+// MarshalJSON will create a ready to render JSON representation of the Info object.
+func (t *Tag) MarshalJSON() ([]byte, error) {
+    return t.RenderJSON("")
+}
+
+// UnmarshalYAML will build the Tag object from a YAML node, see high.UnmarshalNode.
+func (t *Tag) UnmarshalYAML(node *yaml.Node) error {
+    return high.UnmarshalNode(t, node, NewTag)
+}
+
+// UnmarshalJSON will build the Tag object from YAML or JSON, see high.UnmarshalBytes.
+func (t *Tag) UnmarshalJSON(data []byte) error {
+    return high.UnmarshalBytes(t, data, NewTag)
+}
+
 // MarshalYAML will create a ready to render YAML representation of the Info object.
 func (t *Tag) MarshalYAML() (interface{}, error) {
     nb := high.NewNodeBuilder(t, t.low)
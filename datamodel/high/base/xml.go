@@ -56,6 +56,26 @@ func (x *XML) Render() ([]byte, error) {
     return yaml.Marshal(x)
 }
 
+// RenderJSON will return a JSON representation of the XML object as a byte slice, indented using indent.
+func (x *XML) RenderJSON(indent string) ([]byte, error) {
+    return high.RenderJSON(x, indent)
+}
+
+// MarshalJSON will create a ready to render JSON representation of the XML object.
+func (x *XML) MarshalJSON() ([]byte, error) {
+    return x.RenderJSON("")
+}
+
+// UnmarshalYAML will build the XML object from a YAML node, see high.UnmarshalNode.
+func (x *XML) UnmarshalYAML(node *yaml.Node) error {
+    return high.UnmarshalNode(x, node, NewXML)
+}
+
+// UnmarshalJSON will build the XML object from YAML or JSON, see high.UnmarshalBytes.
+func (x *XML) UnmarshalJSON(data []byte) error {
+    return high.UnmarshalBytes(x, data, NewXML)
+}
+
 // MarshalYAML will create a ready to render YAML representation of the XML object.
 func (x *XML) MarshalYAML() (interface{}, error) {
     nb := high.NewNodeBuilder(x, x.low)
@@ -4,7 +4,9 @@
 package high
 
 import (
+    "fmt"
     "github.com/pb33f/libopenapi/datamodel/low"
+    "github.com/pb33f/libopenapi/orderedmap"
     "github.com/pb33f/libopenapi/utils"
     "gopkg.in/yaml.v3"
     "reflect"
@@ -21,6 +23,11 @@ type NodeEntry struct {
     Value      any
     Line       int
     RenderZero bool
+
+    // KeyNode and ValueNode are the original nodes of the entry from the low level model (if there is one). They are
+    // used to retain the comments and style of the original document, wherever the value has not changed.
+    KeyNode   *yaml.Node
+    ValueNode *yaml.Node
 }
 
 // NodeBuilder is a structure used by libopenapi high-level objects, to render themselves back to YAML.
@@ -66,7 +73,7 @@ func (n *NodeBuilder) add(key string, i int) {
     // and add them to the node builder.
     if key == "Extensions" {
         extensions := reflect.ValueOf(n.High).Elem().FieldByName(key)
-        for b, e := range extensions.MapKeys() {
+        for b, e := range sortedMapKeys(extensions) {
             v := extensions.MapIndex(e)
 
             extKey := e.String()
@@ -81,16 +88,16 @@ func (n *NodeBuilder) add(key string, i int) {
                 case reflect.Map:
                     if j, ok := n.Low.(low.HasExtensionsUntyped); ok {
                         originalExtensions := j.GetExtensions()
-                        u := 0
                         for k := range originalExtensions {
                             if k.Value == extKey {
+                                nodeEntry.KeyNode = k.KeyNode
+                                nodeEntry.ValueNode = originalExtensions[k].ValueNode
                                 if originalExtensions[k].ValueNode.Line != 0 {
-                                    nodeEntry.Line = originalExtensions[k].ValueNode.Line + u
+                                    nodeEntry.Line = originalExtensions[k].ValueNode.Line
                                 } else {
-                                    nodeEntry.Line = 999999 + b + u
+                                    nodeEntry.Line = 999999 + b
                                 }
                             }
-                            u++
                         }
                     }
                 }
@@ -129,7 +136,7 @@ func (n *NodeBuilder) add(key string, i int) {
 
     switch value.Kind() {
     case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
-        nodeEntry.Value = strconv.FormatInt(value.Int(), 10)
+        nodeEntry.Value = value.Int()
     case reflect.String:
         nodeEntry.Value = value.String()
     case reflect.Bool:
@@ -148,7 +155,10 @@ func (n *NodeBuilder) add(key string, i int) {
         }
     case reflect.Ptr:
         if !value.IsNil() {
-            nodeEntry.Value = f
+            // empty ordered maps are skipped, the same as empty maps.
+            if om, ok := f.(orderedmap.MapUntyped); !ok || om.Len() > 0 || renderZeroVal == renderZero {
+                nodeEntry.Value = f
+            }
         }
     case reflect.Map:
         if !value.IsNil() && value.Len() > 0 {
@@ -194,7 +204,14 @@ func (n *NodeBuilder) add(key string, i int) {
         case reflect.Struct:
             y := value.Interface()
             nodeEntry.Line = 9999 + i
+            if jk, kj := y.(low.HasKeyNode); kj {
+                nodeEntry.KeyNode = jk.GetKeyNode()
+            }
             if nb, ok := y.(low.HasValueNodeUntyped); ok {
+                // the value node of a reference is the node being referenced, so it has nothing to give.
+                if !nb.IsReference() {
+                    nodeEntry.ValueNode = nb.GetValueNode()
+                }
                 if nb.IsReference() {
                     if jk, kj := y.(low.HasKeyNode); kj {
                         nodeEntry.Line = jk.GetKeyNode().Line
@@ -226,11 +243,7 @@ func (n *NodeBuilder) renderReference() []*yaml.Node {
 
 // Render will render the NodeBuilder back to a YAML node, iterating over every NodeEntry defined
 func (n *NodeBuilder) Render() *yaml.Node {
-    if len(n.Nodes) == 0 {
-        return utils.CreateEmptyMapNode()
-    }
-
-    // order nodes by line number, retain original order
+    // a reference is rendered, even if nothing else has been set.
     m := utils.CreateEmptyMapNode()
     if fg, ok := n.Low.(low.IsReferenced); ok {
         g := reflect.ValueOf(fg)
@@ -241,8 +254,13 @@ func (n *NodeBuilder) Render() *yaml.Node {
             }
         }
     }
+    if len(n.Nodes) == 0 {
+        return m
+    }
+
+    // order nodes by line number, retain original order
 
-    sort.Slice(n.Nodes, func(i, j int) bool {
+    sort.SliceStable(n.Nodes, func(i, j int) bool {
         if n.Nodes[i].Line != n.Nodes[j].Line {
             return n.Nodes[i].Line < n.Nodes[j].Line
         }
@@ -276,6 +294,39 @@ func (n *NodeBuilder) AddYAMLNode(parent *yaml.Node, entry *NodeEntry) *yaml.Nod
     key := entry.Key
 
     var valueNode *yaml.Node
+
+    // renderable values retain their own formatting, so only the formatting of the value node itself is copied.
+    rendered := false
+
+    // ordered maps are already in the right order, so each entry is rendered in turn.
+    if om, ok := value.(orderedmap.MapUntyped); ok {
+        if reflect.ValueOf(value).IsNil() {
+            return parent
+        }
+        p := utils.CreateEmptyMapNode()
+        lowNodes := n.findLowMapNodes(key)
+        om.RangeUntyped(func(k any, v any) bool {
+            x := fmt.Sprint(k)
+            e := &NodeEntry{Tag: x, Value: v, Line: line}
+            if ln, found := lowNodes[x]; found {
+                e.KeyNode, e.ValueNode = ln.KeyNode, ln.ValueNode
+            }
+            n.AddYAMLNode(p, e)
+            return true
+        })
+        if len(p.Content) == 0 && !entry.RenderZero {
+            return parent
+        }
+        valueNode = p
+        retainFormatting(l, valueNode, entry.KeyNode, entry.ValueNode, true, false)
+        if l != nil {
+            parent.Content = append(parent.Content, l, valueNode)
+        } else {
+            parent.Content = valueNode.Content
+        }
+        return parent
+    }
+
     switch t.Kind() {
 
     case reflect.String:
@@ -325,7 +376,7 @@ func (n *NodeBuilder) AddYAMLNode(parent *yaml.Node, entry *NodeEntry) *yaml.Nod
 
         var orderedCollection []*NodeEntry
         m := reflect.ValueOf(value)
-        for g, k := range m.MapKeys() {
+        for g, k := range sortedMapKeys(m) {
             var x string
             // extract key
             yu := k.Interface()
@@ -383,7 +434,7 @@ func (n *NodeBuilder) AddYAMLNode(parent *yaml.Node, entry *NodeEntry) *yaml.Nod
         }
 
         // sort the slice by line number to ensure everything is rendered in order.
-        sort.Slice(orderedCollection, func(i, j int) bool {
+        sort.SliceStable(orderedCollection, func(i, j int) bool {
             return orderedCollection[i].Line < orderedCollection[j].Line
         })
 
@@ -409,15 +460,15 @@ func (n *NodeBuilder) AddYAMLNode(parent *yaml.Node, entry *NodeEntry) *yaml.Nod
         skip := false
         for i := 0; i < m.Len(); i++ {
             sqi := m.Index(i).Interface()
+            skip = false
             // check if this is a reference.
             if glu, ok := sqi.(GoesLowUntyped); ok {
                 if glu != nil {
                     ut := glu.GoLowUntyped()
                     if !reflect.ValueOf(ut).IsNil() {
-                        r := ut.(low.IsReferenced)
-                        if ut != nil && r.GetReference() != "" &&
-                            ut.(low.IsReferenced).IsReference() {
-                            refNode := utils.CreateRefNode(glu.GoLowUntyped().(low.IsReferenced).GetReference())
+                        // not every low-level object can be a reference (Swagger objects mostly can't).
+                        if r, isRef := ut.(low.IsReferenced); isRef && r.GetReference() != "" && r.IsReference() {
+                            refNode := utils.CreateRefNode(r.GetReference())
                             sl.Content = append(sl.Content, refNode)
                             skip = true
                         } else {
@@ -443,6 +494,7 @@ func (n *NodeBuilder) AddYAMLNode(parent *yaml.Node, entry *NodeEntry) *yaml.Nod
 
         if len(sl.Content) > 0 {
             valueNode = sl
+            rendered = true
             break
         }
         if skip {
@@ -473,14 +525,15 @@ func (n *NodeBuilder) AddYAMLNode(parent *yaml.Node, entry *NodeEntry) *yaml.Nod
 
     case reflect.Ptr:
         if r, ok := value.(Renderable); ok {
+            rendered = true
             if gl, lg := value.(GoesLowUntyped); lg {
                 if gl.GoLowUntyped() != nil {
                     ut := reflect.ValueOf(gl.GoLowUntyped())
                     if !ut.IsNil() {
-                        if gl.GoLowUntyped().(low.IsReferenced).IsReference() {
+                        if r, isRef := gl.GoLowUntyped().(low.IsReferenced); isRef && r.IsReference() {
                             rvn := utils.CreateEmptyMapNode()
                             rvn.Content = append(rvn.Content, utils.CreateStringNode("$ref"))
-                            rvn.Content = append(rvn.Content, utils.CreateStringNode(gl.GoLowUntyped().(low.IsReferenced).GetReference()))
+                            rvn.Content = append(rvn.Content, utils.CreateStringNode(r.GetReference()))
                             valueNode = rvn
                             break
                         }
@@ -507,19 +560,16 @@ func (n *NodeBuilder) AddYAMLNode(parent *yaml.Node, entry *NodeEntry) *yaml.Nod
                     }
                 }
             }
+            // a pointer means the value has been set, so zero and negative numbers are rendered too.
             if b, bok := value.(*int64); bok {
                 encodeSkip = true
-                if *b > 0 {
-                    valueNode = utils.CreateIntNode(strconv.Itoa(int(*b)))
-                    valueNode.Line = line
-                }
+                valueNode = utils.CreateIntNode(strconv.FormatInt(*b, 10))
+                valueNode.Line = line
             }
             if b, bok := value.(*float64); bok {
                 encodeSkip = true
-                if *b > 0 {
-                    valueNode = utils.CreateFloatNode(strconv.FormatFloat(*b, 'f', -1, 64))
-                    valueNode.Line = line
-                }
+                valueNode = utils.CreateFloatNode(strconv.FormatFloat(*b, 'f', -1, 64))
+                valueNode.Line = line
             }
             if !encodeSkip {
                 var rawNode yaml.Node
@@ -537,6 +587,7 @@ func (n *NodeBuilder) AddYAMLNode(parent *yaml.Node, entry *NodeEntry) *yaml.Nod
     if valueNode == nil {
         return parent
     }
+    retainFormatting(l, valueNode, entry.KeyNode, entry.ValueNode, true, !rendered)
     if l != nil {
         parent.Content = append(parent.Content, l, valueNode)
     } else {
@@ -545,8 +596,138 @@ func (n *NodeBuilder) AddYAMLNode(parent *yaml.Node, entry *NodeEntry) *yaml.Nod
     return parent
 }
 
+// findLowMapNodes returns the original key and value nodes for each entry of a map held by a field of the low level
+// object, keyed by the name of each entry. Nothing is returned if there is no low level object, or no map.
+func (n *NodeBuilder) findLowMapNodes(field string) map[string]*NodeEntry {
+    if field == "" || n.Low == nil {
+        return nil
+    }
+    lowValue := reflect.ValueOf(n.Low)
+    if lowValue.Kind() != reflect.Ptr || lowValue.IsNil() {
+        return nil
+    }
+    lowField := lowValue.Elem().FieldByName(field)
+    if !lowField.IsValid() {
+        return nil
+    }
+    hv, ok := lowField.Interface().(low.HasValueUnTyped)
+    if !ok {
+        return nil
+    }
+    m := reflect.ValueOf(hv.GetValueUntyped())
+    if m.Kind() != reflect.Map {
+        return nil
+    }
+    nodes := make(map[string]*NodeEntry, m.Len())
+    for _, k := range m.MapKeys() {
+        kn, isKey := k.Interface().(low.HasKeyNode)
+        if !isKey || kn.GetKeyNode() == nil {
+            continue
+        }
+        entry := &NodeEntry{KeyNode: kn.GetKeyNode()}
+        if vn, isValue := m.MapIndex(k).Interface().(low.HasValueNodeUntyped); isValue && !vn.IsReference() {
+            entry.ValueNode = vn.GetValueNode()
+        }
+        nodes[entry.KeyNode.Value] = entry
+    }
+    return nodes
+}
+
+// RetainFormatting copies the head, line and foot comments and the style (quotes, block scalars and flow style) of an
+// original key and value on to a rendered key and value, wherever the value has not changed. Mapping and sequence
+// values are walked, so children that have not changed keep their formatting, even when other children have.
+//
+// The style of JSON is not retained, it's not a choice that was made by the author (JSON is recognized by its keys
+// being double-quoted). Any of the nodes can be nil, the original nodes are never modified.
+func RetainFormatting(key, value, originalKey, originalValue *yaml.Node) {
+    retainFormatting(key, value, originalKey, originalValue, true, true)
+}
+
+func retainFormatting(key, value, originalKey, originalValue *yaml.Node, styled, walk bool) {
+    if originalKey != nil && originalKey.Style&yaml.DoubleQuotedStyle != 0 {
+        styled = false
+    }
+    // keys are quoted by the encoder when they need to be, so only comments are copied.
+    if key != nil && originalKey != nil && key != originalKey && key.Value == originalKey.Value {
+        key.HeadComment = originalKey.HeadComment
+        key.LineComment = originalKey.LineComment
+        key.FootComment = originalKey.FootComment
+    }
+    if !copyFormatting(value, originalValue, styled) || !walk {
+        return
+    }
+    switch value.Kind {
+    case yaml.MappingNode:
+        keys := make(map[string]int, len(originalValue.Content)/2)
+        for i := 0; i+1 < len(originalValue.Content); i += 2 {
+            keys[originalValue.Content[i].Value] = i
+        }
+        for i := 0; i+1 < len(value.Content); i += 2 {
+            if o, ok := keys[value.Content[i].Value]; ok {
+                retainFormatting(value.Content[i], value.Content[i+1],
+                    originalValue.Content[o], originalValue.Content[o+1], styled, true)
+            }
+        }
+    case yaml.SequenceNode:
+        if len(value.Content) == len(originalValue.Content) {
+            for i := range value.Content {
+                retainFormatting(nil, value.Content[i], nil, originalValue.Content[i], styled, true)
+            }
+            return
+        }
+        // items have been added or removed, so only scalars can be matched up (by their value).
+        for _, c := range value.Content {
+            if c.Kind != yaml.ScalarNode {
+                continue
+            }
+            for _, o := range originalValue.Content {
+                if copyFormatting(c, o, styled) {
+                    break
+                }
+            }
+        }
+    }
+}
+
+// copyFormatting copies the comments and style (if styled) of a single original node on to a rendered node. Scalars
+// are only matched if the value is the same, false is returned if the nodes do not match.
+func copyFormatting(node, original *yaml.Node, styled bool) bool {
+    if node == nil || original == nil || node == original || node.Kind != original.Kind {
+        return false
+    }
+    if node.Kind == yaml.ScalarNode && node.Value != original.Value {
+        return false
+    }
+    if styled {
+        switch node.Kind {
+        case yaml.ScalarNode:
+            // quoting a value with a different type (a number that was a string) would change the type.
+            if node.Tag == "" || original.Tag == "" || node.Tag == original.Tag {
+                node.Style = original.Style &^ yaml.TaggedStyle
+            }
+        default:
+            // a flow collection that runs over multiple lines is JSON (or has been written like it).
+            if original.Style&yaml.FlowStyle == 0 || lastLine(original) == original.Line {
+                node.Style = original.Style &^ yaml.TaggedStyle
+            }
+        }
+    }
+    node.HeadComment = original.HeadComment
+    node.LineComment = original.LineComment
+    node.FootComment = original.FootComment
+    return true
+}
+
+// lastLine returns the line of the last node inside a node.
+func lastLine(node *yaml.Node) int {
+    for len(node.Content) > 0 {
+        node = node.Content[len(node.Content)-1]
+    }
+    return node.Line
+}
+
 func (n *NodeBuilder) extractLowMapKeysWrapped(iu reflect.Value, x string, orderedCollection []*NodeEntry, g int) []*NodeEntry {
-    for _, ky := range iu.MapKeys() {
+    for _, ky := range sortedMapKeys(iu) {
         ty := ky.Interface()
         if ere, eok := ty.(low.HasKeyNode); eok {
             er := ere.GetKeyNode().Value
@@ -571,7 +752,11 @@ func (n *NodeBuilder) extractLowMapKeysWrapped(iu reflect.Value, x string, order
 }
 
 func (n *NodeBuilder) extractLowMapKeys(fg reflect.Value, x string, found bool, orderedCollection []*NodeEntry, m reflect.Value, k reflect.Value) (bool, []*NodeEntry) {
-    for j, ky := range fg.MapKeys() {
+    // the low level value may not exist, or may not be a map (the high level value is new).
+    if !fg.IsValid() || fg.Kind() != reflect.Map {
+        return found, orderedCollection
+    }
+    for j, ky := range sortedMapKeys(fg) {
         hu := ky.Interface()
         if we, wok := hu.(low.HasKeyNode); wok {
             er := we.GetKeyNode().Value
@@ -601,6 +786,22 @@ func (n *NodeBuilder) extractLowMapKeys(fg reflect.Value, x string, found bool,
     return found, orderedCollection
 }
 
+// sortedMapKeys returns the keys of a map sorted by their value (or the value of their key node), the order of a map
+// is random, so keys without a line number are rendered in the same order every time.
+func sortedMapKeys(m reflect.Value) []reflect.Value {
+    keys := m.MapKeys()
+    name := func(k reflect.Value) string {
+        if kn, ok := k.Interface().(low.HasKeyNode); ok && kn.GetKeyNode() != nil {
+            return kn.GetKeyNode().Value
+        }
+        return fmt.Sprint(k.Interface())
+    }
+    sort.SliceStable(keys, func(i, j int) bool {
+        return name(keys[i]) < name(keys[j])
+    })
+    return keys
+}
+
 // Renderable is an interface that can be implemented by types that provide a custom MarshaYAML method.
 type Renderable interface {
     MarshalYAML() (interface{}, error)
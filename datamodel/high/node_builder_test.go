@@ -207,8 +207,8 @@ func TestNewNodeBuilder(t *testing.T) {
     data, _ := yaml.Marshal(node)
 
     desired := `thing: ding
-thong: "1"
-thrum: "1234567"
+thong: 1
+thrum: 1234567
 thang: 2.20
 thung: 3.33333
 thyme: true
@@ -284,7 +284,7 @@ func TestNewNodeBuilder_Extensions(t *testing.T) {
     node := nb.Render()
 
     data, _ := yaml.Marshal(node)
-    assert.Len(t, data, 51)
+    assert.Len(t, data, 49)
 }
 
 func TestNewNodeBuilder_LowValueNode(t *testing.T) {
@@ -303,7 +303,7 @@ func TestNewNodeBuilder_LowValueNode(t *testing.T) {
 
     data, _ := yaml.Marshal(node)
 
-    assert.Len(t, data, 51)
+    assert.Len(t, data, 49)
 }
 
 func TestNewNodeBuilder_NoValue(t *testing.T) {
@@ -398,6 +398,48 @@ func TestNewNodeBuilder_Float64(t *testing.T) {
     assert.Equal(t, "1234.232323", node.Content[1].Value)
 }
 
+func TestNewNodeBuilder_PointerZeroAndNegative(t *testing.T) {
+    var c int64
+    d := -1.5
+    t1 := test1{
+        Thurr: &c,
+        Thral: &d,
+    }
+
+    nb := NewNodeBuilder(&t1, &t1)
+    node := nb.Render()
+
+    data, _ := yaml.Marshal(node)
+
+    desired := `thurr: 0
+thral: -1.5`
+
+    assert.Equal(t, desired, strings.TrimSpace(string(data)))
+}
+
+type lowThurm struct {
+    Thurm low.NodeReference[any]
+}
+
+type highThurm struct {
+    Thurm any `yaml:"thurm,omitempty"`
+}
+
+func TestNewNodeBuilder_MapValueMissingLow(t *testing.T) {
+    t1 := highThurm{
+        Thurm: map[string]any{"pizza": "pie"},
+    }
+    nb := NewNodeBuilder(&t1, &lowThurm{})
+    node := nb.Render()
+
+    data, _ := yaml.Marshal(node)
+
+    desired := `thurm:
+    pizza: pie`
+
+    assert.Equal(t, desired, strings.TrimSpace(string(data)))
+}
+
 func TestNewNodeBuilder_EmptyNode(t *testing.T) {
     t1 := new(test1)
     nb := NewNodeBuilder(t1, t1)
@@ -606,7 +648,7 @@ func TestNewNodeBuilder_ExtensionMap(t *testing.T) {
 
     data, _ := yaml.Marshal(node)
 
-    assert.Len(t, data, 62)
+    assert.Len(t, data, 60)
 }
 
 func TestNewNodeBuilder_MapKeyHasValueThatHasValueMismatch(t *testing.T) {
@@ -874,3 +916,47 @@ func TestNewNodeBuilder_ShouldHaveNotDoneTestsLikeThisOhWell(t *testing.T) {
 }
 
 
+func TestRetainFormatting(t *testing.T) {
+    original := `# pizza
+pizza: 'hot' # very
+beer: [cold, wet]
+cake:
+    flavor: "chocolate"
+    slices: 8 # at least
+count: '12'
+json: {
+  "soda": "fizzy"
+}`
+
+    var on yaml.Node
+    _ = yaml.Unmarshal([]byte(original), &on)
+
+    // render the same content (with a couple of changes), without any formatting.
+    var rn yaml.Node
+    _ = rn.Encode(map[string]any{
+        "pizza": "hot",
+        "beer":  []string{"cold", "wet", "fresh"},
+        "cake":  map[string]any{"flavor": "chocolate", "slices": 9},
+        "count": 12,
+        "json":  map[string]any{"soda": "fizzy"},
+    })
+
+    RetainFormatting(nil, &rn, nil, on.Content[0])
+    data, _ := yaml.Marshal(&rn)
+
+    desired := `beer: [cold, wet, fresh]
+cake:
+    flavor: "chocolate"
+    slices: 9
+count: 12
+json:
+    soda: fizzy
+# pizza
+pizza: 'hot' # very`
+
+    assert.Equal(t, desired, strings.TrimSpace(string(data)))
+
+    // nothing happens without an original node.
+    RetainFormatting(nil, &rn, nil, nil)
+    RetainFormatting(nil, nil, nil, &on)
+}
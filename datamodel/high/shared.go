@@ -14,7 +14,13 @@
 package high
 
 import (
+	"sort"
+
 	"github.com/pb33f/libopenapi/datamodel/low"
+	"github.com/pb33f/libopenapi/index"
+	"github.com/pb33f/libopenapi/orderedmap"
+	"github.com/pb33f/libopenapi/utils"
+	"gopkg.in/yaml.v3"
 )
 
 // GoesLow is used to represent any high-level model. All high level models meet this interface and can be used to
@@ -35,6 +41,76 @@ type GoesLowUntyped interface {
 	GoLowUntyped() any
 }
 
+// RenderJSON will render a high-level object as JSON. Everything is rendered in the same order as it would be when
+// rendered as YAML, including extensions. If indent is empty, the JSON is compact, otherwise each level of the JSON is
+// indented using indent.
+func RenderJSON(r Renderable, indent string) ([]byte, error) {
+	rendered, err := r.MarshalYAML()
+	if err != nil {
+		return nil, err
+	}
+	node, ok := rendered.(*yaml.Node)
+	if !ok {
+		node = new(yaml.Node)
+		if err = node.Encode(rendered); err != nil {
+			return nil, err
+		}
+	}
+	return utils.ConvertYAMLNodeToJSON(node, indent)
+}
+
+// NewFromBytes will create a high-level object from a YAML or JSON fragment of a specification (like an operation or a
+// schema), using the constructor of the high-level object. The low-level object is built first, references are
+// resolved using idx. If idx is nil, only references that are local to the fragment can be resolved.
+//
+//	operation, err := high.NewFromBytes(snippet, idx, v3.NewOperation)
+func NewFromBytes[H any, L low.Buildable[N], N any](spec []byte, idx *index.SpecIndex, create func(L) H) (H, error) {
+	var node yaml.Node
+	if err := yaml.Unmarshal(spec, &node); err != nil {
+		var zero H
+		return zero, err
+	}
+	return NewFromNode(&node, idx, create)
+}
+
+// NewFromNode is the same as NewFromBytes, except the fragment has already been parsed into a yaml.Node.
+func NewFromNode[H any, L low.Buildable[N], N any](node *yaml.Node, idx *index.SpecIndex, create func(L) H) (H, error) {
+	built, err := low.BuildModelFromNode[L, N](node, idx)
+	if err != nil {
+		var zero H
+		return zero, err
+	}
+	return create(built), nil
+}
+
+// UnmarshalNode builds a high-level object from a YAML node using its constructor (see NewFromNode) and copies it
+// into target. It implements yaml.Unmarshaler for high-level objects, so they can be decoded from a fragment of a
+// specification. Only references that are local to the node can be resolved, use NewFromNode to resolve
+// references using an index.
+//
+//	func (o *Operation) UnmarshalYAML(node *yaml.Node) error {
+//		return high.UnmarshalNode(o, node, NewOperation)
+//	}
+func UnmarshalNode[H any, L low.Buildable[N], N any](target *H, node *yaml.Node, create func(L) *H) error {
+	built, err := NewFromNode(node, nil, create)
+	if err != nil {
+		return err
+	}
+	*target = *built
+	return nil
+}
+
+// UnmarshalBytes is the same as UnmarshalNode, except the fragment is YAML or JSON that has not been parsed yet. It
+// implements json.Unmarshaler for high-level objects.
+func UnmarshalBytes[H any, L low.Buildable[N], N any](target *H, data []byte, create func(L) *H) error {
+	built, err := NewFromBytes(data, nil, create)
+	if err != nil {
+		return err
+	}
+	*target = *built
+	return nil
+}
+
 // ExtractExtensions is a convenience method for converting low-level extension definitions, to a high level map[string]any
 // definition that is easier to consume in applications.
 func ExtractExtensions(extensions map[low.KeyReference[string]]low.ValueReference[any]) map[string]any {
@@ -45,6 +121,50 @@ func ExtractExtensions(extensions map[low.KeyReference[string]]low.ValueReferenc
 	return extracted
 }
 
+// ToOrderedMap is a convenience function for converting a map of high-level values, built from a low-level map, into
+// an ordered map. Keys are ordered by where they appear in the specification (the position of each key in the
+// low-level map). Keys that don't exist in the low-level map are added to the end, in alphabetical order.
+func ToOrderedMap[H any, L any](entries map[string]H,
+	lowEntries map[low.KeyReference[string]]low.ValueReference[L]) *orderedmap.Map[string, H] {
+	type position struct {
+		key    string
+		line   int
+		column int
+		found  bool
+	}
+	positions := make(map[string]*position, len(entries))
+	for k := range entries {
+		positions[k] = &position{key: k}
+	}
+	for k := range lowEntries {
+		if p, ok := positions[k.Value]; ok && k.KeyNode != nil {
+			p.line, p.column, p.found = k.KeyNode.Line, k.KeyNode.Column, true
+		}
+	}
+	ordered := make([]*position, 0, len(positions))
+	for _, p := range positions {
+		ordered = append(ordered, p)
+	}
+	sort.Slice(ordered, func(i, j int) bool {
+		a, b := ordered[i], ordered[j]
+		if a.found != b.found {
+			return a.found
+		}
+		if a.line != b.line {
+			return a.line < b.line
+		}
+		if a.column != b.column {
+			return a.column < b.column
+		}
+		return a.key < b.key
+	})
+	m := orderedmap.New[string, H]()
+	for _, p := range ordered {
+		m.Set(p.key, entries[p.key])
+	}
+	return m
+}
+
 // UnpackExtensions is a convenience function that makes it easy and simple to unpack an objects extensions
 // into a complex type, provided as a generic. This function is for high-level models that implement `GoesLow()`
 // and for low-level models that support extensions via `HasExtensions`.
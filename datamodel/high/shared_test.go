@@ -4,7 +4,9 @@
 package high
 
 import (
+    "encoding/json"
     "github.com/pb33f/libopenapi/datamodel/low"
+    lowbase "github.com/pb33f/libopenapi/datamodel/low/base"
     "github.com/stretchr/testify/assert"
     "gopkg.in/yaml.v3"
     "testing"
@@ -127,4 +129,62 @@ power: hello`
     res, er := UnpackExtensions[textExtension, *child](p)
     assert.Error(t, er)
     assert.Empty(t, res)
-}
\ No newline at end of file
+}
+
+// testInfo is a high-level object used to test the unmarshal functions, built from a low-level Info.
+type testInfo struct {
+    Title   string
+    Contact string
+    low     *lowbase.Info
+}
+
+func newTestInfo(info *lowbase.Info) *testInfo {
+    t := &testInfo{Title: info.Title.Value, low: info}
+    if !info.Contact.IsEmpty() {
+        t.Contact = info.Contact.Value.Name.Value
+    }
+    return t
+}
+
+func (t *testInfo) UnmarshalYAML(node *yaml.Node) error {
+    return UnmarshalNode(t, node, newTestInfo)
+}
+
+func (t *testInfo) UnmarshalJSON(data []byte) error {
+    return UnmarshalBytes(t, data, newTestInfo)
+}
+
+func TestUnmarshalNode(t *testing.T) {
+    var info testInfo
+    assert.NoError(t, yaml.Unmarshal([]byte(`title: pizza
+contact:
+  $ref: '#/x-contact'
+x-contact:
+  name: pb33f`), &info))
+    assert.Equal(t, "pizza", info.Title)
+    assert.Equal(t, "pb33f", info.Contact) // references local to the node are resolved.
+    assert.NotNil(t, info.low)
+
+    assert.Error(t, yaml.Unmarshal([]byte(`- not an object`), &info))
+}
+
+func TestUnmarshalBytes(t *testing.T) {
+    var info testInfo
+    assert.NoError(t, json.Unmarshal([]byte(`{"title": "pizza", "contact": {"name": "pb33f"}}`), &info))
+    assert.Equal(t, "pizza", info.Title)
+    assert.Equal(t, "pb33f", info.Contact)
+
+    assert.Error(t, json.Unmarshal([]byte(`"not an object"`), &info))
+    assert.Error(t, UnmarshalBytes(&info, []byte(`:{`), newTestInfo))
+}
+
+func TestNewFromBytes(t *testing.T) {
+    info, err := NewFromBytes([]byte(`title: pizza`), nil, newTestInfo)
+    assert.NoError(t, err)
+    assert.Equal(t, "pizza", info.Title)
+
+    _, err = NewFromBytes([]byte(`:{`), nil, newTestInfo)
+    assert.Error(t, err)
+    _, err = NewFromBytes([]byte(`- not an object`), nil, newTestInfo)
+    assert.Error(t, err)
+}
@@ -4,10 +4,13 @@
 package v2
 
 import (
+	"github.com/pb33f/libopenapi/datamodel/high"
 	highbase "github.com/pb33f/libopenapi/datamodel/high/base"
 	lowmodel "github.com/pb33f/libopenapi/datamodel/low"
 	lowbase "github.com/pb33f/libopenapi/datamodel/low/base"
 	low "github.com/pb33f/libopenapi/datamodel/low/v2"
+	"github.com/pb33f/libopenapi/orderedmap"
+	"gopkg.in/yaml.v3"
 )
 
 // Definitions is a high-level represents of a Swagger / OpenAPI 2 Definitions object, backed by a low-level one.
@@ -16,7 +19,7 @@ import (
 // arrays or models.
 //  - https://swagger.io/specification/v2/#definitionsObject
 type Definitions struct {
-	Definitions map[string]*highbase.SchemaProxy
+	Definitions *orderedmap.Map[string, *highbase.SchemaProxy] `json:"-" yaml:"-"`
 	low         *low.Definitions
 }
 
@@ -30,7 +33,7 @@ func NewDefinitions(definitions *low.Definitions) *Definitions {
 			Value: definitions.Schemas[k].Value,
 		})
 	}
-	rd.Definitions = defs
+	rd.Definitions = high.ToOrderedMap(defs, definitions.Schemas)
 	return rd
 }
 
@@ -38,3 +41,44 @@ func NewDefinitions(definitions *low.Definitions) *Definitions {
 func (d *Definitions) GoLow() *low.Definitions {
 	return d.low
 }
+
+// GoLowUntyped will return the low-level Definitions instance that was used to create the high-level one, with no type
+func (d *Definitions) GoLowUntyped() any {
+	return d.low
+}
+
+// Render will return a YAML representation of the Definitions object as a byte slice.
+func (d *Definitions) Render() ([]byte, error) {
+	return yaml.Marshal(d)
+}
+
+// RenderJSON will return a JSON representation of the Definitions object as a byte slice, indented using indent.
+func (d *Definitions) RenderJSON(indent string) ([]byte, error) {
+	return high.RenderJSON(d, indent)
+}
+
+// MarshalJSON will create a ready to render JSON representation of the Definitions object.
+func (d *Definitions) MarshalJSON() ([]byte, error) {
+	return d.RenderJSON("")
+}
+
+// UnmarshalYAML will build the Definitions object from a YAML node, see high.UnmarshalNode.
+func (d *Definitions) UnmarshalYAML(node *yaml.Node) error {
+	return high.UnmarshalNode(d, node, NewDefinitions)
+}
+
+// UnmarshalJSON will build the Definitions object from YAML or JSON, see high.UnmarshalBytes.
+func (d *Definitions) UnmarshalJSON(data []byte) error {
+	return high.UnmarshalBytes(d, data, NewDefinitions)
+}
+
+// MarshalYAML will create a ready to render YAML representation of the Definitions object.
+func (d *Definitions) MarshalYAML() (interface{}, error) {
+	var lowSchemas map[lowmodel.KeyReference[string]]lowmodel.ValueReference[*lowbase.SchemaProxy]
+	if d.low != nil {
+		lowSchemas = d.low.Schemas
+	}
+	nb := high.NewNodeBuilder(d, d.low)
+	addMapEntries(nb, d.Definitions, lowSchemas)
+	return nb.Render(), nil
+}
@@ -3,13 +3,19 @@
 
 package v2
 
-import low "github.com/pb33f/libopenapi/datamodel/low/v2"
+import (
+	"github.com/pb33f/libopenapi/datamodel/high"
+	lowmodel "github.com/pb33f/libopenapi/datamodel/low"
+	low "github.com/pb33f/libopenapi/datamodel/low/v2"
+	"github.com/pb33f/libopenapi/orderedmap"
+	"gopkg.in/yaml.v3"
+)
 
 // Example represents a high-level Swagger / OpenAPI 2 Example object, backed by a low level one.
 // Allows sharing examples for operation responses
 //  - https://swagger.io/specification/v2/#exampleObject
 type Example struct {
-	Values map[string]any
+	Values *orderedmap.Map[string, any] `json:"-" yaml:"-"`
 	low    *low.Examples
 }
 
@@ -22,7 +28,7 @@ func NewExample(examples *low.Examples) *Example {
 		for k := range examples.Values {
 			values[k.Value] = examples.Values[k].Value
 		}
-		e.Values = values
+		e.Values = high.ToOrderedMap(values, examples.Values)
 	}
 	return e
 }
@@ -31,3 +37,44 @@ func NewExample(examples *low.Examples) *Example {
 func (e *Example) GoLow() *low.Examples {
 	return e.low
 }
+
+// GoLowUntyped will return the low-level Example instance that was used to create the high-level one, with no type
+func (e *Example) GoLowUntyped() any {
+	return e.low
+}
+
+// Render will return a YAML representation of the Example object as a byte slice.
+func (e *Example) Render() ([]byte, error) {
+	return yaml.Marshal(e)
+}
+
+// RenderJSON will return a JSON representation of the Example object as a byte slice, indented using indent.
+func (e *Example) RenderJSON(indent string) ([]byte, error) {
+	return high.RenderJSON(e, indent)
+}
+
+// MarshalJSON will create a ready to render JSON representation of the Example object.
+func (e *Example) MarshalJSON() ([]byte, error) {
+	return e.RenderJSON("")
+}
+
+// UnmarshalYAML will build the Example object from a YAML node, see high.UnmarshalNode.
+func (e *Example) UnmarshalYAML(node *yaml.Node) error {
+	return high.UnmarshalNode(e, node, NewExample)
+}
+
+// UnmarshalJSON will build the Example object from YAML or JSON, see high.UnmarshalBytes.
+func (e *Example) UnmarshalJSON(data []byte) error {
+	return high.UnmarshalBytes(e, data, NewExample)
+}
+
+// MarshalYAML will create a ready to render YAML representation of the Example object.
+func (e *Example) MarshalYAML() (interface{}, error) {
+	var lowValues map[lowmodel.KeyReference[string]]lowmodel.ValueReference[any]
+	if e.low != nil {
+		lowValues = e.low.Values
+	}
+	nb := high.NewNodeBuilder(e, e.low)
+	addMapEntries(nb, e.Values, lowValues)
+	return nb.Render(), nil
+}
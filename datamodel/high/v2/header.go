@@ -6,31 +6,32 @@ package v2
 import (
 	"github.com/pb33f/libopenapi/datamodel/high"
 	low "github.com/pb33f/libopenapi/datamodel/low/v2"
+	"gopkg.in/yaml.v3"
 )
 
 // Header Represents a high-level Swagger / OpenAPI 2 Header object, backed by a low-level one.
 // A Header is essentially identical to a Parameter, except it does not contain 'name' or 'in' properties.
 //  - https://swagger.io/specification/v2/#headerObject
 type Header struct {
-	Type             string
-	Format           string
-	Description      string
-	Items            *Items
-	CollectionFormat string
-	Default          any
-	Maximum          int
-	ExclusiveMaximum bool
-	Minimum          int
-	ExclusiveMinimum bool
-	MaxLength        int
-	MinLength        int
-	Pattern          string
-	MaxItems         int
-	MinItems         int
-	UniqueItems      bool
-	Enum             []any
-	MultipleOf       int
-	Extensions       map[string]any
+	Type             string         `json:"type,omitempty" yaml:"type,omitempty"`
+	Format           string         `json:"format,omitempty" yaml:"format,omitempty"`
+	Description      string         `json:"description,omitempty" yaml:"description,omitempty"`
+	Items            *Items         `json:"items,omitempty" yaml:"items,omitempty"`
+	CollectionFormat string         `json:"collectionFormat,omitempty" yaml:"collectionFormat,omitempty"`
+	Default          any            `json:"default,omitempty" yaml:"default,omitempty"`
+	Maximum          int            `json:"maximum,omitempty" yaml:"maximum,omitempty"`
+	ExclusiveMaximum bool           `json:"exclusiveMaximum,omitempty" yaml:"exclusiveMaximum,omitempty"`
+	Minimum          int            `json:"minimum,omitempty" yaml:"minimum,omitempty"`
+	ExclusiveMinimum bool           `json:"exclusiveMinimum,omitempty" yaml:"exclusiveMinimum,omitempty"`
+	MaxLength        int            `json:"maxLength,omitempty" yaml:"maxLength,omitempty"`
+	MinLength        int            `json:"minLength,omitempty" yaml:"minLength,omitempty"`
+	Pattern          string         `json:"pattern,omitempty" yaml:"pattern,omitempty"`
+	MaxItems         int            `json:"maxItems,omitempty" yaml:"maxItems,omitempty"`
+	MinItems         int            `json:"minItems,omitempty" yaml:"minItems,omitempty"`
+	UniqueItems      bool           `json:"uniqueItems,omitempty" yaml:"uniqueItems,omitempty"`
+	Enum             []any          `json:"enum,omitempty" yaml:"enum,omitempty"`
+	MultipleOf       int            `json:"multipleOf,omitempty" yaml:"multipleOf,omitempty"`
+	Extensions       map[string]any `json:"-" yaml:"-"`
 	low              *low.Header
 }
 
@@ -43,7 +44,7 @@ func NewHeader(header *low.Header) *Header {
 		h.Type = header.Type.Value
 	}
 	if !header.Format.IsEmpty() {
-		h.Format = header.Type.Value
+		h.Format = header.Format.Value
 	}
 	if !header.Description.IsEmpty() {
 		h.Description = header.Description.Value
@@ -66,7 +67,7 @@ func NewHeader(header *low.Header) *Header {
 	if !header.Minimum.IsEmpty() {
 		h.Minimum = header.Minimum.Value
 	}
-	if !header.ExclusiveMinimum.Value {
+	if !header.ExclusiveMinimum.IsEmpty() {
 		h.ExclusiveMinimum = header.ExclusiveMinimum.Value
 	}
 	if !header.MaxLength.IsEmpty() {
@@ -104,3 +105,39 @@ func NewHeader(header *low.Header) *Header {
 func (h *Header) GoLow() *low.Header {
 	return h.low
 }
+
+// GoLowUntyped will return the low-level Header instance that was used to create the high-level one, with no type
+func (h *Header) GoLowUntyped() any {
+	return h.low
+}
+
+// Render will return a YAML representation of the Header object as a byte slice.
+func (h *Header) Render() ([]byte, error) {
+	return yaml.Marshal(h)
+}
+
+// RenderJSON will return a JSON representation of the Header object as a byte slice, indented using indent.
+func (h *Header) RenderJSON(indent string) ([]byte, error) {
+	return high.RenderJSON(h, indent)
+}
+
+// MarshalJSON will create a ready to render JSON representation of the Header object.
+func (h *Header) MarshalJSON() ([]byte, error) {
+	return h.RenderJSON("")
+}
+
+// UnmarshalYAML will build the Header object from a YAML node, see high.UnmarshalNode.
+func (h *Header) UnmarshalYAML(node *yaml.Node) error {
+	return high.UnmarshalNode(h, node, NewHeader)
+}
+
+// UnmarshalJSON will build the Header object from YAML or JSON, see high.UnmarshalBytes.
+func (h *Header) UnmarshalJSON(data []byte) error {
+	return high.UnmarshalBytes(h, data, NewHeader)
+}
+
+// MarshalYAML will create a ready to render YAML representation of the Header object.
+func (h *Header) MarshalYAML() (interface{}, error) {
+	nb := high.NewNodeBuilder(h, h.low)
+	return nb.Render(), nil
+}
@@ -4,7 +4,9 @@
 package v2
 
 import (
+	"github.com/pb33f/libopenapi/datamodel/high"
 	low "github.com/pb33f/libopenapi/datamodel/low/v2"
+	"gopkg.in/yaml.v3"
 )
 
 // Items is a high-level representation of a Swagger / OpenAPI 2 Items object, backed by a low level one.
@@ -12,23 +14,23 @@ import (
 // located in "body"
 //  - https://swagger.io/specification/v2/#itemsObject
 type Items struct {
-	Type             string
-	Format           string
-	CollectionFormat string
-	Items            *Items
-	Default          any
-	Maximum          int
-	ExclusiveMaximum bool
-	Minimum          int
-	ExclusiveMinimum bool
-	MaxLength        int
-	MinLength        int
-	Pattern          string
-	MaxItems         int
-	MinItems         int
-	UniqueItems      bool
-	Enum             []any
-	MultipleOf       int
+	Type             string `json:"type,omitempty" yaml:"type,omitempty"`
+	Format           string `json:"format,omitempty" yaml:"format,omitempty"`
+	CollectionFormat string `json:"collectionFormat,omitempty" yaml:"collectionFormat,omitempty"`
+	Items            *Items `json:"items,omitempty" yaml:"items,omitempty"`
+	Default          any    `json:"default,omitempty" yaml:"default,omitempty"`
+	Maximum          int    `json:"maximum,omitempty" yaml:"maximum,omitempty"`
+	ExclusiveMaximum bool   `json:"exclusiveMaximum,omitempty" yaml:"exclusiveMaximum,omitempty"`
+	Minimum          int    `json:"minimum,omitempty" yaml:"minimum,omitempty"`
+	ExclusiveMinimum bool   `json:"exclusiveMinimum,omitempty" yaml:"exclusiveMinimum,omitempty"`
+	MaxLength        int    `json:"maxLength,omitempty" yaml:"maxLength,omitempty"`
+	MinLength        int    `json:"minLength,omitempty" yaml:"minLength,omitempty"`
+	Pattern          string `json:"pattern,omitempty" yaml:"pattern,omitempty"`
+	MaxItems         int    `json:"maxItems,omitempty" yaml:"maxItems,omitempty"`
+	MinItems         int    `json:"minItems,omitempty" yaml:"minItems,omitempty"`
+	UniqueItems      bool   `json:"uniqueItems,omitempty" yaml:"uniqueItems,omitempty"`
+	Enum             []any  `json:"enum,omitempty" yaml:"enum,omitempty"`
+	MultipleOf       int    `json:"multipleOf,omitempty" yaml:"multipleOf,omitempty"`
 	low              *low.Items
 }
 
@@ -98,3 +100,39 @@ func NewItems(items *low.Items) *Items {
 func (i *Items) GoLow() *low.Items {
 	return i.low
 }
+
+// GoLowUntyped will return the low-level Items instance that was used to create the high-level one, with no type
+func (i *Items) GoLowUntyped() any {
+	return i.low
+}
+
+// Render will return a YAML representation of the Items object as a byte slice.
+func (i *Items) Render() ([]byte, error) {
+	return yaml.Marshal(i)
+}
+
+// RenderJSON will return a JSON representation of the Items object as a byte slice, indented using indent.
+func (i *Items) RenderJSON(indent string) ([]byte, error) {
+	return high.RenderJSON(i, indent)
+}
+
+// MarshalJSON will create a ready to render JSON representation of the Items object.
+func (i *Items) MarshalJSON() ([]byte, error) {
+	return i.RenderJSON("")
+}
+
+// UnmarshalYAML will build the Items object from a YAML node, see high.UnmarshalNode.
+func (i *Items) UnmarshalYAML(node *yaml.Node) error {
+	return high.UnmarshalNode(i, node, NewItems)
+}
+
+// UnmarshalJSON will build the Items object from YAML or JSON, see high.UnmarshalBytes.
+func (i *Items) UnmarshalJSON(data []byte) error {
+	return high.UnmarshalBytes(i, data, NewItems)
+}
+
+// MarshalYAML will create a ready to render YAML representation of the Items object.
+func (i *Items) MarshalYAML() (interface{}, error) {
+	nb := high.NewNodeBuilder(i, i.low)
+	return nb.Render(), nil
+}
@@ -7,25 +7,26 @@ import (
 	"github.com/pb33f/libopenapi/datamodel/high"
 	"github.com/pb33f/libopenapi/datamodel/high/base"
 	low "github.com/pb33f/libopenapi/datamodel/low/v2"
+	"gopkg.in/yaml.v3"
 )
 
 // Operation represents a high-level Swagger / OpenAPI 2 Operation object, backed by a low-level one.
 // It describes a single API operation on a path.
 //  - https://swagger.io/specification/v2/#operationObject
 type Operation struct {
-	Tags         []string
-	Summary      string
-	Description  string
-	ExternalDocs *base.ExternalDoc
-	OperationId  string
-	Consumes     []string
-	Produces     []string
-	Parameters   []*Parameter
-	Responses    *Responses
-	Schemes      []string
-	Deprecated   bool
-	Security     []*base.SecurityRequirement
-	Extensions   map[string]any
+	Tags         []string                    `json:"tags,omitempty" yaml:"tags,omitempty"`
+	Summary      string                      `json:"summary,omitempty" yaml:"summary,omitempty"`
+	Description  string                      `json:"description,omitempty" yaml:"description,omitempty"`
+	ExternalDocs *base.ExternalDoc           `json:"externalDocs,omitempty" yaml:"externalDocs,omitempty"`
+	OperationId  string                      `json:"operationId,omitempty" yaml:"operationId,omitempty"`
+	Consumes     []string                    `json:"consumes,omitempty" yaml:"consumes,omitempty"`
+	Produces     []string                    `json:"produces,omitempty" yaml:"produces,omitempty"`
+	Parameters   []*Parameter                `json:"parameters,omitempty" yaml:"parameters,omitempty"`
+	Responses    *Responses                  `json:"responses,omitempty" yaml:"responses,omitempty"`
+	Schemes      []string                    `json:"schemes,omitempty" yaml:"schemes,omitempty"`
+	Deprecated   bool                        `json:"deprecated,omitempty" yaml:"deprecated,omitempty"`
+	Security     []*base.SecurityRequirement `json:"security,omitempty" yaml:"security,omitempty"`
+	Extensions   map[string]any              `json:"-" yaml:"-"`
 	low          *low.Operation
 }
 
@@ -101,3 +102,39 @@ func NewOperation(operation *low.Operation) *Operation {
 func (o *Operation) GoLow() *low.Operation {
 	return o.low
 }
+
+// GoLowUntyped will return the low-level Operation instance that was used to create the high-level one, with no type
+func (o *Operation) GoLowUntyped() any {
+	return o.low
+}
+
+// Render will return a YAML representation of the Operation object as a byte slice.
+func (o *Operation) Render() ([]byte, error) {
+	return yaml.Marshal(o)
+}
+
+// RenderJSON will return a JSON representation of the Operation object as a byte slice, indented using indent.
+func (o *Operation) RenderJSON(indent string) ([]byte, error) {
+	return high.RenderJSON(o, indent)
+}
+
+// MarshalJSON will create a ready to render JSON representation of the Operation object.
+func (o *Operation) MarshalJSON() ([]byte, error) {
+	return o.RenderJSON("")
+}
+
+// UnmarshalYAML will build the Operation object from a YAML node, see high.UnmarshalNode.
+func (o *Operation) UnmarshalYAML(node *yaml.Node) error {
+	return high.UnmarshalNode(o, node, NewOperation)
+}
+
+// UnmarshalJSON will build the Operation object from YAML or JSON, see high.UnmarshalBytes.
+func (o *Operation) UnmarshalJSON(data []byte) error {
+	return high.UnmarshalBytes(o, data, NewOperation)
+}
+
+// MarshalYAML will create a ready to render YAML representation of the Operation object.
+func (o *Operation) MarshalYAML() (interface{}, error) {
+	nb := high.NewNodeBuilder(o, o.low)
+	return nb.Render(), nil
+}
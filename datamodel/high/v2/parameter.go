@@ -7,6 +7,7 @@ import (
 	"github.com/pb33f/libopenapi/datamodel/high"
 	"github.com/pb33f/libopenapi/datamodel/high/base"
 	low "github.com/pb33f/libopenapi/datamodel/low/v2"
+	"gopkg.in/yaml.v3"
 )
 
 // Parameter represents a high-level Swagger / OpenAPI 2 Parameter object, backed by a low-level one.
@@ -41,30 +42,30 @@ import (
 //                           submit-name. This type of form parameters is more commonly used for file transfers
 // https://swagger.io/specification/v2/#parameterObject
 type Parameter struct {
-	Name             string
-	In               string
-	Type             string
-	Format           string
-	Description      string
-	Required         *bool
-	AllowEmptyValue  *bool
-	Schema           *base.SchemaProxy
-	Items            *Items
-	CollectionFormat string
-	Default          any
-	Maximum          *int
-	ExclusiveMaximum *bool
-	Minimum          *int
-	ExclusiveMinimum *bool
-	MaxLength        *int
-	MinLength        *int
-	Pattern          string
-	MaxItems         *int
-	MinItems         *int
-	UniqueItems      *bool
-	Enum             []any
-	MultipleOf       *int
-	Extensions       map[string]any
+	Name             string            `json:"name,omitempty" yaml:"name,omitempty"`
+	In               string            `json:"in,omitempty" yaml:"in,omitempty"`
+	Type             string            `json:"type,omitempty" yaml:"type,omitempty"`
+	Format           string            `json:"format,omitempty" yaml:"format,omitempty"`
+	Description      string            `json:"description,omitempty" yaml:"description,omitempty"`
+	Required         *bool             `json:"required,omitempty" yaml:"required,omitempty"`
+	AllowEmptyValue  *bool             `json:"allowEmptyValue,omitempty" yaml:"allowEmptyValue,omitempty"`
+	Schema           *base.SchemaProxy `json:"schema,omitempty" yaml:"schema,omitempty"`
+	Items            *Items            `json:"items,omitempty" yaml:"items,omitempty"`
+	CollectionFormat string            `json:"collectionFormat,omitempty" yaml:"collectionFormat,omitempty"`
+	Default          any               `json:"default,omitempty" yaml:"default,omitempty"`
+	Maximum          *int              `json:"maximum,omitempty" yaml:"maximum,omitempty"`
+	ExclusiveMaximum *bool             `json:"exclusiveMaximum,omitempty" yaml:"exclusiveMaximum,omitempty"`
+	Minimum          *int              `json:"minimum,omitempty" yaml:"minimum,omitempty"`
+	ExclusiveMinimum *bool             `json:"exclusiveMinimum,omitempty" yaml:"exclusiveMinimum,omitempty"`
+	MaxLength        *int              `json:"maxLength,omitempty" yaml:"maxLength,omitempty"`
+	MinLength        *int              `json:"minLength,omitempty" yaml:"minLength,omitempty"`
+	Pattern          string            `json:"pattern,omitempty" yaml:"pattern,omitempty"`
+	MaxItems         *int              `json:"maxItems,omitempty" yaml:"maxItems,omitempty"`
+	MinItems         *int              `json:"minItems,omitempty" yaml:"minItems,omitempty"`
+	UniqueItems      *bool             `json:"uniqueItems,omitempty" yaml:"uniqueItems,omitempty"`
+	Enum             []any             `json:"enum,omitempty" yaml:"enum,omitempty"`
+	MultipleOf       *int              `json:"multipleOf,omitempty" yaml:"multipleOf,omitempty"`
+	Extensions       map[string]any    `json:"-" yaml:"-"`
 	low              *low.Parameter
 }
 
@@ -153,3 +154,39 @@ func NewParameter(parameter *low.Parameter) *Parameter {
 func (p *Parameter) GoLow() *low.Parameter {
 	return p.low
 }
+
+// GoLowUntyped will return the low-level Parameter instance that was used to create the high-level one, with no type
+func (p *Parameter) GoLowUntyped() any {
+	return p.low
+}
+
+// Render will return a YAML representation of the Parameter object as a byte slice.
+func (p *Parameter) Render() ([]byte, error) {
+	return yaml.Marshal(p)
+}
+
+// RenderJSON will return a JSON representation of the Parameter object as a byte slice, indented using indent.
+func (p *Parameter) RenderJSON(indent string) ([]byte, error) {
+	return high.RenderJSON(p, indent)
+}
+
+// MarshalJSON will create a ready to render JSON representation of the Parameter object.
+func (p *Parameter) MarshalJSON() ([]byte, error) {
+	return p.RenderJSON("")
+}
+
+// UnmarshalYAML will build the Parameter object from a YAML node, see high.UnmarshalNode.
+func (p *Parameter) UnmarshalYAML(node *yaml.Node) error {
+	return high.UnmarshalNode(p, node, NewParameter)
+}
+
+// UnmarshalJSON will build the Parameter object from YAML or JSON, see high.UnmarshalBytes.
+func (p *Parameter) UnmarshalJSON(data []byte) error {
+	return high.UnmarshalBytes(p, data, NewParameter)
+}
+
+// MarshalYAML will create a ready to render YAML representation of the Parameter object.
+func (p *Parameter) MarshalYAML() (interface{}, error) {
+	nb := high.NewNodeBuilder(p, p.low)
+	return nb.Render(), nil
+}
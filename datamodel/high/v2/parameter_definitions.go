@@ -3,7 +3,13 @@
 
 package v2
 
-import low "github.com/pb33f/libopenapi/datamodel/low/v2"
+import (
+	"github.com/pb33f/libopenapi/datamodel/high"
+	lowmodel "github.com/pb33f/libopenapi/datamodel/low"
+	low "github.com/pb33f/libopenapi/datamodel/low/v2"
+	"github.com/pb33f/libopenapi/orderedmap"
+	"gopkg.in/yaml.v3"
+)
 
 // ParameterDefinitions is a high-level representation of a Swagger / OpenAPI 2 Parameters Definitions object
 // that is backed by a low-level one.
@@ -12,7 +18,7 @@ import low "github.com/pb33f/libopenapi/datamodel/low/v2"
 // referenced to the ones defined here. It does not define global operation parameters
 //  - https://swagger.io/specification/v2/#parametersDefinitionsObject
 type ParameterDefinitions struct {
-	Definitions map[string]*Parameter
+	Definitions *orderedmap.Map[string, *Parameter] `json:"-" yaml:"-"`
 	low         *low.ParameterDefinitions
 }
 
@@ -41,7 +47,7 @@ func NewParametersDefinitions(parametersDefinitions *low.ParameterDefinitions) *
 			params[r.key] = r.result
 		}
 	}
-	pd.Definitions = params
+	pd.Definitions = high.ToOrderedMap(params, parametersDefinitions.Definitions)
 	return pd
 }
 
@@ -49,3 +55,44 @@ func NewParametersDefinitions(parametersDefinitions *low.ParameterDefinitions) *
 func (p *ParameterDefinitions) GoLow() *low.ParameterDefinitions {
 	return p.low
 }
+
+// GoLowUntyped will return the low-level ParameterDefinitions instance that was used to create the high-level one, with no type
+func (p *ParameterDefinitions) GoLowUntyped() any {
+	return p.low
+}
+
+// Render will return a YAML representation of the ParameterDefinitions object as a byte slice.
+func (p *ParameterDefinitions) Render() ([]byte, error) {
+	return yaml.Marshal(p)
+}
+
+// RenderJSON will return a JSON representation of the ParameterDefinitions object as a byte slice, indented using indent.
+func (p *ParameterDefinitions) RenderJSON(indent string) ([]byte, error) {
+	return high.RenderJSON(p, indent)
+}
+
+// MarshalJSON will create a ready to render JSON representation of the ParameterDefinitions object.
+func (p *ParameterDefinitions) MarshalJSON() ([]byte, error) {
+	return p.RenderJSON("")
+}
+
+// UnmarshalYAML will build the ParameterDefinitions object from a YAML node, see high.UnmarshalNode.
+func (p *ParameterDefinitions) UnmarshalYAML(node *yaml.Node) error {
+	return high.UnmarshalNode(p, node, NewParametersDefinitions)
+}
+
+// UnmarshalJSON will build the ParameterDefinitions object from YAML or JSON, see high.UnmarshalBytes.
+func (p *ParameterDefinitions) UnmarshalJSON(data []byte) error {
+	return high.UnmarshalBytes(p, data, NewParametersDefinitions)
+}
+
+// MarshalYAML will create a ready to render YAML representation of the ParameterDefinitions object.
+func (p *ParameterDefinitions) MarshalYAML() (interface{}, error) {
+	var lowParams map[lowmodel.KeyReference[string]]lowmodel.ValueReference[*low.Parameter]
+	if p.low != nil {
+		lowParams = p.low.Definitions
+	}
+	nb := high.NewNodeBuilder(p, p.low)
+	addMapEntries(nb, p.Definitions, lowParams)
+	return nb.Render(), nil
+}
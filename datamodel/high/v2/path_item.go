@@ -6,6 +6,7 @@ package v2
 import (
     "github.com/pb33f/libopenapi/datamodel/high"
     low "github.com/pb33f/libopenapi/datamodel/low/v2"
+    "gopkg.in/yaml.v3"
 )
 
 // PathItem represents a high-level Swagger / OpenAPI 2 PathItem object backed by a low-level one.
@@ -15,16 +16,16 @@ import (
 // are available.
 //  - https://swagger.io/specification/v2/#pathItemObject
 type PathItem struct {
-    Ref        string
-    Get        *Operation
-    Put        *Operation
-    Post       *Operation
-    Delete     *Operation
-    Options    *Operation
-    Head       *Operation
-    Patch      *Operation
-    Parameters []*Parameter
-    Extensions map[string]any
+    Ref        string         `json:"$ref,omitempty" yaml:"$ref,omitempty"`
+    Get        *Operation     `json:"get,omitempty" yaml:"get,omitempty"`
+    Put        *Operation     `json:"put,omitempty" yaml:"put,omitempty"`
+    Post       *Operation     `json:"post,omitempty" yaml:"post,omitempty"`
+    Delete     *Operation     `json:"delete,omitempty" yaml:"delete,omitempty"`
+    Options    *Operation     `json:"options,omitempty" yaml:"options,omitempty"`
+    Head       *Operation     `json:"head,omitempty" yaml:"head,omitempty"`
+    Patch      *Operation     `json:"patch,omitempty" yaml:"patch,omitempty"`
+    Parameters []*Parameter   `json:"parameters,omitempty" yaml:"parameters,omitempty"`
+    Extensions map[string]any `json:"-" yaml:"-"`
     low        *low.PathItem
 }
 
@@ -33,6 +34,9 @@ func NewPathItem(pathItem *low.PathItem) *PathItem {
     p := new(PathItem)
     p.low = pathItem
     p.Extensions = high.ExtractExtensions(pathItem.Extensions)
+    if !pathItem.Ref.IsEmpty() {
+        p.Ref = pathItem.Ref.Value
+    }
     if !pathItem.Parameters.IsEmpty() {
         var params []*Parameter
         for k := range pathItem.Parameters.Value {
@@ -113,6 +117,42 @@ func (p *PathItem) GoLow() *low.PathItem {
     return p.low
 }
 
+// GoLowUntyped will return the low-level PathItem instance that was used to create the high-level one, with no type
+func (p *PathItem) GoLowUntyped() any {
+    return p.low
+}
+
+// Render will return a YAML representation of the PathItem object as a byte slice.
+func (p *PathItem) Render() ([]byte, error) {
+    return yaml.Marshal(p)
+}
+
+// RenderJSON will return a JSON representation of the PathItem object as a byte slice, indented using indent.
+func (p *PathItem) RenderJSON(indent string) ([]byte, error) {
+    return high.RenderJSON(p, indent)
+}
+
+// MarshalJSON will create a ready to render JSON representation of the PathItem object.
+func (p *PathItem) MarshalJSON() ([]byte, error) {
+    return p.RenderJSON("")
+}
+
+// UnmarshalYAML will build the PathItem object from a YAML node, see high.UnmarshalNode.
+func (p *PathItem) UnmarshalYAML(node *yaml.Node) error {
+    return high.UnmarshalNode(p, node, NewPathItem)
+}
+
+// UnmarshalJSON will build the PathItem object from YAML or JSON, see high.UnmarshalBytes.
+func (p *PathItem) UnmarshalJSON(data []byte) error {
+    return high.UnmarshalBytes(p, data, NewPathItem)
+}
+
+// MarshalYAML will create a ready to render YAML representation of the PathItem object.
+func (p *PathItem) MarshalYAML() (interface{}, error) {
+    nb := high.NewNodeBuilder(p, p.low)
+    return nb.Render(), nil
+}
+
 func (p *PathItem) GetOperations() map[string]*Operation {
     o := make(map[string]*Operation)
     if p.Get != nil {
@@ -4,6 +4,7 @@
 package v2
 
 import (
+    "encoding/json"
     "github.com/pb33f/libopenapi/datamodel/low"
     v2 "github.com/pb33f/libopenapi/datamodel/low/v2"
     "github.com/pb33f/libopenapi/index"
@@ -42,3 +43,18 @@ options:
 
     assert.Len(t, r.GetOperations(), 7)
 }
+
+func TestPathItem_Unmarshal(t *testing.T) {
+    var p PathItem
+    assert.NoError(t, yaml.Unmarshal([]byte(`get:
+  operationId: pizza`), &p))
+    assert.Equal(t, "pizza", p.Get.OperationId)
+    assert.NotNil(t, p.GoLow())
+
+    assert.NoError(t, json.Unmarshal([]byte(`{"put": {"operationId": "burger"}}`), &p))
+    assert.Nil(t, p.Get)
+    assert.Equal(t, "burger", p.Put.OperationId)
+
+    rendered, _ := p.Render()
+    assert.Equal(t, "put:\n    operationId: burger\n", string(rendered))
+}
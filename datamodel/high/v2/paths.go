@@ -5,13 +5,16 @@ package v2
 
 import (
 	"github.com/pb33f/libopenapi/datamodel/high"
+	lowmodel "github.com/pb33f/libopenapi/datamodel/low"
 	low "github.com/pb33f/libopenapi/datamodel/low/v2"
+	"github.com/pb33f/libopenapi/orderedmap"
+	"gopkg.in/yaml.v3"
 )
 
 // Paths represents a high-level Swagger / OpenAPI Paths object, backed by a low-level one.
 type Paths struct {
-	PathItems  map[string]*PathItem
-	Extensions map[string]any
+	PathItems  *orderedmap.Map[string, *PathItem] `json:"-" yaml:"-"`
+	Extensions map[string]any                     `json:"-" yaml:"-"`
 	low        *low.Paths
 }
 
@@ -42,7 +45,7 @@ func NewPaths(paths *low.Paths) *Paths {
 				pathItems[res.key] = res.result
 			}
 		}
-		p.PathItems = pathItems
+		p.PathItems = high.ToOrderedMap(pathItems, paths.PathItems)
 	}
 	return p
 }
@@ -51,3 +54,44 @@ func NewPaths(paths *low.Paths) *Paths {
 func (p *Paths) GoLow() *low.Paths {
 	return p.low
 }
+
+// GoLowUntyped will return the low-level Paths instance that was used to create the high-level one, with no type
+func (p *Paths) GoLowUntyped() any {
+	return p.low
+}
+
+// Render will return a YAML representation of the Paths object as a byte slice.
+func (p *Paths) Render() ([]byte, error) {
+	return yaml.Marshal(p)
+}
+
+// RenderJSON will return a JSON representation of the Paths object as a byte slice, indented using indent.
+func (p *Paths) RenderJSON(indent string) ([]byte, error) {
+	return high.RenderJSON(p, indent)
+}
+
+// MarshalJSON will create a ready to render JSON representation of the Paths object.
+func (p *Paths) MarshalJSON() ([]byte, error) {
+	return p.RenderJSON("")
+}
+
+// UnmarshalYAML will build the Paths object from a YAML node, see high.UnmarshalNode.
+func (p *Paths) UnmarshalYAML(node *yaml.Node) error {
+	return high.UnmarshalNode(p, node, NewPaths)
+}
+
+// UnmarshalJSON will build the Paths object from YAML or JSON, see high.UnmarshalBytes.
+func (p *Paths) UnmarshalJSON(data []byte) error {
+	return high.UnmarshalBytes(p, data, NewPaths)
+}
+
+// MarshalYAML will create a ready to render YAML representation of the Paths object.
+func (p *Paths) MarshalYAML() (interface{}, error) {
+	var lowPaths map[lowmodel.KeyReference[string]]lowmodel.ValueReference[*low.PathItem]
+	if p.low != nil {
+		lowPaths = p.low.PathItems
+	}
+	nb := high.NewNodeBuilder(p, p.low)
+	addMapEntries(nb, p.PathItems, lowPaths)
+	return nb.Render(), nil
+}
@@ -7,17 +7,19 @@ import (
 	"github.com/pb33f/libopenapi/datamodel/high"
 	"github.com/pb33f/libopenapi/datamodel/high/base"
 	low "github.com/pb33f/libopenapi/datamodel/low/v2"
+	"github.com/pb33f/libopenapi/orderedmap"
+	"gopkg.in/yaml.v3"
 )
 
 // Response is a representation of a high-level Swagger / OpenAPI 2 Response object, backed by a low-level one.
 // Response describes a single response from an API Operation
 //  - https://swagger.io/specification/v2/#responseObject
 type Response struct {
-	Description string
-	Schema      *base.SchemaProxy
-	Headers     map[string]*Header
-	Examples    *Example
-	Extensions  map[string]any
+	Description string             `json:"description,omitempty" yaml:"description,omitempty"`
+	Schema      *base.SchemaProxy  `json:"schema,omitempty" yaml:"schema,omitempty"`
+	Headers     *orderedmap.Map[string, *Header] `json:"headers,omitempty" yaml:"headers,omitempty"`
+	Examples    *Example           `json:"examples,omitempty" yaml:"examples,omitempty"`
+	Extensions  map[string]any     `json:"-" yaml:"-"`
 	low         *low.Response
 }
 
@@ -37,7 +39,7 @@ func NewResponse(response *low.Response) *Response {
 		for k := range response.Headers.Value {
 			headers[k.Value] = NewHeader(response.Headers.Value[k].Value)
 		}
-		r.Headers = headers
+		r.Headers = high.ToOrderedMap(headers, response.Headers.Value)
 	}
 	if !response.Examples.IsEmpty() {
 		r.Examples = NewExample(response.Examples.Value)
@@ -49,3 +51,39 @@ func NewResponse(response *low.Response) *Response {
 func (r *Response) GoLow() *low.Response {
 	return r.low
 }
+
+// GoLowUntyped will return the low-level Response instance that was used to create the high-level one, with no type
+func (r *Response) GoLowUntyped() any {
+	return r.low
+}
+
+// Render will return a YAML representation of the Response object as a byte slice.
+func (r *Response) Render() ([]byte, error) {
+	return yaml.Marshal(r)
+}
+
+// RenderJSON will return a JSON representation of the Response object as a byte slice, indented using indent.
+func (r *Response) RenderJSON(indent string) ([]byte, error) {
+	return high.RenderJSON(r, indent)
+}
+
+// MarshalJSON will create a ready to render JSON representation of the Response object.
+func (r *Response) MarshalJSON() ([]byte, error) {
+	return r.RenderJSON("")
+}
+
+// UnmarshalYAML will build the Response object from a YAML node, see high.UnmarshalNode.
+func (r *Response) UnmarshalYAML(node *yaml.Node) error {
+	return high.UnmarshalNode(r, node, NewResponse)
+}
+
+// UnmarshalJSON will build the Response object from YAML or JSON, see high.UnmarshalBytes.
+func (r *Response) UnmarshalJSON(data []byte) error {
+	return high.UnmarshalBytes(r, data, NewResponse)
+}
+
+// MarshalYAML will create a ready to render YAML representation of the Response object.
+func (r *Response) MarshalYAML() (interface{}, error) {
+	nb := high.NewNodeBuilder(r, r.low)
+	return nb.Render(), nil
+}
@@ -5,14 +5,17 @@ package v2
 
 import (
 	"github.com/pb33f/libopenapi/datamodel/high"
+	lowmodel "github.com/pb33f/libopenapi/datamodel/low"
 	low "github.com/pb33f/libopenapi/datamodel/low/v2"
+	"github.com/pb33f/libopenapi/orderedmap"
+	"gopkg.in/yaml.v3"
 )
 
 // Responses is a high-level representation of a Swagger / OpenAPI 2 Responses object, backed by a low level one.
 type Responses struct {
-	Codes      map[string]*Response
-	Default    *Response
-	Extensions map[string]any
+	Codes      *orderedmap.Map[string, *Response] `json:"-" yaml:"-"`
+	Default    *Response                          `json:"default,omitempty" yaml:"default,omitempty"`
+	Extensions map[string]any                     `json:"-" yaml:"-"`
 	low        *low.Responses
 }
 
@@ -50,7 +53,7 @@ func NewResponses(responses *low.Responses) *Responses {
 				resp[res.key] = res.result
 			}
 		}
-		r.Codes = resp
+		r.Codes = high.ToOrderedMap(resp, responses.Codes)
 	}
 	return r
 }
@@ -59,3 +62,44 @@ func NewResponses(responses *low.Responses) *Responses {
 func (r *Responses) GoLow() *low.Responses {
 	return r.low
 }
+
+// GoLowUntyped will return the low-level Responses instance that was used to create the high-level one, with no type
+func (r *Responses) GoLowUntyped() any {
+	return r.low
+}
+
+// Render will return a YAML representation of the Responses object as a byte slice.
+func (r *Responses) Render() ([]byte, error) {
+	return yaml.Marshal(r)
+}
+
+// RenderJSON will return a JSON representation of the Responses object as a byte slice, indented using indent.
+func (r *Responses) RenderJSON(indent string) ([]byte, error) {
+	return high.RenderJSON(r, indent)
+}
+
+// MarshalJSON will create a ready to render JSON representation of the Responses object.
+func (r *Responses) MarshalJSON() ([]byte, error) {
+	return r.RenderJSON("")
+}
+
+// UnmarshalYAML will build the Responses object from a YAML node, see high.UnmarshalNode.
+func (r *Responses) UnmarshalYAML(node *yaml.Node) error {
+	return high.UnmarshalNode(r, node, NewResponses)
+}
+
+// UnmarshalJSON will build the Responses object from YAML or JSON, see high.UnmarshalBytes.
+func (r *Responses) UnmarshalJSON(data []byte) error {
+	return high.UnmarshalBytes(r, data, NewResponses)
+}
+
+// MarshalYAML will create a ready to render YAML representation of the Responses object.
+func (r *Responses) MarshalYAML() (interface{}, error) {
+	var lowCodes map[lowmodel.KeyReference[string]]lowmodel.ValueReference[*low.Response]
+	if r.low != nil {
+		lowCodes = r.low.Codes
+	}
+	nb := high.NewNodeBuilder(r, r.low)
+	addMapEntries(nb, r.Codes, lowCodes)
+	return nb.Render(), nil
+}
@@ -3,7 +3,13 @@
 
 package v2
 
-import low "github.com/pb33f/libopenapi/datamodel/low/v2"
+import (
+	"github.com/pb33f/libopenapi/datamodel/high"
+	lowmodel "github.com/pb33f/libopenapi/datamodel/low"
+	low "github.com/pb33f/libopenapi/datamodel/low/v2"
+	"github.com/pb33f/libopenapi/orderedmap"
+	"gopkg.in/yaml.v3"
+)
 
 // ResponsesDefinitions is a high-level representation of a Swagger / OpenAPI 2 Responses Definitions object.
 // that is backed by a low-level one.
@@ -12,7 +18,7 @@ import low "github.com/pb33f/libopenapi/datamodel/low/v2"
 // referenced to the ones defined here. It does not define global operation responses
 //  - https://swagger.io/specification/v2/#responsesDefinitionsObject
 type ResponsesDefinitions struct {
-	Definitions map[string]*Response
+	Definitions *orderedmap.Map[string, *Response] `json:"-" yaml:"-"`
 	low         *low.ResponsesDefinitions
 }
 
@@ -42,7 +48,7 @@ func NewResponsesDefinitions(responsesDefinitions *low.ResponsesDefinitions) *Re
 			responses[r.key] = r.result
 		}
 	}
-	rd.Definitions = responses
+	rd.Definitions = high.ToOrderedMap(responses, responsesDefinitions.Definitions)
 	return rd
 }
 
@@ -50,3 +56,44 @@ func NewResponsesDefinitions(responsesDefinitions *low.ResponsesDefinitions) *Re
 func (r *ResponsesDefinitions) GoLow() *low.ResponsesDefinitions {
 	return r.low
 }
+
+// GoLowUntyped will return the low-level ResponsesDefinitions instance that was used to create the high-level one, with no type
+func (r *ResponsesDefinitions) GoLowUntyped() any {
+	return r.low
+}
+
+// Render will return a YAML representation of the ResponsesDefinitions object as a byte slice.
+func (r *ResponsesDefinitions) Render() ([]byte, error) {
+	return yaml.Marshal(r)
+}
+
+// RenderJSON will return a JSON representation of the ResponsesDefinitions object as a byte slice, indented using indent.
+func (r *ResponsesDefinitions) RenderJSON(indent string) ([]byte, error) {
+	return high.RenderJSON(r, indent)
+}
+
+// MarshalJSON will create a ready to render JSON representation of the ResponsesDefinitions object.
+func (r *ResponsesDefinitions) MarshalJSON() ([]byte, error) {
+	return r.RenderJSON("")
+}
+
+// UnmarshalYAML will build the ResponsesDefinitions object from a YAML node, see high.UnmarshalNode.
+func (r *ResponsesDefinitions) UnmarshalYAML(node *yaml.Node) error {
+	return high.UnmarshalNode(r, node, NewResponsesDefinitions)
+}
+
+// UnmarshalJSON will build the ResponsesDefinitions object from YAML or JSON, see high.UnmarshalBytes.
+func (r *ResponsesDefinitions) UnmarshalJSON(data []byte) error {
+	return high.UnmarshalBytes(r, data, NewResponsesDefinitions)
+}
+
+// MarshalYAML will create a ready to render YAML representation of the ResponsesDefinitions object.
+func (r *ResponsesDefinitions) MarshalYAML() (interface{}, error) {
+	var lowResponses map[lowmodel.KeyReference[string]]lowmodel.ValueReference[*low.Response]
+	if r.low != nil {
+		lowResponses = r.low.Definitions
+	}
+	nb := high.NewNodeBuilder(r, r.low)
+	addMapEntries(nb, r.Definitions, lowResponses)
+	return nb.Render(), nil
+}
@@ -4,7 +4,11 @@
 package v2
 
 import (
+	"github.com/pb33f/libopenapi/datamodel/high"
+	lowmodel "github.com/pb33f/libopenapi/datamodel/low"
 	low "github.com/pb33f/libopenapi/datamodel/low/v2"
+	"github.com/pb33f/libopenapi/orderedmap"
+	"gopkg.in/yaml.v3"
 )
 
 // Scopes is a high-level representation of a Swagger / OpenAPI 2 OAuth2 Scopes object, that is backed by a low-level one.
@@ -12,7 +16,7 @@ import (
 // Scopes lists the available scopes for an OAuth2 security scheme.
 //  - https://swagger.io/specification/v2/#scopesObject
 type Scopes struct {
-	Values map[string]string
+	Values *orderedmap.Map[string, string] `json:"-" yaml:"-"`
 	low    *low.Scopes
 }
 
@@ -24,7 +28,7 @@ func NewScopes(scopes *low.Scopes) *Scopes {
 	for k := range scopes.Values {
 		scopeValues[k.Value] = scopes.Values[k].Value
 	}
-	s.Values = scopeValues
+	s.Values = high.ToOrderedMap(scopeValues, scopes.Values)
 	return s
 }
 
@@ -32,3 +36,44 @@ func NewScopes(scopes *low.Scopes) *Scopes {
 func (s *Scopes) GoLow() *low.Scopes {
 	return s.low
 }
+
+// GoLowUntyped will return the low-level Scopes instance that was used to create the high-level one, with no type
+func (s *Scopes) GoLowUntyped() any {
+	return s.low
+}
+
+// Render will return a YAML representation of the Scopes object as a byte slice.
+func (s *Scopes) Render() ([]byte, error) {
+	return yaml.Marshal(s)
+}
+
+// RenderJSON will return a JSON representation of the Scopes object as a byte slice, indented using indent.
+func (s *Scopes) RenderJSON(indent string) ([]byte, error) {
+	return high.RenderJSON(s, indent)
+}
+
+// MarshalJSON will create a ready to render JSON representation of the Scopes object.
+func (s *Scopes) MarshalJSON() ([]byte, error) {
+	return s.RenderJSON("")
+}
+
+// UnmarshalYAML will build the Scopes object from a YAML node, see high.UnmarshalNode.
+func (s *Scopes) UnmarshalYAML(node *yaml.Node) error {
+	return high.UnmarshalNode(s, node, NewScopes)
+}
+
+// UnmarshalJSON will build the Scopes object from YAML or JSON, see high.UnmarshalBytes.
+func (s *Scopes) UnmarshalJSON(data []byte) error {
+	return high.UnmarshalBytes(s, data, NewScopes)
+}
+
+// MarshalYAML will create a ready to render YAML representation of the Scopes object.
+func (s *Scopes) MarshalYAML() (interface{}, error) {
+	var lowValues map[lowmodel.KeyReference[string]]lowmodel.ValueReference[string]
+	if s.low != nil {
+		lowValues = s.low.Values
+	}
+	nb := high.NewNodeBuilder(s, s.low)
+	addMapEntries(nb, s.Values, lowValues)
+	return nb.Render(), nil
+}
@@ -3,7 +3,13 @@
 
 package v2
 
-import low "github.com/pb33f/libopenapi/datamodel/low/v2"
+import (
+	"github.com/pb33f/libopenapi/datamodel/high"
+	lowmodel "github.com/pb33f/libopenapi/datamodel/low"
+	low "github.com/pb33f/libopenapi/datamodel/low/v2"
+	"github.com/pb33f/libopenapi/orderedmap"
+	"gopkg.in/yaml.v3"
+)
 
 // SecurityDefinitions is a high-level representation of a Swagger / OpenAPI 2 Security Definitions object, that
 // is backed by a low-level one.
@@ -12,7 +18,7 @@ import low "github.com/pb33f/libopenapi/datamodel/low/v2"
 // schemes on the operations and only serves to provide the relevant details for each scheme
 //  - https://swagger.io/specification/v2/#securityDefinitionsObject
 type SecurityDefinitions struct {
-	Definitions map[string]*SecurityScheme
+	Definitions *orderedmap.Map[string, *SecurityScheme] `json:"-" yaml:"-"`
 	low         *low.SecurityDefinitions
 }
 
@@ -24,7 +30,7 @@ func NewSecurityDefinitions(definitions *low.SecurityDefinitions) *SecurityDefin
 	for k := range definitions.Definitions {
 		schemes[k.Value] = NewSecurityScheme(definitions.Definitions[k].Value)
 	}
-	sd.Definitions = schemes
+	sd.Definitions = high.ToOrderedMap(schemes, definitions.Definitions)
 	return sd
 }
 
@@ -32,3 +38,44 @@ func NewSecurityDefinitions(definitions *low.SecurityDefinitions) *SecurityDefin
 func (sd *SecurityDefinitions) GoLow() *low.SecurityDefinitions {
 	return sd.low
 }
+
+// GoLowUntyped will return the low-level SecurityDefinitions instance that was used to create the high-level one, with no type
+func (sd *SecurityDefinitions) GoLowUntyped() any {
+	return sd.low
+}
+
+// Render will return a YAML representation of the SecurityDefinitions object as a byte slice.
+func (sd *SecurityDefinitions) Render() ([]byte, error) {
+	return yaml.Marshal(sd)
+}
+
+// RenderJSON will return a JSON representation of the SecurityDefinitions object as a byte slice, indented using indent.
+func (sd *SecurityDefinitions) RenderJSON(indent string) ([]byte, error) {
+	return high.RenderJSON(sd, indent)
+}
+
+// MarshalJSON will create a ready to render JSON representation of the SecurityDefinitions object.
+func (sd *SecurityDefinitions) MarshalJSON() ([]byte, error) {
+	return sd.RenderJSON("")
+}
+
+// UnmarshalYAML will build the SecurityDefinitions object from a YAML node, see high.UnmarshalNode.
+func (sd *SecurityDefinitions) UnmarshalYAML(node *yaml.Node) error {
+	return high.UnmarshalNode(sd, node, NewSecurityDefinitions)
+}
+
+// UnmarshalJSON will build the SecurityDefinitions object from YAML or JSON, see high.UnmarshalBytes.
+func (sd *SecurityDefinitions) UnmarshalJSON(data []byte) error {
+	return high.UnmarshalBytes(sd, data, NewSecurityDefinitions)
+}
+
+// MarshalYAML will create a ready to render YAML representation of the SecurityDefinitions object.
+func (sd *SecurityDefinitions) MarshalYAML() (interface{}, error) {
+	var lowSchemes map[lowmodel.KeyReference[string]]lowmodel.ValueReference[*low.SecurityScheme]
+	if sd.low != nil {
+		lowSchemes = sd.low.Definitions
+	}
+	nb := high.NewNodeBuilder(sd, sd.low)
+	addMapEntries(nb, sd.Definitions, lowSchemes)
+	return nb.Render(), nil
+}
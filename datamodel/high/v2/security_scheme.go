@@ -6,6 +6,7 @@ package v2
 import (
 	"github.com/pb33f/libopenapi/datamodel/high"
 	low "github.com/pb33f/libopenapi/datamodel/low/v2"
+	"gopkg.in/yaml.v3"
 )
 
 // SecurityScheme is a high-level representation of a Swagger / OpenAPI 2 SecurityScheme object
@@ -16,15 +17,15 @@ import (
 // (implicit, password, application and access code)
 //  - https://swagger.io/specification/v2/#securityDefinitionsObject
 type SecurityScheme struct {
-	Type             string
-	Description      string
-	Name             string
-	In               string
-	Flow             string
-	AuthorizationUrl string
-	TokenUrl         string
-	Scopes           *Scopes
-	Extensions       map[string]any
+	Type             string         `json:"type,omitempty" yaml:"type,omitempty"`
+	Description      string         `json:"description,omitempty" yaml:"description,omitempty"`
+	Name             string         `json:"name,omitempty" yaml:"name,omitempty"`
+	In               string         `json:"in,omitempty" yaml:"in,omitempty"`
+	Flow             string         `json:"flow,omitempty" yaml:"flow,omitempty"`
+	AuthorizationUrl string         `json:"authorizationUrl,omitempty" yaml:"authorizationUrl,omitempty"`
+	TokenUrl         string         `json:"tokenUrl,omitempty" yaml:"tokenUrl,omitempty"`
+	Scopes           *Scopes        `json:"scopes,omitempty" yaml:"scopes,omitempty"`
+	Extensions       map[string]any `json:"-" yaml:"-"`
 	low              *low.SecurityScheme
 }
 
@@ -64,3 +65,39 @@ func NewSecurityScheme(securityScheme *low.SecurityScheme) *SecurityScheme {
 func (s *SecurityScheme) GoLow() *low.SecurityScheme {
 	return s.low
 }
+
+// GoLowUntyped will return the low-level SecurityScheme instance that was used to create the high-level one, with no type
+func (s *SecurityScheme) GoLowUntyped() any {
+	return s.low
+}
+
+// Render will return a YAML representation of the SecurityScheme object as a byte slice.
+func (s *SecurityScheme) Render() ([]byte, error) {
+	return yaml.Marshal(s)
+}
+
+// RenderJSON will return a JSON representation of the SecurityScheme object as a byte slice, indented using indent.
+func (s *SecurityScheme) RenderJSON(indent string) ([]byte, error) {
+	return high.RenderJSON(s, indent)
+}
+
+// MarshalJSON will create a ready to render JSON representation of the SecurityScheme object.
+func (s *SecurityScheme) MarshalJSON() ([]byte, error) {
+	return s.RenderJSON("")
+}
+
+// UnmarshalYAML will build the SecurityScheme object from a YAML node, see high.UnmarshalNode.
+func (s *SecurityScheme) UnmarshalYAML(node *yaml.Node) error {
+	return high.UnmarshalNode(s, node, NewSecurityScheme)
+}
+
+// UnmarshalJSON will build the SecurityScheme object from YAML or JSON, see high.UnmarshalBytes.
+func (s *SecurityScheme) UnmarshalJSON(data []byte) error {
+	return high.UnmarshalBytes(s, data, NewSecurityScheme)
+}
+
+// MarshalYAML will create a ready to render YAML representation of the SecurityScheme object.
+func (s *SecurityScheme) MarshalYAML() (interface{}, error) {
+	nb := high.NewNodeBuilder(s, s.low)
+	return nb.Render(), nil
+}
@@ -12,85 +12,90 @@
 package v2
 
 import (
+	"reflect"
+
 	"github.com/pb33f/libopenapi/datamodel/high"
 	"github.com/pb33f/libopenapi/datamodel/high/base"
+	lowmodel "github.com/pb33f/libopenapi/datamodel/low"
 	low "github.com/pb33f/libopenapi/datamodel/low/v2"
+	"github.com/pb33f/libopenapi/orderedmap"
+	"gopkg.in/yaml.v3"
 )
 
 // Swagger represents a high-level Swagger / OpenAPI 2 document. An instance of Swagger is the root of the specification.
 type Swagger struct {
 
 	// Swagger is the version of Swagger / OpenAPI being used, extracted from the 'swagger: 2.x' definition.
-	Swagger string
+	Swagger string `json:"swagger,omitempty" yaml:"swagger,omitempty"`
 
 	// Info represents a specification Info definition.
 	// Provides metadata about the API. The metadata can be used by the clients if needed.
 	// - https://swagger.io/specification/v2/#infoObject
-	Info *base.Info
+	Info *base.Info `json:"info,omitempty" yaml:"info,omitempty"`
 
 	// Host is The host (name or ip) serving the API. This MUST be the host only and does not include the scheme nor
 	// sub-paths. It MAY include a port. If the host is not included, the host serving the documentation is to be used
 	// (including the port). The host does not support path templating.
-	Host string
+	Host string `json:"host,omitempty" yaml:"host,omitempty"`
 
 	// BasePath is The base path on which the API is served, which is relative to the host. If it is not included, the API is
 	// served directly under the host. The value MUST start with a leading slash (/).
 	// The basePath does not support path templating.
-	BasePath string
+	BasePath string `json:"basePath,omitempty" yaml:"basePath,omitempty"`
 
 	// Schemes represents the transfer protocol of the API. Requirements MUST be from the list: "http", "https", "ws", "wss".
 	// If the schemes is not included, the default scheme to be used is the one used to access
 	// the Swagger definition itself.
-	Schemes []string
+	Schemes []string `json:"schemes,omitempty" yaml:"schemes,omitempty"`
 
 	// Consumes is a list of MIME types the APIs can consume. This is global to all APIs but can be overridden on
 	// specific API calls. Value MUST be as described under Mime Types.
-	Consumes []string
+	Consumes []string `json:"consumes,omitempty" yaml:"consumes,omitempty"`
 
 	// Produces is a list of MIME types the APIs can produce. This is global to all APIs but can be overridden on
 	// specific API calls. Value MUST be as described under Mime Types.
-	Produces []string
+	Produces []string `json:"produces,omitempty" yaml:"produces,omitempty"`
 
 	// Paths are the paths and operations for the API. Perhaps the most important part of the specification.
 	//  - https://swagger.io/specification/v2/#pathsObject
-	Paths *Paths
+	Paths *Paths `json:"paths,omitempty" yaml:"paths,omitempty"`
 
 	// Definitions is an object to hold data types produced and consumed by operations. It's composed of Schema instances
 	//  - https://swagger.io/specification/v2/#definitionsObject
-	Definitions *Definitions
+	Definitions *Definitions `json:"definitions,omitempty" yaml:"definitions,omitempty"`
 
 	// Parameters is an object to hold parameters that can be used across operations.
 	// This property does not define global parameters for all operations.
 	//  - https://swagger.io/specification/v2/#parametersDefinitionsObject
-	Parameters *ParameterDefinitions
+	Parameters *ParameterDefinitions `json:"parameters,omitempty" yaml:"parameters,omitempty"`
 
 	// Responses is an object to hold responses that can be used across operations.
 	// This property does not define global responses for all operations.
 	//  - https://swagger.io/specification/v2/#responsesDefinitionsObject
-	Responses *ResponsesDefinitions
+	Responses *ResponsesDefinitions `json:"responses,omitempty" yaml:"responses,omitempty"`
 
 	// SecurityDefinitions represents security scheme definitions that can be used across the specification.
 	//  - https://swagger.io/specification/v2/#securityDefinitionsObject
-	SecurityDefinitions *SecurityDefinitions
+	SecurityDefinitions *SecurityDefinitions `json:"securityDefinitions,omitempty" yaml:"securityDefinitions,omitempty"`
 
 	// Security is a declaration of which security schemes are applied for the API as a whole. The list of values
 	// describes alternative security schemes that can be used (that is, there is a logical OR between the security
 	// requirements). Individual operations can override this definition.
 	//  - https://swagger.io/specification/v2/#securityRequirementObject
-	Security []*base.SecurityRequirement
+	Security []*base.SecurityRequirement `json:"security,omitempty" yaml:"security,omitempty"`
 
 	// Tags are A list of tags used by the specification with additional metadata.
 	// The order of the tags can be used to reflect on their order by the parsing tools. Not all tags that are used
 	// by the Operation Object must be declared. The tags that are not declared may be organized randomly or based
 	// on the tools' logic. Each tag name in the list MUST be unique.
 	//  - https://swagger.io/specification/v2/#tagObject
-	Tags []*base.Tag
+	Tags []*base.Tag `json:"tags,omitempty" yaml:"tags,omitempty"`
 
 	// ExternalDocs is an instance of base.ExternalDoc for.. well, obvious really, innit.
-	ExternalDocs *base.ExternalDoc
+	ExternalDocs *base.ExternalDoc `json:"externalDocs,omitempty" yaml:"externalDocs,omitempty"`
 
 	// Extensions contains all custom extensions defined for the top-level document.
-	Extensions map[string]any
+	Extensions map[string]any `json:"-" yaml:"-"`
 	low        *low.Swagger
 }
 
@@ -174,8 +179,73 @@ func (s *Swagger) GoLow() *low.Swagger {
 	return s.low
 }
 
+// GoLowUntyped will return the low-level Swagger instance that was used to create the high-level one, with no type
+func (s *Swagger) GoLowUntyped() any {
+	return s.low
+}
+
+// Render will return a YAML representation of the Swagger object as a byte slice.
+func (s *Swagger) Render() ([]byte, error) {
+	return yaml.Marshal(s)
+}
+
+// RenderJSON will return a JSON representation of the Swagger object as a byte slice, indented using indent.
+func (s *Swagger) RenderJSON(indent string) ([]byte, error) {
+	return high.RenderJSON(s, indent)
+}
+
+// MarshalJSON will create a ready to render JSON representation of the Swagger object.
+func (s *Swagger) MarshalJSON() ([]byte, error) {
+	return s.RenderJSON("")
+}
+
+// MarshalYAML will create a ready to render YAML representation of the Swagger object.
+func (s *Swagger) MarshalYAML() (interface{}, error) {
+	nb := high.NewNodeBuilder(s, s.low)
+	node := nb.Render()
+
+	// comments at the very top and bottom of the original document belong to the document, not any of its keys.
+	if s.low != nil && s.low.Index != nil && s.low.Index.GetRootNode() != nil {
+		node.HeadComment = s.low.Index.GetRootNode().HeadComment
+		node.FootComment = s.low.Index.GetRootNode().FootComment
+	}
+	return node, nil
+}
+
 // everything is build async, this little gem holds the results.
 type asyncResult[T any] struct {
 	key    string
 	result T
 }
+
+// addMapEntries adds every entry of an ordered map of high-level values to a NodeBuilder. Entries are given the line
+// numbers of the keys in the low-level map they were built from, so they are rendered in the right place alongside
+// any extensions. New entries are added to the bottom, in the order they were added to the map.
+//
+// Values that have not been changed are rendered from the original node, so examples and other free-form values
+// don't lose their order. The original key and value nodes are also kept, so comments and styles are retained.
+func addMapEntries[H any, L any](nb *high.NodeBuilder, entries *orderedmap.Map[string, H],
+	lowEntries map[lowmodel.KeyReference[string]]lowmodel.ValueReference[L]) {
+	lowKeys := make(map[string]lowmodel.KeyReference[string], len(lowEntries))
+	for k := range lowEntries {
+		lowKeys[k.Value] = k
+	}
+	for i, pair := range entries.Pairs() {
+		k := pair.Key
+		entry := &high.NodeEntry{Tag: k, Key: k, Value: pair.Value, Line: 9999 + i}
+		if lk, ok := lowKeys[k]; ok {
+			if lk.KeyNode != nil {
+				entry.Line = lk.KeyNode.Line
+			}
+			lv := lowEntries[lk]
+			entry.KeyNode = lk.KeyNode
+			if !lv.IsReference() {
+				entry.ValueNode = lv.ValueNode
+			}
+			if lv.ValueNode != nil && reflect.DeepEqual(any(pair.Value), any(lv.Value)) {
+				entry.Value = lv
+			}
+		}
+		nb.Nodes = append(nb.Nodes, entry)
+	}
+}
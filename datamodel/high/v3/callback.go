@@ -6,6 +6,7 @@ package v3
 import (
 	"github.com/pb33f/libopenapi/datamodel/high"
 	low "github.com/pb33f/libopenapi/datamodel/low/v3"
+	"github.com/pb33f/libopenapi/orderedmap"
 	"github.com/pb33f/libopenapi/utils"
 	"gopkg.in/yaml.v3"
 	"sort"
@@ -19,7 +20,7 @@ import (
 // that identifies a URL to use for the callback operation.
 //  - https://spec.openapis.org/oas/v3.1.0#callback-object
 type Callback struct {
-	Expression map[string]*PathItem `json:"-" yaml:"-"`
+	Expression *orderedmap.Map[string, *PathItem] `json:"-" yaml:"-"`
 	Extensions map[string]any       `json:"-" yaml:"-"`
 	low        *low.Callback
 }
@@ -28,10 +29,11 @@ type Callback struct {
 func NewCallback(lowCallback *low.Callback) *Callback {
 	n := new(Callback)
 	n.low = lowCallback
-	n.Expression = make(map[string]*PathItem)
+	expressions := make(map[string]*PathItem)
 	for i := range lowCallback.Expression.Value {
-		n.Expression[i.Value] = NewPathItem(lowCallback.Expression.Value[i].Value)
+		expressions[i.Value] = NewPathItem(lowCallback.Expression.Value[i].Value)
 	}
+	n.Expression = high.ToOrderedMap(expressions, lowCallback.Expression.Value)
 	n.Extensions = make(map[string]any)
 	for k, v := range lowCallback.Extensions {
 		n.Extensions[k.Value] = v.Value
@@ -54,38 +56,61 @@ func (c *Callback) Render() ([]byte, error) {
 	return yaml.Marshal(c)
 }
 
+// RenderJSON will return a JSON representation of the Callback object as a byte slice, indented using indent.
+func (c *Callback) RenderJSON(indent string) ([]byte, error) {
+	return high.RenderJSON(c, indent)
+}
+
+// MarshalJSON will create a ready to render JSON representation of the Callback object.
+func (c *Callback) MarshalJSON() ([]byte, error) {
+	return c.RenderJSON("")
+}
+
+// UnmarshalYAML will build the Callback object from a YAML node, see high.UnmarshalNode.
+func (c *Callback) UnmarshalYAML(node *yaml.Node) error {
+	return high.UnmarshalNode(c, node, NewCallback)
+}
+
+// UnmarshalJSON will build the Callback object from YAML or JSON, see high.UnmarshalBytes.
+func (c *Callback) UnmarshalJSON(data []byte) error {
+	return high.UnmarshalBytes(c, data, NewCallback)
+}
+
 // MarshalYAML will create a ready to render YAML representation of the Callback object.
 func (c *Callback) MarshalYAML() (interface{}, error) {
 	// map keys correctly.
 	m := utils.CreateEmptyMapNode()
 	type cbItem struct {
 		cb   *PathItem
-		exp  string
+		exp  *yaml.Node
 		line int
 		ext  *yaml.Node
 	}
 	var mapped []*cbItem
 
-	for k, ex := range c.Expression {
+	for _, pair := range c.Expression.Pairs() {
+		k, ex := pair.Key, pair.Value
 		ln := 999 // default to a high value to weight new content to the bottom.
+		kn := utils.CreateStringNode(k)
 		if c.low != nil {
 			for lKey := range c.low.Expression.Value {
 				if lKey.Value == k {
 					ln = lKey.KeyNode.Line
+					high.RetainFormatting(kn, nil, lKey.KeyNode, nil)
 				}
 			}
 		}
-		mapped = append(mapped, &cbItem{ex, k, ln, nil})
+		mapped = append(mapped, &cbItem{ex, kn, ln, nil})
 	}
 
 	// extract extensions
 	nb := high.NewNodeBuilder(c, c.low)
 	extNode := nb.Render()
 	if extNode != nil && extNode.Content != nil {
-		var label string
+		var label *yaml.Node
 		for u := range extNode.Content {
 			if u%2 == 0 {
-				label = extNode.Content[u].Value
+				label = extNode.Content[u]
 				continue
 			}
 			mapped = append(mapped, &cbItem{nil, label,
@@ -93,17 +118,17 @@ func (c *Callback) MarshalYAML() (interface{}, error) {
 		}
 	}
 
-	sort.Slice(mapped, func(i, j int) bool {
+	sort.SliceStable(mapped, func(i, j int) bool {
 		return mapped[i].line < mapped[j].line
 	})
 	for j := range mapped {
 		if mapped[j].cb != nil {
 			rendered, _ := mapped[j].cb.MarshalYAML()
-			m.Content = append(m.Content, utils.CreateStringNode(mapped[j].exp))
+			m.Content = append(m.Content, mapped[j].exp)
 			m.Content = append(m.Content, rendered.(*yaml.Node))
 		}
 		if mapped[j].ext != nil {
-			m.Content = append(m.Content, utils.CreateStringNode(mapped[j].exp))
+			m.Content = append(m.Content, mapped[j].exp)
 			m.Content = append(m.Content, mapped[j].ext)
 		}
 	}
@@ -7,6 +7,7 @@ import (
     "github.com/pb33f/libopenapi/datamodel/low"
     v3 "github.com/pb33f/libopenapi/datamodel/low/v3"
     "github.com/pb33f/libopenapi/index"
+    "github.com/pb33f/libopenapi/orderedmap"
     "github.com/stretchr/testify/assert"
     "gopkg.in/yaml.v3"
     "strings"
@@ -16,18 +17,18 @@ import (
 func TestCallback_MarshalYAML(t *testing.T) {
 
     cb := &Callback{
-        Expression: map[string]*PathItem{
-            "https://pb33f.io": {
+        Expression: orderedmap.FromPairs(
+            orderedmap.Pair[string, *PathItem]{Key: "https://pb33f.io", Value: &PathItem{
                 Get: &Operation{
                     OperationId: "oneTwoThree",
                 },
-            },
-            "https://pb33f.io/libopenapi": {
+            }},
+            orderedmap.Pair[string, *PathItem]{Key: "https://pb33f.io/libopenapi", Value: &PathItem{
                 Get: &Operation{
                     OperationId: "openaypeeeye",
                 },
-            },
-        },
+            }},
+        ),
         Extensions: map[string]any{
             "x-burgers": "why not?",
         },
@@ -39,7 +40,7 @@ func TestCallback_MarshalYAML(t *testing.T) {
     assert.Len(t, rend, 152)
 
     // mutate
-    cb.Expression["https://pb33f.io"].Get.OperationId = "blim-blam"
+    cb.Expression.GetOrZero("https://pb33f.io").Get.OperationId = "blim-blam"
     cb.Extensions = map[string]interface{}{"x-burgers": "yes please!"}
 
     rend, _ = cb.Render()
@@ -9,6 +9,7 @@ import (
 	lowmodel "github.com/pb33f/libopenapi/datamodel/low"
 	"github.com/pb33f/libopenapi/datamodel/low/base"
 	low "github.com/pb33f/libopenapi/datamodel/low/v3"
+	"github.com/pb33f/libopenapi/orderedmap"
 	"gopkg.in/yaml.v3"
 )
 
@@ -30,16 +31,16 @@ const (
 // will have no effect on the API unless they are explicitly referenced from properties outside the components object.
 //  - https://spec.openapis.org/oas/v3.1.0#components-object
 type Components struct {
-	Schemas map[string]*highbase.SchemaProxy `json:"schemas,omitempty" yaml:"schemas,omitempty"`
-	Responses map[string]*Response `json:"responses,omitempty" yaml:"responses,omitempty"`
-	Parameters map[string]*Parameter        `json:"parameters,omitempty" yaml:"parameters,omitempty"`
-	Examples   map[string]*highbase.Example `json:"examples,omitempty" yaml:"examples,omitempty"`
-	RequestBodies   map[string]*RequestBody    `json:"requestBodies,omitempty" yaml:"requestBodies,omitempty"`
-	Headers         map[string]*Header         `json:"headers,omitempty" yaml:"headers,omitempty"`
-	SecuritySchemes map[string]*SecurityScheme `json:"securitySchemes,omitempty" yaml:"securitySchemes,omitempty"`
-	Links           map[string]*Link           `json:"links,omitempty" yaml:"links,omitempty"`
-	Callbacks       map[string]*Callback       `json:"callbacks,omitempty" yaml:"callbacks,omitempty"`
-	Extensions      map[string]any             `json:"-" yaml:"-"`
+	Schemas         *orderedmap.Map[string, *highbase.SchemaProxy] `json:"schemas,omitempty" yaml:"schemas,omitempty"`
+	Responses       *orderedmap.Map[string, *Response]             `json:"responses,omitempty" yaml:"responses,omitempty"`
+	Parameters      *orderedmap.Map[string, *Parameter]            `json:"parameters,omitempty" yaml:"parameters,omitempty"`
+	Examples        *orderedmap.Map[string, *highbase.Example]     `json:"examples,omitempty" yaml:"examples,omitempty"`
+	RequestBodies   *orderedmap.Map[string, *RequestBody]          `json:"requestBodies,omitempty" yaml:"requestBodies,omitempty"`
+	Headers         *orderedmap.Map[string, *Header]               `json:"headers,omitempty" yaml:"headers,omitempty"`
+	SecuritySchemes *orderedmap.Map[string, *SecurityScheme]       `json:"securitySchemes,omitempty" yaml:"securitySchemes,omitempty"`
+	Links           *orderedmap.Map[string, *Link]                 `json:"links,omitempty" yaml:"links,omitempty"`
+	Callbacks       *orderedmap.Map[string, *Callback]             `json:"callbacks,omitempty" yaml:"callbacks,omitempty"`
+	Extensions      map[string]any                                 `json:"-" yaml:"-"`
 	low             *low.Components
 }
 
@@ -138,15 +139,15 @@ func NewComponents(comp *low.Components) *Components {
 			securitySchemeMap[ssRes.key] = ssRes.res
 		}
 	}
-	c.Schemas = schemas
-	c.Callbacks = cbMap
-	c.Links = linkMap
-	c.Parameters = parameterMap
-	c.Headers = headerMap
-	c.Responses = responseMap
-	c.RequestBodies = requestBodyMap
-	c.Examples = exampleMap
-	c.SecuritySchemes = securitySchemeMap
+	c.Schemas = high.ToOrderedMap(schemas, comp.Schemas.Value)
+	c.Callbacks = high.ToOrderedMap(cbMap, comp.Callbacks.Value)
+	c.Links = high.ToOrderedMap(linkMap, comp.Links.Value)
+	c.Parameters = high.ToOrderedMap(parameterMap, comp.Parameters.Value)
+	c.Headers = high.ToOrderedMap(headerMap, comp.Headers.Value)
+	c.Responses = high.ToOrderedMap(responseMap, comp.Responses.Value)
+	c.RequestBodies = high.ToOrderedMap(requestBodyMap, comp.RequestBodies.Value)
+	c.Examples = high.ToOrderedMap(exampleMap, comp.Examples.Value)
+	c.SecuritySchemes = high.ToOrderedMap(securitySchemeMap, comp.SecuritySchemes.Value)
 	return c
 }
 
@@ -183,6 +184,26 @@ func (c *Components) Render() ([]byte, error) {
 	return yaml.Marshal(c)
 }
 
+// RenderJSON will return a JSON representation of the Components object as a byte slice, indented using indent.
+func (c *Components) RenderJSON(indent string) ([]byte, error) {
+	return high.RenderJSON(c, indent)
+}
+
+// MarshalJSON will create a ready to render JSON representation of the Components object.
+func (c *Components) MarshalJSON() ([]byte, error) {
+	return c.RenderJSON("")
+}
+
+// UnmarshalYAML will build the Components object from a YAML node, see high.UnmarshalNode.
+func (c *Components) UnmarshalYAML(node *yaml.Node) error {
+	return high.UnmarshalNode(c, node, NewComponents)
+}
+
+// UnmarshalJSON will build the Components object from YAML or JSON, see high.UnmarshalBytes.
+func (c *Components) UnmarshalJSON(data []byte) error {
+	return high.UnmarshalBytes(c, data, NewComponents)
+}
+
 // MarshalYAML will create a ready to render YAML representation of the Response object.
 func (c *Components) MarshalYAML() (interface{}, error) {
 	nb := high.NewNodeBuilder(c, c.low)
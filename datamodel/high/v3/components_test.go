@@ -7,6 +7,7 @@ import (
     "github.com/pb33f/libopenapi/datamodel/low"
     v3 "github.com/pb33f/libopenapi/datamodel/low/v3"
     "github.com/pb33f/libopenapi/index"
+    "github.com/pb33f/libopenapi/orderedmap"
     "github.com/stretchr/testify/assert"
     "gopkg.in/yaml.v3"
     "strings"
@@ -16,26 +17,26 @@ import (
 func TestComponents_MarshalYAML(t *testing.T) {
 
     comp := &Components{
-        Responses: map[string]*Response{
-            "200": {
+        Responses: orderedmap.FromPairs(
+            orderedmap.Pair[string, *Response]{Key: "200", Value: &Response{
                 Description: "OK",
-            },
-        },
-        Parameters: map[string]*Parameter{
-            "id": {
+            }},
+        ),
+        Parameters: orderedmap.FromPairs(
+            orderedmap.Pair[string, *Parameter]{Key: "id", Value: &Parameter{
                 Name: "id",
                 In:   "path",
-            },
-        },
-        RequestBodies: map[string]*RequestBody{
-            "body": {
-                Content: map[string]*MediaType{
-                    "application/json": {
+            }},
+        ),
+        RequestBodies: orderedmap.FromPairs(
+            orderedmap.Pair[string, *RequestBody]{Key: "body", Value: &RequestBody{
+                Content: orderedmap.FromPairs(
+                    orderedmap.Pair[string, *MediaType]{Key: "application/json", Value: &MediaType{
                         Example: "why?",
-                    },
-                },
-            },
-        },
+                    }},
+                ),
+            }},
+        ),
     }
 
     dat, _ := comp.Render()
@@ -14,6 +14,7 @@ import (
 	"github.com/pb33f/libopenapi/datamodel/high/base"
 	low "github.com/pb33f/libopenapi/datamodel/low/v3"
 	"github.com/pb33f/libopenapi/index"
+	"github.com/pb33f/libopenapi/orderedmap"
 	"gopkg.in/yaml.v3"
 )
 
@@ -79,7 +80,7 @@ type Document struct {
 	// for example by an out-of-band registration. The key name is a unique string to refer to each webhook,
 	// while the (optionally referenced) Path Item Object describes a request that may be initiated by the API provider
 	// and the expected responses. An example is available.
-	Webhooks map[string]*PathItem `json:"webhooks,omitempty" yaml:"webhooks,omitempty"`
+	Webhooks *orderedmap.Map[string, *PathItem] `json:"webhooks,omitempty" yaml:"webhooks,omitempty"`
 
 	// Index is a reference to the *index.SpecIndex that was created for the document and used
 	// as a guide when building out the Document. Ideal if further processing is required on the model and
@@ -131,7 +132,7 @@ func NewDocument(document *low.Document) *Document {
 		for h := range document.Webhooks.Value {
 			hooks[h.Value] = NewPathItem(document.Webhooks.Value[h].Value)
 		}
-		d.Webhooks = hooks
+		d.Webhooks = high.ToOrderedMap(hooks, document.Webhooks.Value)
 	}
 	if !document.Security.IsEmpty() {
 		var security []*base.SecurityRequirement
@@ -153,8 +154,25 @@ func (d *Document) Render() ([]byte, error) {
 	return yaml.Marshal(d)
 }
 
+// RenderJSON will return a JSON representation of the Document object as a byte slice, indented using indent.
+func (d *Document) RenderJSON(indent string) ([]byte, error) {
+	return high.RenderJSON(d, indent)
+}
+
+// MarshalJSON will create a ready to render JSON representation of the Document object.
+func (d *Document) MarshalJSON() ([]byte, error) {
+	return d.RenderJSON("")
+}
+
 // MarshalYAML will create a ready to render YAML representation of the Document object.
 func (d *Document) MarshalYAML() (interface{}, error) {
 	nb := high.NewNodeBuilder(d, d.low)
-	return nb.Render(), nil
+	node := nb.Render()
+
+	// comments at the very top and bottom of the original document belong to the document, not any of its keys.
+	if d.low != nil && d.low.Index != nil && d.low.Index.GetRootNode() != nil {
+		node.HeadComment = d.low.Index.GetRootNode().HeadComment
+		node.FootComment = d.low.Index.GetRootNode().FootComment
+	}
+	return node, nil
 }
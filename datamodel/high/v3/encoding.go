@@ -7,6 +7,7 @@ import (
 	"github.com/pb33f/libopenapi/datamodel/high"
 	lowmodel "github.com/pb33f/libopenapi/datamodel/low"
 	low "github.com/pb33f/libopenapi/datamodel/low/v3"
+	"github.com/pb33f/libopenapi/orderedmap"
 	"gopkg.in/yaml.v3"
 )
 
@@ -14,7 +15,7 @@ import (
 //  - https://spec.openapis.org/oas/v3.1.0#encoding-object
 type Encoding struct {
 	ContentType   string             `json:"contentType,omitempty" yaml:"contentType,omitempty"`
-	Headers       map[string]*Header `json:"headers,omitempty" yaml:"headers,omitempty"`
+	Headers       *orderedmap.Map[string, *Header] `json:"headers,omitempty" yaml:"headers,omitempty"`
 	Style         string             `json:"style,omitempty" yaml:"style,omitempty"`
 	Explode       *bool              `json:"explode,omitempty" yaml:"explode,omitempty"`
 	AllowReserved bool               `json:"allowReserved,omitempty" yaml:"allowReserved,omitempty"`
@@ -48,6 +49,26 @@ func (e *Encoding) Render() ([]byte, error) {
 	return yaml.Marshal(e)
 }
 
+// RenderJSON will return a JSON representation of the Encoding object as a byte slice, indented using indent.
+func (e *Encoding) RenderJSON(indent string) ([]byte, error) {
+	return high.RenderJSON(e, indent)
+}
+
+// MarshalJSON will create a ready to render JSON representation of the Encoding object.
+func (e *Encoding) MarshalJSON() ([]byte, error) {
+	return e.RenderJSON("")
+}
+
+// UnmarshalYAML will build the Encoding object from a YAML node, see high.UnmarshalNode.
+func (e *Encoding) UnmarshalYAML(node *yaml.Node) error {
+	return high.UnmarshalNode(e, node, NewEncoding)
+}
+
+// UnmarshalJSON will build the Encoding object from YAML or JSON, see high.UnmarshalBytes.
+func (e *Encoding) UnmarshalJSON(data []byte) error {
+	return high.UnmarshalBytes(e, data, NewEncoding)
+}
+
 // MarshalYAML will create a ready to render YAML representation of the Encoding object.
 func (e *Encoding) MarshalYAML() (interface{}, error) {
 	nb := high.NewNodeBuilder(e, e.low)
@@ -55,10 +76,10 @@ func (e *Encoding) MarshalYAML() (interface{}, error) {
 }
 
 // ExtractEncoding converts hard to navigate low-level plumbing Encoding definitions, into a high-level simple map
-func ExtractEncoding(elements map[lowmodel.KeyReference[string]]lowmodel.ValueReference[*low.Encoding]) map[string]*Encoding {
+func ExtractEncoding(elements map[lowmodel.KeyReference[string]]lowmodel.ValueReference[*low.Encoding]) *orderedmap.Map[string, *Encoding] {
 	extracted := make(map[string]*Encoding)
 	for k, v := range elements {
 		extracted[k.Value] = NewEncoding(v.Value)
 	}
-	return extracted
+	return high.ToOrderedMap(extracted, elements)
 }
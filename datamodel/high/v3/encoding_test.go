@@ -4,6 +4,7 @@
 package v3
 
 import (
+    "github.com/pb33f/libopenapi/orderedmap"
     "github.com/stretchr/testify/assert"
     "strings"
     "testing"
@@ -14,7 +15,9 @@ func TestEncoding_MarshalYAML(t *testing.T) {
     explode := true
     encoding := &Encoding{
         ContentType: "application/json",
-        Headers:     map[string]*Header{"x-pizza-time": {Description: "oh yes please"}},
+        Headers:     orderedmap.FromPairs(
+            orderedmap.Pair[string, *Header]{Key: "x-pizza-time", Value: &Header{Description: "oh yes please"}},
+        ),
         Style:       "simple",
         Explode:     &explode,
     }
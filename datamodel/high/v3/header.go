@@ -9,6 +9,7 @@ import (
 	lowmodel "github.com/pb33f/libopenapi/datamodel/low"
 	"github.com/pb33f/libopenapi/datamodel/low/base"
 	low "github.com/pb33f/libopenapi/datamodel/low/v3"
+	"github.com/pb33f/libopenapi/orderedmap"
 	"gopkg.in/yaml.v3"
 )
 
@@ -24,8 +25,8 @@ type Header struct {
 	AllowReserved   bool                         `json:"allowReserved,omitempty" yaml:"allowReserved,omitempty"`
 	Schema          *highbase.SchemaProxy        `json:"schema,omitempty" yaml:"schema,omitempty"`
 	Example         any                          `json:"example,omitempty" yaml:"example,omitempty"`
-	Examples        map[string]*highbase.Example `json:"examples,omitempty" yaml:"examples,omitempty"`
-	Content         map[string]*MediaType        `json:"content,omitempty" yaml:"content,omitempty"`
+	Examples        *orderedmap.Map[string, *highbase.Example] `json:"examples,omitempty" yaml:"examples,omitempty"`
+	Content         *orderedmap.Map[string, *MediaType]        `json:"content,omitempty" yaml:"content,omitempty"`
 	Extensions      map[string]any               `json:"-" yaml:"-"`
 	low             *low.Header
 }
@@ -65,12 +66,12 @@ func (h *Header) GoLowUntyped() any {
 }
 
 // ExtractHeaders will extract a hard to navigate low-level Header map, into simple high-level one.
-func ExtractHeaders(elements map[lowmodel.KeyReference[string]]lowmodel.ValueReference[*low.Header]) map[string]*Header {
+func ExtractHeaders(elements map[lowmodel.KeyReference[string]]lowmodel.ValueReference[*low.Header]) *orderedmap.Map[string, *Header] {
 	extracted := make(map[string]*Header)
 	for k, v := range elements {
 		extracted[k.Value] = NewHeader(v.Value)
 	}
-	return extracted
+	return high.ToOrderedMap(extracted, elements)
 }
 
 // Render will return a YAML representation of the Header object as a byte slice.
@@ -78,6 +79,26 @@ func (h *Header) Render() ([]byte, error) {
 	return yaml.Marshal(h)
 }
 
+// RenderJSON will return a JSON representation of the Header object as a byte slice, indented using indent.
+func (h *Header) RenderJSON(indent string) ([]byte, error) {
+	return high.RenderJSON(h, indent)
+}
+
+// MarshalJSON will create a ready to render JSON representation of the Header object.
+func (h *Header) MarshalJSON() ([]byte, error) {
+	return h.RenderJSON("")
+}
+
+// UnmarshalYAML will build the Header object from a YAML node, see high.UnmarshalNode.
+func (h *Header) UnmarshalYAML(node *yaml.Node) error {
+	return high.UnmarshalNode(h, node, NewHeader)
+}
+
+// UnmarshalJSON will build the Header object from YAML or JSON, see high.UnmarshalBytes.
+func (h *Header) UnmarshalJSON(data []byte) error {
+	return high.UnmarshalBytes(h, data, NewHeader)
+}
+
 // MarshalYAML will create a ready to render YAML representation of the Header object.
 func (h *Header) MarshalYAML() (interface{}, error) {
 	nb := high.NewNodeBuilder(h, h.low)
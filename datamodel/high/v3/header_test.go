@@ -5,6 +5,7 @@ package v3
 
 import (
     "github.com/pb33f/libopenapi/datamodel/high/base"
+    "github.com/pb33f/libopenapi/orderedmap"
     "github.com/stretchr/testify/assert"
     "strings"
     "testing"
@@ -21,7 +22,9 @@ func TestHeader_MarshalYAML(t *testing.T) {
         Explode:         true,
         AllowReserved:   true,
         Example:         "example",
-        Examples:        map[string]*base.Example{"example": {Value: "example"}},
+        Examples:        orderedmap.FromPairs(
+            orderedmap.Pair[string, *base.Example]{Key: "example", Value: &base.Example{Value: "example"}},
+        ),
         Extensions:      map[string]interface{}{"x-burgers": "why not?"},
     }
 
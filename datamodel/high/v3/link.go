@@ -6,6 +6,7 @@ package v3
 import (
 	"github.com/pb33f/libopenapi/datamodel/high"
 	low "github.com/pb33f/libopenapi/datamodel/low/v3"
+	"github.com/pb33f/libopenapi/orderedmap"
 	"gopkg.in/yaml.v3"
 )
 
@@ -24,7 +25,7 @@ import (
 type Link struct {
 	OperationRef string            `json:"operationRef,omitempty" yaml:"operationRef,omitempty"`
 	OperationId  string            `json:"operationId,omitempty" yaml:"operationId,omitempty"`
-	Parameters   map[string]string `json:"parameters,omitempty" yaml:"parameters,omitempty"`
+	Parameters   *orderedmap.Map[string, string] `json:"parameters,omitempty" yaml:"parameters,omitempty"`
 	RequestBody  string            `json:"requestBody,omitempty" yaml:"requestBody,omitempty"`
 	Description  string            `json:"description,omitempty" yaml:"description,omitempty"`
 	Server       *Server           `json:"server,omitempty" yaml:"server,omitempty"`
@@ -42,7 +43,7 @@ func NewLink(link *low.Link) *Link {
 	for k, v := range link.Parameters.Value {
 		params[k.Value] = v.Value
 	}
-	l.Parameters = params
+	l.Parameters = high.ToOrderedMap(params, link.Parameters.Value)
 	l.RequestBody = link.RequestBody.Value
 	l.Description = link.Description.Value
 	if link.Server.Value != nil {
@@ -67,6 +68,26 @@ func (l *Link) Render() ([]byte, error) {
 	return yaml.Marshal(l)
 }
 
+// RenderJSON will return a JSON representation of the Link object as a byte slice, indented using indent.
+func (l *Link) RenderJSON(indent string) ([]byte, error) {
+	return high.RenderJSON(l, indent)
+}
+
+// MarshalJSON will create a ready to render JSON representation of the Link object.
+func (l *Link) MarshalJSON() ([]byte, error) {
+	return l.RenderJSON("")
+}
+
+// UnmarshalYAML will build the Link object from a YAML node, see high.UnmarshalNode.
+func (l *Link) UnmarshalYAML(node *yaml.Node) error {
+	return high.UnmarshalNode(l, node, NewLink)
+}
+
+// UnmarshalJSON will build the Link object from YAML or JSON, see high.UnmarshalBytes.
+func (l *Link) UnmarshalJSON(data []byte) error {
+	return high.UnmarshalBytes(l, data, NewLink)
+}
+
 // MarshalYAML will create a ready to render YAML representation of the Link object.
 func (l *Link) MarshalYAML() (interface{}, error) {
 	nb := high.NewNodeBuilder(l, l.low)
@@ -4,6 +4,7 @@
 package v3
 
 import (
+    "github.com/pb33f/libopenapi/orderedmap"
     "github.com/stretchr/testify/assert"
     "strings"
     "testing"
@@ -13,9 +14,9 @@ func TestLink_MarshalYAML(t *testing.T) {
     link := Link{
         OperationRef: "somewhere",
         OperationId:  "somewhereOutThere",
-        Parameters: map[string]string{
-            "over": "theRainbow",
-        },
+        Parameters: orderedmap.FromPairs(
+            orderedmap.Pair[string, string]{Key: "over", Value: "theRainbow"},
+        ),
         RequestBody: "hello?",
         Description: "are you there?",
         Server: &Server{
@@ -8,6 +8,7 @@ import (
 	"github.com/pb33f/libopenapi/datamodel/high/base"
 	lowmodel "github.com/pb33f/libopenapi/datamodel/low"
 	low "github.com/pb33f/libopenapi/datamodel/low/v3"
+	"github.com/pb33f/libopenapi/orderedmap"
 	"gopkg.in/yaml.v3"
 	"sync"
 )
@@ -19,8 +20,8 @@ import (
 type MediaType struct {
 	Schema     *base.SchemaProxy        `json:"schema,omitempty" yaml:"schema,omitempty"`
 	Example    any                      `json:"example,omitempty" yaml:"example,omitempty"`
-	Examples   map[string]*base.Example `json:"examples,omitempty" yaml:"examples,omitempty"`
-	Encoding   map[string]*Encoding     `json:"encoding,omitempty" yaml:"encoding,omitempty"`
+	Examples   *orderedmap.Map[string, *base.Example] `json:"examples,omitempty" yaml:"examples,omitempty"`
+	Encoding   *orderedmap.Map[string, *Encoding]     `json:"encoding,omitempty" yaml:"encoding,omitempty"`
 	Extensions map[string]any           `json:"-" yaml:"-"`
 	low        *low.MediaType
 }
@@ -54,6 +55,26 @@ func (m *MediaType) Render() ([]byte, error) {
 	return yaml.Marshal(m)
 }
 
+// RenderJSON will return a JSON representation of the MediaType object as a byte slice, indented using indent.
+func (m *MediaType) RenderJSON(indent string) ([]byte, error) {
+	return high.RenderJSON(m, indent)
+}
+
+// MarshalJSON will create a ready to render JSON representation of the MediaType object.
+func (m *MediaType) MarshalJSON() ([]byte, error) {
+	return m.RenderJSON("")
+}
+
+// UnmarshalYAML will build the MediaType object from a YAML node, see high.UnmarshalNode.
+func (m *MediaType) UnmarshalYAML(node *yaml.Node) error {
+	return high.UnmarshalNode(m, node, NewMediaType)
+}
+
+// UnmarshalJSON will build the MediaType object from YAML or JSON, see high.UnmarshalBytes.
+func (m *MediaType) UnmarshalJSON(data []byte) error {
+	return high.UnmarshalBytes(m, data, NewMediaType)
+}
+
 // MarshalYAML will create a ready to render YAML representation of the MediaType object.
 func (m *MediaType) MarshalYAML() (interface{}, error) {
 	nb := high.NewNodeBuilder(m, m.low)
@@ -62,7 +83,7 @@ func (m *MediaType) MarshalYAML() (interface{}, error) {
 
 // ExtractContent takes in a complex and hard to navigate low-level content map, and converts it in to a much simpler
 // and easier to navigate high-level one.
-func ExtractContent(elements map[lowmodel.KeyReference[string]]lowmodel.ValueReference[*low.MediaType]) map[string]*MediaType {
+func ExtractContent(elements map[lowmodel.KeyReference[string]]lowmodel.ValueReference[*low.MediaType]) *orderedmap.Map[string, *MediaType] {
 	// extract everything async
 	doneChan := make(chan bool)
 
@@ -86,5 +107,5 @@ func ExtractContent(elements map[lowmodel.KeyReference[string]]lowmodel.ValueRef
 			n++
 		}
 	}
-	return extracted
+	return high.ToOrderedMap(extracted, elements)
 }
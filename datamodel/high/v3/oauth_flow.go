@@ -6,6 +6,7 @@ package v3
 import (
 	"github.com/pb33f/libopenapi/datamodel/high"
 	low "github.com/pb33f/libopenapi/datamodel/low/v3"
+	"github.com/pb33f/libopenapi/orderedmap"
 	"gopkg.in/yaml.v3"
 )
 
@@ -15,7 +16,7 @@ type OAuthFlow struct {
 	AuthorizationUrl string            `json:"authorizationUrl,omitempty" yaml:"authorizationUrl,omitempty"`
 	TokenUrl         string            `json:"tokenUrl,omitempty" yaml:"tokenUrl,omitempty"`
 	RefreshUrl       string            `json:"refreshUrl,omitempty" yaml:"refreshUrl,omitempty"`
-	Scopes           map[string]string `json:"scopes,omitempty" yaml:"scopes,omitempty"`
+	Scopes           *orderedmap.Map[string, string] `json:"scopes,omitempty" yaml:"scopes,omitempty"`
 	Extensions       map[string]any    `json:"-" yaml:"-"`
 	low              *low.OAuthFlow
 }
@@ -31,7 +32,7 @@ func NewOAuthFlow(flow *low.OAuthFlow) *OAuthFlow {
 	for k, v := range flow.Scopes.Value {
 		scopes[k.Value] = v.Value
 	}
-	o.Scopes = scopes
+	o.Scopes = high.ToOrderedMap(scopes, flow.Scopes.Value)
 	o.Extensions = high.ExtractExtensions(flow.Extensions)
 	return o
 }
@@ -51,6 +52,26 @@ func (o *OAuthFlow) Render() ([]byte, error) {
 	return yaml.Marshal(o)
 }
 
+// RenderJSON will return a JSON representation of the OAuthFlow object as a byte slice, indented using indent.
+func (o *OAuthFlow) RenderJSON(indent string) ([]byte, error) {
+	return high.RenderJSON(o, indent)
+}
+
+// MarshalJSON will create a ready to render JSON representation of the OAuthFlow object.
+func (o *OAuthFlow) MarshalJSON() ([]byte, error) {
+	return o.RenderJSON("")
+}
+
+// UnmarshalYAML will build the OAuthFlow object from a YAML node, see high.UnmarshalNode.
+func (o *OAuthFlow) UnmarshalYAML(node *yaml.Node) error {
+	return high.UnmarshalNode(o, node, NewOAuthFlow)
+}
+
+// UnmarshalJSON will build the OAuthFlow object from YAML or JSON, see high.UnmarshalBytes.
+func (o *OAuthFlow) UnmarshalJSON(data []byte) error {
+	return high.UnmarshalBytes(o, data, NewOAuthFlow)
+}
+
 // MarshalYAML will create a ready to render YAML representation of the OAuthFlow object.
 func (o *OAuthFlow) MarshalYAML() (interface{}, error) {
 	nb := high.NewNodeBuilder(o, o.low)
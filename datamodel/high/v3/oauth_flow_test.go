@@ -4,6 +4,7 @@
 package v3
 
 import (
+    "github.com/pb33f/libopenapi/orderedmap"
     "github.com/stretchr/testify/assert"
     "strings"
     "testing"
@@ -15,7 +16,10 @@ func TestOAuthFlow_MarshalYAML(t *testing.T) {
         AuthorizationUrl: "https://pb33f.io",
         TokenUrl:         "https://pb33f.io/token",
         RefreshUrl:       "https://pb33f.io/refresh",
-        Scopes:           map[string]string{"chicken": "nuggets", "beefy": "soup"},
+        Scopes:           orderedmap.FromPairs(
+            orderedmap.Pair[string, string]{Key: "chicken", Value: "nuggets"},
+            orderedmap.Pair[string, string]{Key: "beefy", Value: "soup"},
+        ),
     }
 
     rend, _ := oflow.Render()
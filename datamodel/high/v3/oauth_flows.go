@@ -58,6 +58,26 @@ func (o *OAuthFlows) Render() ([]byte, error) {
 	return yaml.Marshal(o)
 }
 
+// RenderJSON will return a JSON representation of the OAuthFlows object as a byte slice, indented using indent.
+func (o *OAuthFlows) RenderJSON(indent string) ([]byte, error) {
+	return high.RenderJSON(o, indent)
+}
+
+// MarshalJSON will create a ready to render JSON representation of the OAuthFlows object.
+func (o *OAuthFlows) MarshalJSON() ([]byte, error) {
+	return o.RenderJSON("")
+}
+
+// UnmarshalYAML will build the OAuthFlows object from a YAML node, see high.UnmarshalNode.
+func (o *OAuthFlows) UnmarshalYAML(node *yaml.Node) error {
+	return high.UnmarshalNode(o, node, NewOAuthFlows)
+}
+
+// UnmarshalJSON will build the OAuthFlows object from YAML or JSON, see high.UnmarshalBytes.
+func (o *OAuthFlows) UnmarshalJSON(data []byte) error {
+	return high.UnmarshalBytes(o, data, NewOAuthFlows)
+}
+
 // MarshalYAML will create a ready to render YAML representation of the OAuthFlows object.
 func (o *OAuthFlows) MarshalYAML() (interface{}, error) {
 	nb := high.NewNodeBuilder(o, o.low)
@@ -47,10 +47,10 @@ clientCredentials:
 
 	r := NewOAuthFlows(&n)
 
-	assert.Len(t, r.Implicit.Scopes, 2)
-	assert.Len(t, r.AuthorizationCode.Scopes, 2)
-	assert.Len(t, r.Password.Scopes, 2)
-	assert.Len(t, r.ClientCredentials.Scopes, 2)
+	assert.Equal(t, 2, r.Implicit.Scopes.Len())
+	assert.Equal(t, 2, r.AuthorizationCode.Scopes.Len())
+	assert.Equal(t, 2, r.Password.Scopes.Len())
+	assert.Equal(t, 2, r.ClientCredentials.Scopes.Len())
 	assert.Equal(t, 2, r.GoLow().Implicit.Value.AuthorizationUrl.KeyNode.Line)
 
 	// now render it back out, and it should be identical!
@@ -81,7 +81,7 @@ clientCredentials:
         CHIP:CHOP: microwave a sock`
 
 	// now modify it and render it back out, and it should be identical!
-	r.ClientCredentials.Scopes["CHIP:CHOP"] = "microwave a sock"
+	r.ClientCredentials.Scopes.Set("CHIP:CHOP", "microwave a sock")
 	rBytes, _ = r.Render()
 	assert.Equal(t, modified, strings.TrimSpace(string(rBytes)))
 
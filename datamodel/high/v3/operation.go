@@ -7,6 +7,7 @@ import (
 	"github.com/pb33f/libopenapi/datamodel/high"
 	"github.com/pb33f/libopenapi/datamodel/high/base"
 	low "github.com/pb33f/libopenapi/datamodel/low/v3"
+	"github.com/pb33f/libopenapi/orderedmap"
 	"gopkg.in/yaml.v3"
 )
 
@@ -16,19 +17,19 @@ import (
 // happens here. The entire being for existence of this library and the specification, is this Operation.
 //   - https://spec.openapis.org/oas/v3.1.0#operation-object
 type Operation struct {
-	Tags         []string                    `json:"tags,omitempty" yaml:"tags,omitempty"`
-	Summary      string                      `json:"summary,omitempty" yaml:"summary,omitempty"`
-	Description  string                      `json:"description,omitempty" yaml:"description,omitempty"`
-	ExternalDocs *base.ExternalDoc           `json:"externalDocs,omitempty" yaml:"externalDocs,omitempty"`
-	OperationId  string                      `json:"operationId,omitempty" yaml:"operationId,omitempty"`
-	Parameters   []*Parameter                `json:"parameters,omitempty" yaml:"parameters,omitempty"`
-	RequestBody  *RequestBody                `json:"requestBody,omitempty" yaml:"requestBody,omitempty"`
-	Responses    *Responses                  `json:"responses,omitempty" yaml:"responses,omitempty"`
-	Callbacks    map[string]*Callback        `json:"callbacks,omitempty" yaml:"callbacks,omitempty"`
-	Deprecated   *bool                       `json:"deprecated,omitempty" yaml:"deprecated,omitempty"`
-	Security     []*base.SecurityRequirement `json:"security,omitempty" yaml:"security,omitempty"`
-	Servers      []*Server                   `json:"servers,omitempty" yaml:"servers,omitempty"`
-	Extensions   map[string]any              `json:"-" yaml:"-"`
+	Tags         []string                           `json:"tags,omitempty" yaml:"tags,omitempty"`
+	Summary      string                             `json:"summary,omitempty" yaml:"summary,omitempty"`
+	Description  string                             `json:"description,omitempty" yaml:"description,omitempty"`
+	ExternalDocs *base.ExternalDoc                  `json:"externalDocs,omitempty" yaml:"externalDocs,omitempty"`
+	OperationId  string                             `json:"operationId,omitempty" yaml:"operationId,omitempty"`
+	Parameters   []*Parameter                       `json:"parameters,omitempty" yaml:"parameters,omitempty"`
+	RequestBody  *RequestBody                       `json:"requestBody,omitempty" yaml:"requestBody,omitempty"`
+	Responses    *Responses                         `json:"responses,omitempty" yaml:"responses,omitempty"`
+	Callbacks    *orderedmap.Map[string, *Callback] `json:"callbacks,omitempty" yaml:"callbacks,omitempty"`
+	Deprecated   *bool                              `json:"deprecated,omitempty" yaml:"deprecated,omitempty"`
+	Security     []*base.SecurityRequirement        `json:"security,omitempty" yaml:"security,omitempty"`
+	Servers      []*Server                          `json:"servers,omitempty" yaml:"servers,omitempty"`
+	Extensions   map[string]any                     `json:"-" yaml:"-"`
 	low          *low.Operation
 }
 
@@ -81,7 +82,7 @@ func NewOperation(operation *low.Operation) *Operation {
 		for k, v := range operation.Callbacks.Value {
 			cbs[k.Value] = NewCallback(v.Value)
 		}
-		o.Callbacks = cbs
+		o.Callbacks = high.ToOrderedMap(cbs, operation.Callbacks.Value)
 	}
 	return o
 }
@@ -101,6 +102,26 @@ func (o *Operation) Render() ([]byte, error) {
 	return yaml.Marshal(o)
 }
 
+// RenderJSON will return a JSON representation of the Operation object as a byte slice, indented using indent.
+func (o *Operation) RenderJSON(indent string) ([]byte, error) {
+	return high.RenderJSON(o, indent)
+}
+
+// MarshalJSON will create a ready to render JSON representation of the Operation object.
+func (o *Operation) MarshalJSON() ([]byte, error) {
+	return o.RenderJSON("")
+}
+
+// UnmarshalYAML will build the Operation object from a YAML node, see high.UnmarshalNode.
+func (o *Operation) UnmarshalYAML(node *yaml.Node) error {
+	return high.UnmarshalNode(o, node, NewOperation)
+}
+
+// UnmarshalJSON will build the Operation object from YAML or JSON, see high.UnmarshalBytes.
+func (o *Operation) UnmarshalJSON(data []byte) error {
+	return high.UnmarshalBytes(o, data, NewOperation)
+}
+
 // MarshalYAML will create a ready to render YAML representation of the Operation object.
 func (o *Operation) MarshalYAML() (interface{}, error) {
 	nb := high.NewNodeBuilder(o, o.low)
@@ -4,6 +4,8 @@
 package v3
 
 import (
+	"encoding/json"
+	"github.com/pb33f/libopenapi/datamodel/high"
 	"github.com/pb33f/libopenapi/datamodel/high/base"
 	"strings"
 	"testing"
@@ -48,8 +50,8 @@ callbacks:
 
 	assert.Equal(t, "https://pb33f.io", r.ExternalDocs.URL)
 	assert.Equal(t, 1, r.GoLow().ExternalDocs.KeyNode.Line)
-	assert.Contains(t, r.Callbacks, "testCallback")
-	assert.Contains(t, r.Callbacks["testCallback"].Expression, "{$request.body#/callbackUrl}")
+	assert.Contains(t, r.Callbacks.Keys(), "testCallback")
+	assert.Contains(t, r.Callbacks.GetOrZero("testCallback").Expression.Keys(), "{$request.body#/callbackUrl}")
 	assert.Equal(t, 3, r.GoLow().Callbacks.KeyNode.Line)
 }
 
@@ -89,4 +91,88 @@ requestBody:
 
 	assert.Equal(t, desired, strings.TrimSpace(string(rend)))
 
-}
\ No newline at end of file
+}
+func TestOperation_MarshalYAML_ParameterAfterReference(t *testing.T) {
+
+	op := &Operation{
+		OperationId: "slice",
+		Parameters: []*Parameter{
+			NewParameter(&v3.Parameter{Reference: &low.Reference{Reference: "#/components/parameters/mice"}}),
+			{
+				Name: "rice",
+			},
+		},
+	}
+
+	rend, _ := op.Render()
+
+	desired := `operationId: slice
+parameters:
+    - $ref: '#/components/parameters/mice'
+    - name: rice`
+
+	assert.Equal(t, desired, strings.TrimSpace(string(rend)))
+}
+
+func TestOperation_UnmarshalYAML(t *testing.T) {
+	snippet := `operationId: createBurger
+parameters:
+  - $ref: '#/components/parameters/burgerId'
+responses:
+  "200":
+    description: ok
+components:
+  parameters:
+    burgerId:
+      name: burgerId
+      in: path`
+
+	var op Operation
+	err := yaml.Unmarshal([]byte(snippet), &op)
+	assert.NoError(t, err)
+	assert.Equal(t, "createBurger", op.OperationId)
+	assert.Equal(t, "burgerId", op.Parameters[0].Name)
+	assert.Equal(t, "ok", op.Responses.Codes.GetOrZero("200").Description)
+	assert.NotNil(t, op.GoLow())
+
+	// JSON works the same way, and the operation can be rendered again.
+	err = json.Unmarshal([]byte(`{"operationId":"eatBurger","deprecated":true}`), &op)
+	assert.NoError(t, err)
+	assert.Equal(t, "eatBurger", op.OperationId)
+	assert.True(t, *op.Deprecated)
+	assert.Nil(t, op.Parameters)
+
+	rendered, _ := op.RenderJSON("")
+	assert.Equal(t, `{"operationId":"eatBurger","deprecated":true}`, string(rendered))
+
+	assert.Error(t, yaml.Unmarshal([]byte(`- nope`), &op))
+	assert.Error(t, json.Unmarshal([]byte(`"nope"`), &op))
+}
+
+func TestOperation_NewFromBytes(t *testing.T) {
+	spec := `openapi: 3.1.0
+components:
+  parameters:
+    burgerId:
+      name: burgerId
+      in: path`
+
+	var idxNode yaml.Node
+	_ = yaml.Unmarshal([]byte(spec), &idxNode)
+	idx := index.NewSpecIndexWithConfig(&idxNode, index.CreateClosedAPIIndexConfig())
+
+	// references are resolved using the index of the document the snippet will be inserted into.
+	op, err := high.NewFromBytes([]byte(`{"operationId": "findBurger",
+"parameters": [{"$ref": "#/components/parameters/burgerId"}]}`), idx, NewOperation)
+	assert.NoError(t, err)
+	assert.Equal(t, "findBurger", op.OperationId)
+	assert.Equal(t, "path", op.Parameters[0].In)
+	assert.True(t, op.Parameters[0].GoLow().IsReference())
+
+	_, err = high.NewFromBytes([]byte(`{"operationId": "findBurger",
+"parameters": [{"$ref": "#/components/parameters/fries"}]}`), idx, NewOperation)
+	assert.Error(t, err)
+
+	_, err = high.NewFromBytes([]byte(`:{`), idx, NewOperation)
+	assert.Error(t, err)
+}
@@ -36,6 +36,6 @@ func Example_createHighLevelOpenAPIDocument() {
 
 	// Print out some details
 	fmt.Printf("Petstore contains %d paths and %d component schemas",
-		len(doc.Paths.PathItems), len(doc.Components.Schemas))
+		doc.Paths.PathItems.Len(), doc.Components.Schemas.Len())
 	// Output: Petstore contains 13 paths and 8 component schemas
 }
@@ -7,6 +7,7 @@ import (
     "github.com/pb33f/libopenapi/datamodel/high"
     "github.com/pb33f/libopenapi/datamodel/high/base"
     low "github.com/pb33f/libopenapi/datamodel/low/v3"
+    "github.com/pb33f/libopenapi/orderedmap"
     "gopkg.in/yaml.v3"
 )
 
@@ -26,8 +27,8 @@ type Parameter struct {
     AllowReserved   bool                     `json:"allowReserved,omitempty" yaml:"allowReserved,omitempty"`
     Schema          *base.SchemaProxy        `json:"schema,omitempty" yaml:"schema,omitempty"`
     Example         any                      `json:"example,omitempty" yaml:"example,omitempty"`
-    Examples        map[string]*base.Example `json:"examples,omitempty" yaml:"examples,omitempty"`
-    Content         map[string]*MediaType    `json:"content,omitempty" yaml:"content,omitempty"`
+    Examples        *orderedmap.Map[string, *base.Example] `json:"examples,omitempty" yaml:"examples,omitempty"`
+    Content         *orderedmap.Map[string, *MediaType]    `json:"content,omitempty" yaml:"content,omitempty"`
     Extensions      map[string]any           `json:"-" yaml:"-"`
     low             *low.Parameter
 }
@@ -72,6 +73,26 @@ func (p *Parameter) Render() ([]byte, error) {
     return yaml.Marshal(p)
 }
 
+// RenderJSON will return a JSON representation of the Encoding object as a byte slice, indented using indent.
+func (p *Parameter) RenderJSON(indent string) ([]byte, error) {
+    return high.RenderJSON(p, indent)
+}
+
+// MarshalJSON will create a ready to render JSON representation of the Encoding object.
+func (p *Parameter) MarshalJSON() ([]byte, error) {
+    return p.RenderJSON("")
+}
+
+// UnmarshalYAML will build the Parameter object from a YAML node, see high.UnmarshalNode.
+func (p *Parameter) UnmarshalYAML(node *yaml.Node) error {
+    return high.UnmarshalNode(p, node, NewParameter)
+}
+
+// UnmarshalJSON will build the Parameter object from YAML or JSON, see high.UnmarshalBytes.
+func (p *Parameter) UnmarshalJSON(data []byte) error {
+    return high.UnmarshalBytes(p, data, NewParameter)
+}
+
 // MarshalYAML will create a ready to render YAML representation of the Encoding object.
 func (p *Parameter) MarshalYAML() (interface{}, error) {
     nb := high.NewNodeBuilder(p, p.low)
@@ -5,6 +5,7 @@ package v3
 
 import (
     "github.com/pb33f/libopenapi/datamodel/high/base"
+    "github.com/pb33f/libopenapi/orderedmap"
     "github.com/stretchr/testify/assert"
     "strings"
     "testing"
@@ -22,7 +23,9 @@ func TestParameter_MarshalYAML(t *testing.T) {
         Explode:       &explode,
         AllowReserved: true,
         Example:       "example",
-        Examples:      map[string]*base.Example{"example": {Value: "example"}},
+        Examples:      orderedmap.FromPairs(
+            orderedmap.Pair[string, *base.Example]{Key: "example", Value: &base.Example{Value: "example"}},
+        ),
         Extensions:    map[string]interface{}{"x-burgers": "why not?"},
     }
 
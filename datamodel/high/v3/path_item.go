@@ -163,6 +163,26 @@ func (p *PathItem) Render() ([]byte, error) {
     return yaml.Marshal(p)
 }
 
+// RenderJSON will return a JSON representation of the PathItem object as a byte slice, indented using indent.
+func (p *PathItem) RenderJSON(indent string) ([]byte, error) {
+    return high.RenderJSON(p, indent)
+}
+
+// MarshalJSON will create a ready to render JSON representation of the PathItem object.
+func (p *PathItem) MarshalJSON() ([]byte, error) {
+    return p.RenderJSON("")
+}
+
+// UnmarshalYAML will build the PathItem object from a YAML node, see high.UnmarshalNode.
+func (p *PathItem) UnmarshalYAML(node *yaml.Node) error {
+    return high.UnmarshalNode(p, node, NewPathItem)
+}
+
+// UnmarshalJSON will build the PathItem object from YAML or JSON, see high.UnmarshalBytes.
+func (p *PathItem) UnmarshalJSON(data []byte) error {
+    return high.UnmarshalBytes(p, data, NewPathItem)
+}
+
 // MarshalYAML will create a ready to render YAML representation of the PathItem object.
 func (p *PathItem) MarshalYAML() (interface{}, error) {
     nb := high.NewNodeBuilder(p, p.low)
@@ -6,6 +6,7 @@ package v3
 import (
 	"github.com/pb33f/libopenapi/datamodel/high"
 	low "github.com/pb33f/libopenapi/datamodel/low/v3"
+	"github.com/pb33f/libopenapi/orderedmap"
 	"github.com/pb33f/libopenapi/utils"
 	"gopkg.in/yaml.v3"
 	"sort"
@@ -18,8 +19,8 @@ import (
 // constraints.
 //  - https://spec.openapis.org/oas/v3.1.0#paths-object
 type Paths struct {
-	PathItems  map[string]*PathItem `json:"-" yaml:"-"`
-	Extensions map[string]any       `json:"-" yaml:"-"`
+	PathItems  *orderedmap.Map[string, *PathItem] `json:"-" yaml:"-"`
+	Extensions map[string]any                     `json:"-" yaml:"-"`
 	low        *low.Paths
 }
 
@@ -50,7 +51,7 @@ func NewPaths(paths *low.Paths) *Paths {
 			items[r.k] = r.v
 		}
 	}
-	p.PathItems = items
+	p.PathItems = high.ToOrderedMap(items, paths.PathItems)
 	return p
 }
 
@@ -69,36 +70,59 @@ func (p *Paths) Render() ([]byte, error) {
 	return yaml.Marshal(p)
 }
 
+// RenderJSON will return a JSON representation of the Paths object as a byte slice, indented using indent.
+func (p *Paths) RenderJSON(indent string) ([]byte, error) {
+	return high.RenderJSON(p, indent)
+}
+
+// MarshalJSON will create a ready to render JSON representation of the Paths object.
+func (p *Paths) MarshalJSON() ([]byte, error) {
+	return p.RenderJSON("")
+}
+
+// UnmarshalYAML will build the Paths object from a YAML node, see high.UnmarshalNode.
+func (p *Paths) UnmarshalYAML(node *yaml.Node) error {
+	return high.UnmarshalNode(p, node, NewPaths)
+}
+
+// UnmarshalJSON will build the Paths object from YAML or JSON, see high.UnmarshalBytes.
+func (p *Paths) UnmarshalJSON(data []byte) error {
+	return high.UnmarshalBytes(p, data, NewPaths)
+}
+
 // MarshalYAML will create a ready to render YAML representation of the Paths object.
 func (p *Paths) MarshalYAML() (interface{}, error) {
 	// map keys correctly.
 	m := utils.CreateEmptyMapNode()
 	type pathItem struct {
 		pi       *PathItem
-		path     string
+		path     *yaml.Node
 		line     int
 		rendered *yaml.Node
 	}
 	var mapped []*pathItem
 
-	for k, pi := range p.PathItems {
+	for _, pair := range p.PathItems.Pairs() {
+		k, pi := pair.Key, pair.Value
 		ln := 9999 // default to a high value to weight new content to the bottom.
+		kn := utils.CreateStringNode(k)
 		if p.low != nil {
-			lpi := p.low.FindPath(k)
+			lk, lpi := p.low.FindPathAndKey(k)
 			if lpi != nil {
 				ln = lpi.ValueNode.Line
+				high.RetainFormatting(kn, nil, lk.KeyNode, nil)
 			}
 		}
-		mapped = append(mapped, &pathItem{pi, k, ln, nil})
+		mapped = append(mapped, &pathItem{pi, kn, ln, nil})
 	}
 
 	nb := high.NewNodeBuilder(p, p.low)
 	extNode := nb.Render()
 	if extNode != nil && extNode.Content != nil {
-		var label string
+		var label *yaml.Node
 		for u := range extNode.Content {
 			if u%2 == 0 {
-				label = extNode.Content[u].Value
+				label = extNode.Content[u]
 				continue
 			}
 			mapped = append(mapped, &pathItem{nil, label,
@@ -106,17 +130,17 @@ func (p *Paths) MarshalYAML() (interface{}, error) {
 		}
 	}
 
-	sort.Slice(mapped, func(i, j int) bool {
+	sort.SliceStable(mapped, func(i, j int) bool {
 		return mapped[i].line < mapped[j].line
 	})
 	for j := range mapped {
 		if mapped[j].pi != nil {
 			rendered, _ := mapped[j].pi.MarshalYAML()
-			m.Content = append(m.Content, utils.CreateStringNode(mapped[j].path))
+			m.Content = append(m.Content, mapped[j].path)
 			m.Content = append(m.Content, rendered.(*yaml.Node))
 		}
 		if mapped[j].rendered != nil {
-			m.Content = append(m.Content, utils.CreateStringNode(mapped[j].path))
+			m.Content = append(m.Content, mapped[j].path)
 			m.Content = append(m.Content, mapped[j].rendered)
 		}
 	}
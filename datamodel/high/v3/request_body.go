@@ -6,6 +6,7 @@ package v3
 import (
 	"github.com/pb33f/libopenapi/datamodel/high"
 	low "github.com/pb33f/libopenapi/datamodel/low/v3"
+	"github.com/pb33f/libopenapi/orderedmap"
 	"gopkg.in/yaml.v3"
 )
 
@@ -13,7 +14,7 @@ import (
 //  - https://spec.openapis.org/oas/v3.1.0#request-body-object
 type RequestBody struct {
 	Description string                `json:"description,omitempty" yaml:"description,omitempty"`
-	Content     map[string]*MediaType `json:"content,omitempty" yaml:"content,omitempty"`
+	Content     *orderedmap.Map[string, *MediaType] `json:"content,omitempty" yaml:"content,omitempty"`
 	Required    *bool                 `json:"required,omitempty" yaml:"required,renderZero,omitempty"`
 	Extensions  map[string]any        `json:"-" yaml:"-"`
 	low         *low.RequestBody
@@ -47,6 +48,26 @@ func (r *RequestBody) Render() ([]byte, error) {
 	return yaml.Marshal(r)
 }
 
+// RenderJSON will return a JSON representation of the RequestBody object as a byte slice, indented using indent.
+func (r *RequestBody) RenderJSON(indent string) ([]byte, error) {
+	return high.RenderJSON(r, indent)
+}
+
+// MarshalJSON will create a ready to render JSON representation of the RequestBody object.
+func (r *RequestBody) MarshalJSON() ([]byte, error) {
+	return r.RenderJSON("")
+}
+
+// UnmarshalYAML will build the RequestBody object from a YAML node, see high.UnmarshalNode.
+func (r *RequestBody) UnmarshalYAML(node *yaml.Node) error {
+	return high.UnmarshalNode(r, node, NewRequestBody)
+}
+
+// UnmarshalJSON will build the RequestBody object from YAML or JSON, see high.UnmarshalBytes.
+func (r *RequestBody) UnmarshalJSON(data []byte) error {
+	return high.UnmarshalBytes(r, data, NewRequestBody)
+}
+
 // MarshalYAML will create a ready to render YAML representation of the RequestBody object.
 func (r *RequestBody) MarshalYAML() (interface{}, error) {
 	nb := high.NewNodeBuilder(r, r.low)
@@ -6,6 +6,7 @@ package v3
 import (
 	"github.com/pb33f/libopenapi/datamodel/high"
 	low "github.com/pb33f/libopenapi/datamodel/low/v3"
+	"github.com/pb33f/libopenapi/orderedmap"
 	"gopkg.in/yaml.v3"
 )
 
@@ -16,9 +17,9 @@ import (
 //  - https://spec.openapis.org/oas/v3.1.0#response-object
 type Response struct {
 	Description string                `json:"description,omitempty" yaml:"description,omitempty"`
-	Headers     map[string]*Header    `json:"headers,omitempty" yaml:"headers,omitempty"`
-	Content     map[string]*MediaType `json:"content,omitempty" yaml:"content,omitempty"`
-	Links       map[string]*Link      `json:"links,omitempty" yaml:"links,omitempty"`
+	Headers     *orderedmap.Map[string, *Header]    `json:"headers,omitempty" yaml:"headers,omitempty"`
+	Content     *orderedmap.Map[string, *MediaType] `json:"content,omitempty" yaml:"content,omitempty"`
+	Links       *orderedmap.Map[string, *Link]      `json:"links,omitempty" yaml:"links,omitempty"`
 	Extensions  map[string]any        `json:"-" yaml:"-"`
 	low         *low.Response
 }
@@ -40,7 +41,7 @@ func NewResponse(response *low.Response) *Response {
 		for k, v := range response.Links.Value {
 			responseLinks[k.Value] = NewLink(v.Value)
 		}
-		r.Links = responseLinks
+		r.Links = high.ToOrderedMap(responseLinks, response.Links.Value)
 	}
 	return r
 }
@@ -60,6 +61,26 @@ func (r *Response) Render() ([]byte, error) {
 	return yaml.Marshal(r)
 }
 
+// RenderJSON will return a JSON representation of the Response object as a byte slice, indented using indent.
+func (r *Response) RenderJSON(indent string) ([]byte, error) {
+	return high.RenderJSON(r, indent)
+}
+
+// MarshalJSON will create a ready to render JSON representation of the Response object.
+func (r *Response) MarshalJSON() ([]byte, error) {
+	return r.RenderJSON("")
+}
+
+// UnmarshalYAML will build the Response object from a YAML node, see high.UnmarshalNode.
+func (r *Response) UnmarshalYAML(node *yaml.Node) error {
+	return high.UnmarshalNode(r, node, NewResponse)
+}
+
+// UnmarshalJSON will build the Response object from YAML or JSON, see high.UnmarshalBytes.
+func (r *Response) UnmarshalJSON(data []byte) error {
+	return high.UnmarshalBytes(r, data, NewResponse)
+}
+
 // MarshalYAML will create a ready to render YAML representation of the Response object.
 func (r *Response) MarshalYAML() (interface{}, error) {
 	nb := high.NewNodeBuilder(r, r.low)
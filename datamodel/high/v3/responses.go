@@ -7,9 +7,11 @@ import (
 	"fmt"
 	"github.com/pb33f/libopenapi/datamodel/high"
 	low "github.com/pb33f/libopenapi/datamodel/low/v3"
+	"github.com/pb33f/libopenapi/orderedmap"
 	"github.com/pb33f/libopenapi/utils"
 	"gopkg.in/yaml.v3"
 	"sort"
+	"strings"
 )
 
 // Responses represents a high-level OpenAPI 3+ Responses object that is backed by a low-level one.
@@ -27,9 +29,9 @@ import (
 // be the response for a successful operation call.
 //  - https://spec.openapis.org/oas/v3.1.0#responses-object
 type Responses struct {
-	Codes      map[string]*Response `json:"-" yaml:"-"`
-	Default    *Response            `json:"default,omitempty" yaml:"default,omitempty"`
-	Extensions map[string]any       `json:"-" yaml:"-"`
+	Codes      *orderedmap.Map[string, *Response] `json:"-" yaml:"-"`
+	Default    *Response                          `json:"default,omitempty" yaml:"default,omitempty"`
+	Extensions map[string]any                     `json:"-" yaml:"-"`
 	low        *low.Responses
 }
 
@@ -67,13 +69,32 @@ func NewResponses(responses *low.Responses) *Responses {
 			codes[re.code] = re.resp
 		}
 	}
-	r.Codes = codes
+	r.Codes = high.ToOrderedMap(codes, responses.Codes)
 	return r
 }
 
-// FindResponseByCode is a shortcut for looking up code by an integer vs. a string
+// FindResponseByCode is a shortcut for looking up code by an integer vs. a string. If there is no response defined
+// for the exact code, then a matching range code will be used instead (for example, '2XX' for a 201).
 func (r *Responses) FindResponseByCode(code int) *Response {
-	return r.Codes[fmt.Sprintf("%d", code)]
+	if resp, ok := r.Codes.Get(fmt.Sprintf("%d", code)); ok {
+		return resp
+	}
+	rangeCode := fmt.Sprintf("%dXX", code/100)
+	for _, pair := range r.Codes.Pairs() {
+		if strings.EqualFold(pair.Key, rangeCode) {
+			return pair.Value
+		}
+	}
+	return nil
+}
+
+// FindResponseByCodeOrDefault operates the same as FindResponseByCode, except if no response is found for the code,
+// the Default response is returned (which may also be nil).
+func (r *Responses) FindResponseByCodeOrDefault(code int) *Response {
+	if resp := r.FindResponseByCode(code); resp != nil {
+		return resp
+	}
+	return r.Default
 }
 
 // GoLow returns the low-level Response object used to create the high-level one.
@@ -91,38 +112,61 @@ func (r *Responses) Render() ([]byte, error) {
 	return yaml.Marshal(r)
 }
 
+// RenderJSON will return a JSON representation of the Responses object as a byte slice, indented using indent.
+func (r *Responses) RenderJSON(indent string) ([]byte, error) {
+	return high.RenderJSON(r, indent)
+}
+
+// MarshalJSON will create a ready to render JSON representation of the Responses object.
+func (r *Responses) MarshalJSON() ([]byte, error) {
+	return r.RenderJSON("")
+}
+
+// UnmarshalYAML will build the Responses object from a YAML node, see high.UnmarshalNode.
+func (r *Responses) UnmarshalYAML(node *yaml.Node) error {
+	return high.UnmarshalNode(r, node, NewResponses)
+}
+
+// UnmarshalJSON will build the Responses object from YAML or JSON, see high.UnmarshalBytes.
+func (r *Responses) UnmarshalJSON(data []byte) error {
+	return high.UnmarshalBytes(r, data, NewResponses)
+}
+
 // MarshalYAML will create a ready to render YAML representation of the Responses object.
 func (r *Responses) MarshalYAML() (interface{}, error) {
 	// map keys correctly.
 	m := utils.CreateEmptyMapNode()
 	type responseItem struct {
 		resp *Response
-		code string
+		code *yaml.Node
 		line int
 		ext  *yaml.Node
 	}
 	var mapped []*responseItem
 
-	for k, re := range r.Codes {
+	for _, pair := range r.Codes.Pairs() {
+		k, re := pair.Key, pair.Value
 		ln := 9999 // default to a high value to weight new content to the bottom.
+		kn := utils.CreateStringNode(k)
 		if r.low != nil {
 			for lKey := range r.low.Codes {
 				if lKey.Value == k {
 					ln = lKey.KeyNode.Line
+					high.RetainFormatting(kn, nil, lKey.KeyNode, nil)
 				}
 			}
 		}
-		mapped = append(mapped, &responseItem{re, k, ln, nil})
+		mapped = append(mapped, &responseItem{re, kn, ln, nil})
 	}
 
 	// extract extensions
 	nb := high.NewNodeBuilder(r, r.low)
 	extNode := nb.Render()
 	if extNode != nil && extNode.Content != nil {
-		var label string
+		var label *yaml.Node
 		for u := range extNode.Content {
 			if u%2 == 0 {
-				label = extNode.Content[u].Value
+				label = extNode.Content[u]
 				continue
 			}
 			mapped = append(mapped, &responseItem{nil, label,
@@ -130,17 +174,17 @@ func (r *Responses) MarshalYAML() (interface{}, error) {
 		}
 	}
 
-	sort.Slice(mapped, func(i, j int) bool {
+	sort.SliceStable(mapped, func(i, j int) bool {
 		return mapped[i].line < mapped[j].line
 	})
 	for j := range mapped {
 		if mapped[j].resp != nil {
 			rendered, _ := mapped[j].resp.MarshalYAML()
-			m.Content = append(m.Content, utils.CreateStringNode(mapped[j].code))
+			m.Content = append(m.Content, mapped[j].code)
 			m.Content = append(m.Content, rendered.(*yaml.Node))
 		}
 		if mapped[j].ext != nil {
-			m.Content = append(m.Content, utils.CreateStringNode(mapped[j].code))
+			m.Content = append(m.Content, mapped[j].code)
 			m.Content = append(m.Content, mapped[j].ext)
 		}
 
@@ -67,3 +67,32 @@ func TestResponses_MarshalYAML(t *testing.T) {
 	assert.Equal(t, yml, strings.TrimSpace(string(rend)))
 
 }
+
+func TestResponses_FindResponseByCode(t *testing.T) {
+
+	yml := `"200":
+  description: OK
+"2XX":
+  description: some kind of success
+"4xx":
+  description: client problems
+default:
+  description: something else`
+
+	var idxNode yaml.Node
+	_ = yaml.Unmarshal([]byte(yml), &idxNode)
+	idx := index.NewSpecIndex(&idxNode)
+
+	var n v3.Responses
+	_ = low.BuildModel(&idxNode, &n)
+	_ = n.Build(idxNode.Content[0], idx)
+
+	r := NewResponses(&n)
+
+	assert.Equal(t, "OK", r.FindResponseByCode(200).Description)
+	assert.Equal(t, "some kind of success", r.FindResponseByCode(201).Description)
+	assert.Equal(t, "client problems", r.FindResponseByCode(404).Description)
+	assert.Nil(t, r.FindResponseByCode(500))
+	assert.Equal(t, "something else", r.FindResponseByCodeOrDefault(500).Description)
+	assert.Equal(t, "OK", r.FindResponseByCodeOrDefault(200).Description)
+}
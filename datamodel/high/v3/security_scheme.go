@@ -65,6 +65,26 @@ func (s *SecurityScheme) Render() ([]byte, error) {
 	return yaml.Marshal(s)
 }
 
+// RenderJSON will return a JSON representation of the SecurityScheme object as a byte slice, indented using indent.
+func (s *SecurityScheme) RenderJSON(indent string) ([]byte, error) {
+	return high.RenderJSON(s, indent)
+}
+
+// MarshalJSON will create a ready to render JSON representation of the SecurityScheme object.
+func (s *SecurityScheme) MarshalJSON() ([]byte, error) {
+	return s.RenderJSON("")
+}
+
+// UnmarshalYAML will build the SecurityScheme object from a YAML node, see high.UnmarshalNode.
+func (s *SecurityScheme) UnmarshalYAML(node *yaml.Node) error {
+	return high.UnmarshalNode(s, node, NewSecurityScheme)
+}
+
+// UnmarshalJSON will build the SecurityScheme object from YAML or JSON, see high.UnmarshalBytes.
+func (s *SecurityScheme) UnmarshalJSON(data []byte) error {
+	return high.UnmarshalBytes(s, data, NewSecurityScheme)
+}
+
 // MarshalYAML will create a ready to render YAML representation of the Response object.
 func (s *SecurityScheme) MarshalYAML() (interface{}, error) {
 	nb := high.NewNodeBuilder(s, s.low)
@@ -6,6 +6,7 @@ package v3
 import (
 	"github.com/pb33f/libopenapi/datamodel/high"
 	low "github.com/pb33f/libopenapi/datamodel/low/v3"
+	"github.com/pb33f/libopenapi/orderedmap"
 	"gopkg.in/yaml.v3"
 )
 
@@ -14,7 +15,7 @@ import (
 type Server struct {
 	URL         string                     `json:"url,omitempty" yaml:"url,omitempty"`
 	Description string                     `json:"description,omitempty" yaml:"description,omitempty"`
-	Variables   map[string]*ServerVariable `json:"variables,omitempty" yaml:"variables,omitempty"`
+	Variables   *orderedmap.Map[string, *ServerVariable] `json:"variables,omitempty" yaml:"variables,omitempty"`
 	Extensions  map[string]any             `json:"-" yaml:"-"`
 	low         *low.Server
 }
@@ -29,7 +30,7 @@ func NewServer(server *low.Server) *Server {
 	for k, val := range server.Variables.Value {
 		vars[k.Value] = NewServerVariable(val.Value)
 	}
-	s.Variables = vars
+	s.Variables = high.ToOrderedMap(vars, server.Variables.Value)
 	s.Extensions = high.ExtractExtensions(server.Extensions)
 	return s
 }
@@ -49,6 +50,26 @@ func (s *Server) Render() ([]byte, error) {
 	return yaml.Marshal(s)
 }
 
+// RenderJSON will return a JSON representation of the Server object as a byte slice, indented using indent.
+func (s *Server) RenderJSON(indent string) ([]byte, error) {
+	return high.RenderJSON(s, indent)
+}
+
+// MarshalJSON will create a ready to render JSON representation of the Server object.
+func (s *Server) MarshalJSON() ([]byte, error) {
+	return s.RenderJSON("")
+}
+
+// UnmarshalYAML will build the Server object from a YAML node, see high.UnmarshalNode.
+func (s *Server) UnmarshalYAML(node *yaml.Node) error {
+	return high.UnmarshalNode(s, node, NewServer)
+}
+
+// UnmarshalJSON will build the Server object from YAML or JSON, see high.UnmarshalBytes.
+func (s *Server) UnmarshalJSON(data []byte) error {
+	return high.UnmarshalBytes(s, data, NewServer)
+}
+
 // MarshalYAML will create a ready to render YAML representation of the Server object.
 func (s *Server) MarshalYAML() (interface{}, error) {
 	nb := high.NewNodeBuilder(s, s.low)
@@ -4,6 +4,7 @@
 package v3
 
 import (
+    "github.com/pb33f/libopenapi/orderedmap"
     "github.com/stretchr/testify/assert"
     "strings"
     "testing"
@@ -23,11 +24,11 @@ description: the b33f`
     assert.Equal(t, desired, strings.TrimSpace(string(rend)))
 
     // mutate
-    server.Variables = map[string]*ServerVariable{
-        "rainbow": {
+    server.Variables = orderedmap.FromPairs(
+        orderedmap.Pair[string, *ServerVariable]{Key: "rainbow", Value: &ServerVariable{
             Enum: []string{"one", "two", "three"},
-        },
-    }
+        }},
+    )
 
     desired = `url: https://pb33f.io
 description: the b33f
@@ -51,6 +51,16 @@ func (s *ServerVariable) Render() ([]byte, error) {
 	return yaml.Marshal(s)
 }
 
+// RenderJSON will return a JSON representation of the ServerVariable object as a byte slice, indented using indent.
+func (s *ServerVariable) RenderJSON(indent string) ([]byte, error) {
+	return high.RenderJSON(s, indent)
+}
+
+// MarshalJSON will create a ready to render JSON representation of the ServerVariable object.
+func (s *ServerVariable) MarshalJSON() ([]byte, error) {
+	return s.RenderJSON("")
+}
+
 // MarshalYAML will create a ready to render YAML representation of the ServerVariable object.
 func (s *ServerVariable) MarshalYAML() (interface{}, error) {
 	nb := high.NewNodeBuilder(s, s.low)
@@ -126,6 +126,15 @@ type Schema struct {
 	// Parent Proxy refers back to the low level SchemaProxy that is proxying this schema.
 	ParentProxy *SchemaProxy
 	*low.Reference
+
+	// the (possibly dereferenced) node that was used to build this schema.
+	rootNode *yaml.Node
+}
+
+// GetRootNode returns the yaml.Node used to build the Schema. If the schema was a reference, then this is the node
+// the reference resolved to, not the reference itself.
+func (s *Schema) GetRootNode() *yaml.Node {
+	return s.rootNode
 }
 
 // Hash will calculate a SHA256 hash from the values of the schema, This allows equality checking against
@@ -531,6 +540,8 @@ func (s *Schema) Build(root *yaml.Node, idx *index.SpecIndex) error {
 		}
 	}
 
+	s.rootNode = root
+
 	// Build model using possibly dereferenced root
 	if err := low.BuildModel(root, s); err != nil {
 		return err
@@ -1143,7 +1154,9 @@ func buildSchema(schemas chan schemaProxyBuildResult, labelNode, valueNode *yaml
 
 		isRef := false
 		refLocation := ""
-		if utils.IsNodeMap(valueNode) {
+
+		// boolean schemas (3.1) are wrapped in a proxy just like a map, the proxy holds the boolean value node.
+		if utils.IsNodeMap(valueNode) || utils.IsNodeBoolValue(valueNode) {
 			h := false
 			if h, _, refLocation = utils.IsNodeRefValue(valueNode); h {
 				isRef = true
@@ -1560,6 +1560,23 @@ func TestSchema_Hash_EqualJumbled(t *testing.T) {
 	assert.True(t, low.AreEqual(lDoc.Value.Schema(), rDoc.Value.Schema()))
 }
 
+func TestSchema_Build_BooleanSubSchemas(t *testing.T) {
+	yml := `type: array
+unevaluatedItems: false
+unevaluatedProperties: true`
+
+	var idxNode yaml.Node
+	_ = yaml.Unmarshal([]byte(yml), &idxNode)
+
+	var sch Schema
+	_ = low.BuildModel(idxNode.Content[0], &sch)
+	err := sch.Build(idxNode.Content[0], nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "false", sch.UnevaluatedItems.Value.GetValueNode().Value)
+	assert.Equal(t, "true", sch.UnevaluatedProperties.Value.GetValueNode().Value)
+	assert.Equal(t, idxNode.Content[0], sch.GetRootNode())
+}
+
 func test_get_allOf_schema_blob() string {
 	return `type: object
 description: allOf sequence check
@@ -159,6 +159,26 @@ func ExtractObjectRaw[T Buildable[N], N any](root *yaml.Node, idx *index.SpecInd
 	return n, nil, isReference, referenceValue
 }
 
+// BuildModelFromNode will build a typed Buildable[N] object from a fragment of a specification (like an operation
+// or a schema), rather than from a whole document. The root node can be a document node, or the object itself.
+//
+// References are resolved using idx. If idx is nil, the fragment is indexed on its own, so only references that are
+// local to the fragment can be resolved (remote and file lookups are not allowed).
+func BuildModelFromNode[T Buildable[N], N any](root *yaml.Node, idx *index.SpecIndex) (T, error) {
+	if root != nil && root.Kind == yaml.DocumentNode && len(root.Content) > 0 {
+		root = root.Content[0]
+	}
+	if root == nil || root.Kind != yaml.MappingNode {
+		return nil, fmt.Errorf("unable to build model, the node is not an object")
+	}
+	if idx == nil {
+		doc := &yaml.Node{Kind: yaml.DocumentNode, Content: []*yaml.Node{root}}
+		idx = index.NewSpecIndexWithConfig(doc, index.CreateClosedAPIIndexConfig())
+	}
+	n, err, _, _ := ExtractObjectRaw[T, N](root, idx)
+	return n, err
+}
+
 // ExtractObject will extract a typed Buildable[N] object from a root yaml.Node. The result is wrapped in a
 // NodeReference[T] that contains the key node found and value node found when looking up the reference.
 func ExtractObject[T Buildable[N], N any](label string, root *yaml.Node, idx *index.SpecIndex) (NodeReference[T], error) {
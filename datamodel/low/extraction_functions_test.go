@@ -525,6 +525,57 @@ func TestExtractObjectRaw(t *testing.T) {
 	assert.Equal(t, "hello pizza", tag.Description.Value)
 }
 
+func TestBuildModelFromNode(t *testing.T) {
+
+	yml := `description: hello pizza
+other:
+  description: hello pie`
+
+	var cNode yaml.Node
+	_ = yaml.Unmarshal([]byte(yml), &cNode)
+
+	tag, err := BuildModelFromNode[*pizza](&cNode, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "hello pizza", tag.Description.Value)
+
+	// references local to the fragment can be resolved without an index.
+	yml = `$ref: '#/other'
+other:
+  description: hello pie`
+
+	_ = yaml.Unmarshal([]byte(yml), &cNode)
+	tag, err = BuildModelFromNode[*pizza](cNode.Content[0], nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "hello pie", tag.Description.Value)
+
+	yml = `components:
+  schemas:
+    pizza:
+      description: hello`
+
+	var idxNode yaml.Node
+	_ = yaml.Unmarshal([]byte(yml), &idxNode)
+	idx := index.NewSpecIndexWithConfig(&idxNode, index.CreateClosedAPIIndexConfig())
+
+	yml = `$ref: '#/components/schemas/pizza'`
+	_ = yaml.Unmarshal([]byte(yml), &cNode)
+	tag, err = BuildModelFromNode[*pizza](&cNode, idx)
+	assert.NoError(t, err)
+	assert.Equal(t, "hello", tag.Description.Value)
+}
+
+func TestBuildModelFromNode_NotAnObject(t *testing.T) {
+	var cNode yaml.Node
+	_ = yaml.Unmarshal([]byte(`- pizza`), &cNode)
+
+	tag, err := BuildModelFromNode[*pizza](&cNode, nil)
+	assert.Error(t, err)
+	assert.Nil(t, tag)
+
+	_, err = BuildModelFromNode[*pizza](nil, nil)
+	assert.Error(t, err)
+}
+
 func TestExtractObjectRaw_With_Ref(t *testing.T) {
 
 	yml := `components:
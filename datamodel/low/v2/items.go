@@ -110,7 +110,7 @@ func (i *Items) Build(root *yaml.Node, idx *index.SpecIndex) error {
 	}
 	i.Items = items
 
-	_, ln, vn := utils.FindKeyNodeFull(DefaultLabel, root.Content)
+	_, ln, vn := utils.FindKeyNodeFullTop(DefaultLabel, root.Content)
 	if vn != nil {
 		var n map[string]interface{}
 		err := vn.Decode(&n)
@@ -71,6 +71,7 @@ type Parameter struct {
 	Enum             low.NodeReference[[]low.ValueReference[any]]
 	MultipleOf       low.NodeReference[int]
 	Extensions       map[low.KeyReference[string]]low.ValueReference[any]
+	*low.Reference
 }
 
 // FindExtension attempts to locate a extension value given a name.
@@ -85,6 +86,7 @@ func (p *Parameter) GetExtensions() map[low.KeyReference[string]]low.ValueRefere
 
 // Build will extract out extensions, schema, items and default value
 func (p *Parameter) Build(root *yaml.Node, idx *index.SpecIndex) error {
+	p.Reference = new(low.Reference)
 	p.Extensions = low.ExtractExtensions(root)
 	sch, sErr := base.ExtractSchema(root, idx)
 	if sErr != nil {
@@ -93,13 +95,16 @@ func (p *Parameter) Build(root *yaml.Node, idx *index.SpecIndex) error {
 	if sch != nil {
 		p.Schema = *sch
 	}
-	items, iErr := low.ExtractObject[*Items](ItemsLabel, root, idx)
-	if iErr != nil {
-		return iErr
+	// body parameters have a schema (that may have items), only look for items on the parameter itself.
+	if _, itemsNode := utils.FindKeyNodeTop(ItemsLabel, root.Content); itemsNode != nil {
+		items, iErr := low.ExtractObject[*Items](ItemsLabel, root, idx)
+		if iErr != nil {
+			return iErr
+		}
+		p.Items = items
 	}
-	p.Items = items
 
-	_, ln, vn := utils.FindKeyNodeFull(DefaultLabel, root.Content)
+	_, ln, vn := utils.FindKeyNodeFullTop(DefaultLabel, root.Content)
 	if vn != nil {
 		var n map[string]interface{}
 		err := vn.Decode(&n)
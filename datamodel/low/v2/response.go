@@ -24,6 +24,7 @@ type Response struct {
 	Headers     low.NodeReference[map[low.KeyReference[string]]low.ValueReference[*Header]]
 	Examples    low.NodeReference[*Examples]
 	Extensions  map[low.KeyReference[string]]low.ValueReference[any]
+	*low.Reference
 }
 
 // FindExtension will attempt to locate an extension value given a key to lookup.
@@ -43,6 +44,7 @@ func (r *Response) FindHeader(hType string) *low.ValueReference[*Header] {
 
 // Build will extract schema, extensions, examples and headers from node
 func (r *Response) Build(root *yaml.Node, idx *index.SpecIndex) error {
+	r.Reference = new(low.Reference)
 	r.Extensions = low.ExtractExtensions(root)
 	s, err := base.ExtractSchema(root, idx)
 	if err != nil {
@@ -59,6 +59,7 @@ func (r *Responses) getDefault() *low.NodeReference[*Response] {
 				ValueNode: o.ValueNode,
 				KeyNode:   n.KeyNode,
 				Value:     o.Value,
+				Reference: o.Reference,
 			}
 		}
 	}
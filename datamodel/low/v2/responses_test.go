@@ -48,6 +48,34 @@ func TestResponses_Build_Response_Default(t *testing.T) {
 
 }
 
+func TestResponses_Build_Response_DefaultReference(t *testing.T) {
+
+	yml := `default:
+  $ref: '#/responses/Error'`
+
+	var idxNode yaml.Node
+	mErr := yaml.Unmarshal([]byte(yml), &idxNode)
+	assert.NoError(t, mErr)
+
+	refYml := `responses:
+  Error:
+    description: an error`
+
+	var refNode yaml.Node
+	_ = yaml.Unmarshal([]byte(refYml), &refNode)
+	idx := index.NewSpecIndex(&refNode)
+
+	var n Responses
+	err := low.BuildModel(&idxNode, &n)
+	assert.NoError(t, err)
+
+	err = n.Build(idxNode.Content[0], idx)
+	assert.NoError(t, err)
+	assert.Equal(t, "#/responses/Error", n.Default.Reference)
+	assert.Equal(t, "an error", n.Default.Value.Description.Value)
+
+}
+
 func TestResponses_Build_WrongType(t *testing.T) {
 
 	yml := `- $ref: break`
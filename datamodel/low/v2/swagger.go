@@ -12,6 +12,7 @@
 package v2
 
 import (
+    "context"
     "github.com/pb33f/libopenapi/datamodel"
     "github.com/pb33f/libopenapi/datamodel/low"
     "github.com/pb33f/libopenapi/datamodel/low/base"
@@ -124,33 +125,48 @@ func (s *Swagger) GetExtensions() map[low.KeyReference[string]]low.ValueReferenc
 // CreateDocumentFromConfig will create a new Swagger document from the provided SpecInfo and DocumentConfiguration.
 func CreateDocumentFromConfig(info *datamodel.SpecInfo,
     configuration *datamodel.DocumentConfiguration) (*Swagger, []error) {
-    return createDocument(info, configuration)
+    return createDocument(context.Background(), info, configuration)
+}
+
+// CreateDocumentFromConfigWithContext is the same as CreateDocumentFromConfig, except building the document stops
+// when the context is done. If it's done before the document is built, then the partially built document is
+// returned, and the error from the context is the last error returned.
+func CreateDocumentFromConfigWithContext(ctx context.Context, info *datamodel.SpecInfo,
+    configuration *datamodel.DocumentConfiguration) (*Swagger, []error) {
+    return createDocument(ctx, info, configuration)
 }
 
 // CreateDocument will create a new Swagger document from the provided SpecInfo.
 //
 // Deprecated: Use CreateDocumentFromConfig instead.
 func CreateDocument(info *datamodel.SpecInfo) (*Swagger, []error) {
-    return createDocument(info, &datamodel.DocumentConfiguration{
+    return createDocument(context.Background(), info, &datamodel.DocumentConfiguration{
         AllowRemoteReferences: true,
         AllowFileReferences:   true,
     })
 }
 
-func createDocument(info *datamodel.SpecInfo, config *datamodel.DocumentConfiguration) (*Swagger, []error) {
+func createDocument(ctx context.Context, info *datamodel.SpecInfo, config *datamodel.DocumentConfiguration) (*Swagger, []error) {
     doc := Swagger{Swagger: low.ValueReference[string]{Value: info.Version, ValueNode: info.RootNode}}
     doc.Extensions = low.ExtractExtensions(info.RootNode.Content[0])
 
     // build an index
-    idx := index.NewSpecIndexWithConfig(info.RootNode, &index.SpecIndexConfig{
+    idx, ctxErr := index.NewSpecIndexWithContext(ctx, info.RootNode, &index.SpecIndexConfig{
         BaseURL:           config.BaseURL,
+        BasePath:          config.BasePath,
         AllowRemoteLookup: config.AllowRemoteReferences,
         AllowFileLookup:   config.AllowFileReferences,
+        FS:                config.FS,
+        RemoteFetcher:     config.RemoteFetcher,
+        ReferenceLimits:   config.ReferenceLimits,
     })
     doc.Index = idx
     doc.SpecInfo = info
 
     var errors []error
+    if ctxErr != nil {
+        return &doc, append(errors, ctxErr)
+    }
 
     // build out swagger scalar variables.
     _ = low.BuildModel(info.RootNode.Content[0], &doc)
@@ -165,13 +181,16 @@ func createDocument(info *datamodel.SpecInfo, config *datamodel.DocumentConfigur
 
     // create resolver and check for circular references.
     resolve := resolver.NewResolver(idx)
-    resolvingErrors := resolve.CheckForCircularReferences()
+    resolvingErrors, ctxErr := resolve.CheckForCircularReferencesWithContext(ctx)
 
     if len(resolvingErrors) > 0 {
         for r := range resolvingErrors {
             errors = append(errors, resolvingErrors[r])
         }
     }
+    if ctxErr != nil {
+        return &doc, append(errors, ctxErr)
+    }
 
     extractionFuncs := []documentFunction{
         extractInfo,
@@ -198,7 +217,9 @@ func createDocument(info *datamodel.SpecInfo, config *datamodel.DocumentConfigur
             errors = append(errors, e)
         }
     }
-
+    if ctx.Err() != nil {
+        errors = append(errors, ctx.Err())
+    }
     return &doc, errors
 }
 
@@ -117,6 +117,7 @@ const (
 	FlowLabel                  = "flow"
 	FlowsLabel                 = "flows"
 	SchemeLabel                = "scheme"
+	BearerFormatLabel          = "bearerFormat"
 	OpenIdConnectUrlLabel      = "openIdConnectUrl"
 	ScopesLabel                = "scopes"
 	OperationRefLabel          = "operationRef"
@@ -1,6 +1,7 @@
 package v3
 
 import (
+	"context"
 	"errors"
 	"os"
 	"sync"
@@ -22,15 +23,23 @@ func CreateDocument(info *datamodel.SpecInfo) (*Document, []error) {
 		AllowFileReferences:   true,
 		AllowRemoteReferences: true,
 	}
-	return createDocument(info, &config)
+	return createDocument(context.Background(), info, &config)
 }
 
 // CreateDocumentFromConfig Create a new document from the provided SpecInfo and DocumentConfiguration pointer.
 func CreateDocumentFromConfig(info *datamodel.SpecInfo, config *datamodel.DocumentConfiguration) (*Document, []error) {
-	return createDocument(info, config)
+	return createDocument(context.Background(), info, config)
 }
 
-func createDocument(info *datamodel.SpecInfo, config *datamodel.DocumentConfiguration) (*Document, []error) {
+// CreateDocumentFromConfigWithContext is the same as CreateDocumentFromConfig, except building the document stops
+// when the context is done. If it's done before the document is built, then the partially built document (which
+// may only contain the index) is returned, and the error from the context is the last error returned.
+func CreateDocumentFromConfigWithContext(ctx context.Context, info *datamodel.SpecInfo,
+	config *datamodel.DocumentConfiguration) (*Document, []error) {
+	return createDocument(ctx, info, config)
+}
+
+func createDocument(ctx context.Context, info *datamodel.SpecInfo, config *datamodel.DocumentConfiguration) (*Document, []error) {
 	_, labelNode, versionNode := utils.FindKeyNodeFull(OpenAPILabel, info.RootNode.Content)
 	var version low.NodeReference[string]
 	if versionNode == nil {
@@ -39,35 +48,48 @@ func createDocument(info *datamodel.SpecInfo, config *datamodel.DocumentConfigur
 	version = low.NodeReference[string]{Value: versionNode.Value, KeyNode: labelNode, ValueNode: versionNode}
 	doc := Document{Version: version}
 
-	// get current working directory as a basePath
-	cwd, _ := os.Getwd()
+	// get current working directory as a basePath, unless references are read from a file system,
+	// then the root of the file system is used.
+	var cwd string
+	if config.FS == nil {
+		cwd, _ = os.Getwd()
+	}
 
 	// If basePath is provided override it
 	if config.BasePath != "" {
 		cwd = config.BasePath
 	}
 	// build an index
-	idx := index.NewSpecIndexWithConfig(info.RootNode, &index.SpecIndexConfig{
+	idx, ctxErr := index.NewSpecIndexWithContext(ctx, info.RootNode, &index.SpecIndexConfig{
 		BaseURL:           config.BaseURL,
 		BasePath:          cwd,
 		AllowFileLookup:   config.AllowFileReferences,
 		AllowRemoteLookup: config.AllowRemoteReferences,
+		FS:                config.FS,
+		RemoteFetcher:     config.RemoteFetcher,
+		ReferenceLimits:   config.ReferenceLimits,
 	})
 	doc.Index = idx
 
 	var errs []error
 
 	errs = idx.GetReferenceIndexErrors()
+	if ctxErr != nil {
+		return &doc, append(errs, ctxErr)
+	}
 
 	// create resolver and check for circular references.
 	resolve := resolver.NewResolver(idx)
-	resolvingErrors := resolve.CheckForCircularReferences()
+	resolvingErrors, ctxErr := resolve.CheckForCircularReferencesWithContext(ctx)
 
 	if len(resolvingErrors) > 0 {
 		for r := range resolvingErrors {
 			errs = append(errs, resolvingErrors[r])
 		}
 	}
+	if ctxErr != nil {
+		return &doc, append(errs, ctxErr)
+	}
 
 	var wg sync.WaitGroup
 
@@ -107,6 +129,9 @@ func createDocument(info *datamodel.SpecInfo, config *datamodel.DocumentConfigur
 		go runExtraction(info, &doc, idx, f, &errs, &wg)
 	}
 	wg.Wait()
+	if ctx.Err() != nil {
+		errs = append(errs, ctx.Err())
+	}
 	return &doc, errs
 }
 
@@ -0,0 +1,438 @@
+// Copyright 2023 Princess B33f Heavy Industries / Dave Shanley
+// SPDX-License-Identifier: MIT
+
+package datamodel
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/pb33f/libopenapi/datamodel/high/base"
+	"github.com/pb33f/libopenapi/datamodel/low"
+	lowbase "github.com/pb33f/libopenapi/datamodel/low/base"
+	"github.com/pb33f/libopenapi/index"
+	"gopkg.in/yaml.v3"
+)
+
+// SchemaValidationError represents a single violation found when validating a specification against the
+// OpenAPI JSON Schema (meta-schema) for its version.
+//
+// The Location is a JSON Pointer (RFC 6901) to the offending value in the specification, the Line and Column
+// are pulled from the yaml.Node that holds that value, so violations can be reported straight back to the author.
+type SchemaValidationError struct {
+	// Message is a human-readable explanation of the violation.
+	Message string `json:"message"`
+
+	// Location is a JSON Pointer to the value in the specification that failed validation, e.g. /paths/~1pets/get
+	Location string `json:"location"`
+
+	// SchemaLocation is a JSON Pointer to the keyword in the meta-schema that was violated.
+	SchemaLocation string `json:"schemaLocation"`
+
+	// Line is the line number of the offending node in the specification.
+	Line int `json:"line"`
+
+	// Column is the column number of the offending node in the specification.
+	Column int `json:"column"`
+
+	// Node is the yaml.Node that failed validation.
+	Node *yaml.Node `json:"-"`
+}
+
+func (e *SchemaValidationError) Error() string {
+	loc := e.Location
+	if loc == "" {
+		loc = "/"
+	}
+	return fmt.Sprintf("%s: %s (line %d, column %d)", loc, e.Message, e.Line, e.Column)
+}
+
+// ValidateSpecInfo will validate the RootNode of a SpecInfo against the JSON Schema that was selected for the
+// specification when it was extracted (see APISchema). Every violation is returned as a *SchemaValidationError.
+//
+// An error is returned if the specification has no schema available (for example, AsyncAPI documents), or if the
+// schema cannot be parsed.
+func ValidateSpecInfo(info *SpecInfo) ([]*SchemaValidationError, error) {
+	if info == nil || info.RootNode == nil {
+		return nil, errors.New("unable to validate specification, no specification has been loaded")
+	}
+	if info.APISchema == "" {
+		return nil, fmt.Errorf("unable to validate specification, there is no schema available for type '%s'",
+			info.SpecType)
+	}
+	return ValidateNodeAgainstSchema(info.RootNode, info.APISchema)
+}
+
+// ValidateNodeAgainstSchema will validate a *yaml.Node tree against a JSON Schema supplied as a JSON string.
+// Draft-04 (used by Swagger and OpenAPI 3.0) and 2020-12 (used by OpenAPI 3.1) schemas are supported. The schema is
+// built as a base.Schema and the node is checked using Schema.Validate, so the same rules are used to validate a
+// specification and the values described by the schemas inside it. External references (other than to the JSON
+// Schema draft-04 meta-schema, which is embedded) are not fetched, and the 'format' keyword is treated as an
+// annotation only.
+func ValidateNodeAgainstSchema(node *yaml.Node, schema string) ([]*SchemaValidationError, error) {
+	m, err := compileMetaSchema(schema)
+	if err != nil {
+		return nil, err
+	}
+	return m.validate(resolveAliasNode(node)), nil
+}
+
+var metaSchemaCache sync.Map
+
+// knownSchema returns the name and content of an external schema that can be referenced by the OpenAPI schemas.
+func knownSchema(uri string) (string, string, bool) {
+	switch strings.TrimSuffix(uri, "/") {
+	case "http://json-schema.org/draft-04/schema":
+		return "json-schema-draft-04", JSONSchemaDraft04Data, true
+	}
+	return "", "", false
+}
+
+// metaSchema is a JSON Schema that has been prepared to be built as a base.Schema (see prepareSchemaNode). It's
+// cached and shared, so a new index and schema are built from it for every validation.
+type metaSchema struct {
+	root      *yaml.Node
+	locations map[*yaml.Node]string // the JSON Pointer of every node, as it was in the schema that was supplied.
+}
+
+func compileMetaSchema(schema string) (*metaSchema, error) {
+	if m, ok := metaSchemaCache.Load(schema); ok {
+		return m.(*metaSchema), nil
+	}
+	m := &metaSchema{locations: make(map[*yaml.Node]string)}
+	root, err := parseSchemaNode(schema, "", m.locations)
+	if err != nil {
+		return nil, err
+	}
+	m.root = root
+	if root.Kind == yaml.MappingNode {
+		if err = prepareSchemaNode(root, m.locations); err != nil {
+			return nil, err
+		}
+	}
+	metaSchemaCache.Store(schema, m)
+	return m, nil
+}
+
+// parseSchemaNode parses a schema, recording the JSON Pointer of every node (prefixed by uri) in locations.
+func parseSchemaNode(schema, uri string, locations map[*yaml.Node]string) (*yaml.Node, error) {
+	var doc yaml.Node
+	if err := yaml.Unmarshal([]byte(schema), &doc); err != nil {
+		return nil, fmt.Errorf("unable to parse schema: %s", err.Error())
+	}
+	root := resolveAliasNode(&doc)
+	if root == nil || (root.Kind != yaml.MappingNode && root.ShortTag() != "!!bool") {
+		return nil, errors.New("unable to parse schema: a schema must be an object or a boolean")
+	}
+	collectLocations(root, uri, locations)
+	return root, nil
+}
+
+// prepareSchemaNode rewrites the parts of a schema that can't be represented by a base.Schema, or resolved by the
+// index. Known external schemas are embedded under '$defs', and references to them point to the copy.
+func prepareSchemaNode(root *yaml.Node, locations map[*yaml.Node]string) error {
+	r := newSchemaRewriter(root, "")
+	r.rewrite(root)
+	var defs *yaml.Node
+	for len(r.embedded) > 0 {
+		for name, uri := range r.embedded {
+			delete(r.embedded, name)
+			if defs == nil {
+				if defs = findMappingValue(root, "$defs"); defs == nil {
+					defs = &yaml.Node{Kind: yaml.MappingNode, Tag: "!!map"}
+					root.Content = append(root.Content, &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str",
+						Value: "$defs"}, defs)
+				}
+			}
+			if findMappingValue(defs, name) != nil {
+				continue
+			}
+			_, schema, _ := knownSchema(uri)
+			embedded, err := parseSchemaNode(schema, strings.TrimSuffix(uri, "/")+"#", locations)
+			if err != nil {
+				return err
+			}
+			er := newSchemaRewriter(embedded, "/$defs/"+name)
+			er.embedded = r.embedded
+			er.rewrite(embedded)
+			defs.Content = append(defs.Content, &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: name},
+				embedded)
+		}
+	}
+	return nil
+}
+
+// schemaRewriter rewrites the references of a schema document, so they can be resolved by the index.
+//   - anchors are replaced with JSON Pointers, and a $dynamicRef becomes a $ref to its anchor.
+//   - after draft-04, the keywords next to a $ref are not ignored, so the $ref is moved into an allOf.
+//   - references to unknown external schemas are removed, which means anything is accepted.
+type schemaRewriter struct {
+	base     string            // the JSON Pointer of the document, in the prepared schema.
+	draft4   bool              // true if the document is a draft-04 schema.
+	anchors  map[string]string // the JSON Pointer of every anchor, in the prepared schema.
+	embedded map[string]string // the URI of every known external schema that has been referenced, by name.
+}
+
+func newSchemaRewriter(root *yaml.Node, base string) *schemaRewriter {
+	r := &schemaRewriter{base: base, anchors: make(map[string]string), embedded: make(map[string]string)}
+	if n := findMappingValue(root, "$schema"); n != nil && strings.Contains(n.Value, "draft-04") {
+		r.draft4 = true
+	}
+	r.collectAnchors(root, base)
+	return r
+}
+
+func (r *schemaRewriter) collectAnchors(node *yaml.Node, pointer string) {
+	switch node.Kind {
+	case yaml.SequenceNode:
+		for i, n := range node.Content {
+			r.collectAnchors(n, appendPointer(pointer, strconv.Itoa(i)))
+		}
+	case yaml.MappingNode:
+		for i := 0; i < len(node.Content)-1; i += 2 {
+			key, value := node.Content[i].Value, node.Content[i+1]
+			if (key == "$anchor" || key == "$dynamicAnchor") && value.Kind == yaml.ScalarNode {
+				r.anchors[value.Value] = pointer
+			}
+			r.collectAnchors(value, appendPointer(pointer, key))
+		}
+	}
+}
+
+func (r *schemaRewriter) rewrite(node *yaml.Node) {
+	switch node.Kind {
+	case yaml.SequenceNode:
+		for _, n := range node.Content {
+			r.rewrite(n)
+		}
+	case yaml.MappingNode:
+		for i := 0; i < len(node.Content)-1; i += 2 {
+			r.rewrite(node.Content[i+1])
+		}
+		r.rewriteReference(node)
+	}
+}
+
+// rewriteReference rewrites the $ref or $dynamicRef of a schema.
+func (r *schemaRewriter) rewriteReference(node *yaml.Node) {
+	for i := 0; i < len(node.Content)-1; i += 2 {
+		key, value := node.Content[i], node.Content[i+1]
+		if (key.Value != "$ref" && key.Value != "$dynamicRef") || value.Kind != yaml.ScalarNode {
+			continue
+		}
+		ref := []*yaml.Node{key, value}
+		node.Content = append(node.Content[:i:i], node.Content[i+2:]...)
+		pointer, ok := r.resolve(value.Value)
+		if !ok {
+			return
+		}
+		key.Value, value.Value = "$ref", "#"+pointer
+		if r.draft4 || len(node.Content) == 0 {
+			node.Content = append(ref, node.Content...)
+			return
+		}
+		allOf := findMappingValue(node, "allOf")
+		if allOf == nil {
+			allOf = &yaml.Node{Kind: yaml.SequenceNode, Tag: "!!seq"}
+			node.Content = append(node.Content, &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: "allOf"},
+				allOf)
+		}
+		allOf.Content = append(allOf.Content, &yaml.Node{Kind: yaml.MappingNode, Tag: "!!map", Content: ref})
+		return
+	}
+}
+
+// resolve returns the JSON Pointer of a reference in the prepared schema, false is returned for unknown references.
+func (r *schemaRewriter) resolve(ref string) (string, bool) {
+	uri, fragment, _ := strings.Cut(ref, "#")
+	if uri != "" {
+		name, _, ok := knownSchema(uri)
+		if ok {
+			r.embedded[name] = uri
+		}
+		return "/$defs/" + name + fragment, ok
+	}
+	if fragment == "" || strings.HasPrefix(fragment, "/") {
+		return r.base + fragment, true
+	}
+	pointer, ok := r.anchors[fragment]
+	return pointer, ok
+}
+
+// collectLocations records the JSON Pointer of every node in a schema. A key has the same pointer as its value.
+func collectLocations(node *yaml.Node, pointer string, locations map[*yaml.Node]string) {
+	locations[node] = pointer
+	switch node.Kind {
+	case yaml.SequenceNode:
+		for i, n := range node.Content {
+			collectLocations(n, appendPointer(pointer, strconv.Itoa(i)), locations)
+		}
+	case yaml.MappingNode:
+		for i := 0; i < len(node.Content)-1; i += 2 {
+			p := appendPointer(pointer, node.Content[i].Value)
+			locations[node.Content[i]] = p
+			collectLocations(node.Content[i+1], p, locations)
+		}
+	}
+}
+
+// validate builds the schema and validates a node against it.
+func (m *metaSchema) validate(node *yaml.Node) []*SchemaValidationError {
+	doc := &yaml.Node{Kind: yaml.DocumentNode, Content: []*yaml.Node{m.root}}
+	idx := index.NewSpecIndexWithConfig(doc, index.CreateClosedAPIIndexConfig())
+	lowProxy := new(lowbase.SchemaProxy)
+	_ = lowProxy.Build(m.root, idx)
+	proxy := base.NewSchemaProxy(&low.NodeReference[*lowbase.SchemaProxy]{Value: lowProxy, ValueNode: m.root})
+
+	var errs []*SchemaValidationError
+	for _, e := range proxy.Validate(nodeToJSONValue(node)) {
+		value, key := locateNode(node, e.Location)
+		if key != nil && (e.Keyword == "additionalProperties" || e.Keyword == "unevaluatedProperties") {
+			value = key // the property itself is not allowed.
+		}
+		errs = append(errs, &SchemaValidationError{
+			Message:        e.Reason,
+			Location:       e.Location,
+			SchemaLocation: m.locations[e.Node],
+			Line:           value.Line,
+			Column:         value.Column,
+			Node:           value,
+		})
+	}
+	// errors are reported in the order they appear in the specification.
+	sort.SliceStable(errs, func(i, j int) bool {
+		if errs[i].Line != errs[j].Line {
+			return errs[i].Line < errs[j].Line
+		}
+		return errs[i].Column < errs[j].Column
+	})
+	return errs
+}
+
+// locateNode returns the node found at a JSON Pointer, and the key of the node if it's the value of a property. If
+// the pointer can't be followed to the end, the last node found is returned.
+func locateNode(root *yaml.Node, pointer string) (*yaml.Node, *yaml.Node) {
+	node := root
+	var key *yaml.Node
+	if pointer == "" {
+		return node, nil
+	}
+	for _, seg := range strings.Split(pointer[1:], "/") {
+		seg = strings.ReplaceAll(strings.ReplaceAll(seg, "~1", "/"), "~0", "~")
+		switch node.Kind {
+		case yaml.MappingNode:
+			found := false
+			for i := 0; i < len(node.Content)-1; i += 2 {
+				if node.Content[i].Value == seg {
+					key, node, found = node.Content[i], resolveAliasNode(node.Content[i+1]), true
+					break
+				}
+			}
+			if !found {
+				return node, nil
+			}
+		case yaml.SequenceNode:
+			i, err := strconv.Atoi(seg)
+			if err != nil || i < 0 || i >= len(node.Content) {
+				return node, nil
+			}
+			key, node = nil, resolveAliasNode(node.Content[i])
+		default:
+			return node, nil
+		}
+	}
+	return node, key
+}
+
+// nodeNumber extracts a float64 from a numeric scalar node.
+func nodeNumber(node *yaml.Node) (float64, bool) {
+	if node.Kind != yaml.ScalarNode {
+		return 0, false
+	}
+	switch node.ShortTag() {
+	case "!!int":
+		if i, err := strconv.ParseInt(strings.ReplaceAll(node.Value, "_", ""), 0, 64); err == nil {
+			return float64(i), true
+		}
+		var f float64
+		if node.Decode(&f) == nil {
+			return f, true
+		}
+	case "!!float":
+		var f float64
+		if node.Decode(&f) == nil {
+			return f, true
+		}
+	}
+	return 0, false
+}
+
+// nodeToJSONValue converts a yaml.Node into a value that mirrors what encoding/json would produce, so values can
+// be compared against enums and consts in a schema.
+func nodeToJSONValue(node *yaml.Node) any {
+	node = resolveAliasNode(node)
+	switch node.Kind {
+	case yaml.MappingNode:
+		m := make(map[string]any)
+		for i := 0; i < len(node.Content)-1; i += 2 {
+			m[node.Content[i].Value] = nodeToJSONValue(node.Content[i+1])
+		}
+		return m
+	case yaml.SequenceNode:
+		s := make([]any, len(node.Content))
+		for i := range node.Content {
+			s[i] = nodeToJSONValue(node.Content[i])
+		}
+		return s
+	case yaml.ScalarNode:
+		switch node.ShortTag() {
+		case "!!int", "!!float":
+			if n, ok := nodeNumber(node); ok {
+				return n
+			}
+		case "!!bool":
+			var b bool
+			_ = node.Decode(&b)
+			return b
+		case "!!null":
+			return nil
+		}
+		return node.Value
+	}
+	return nil
+}
+
+func resolveAliasNode(node *yaml.Node) *yaml.Node {
+	for node != nil && (node.Kind == yaml.AliasNode || node.Kind == yaml.DocumentNode) {
+		if node.Kind == yaml.AliasNode {
+			node = node.Alias
+		} else {
+			if len(node.Content) == 0 {
+				return node
+			}
+			node = node.Content[0]
+		}
+	}
+	return node
+}
+
+func findMappingValue(node *yaml.Node, key string) *yaml.Node {
+	for i := 0; i < len(node.Content)-1; i += 2 {
+		if node.Content[i].Value == key {
+			return node.Content[i+1]
+		}
+	}
+	return nil
+}
+
+func escapePointerSegment(seg string) string {
+	return strings.ReplaceAll(strings.ReplaceAll(seg, "~", "~0"), "/", "~1")
+}
+
+func appendPointer(path, seg string) string {
+	return path + "/" + escapePointerSegment(seg)
+}
@@ -0,0 +1,229 @@
+// Copyright 2023 Princess B33f Heavy Industries / Dave Shanley
+// SPDX-License-Identifier: MIT
+
+package datamodel
+
+import (
+	"io/ioutil"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/yaml.v3"
+)
+
+func TestValidateSpecInfo_Valid(t *testing.T) {
+	for _, spec := range []string{"petstorev2.json", "petstorev3.json", "stripe.yaml", "xsoar.json"} {
+		data, _ := ioutil.ReadFile("../test_specs/" + spec)
+		info, err := ExtractSpecInfo(data)
+		assert.NoError(t, err)
+		errs, err := ValidateSpecInfo(info)
+		assert.NoError(t, err)
+		assert.Len(t, errs, 0, spec)
+	}
+}
+
+func TestValidateSpecInfo_OpenAPI3(t *testing.T) {
+	spec := `openapi: 3.0.3
+info:
+  title: pizza
+paths:
+  /pizza:
+    get:
+      responses:
+        "200":
+          description: ok
+        "600":
+          description: nope`
+
+	info, _ := ExtractSpecInfo([]byte(spec))
+	errs, err := ValidateSpecInfo(info)
+	assert.NoError(t, err)
+	assert.Len(t, errs, 2)
+
+	assert.Equal(t, "/info", errs[0].Location)
+	assert.Equal(t, "required property 'version' is missing", errs[0].Message)
+	assert.Equal(t, "/definitions/Info/required", errs[0].SchemaLocation)
+	assert.Equal(t, 3, errs[0].Line)
+	assert.Equal(t, 3, errs[0].Column)
+
+	assert.Equal(t, "/paths/~1pizza/get/responses/600", errs[1].Location)
+	assert.Equal(t, 10, errs[1].Line)
+	assert.Equal(t, "/paths/~1pizza/get/responses/600: property '600' is not allowed (line 10, column 9)",
+		errs[1].Error())
+}
+
+func TestValidateSpecInfo_OpenAPI31(t *testing.T) {
+	spec := `openapi: 3.1.0
+info:
+  title: pizza
+  version: 1.0.0
+components:
+  securitySchemes:
+    JWT:
+      type: http
+      scheme: bearer
+      name: nope
+  schemas:
+    Pizza:
+      type: [string, "null"]
+paths:
+  /pizza:
+    get:
+      responses:
+        "200":
+          description: ok
+          content:
+            application/json:
+              schema: 12`
+
+	info, _ := ExtractSpecInfo([]byte(spec))
+	errs, err := ValidateSpecInfo(info)
+	assert.NoError(t, err)
+	assert.Len(t, errs, 2)
+
+	// the keywords next to a $ref are used, so properties not defined there or by the $ref are not allowed.
+	assert.Equal(t, "/components/securitySchemes/JWT/name", errs[0].Location)
+	assert.Equal(t, "property 'name' has not been evaluated and is not allowed", errs[0].Message)
+	assert.Equal(t, "/$defs/security-scheme/unevaluatedProperties", errs[0].SchemaLocation)
+	assert.Equal(t, 10, errs[0].Line)
+
+	// a schema is found using the $dynamicRef to the 'meta' anchor.
+	assert.Equal(t, "/paths/~1pizza/get/responses/200/content/application~1json/schema", errs[1].Location)
+	assert.Equal(t, "expected type 'object' or 'boolean', got 'integer'", errs[1].Message)
+	assert.Equal(t, "/$defs/schema/type", errs[1].SchemaLocation)
+}
+
+func TestValidateSpecInfo_TimestampVersion(t *testing.T) {
+	// an unquoted date is a timestamp in YAML, but is still a string.
+	spec := `openapi: 3.0.3
+info:
+  title: pizza
+  version: 2023-01-01
+paths: {}`
+
+	info, _ := ExtractSpecInfo([]byte(spec))
+	errs, err := ValidateSpecInfo(info)
+	assert.NoError(t, err)
+	assert.Len(t, errs, 0)
+}
+
+func TestValidateSpecInfo_Swagger(t *testing.T) {
+	spec := `swagger: "2.0"
+info:
+  title: pizza
+  version: 1.0.0
+paths:
+  /pizza:
+    get:
+      parameters:
+        - name: size
+          in: query
+          type: float
+        - name: topping
+          in: query
+          type: string
+          maxLength: -1
+      responses:
+        200:
+          schema:
+            type: string`
+
+	info, _ := ExtractSpecInfo([]byte(spec))
+	errs, err := ValidateSpecInfo(info)
+	assert.NoError(t, err)
+	assert.Len(t, errs, 3)
+	assert.Equal(t, "/paths/~1pizza/get/parameters/0/type", errs[0].Location)
+	assert.Equal(t, 11, errs[0].Line)
+
+	// the draft-04 meta-schema is embedded, so references to it are followed.
+	assert.Equal(t, "/paths/~1pizza/get/parameters/1/maxLength", errs[1].Location)
+	assert.Equal(t, "http://json-schema.org/draft-04/schema#/definitions/positiveInteger/minimum",
+		errs[1].SchemaLocation)
+
+	assert.Equal(t, "/paths/~1pizza/get/responses/200", errs[2].Location)
+	assert.Equal(t, "required property 'description' is missing", errs[2].Message)
+}
+
+func TestValidateSpecInfo_NoSchema(t *testing.T) {
+	_, err := ValidateSpecInfo(nil)
+	assert.Error(t, err)
+
+	info, _ := ExtractSpecInfo([]byte(`asyncapi: 2.0.0`))
+	_, err = ValidateSpecInfo(info)
+	assert.Error(t, err)
+}
+
+func TestValidateNodeAgainstSchema(t *testing.T) {
+	schema := `{
+  "$schema": "https://json-schema.org/draft/2020-12/schema",
+  "type": "object",
+  "properties": {
+    "name": {"type": "string", "minLength": 2, "maxLength": 4},
+    "count": {"type": "integer", "minimum": 1, "exclusiveMaximum": 10, "multipleOf": 3},
+    "tags": {"type": "array", "uniqueItems": true, "maxItems": 2, "items": {"enum": ["a", "b", "c"]}},
+    "kind": {"const": "cake"}
+  },
+  "not": {"required": ["forbidden"]}
+}`
+	var node yaml.Node
+	_ = yaml.Unmarshal([]byte(`name: x
+count: 10
+tags: [a, a, d]
+kind: pie
+forbidden: true`), &node)
+
+	errs, err := ValidateNodeAgainstSchema(&node, schema)
+	assert.NoError(t, err)
+	assert.Len(t, errs, 8)
+
+	_, err = ValidateNodeAgainstSchema(&node, "{not json")
+	assert.Error(t, err)
+	_, err = ValidateNodeAgainstSchema(&node, "[]")
+	assert.Error(t, err)
+}
+
+func TestValidateNodeAgainstSchema_References(t *testing.T) {
+	schema := `{
+  "$schema": "https://json-schema.org/draft/2020-12/schema",
+  "type": "object",
+  "properties": {
+    "name": {"$ref": "#name"},
+    "size": {"$ref": "https://example.com/unknown.json#/size"},
+    "topping": {"$ref": "#/$defs/topping", "maxLength": 3}
+  },
+  "$defs": {
+    "name": {"$anchor": "name", "type": "string"},
+    "topping": {"type": "string", "minLength": 2}
+  }
+}`
+	var node yaml.Node
+	_ = yaml.Unmarshal([]byte(`name: 1
+size: anything
+topping: x`), &node)
+
+	errs, err := ValidateNodeAgainstSchema(&node, schema)
+	assert.NoError(t, err)
+	assert.Len(t, errs, 2)
+	assert.Equal(t, "/name", errs[0].Location)
+	assert.Equal(t, "/$defs/name/type", errs[0].SchemaLocation)
+	assert.Equal(t, "/topping", errs[1].Location)
+	assert.Equal(t, "/$defs/topping/minLength", errs[1].SchemaLocation)
+
+	_ = yaml.Unmarshal([]byte(`topping: pepperoni`), &node)
+	errs, _ = ValidateNodeAgainstSchema(&node, schema)
+	assert.Len(t, errs, 1)
+	assert.Equal(t, "/properties/topping/maxLength", errs[0].SchemaLocation)
+
+	// the schema is shared, so it can be used by many validations at once.
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			errs, _ := ValidateNodeAgainstSchema(&node, schema)
+			assert.Len(t, errs, 1)
+		}()
+	}
+	wg.Wait()
+}
@@ -14,11 +14,13 @@
 package libopenapi
 
 import (
+	"context"
 	"errors"
 	"fmt"
 
 	"github.com/pb33f/libopenapi/index"
 
+	"github.com/pb33f/libopenapi/bundler"
 	"github.com/pb33f/libopenapi/datamodel"
 	v2high "github.com/pb33f/libopenapi/datamodel/high/v2"
 	v3high "github.com/pb33f/libopenapi/datamodel/high/v3"
@@ -56,22 +58,60 @@ type Document interface {
 	// any other types.
 	BuildV3Model() (*DocumentModel[v3high.Document], []error)
 
+	// BuildV2ModelWithContext is the same as BuildV2Model, except building stops when the context is done. This
+	// includes indexing, fetching remote references and checking for circular references. If the context is done
+	// before the model is built, then a partially built model is returned (it's not retained by the document), and
+	// the error from the context is the last error in the slice.
+	BuildV2ModelWithContext(ctx context.Context) (*DocumentModel[v2high.Swagger], []error)
+
+	// BuildV3ModelWithContext is the same as BuildV3Model, except building stops when the context is done. This
+	// includes indexing, fetching remote references and checking for circular references. If the context is done
+	// before the model is built, then a partially built model is returned (it's not retained by the document), and
+	// the error from the context is the last error in the slice.
+	BuildV3ModelWithContext(ctx context.Context) (*DocumentModel[v3high.Document], []error)
+
 	// RenderAndReload will render the high level model as it currently exists (including any mutations, additions
 	// and removals to and from any object in the tree). It will then reload the low level model with the new bytes
 	// extracted from the model that was re-rendered. This is useful if you want to make changes to the high level model
 	// and then 'reload' the model into memory, so that line numbers and column numbers are correct and all update
 	// according to the changes made.
 	//
-	// The method returns the raw YAML bytes that were rendered, and any errors that occurred during rebuilding of the model.
+	// The method returns the raw YAML bytes that were rendered (or JSON, if KeepInputFormat is set in the configuration
+	// and the document was supplied as JSON), and any errors that occurred during rebuilding of the model.
 	// This is a destructive operation, and will re-build the entire model from scratch using the new bytes, so any
 	// references to the old model will be lost. The second return is the new Document that was created, and the third
 	// return is any errors hit trying to re-render.
 	//
-	// **IMPORTANT** This method only supports OpenAPI Documents. The Swagger model will not support mutations correctly
-	// and will not update when called. This choice has been made because we don't want to continue supporting Swagger,
-	// it's too old, so it should be motivation to upgrade to OpenAPI 3.
+	// Comments and the style of anything that has not changed (quotes, block scalars and flow style) are retained from
+	// the original YAML specification.
+	//
+	// **IMPORTANT** This method only supports OpenAPI Documents, use RenderAndReloadSwagger() for Swagger documents.
 	RenderAndReload() ([]byte, Document, *DocumentModel[v3high.Document], []error)
 
+	// RenderAndReloadSwagger is the Swagger (version 2) version of RenderAndReload. It will render the high level
+	// Swagger model as it currently exists (including any mutations, additions and removals), and then re-build the
+	// document and the model from the rendered bytes. The order of everything in the original specification is retained.
+	//
+	// The method returns the raw YAML (or JSON) bytes that were rendered, the new Document that was created, the new
+	// model and any errors that occurred during rebuilding of the model. BuildV2Model() must have been called first.
+	RenderAndReloadSwagger() ([]byte, Document, *DocumentModel[v2high.Swagger], []error)
+
+	// ValidateStructure will validate the document against the official OpenAPI JSON Schema for the version of the
+	// specification (Swagger 2.0, OpenAPI 3.0 or OpenAPI 3.1). Every violation found is returned as an error, each
+	// error is a *datamodel.SchemaValidationError that contains a JSON Pointer to the offending value as well as the
+	// line and column it was found on. If the document is valid, nil is returned.
+	ValidateStructure() []error
+
+	// Bundle will produce a single, self-contained specification from a specification that references external
+	// files or remote documents. Every external object is moved into the components of the document (or
+	// definitions, parameters and responses for Swagger) using a name that does not collide with existing
+	// components, and every $ref is re-written to point to it. Circular references are kept as references.
+	//
+	// File and remote references must be allowed by the DocumentConfiguration, and the BasePath should be set to
+	// the directory containing the specification. The bundled specification is returned as YAML, or JSON if the
+	// document was supplied as JSON.
+	Bundle() ([]byte, error)
+
 	// Serialize will re-render a Document back into a []byte slice. If any modifications have been made to the
 	// underlying data model using low level APIs, then those changes will be reflected in the serialized output.
 	//
@@ -122,6 +162,32 @@ func NewDocument(specByteArray []byte) (Document, error) {
 	return d, nil
 }
 
+// NewDocumentWithContext is the same as NewDocument, except it will stop waiting for the specification to be
+// parsed when the context is done, and the error from the context is returned. Parsing itself can't be cancelled, it
+// carries on in the background until it's finished and the result is thrown away. Use the context with
+// BuildV2ModelWithContext or BuildV3ModelWithContext to stop building the model, which is where references are
+// looked up and resolved.
+func NewDocumentWithContext(ctx context.Context, specByteArray []byte) (Document, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	type parsed struct {
+		doc Document
+		err error
+	}
+	done := make(chan parsed, 1)
+	go func() {
+		d, err := NewDocument(specByteArray)
+		done <- parsed{d, err}
+	}()
+	select {
+	case p := <-done:
+		return p.doc, p.err
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
 // NewDocumentWithConfiguration is the same as NewDocument, except it's a convenience function that calls NewDocument
 // under the hood and then calls SetConfiguration() on the returned Document.
 func NewDocumentWithConfiguration(specByteArray []byte, configuration *datamodel.DocumentConfiguration) (Document, error) {
@@ -151,16 +217,36 @@ func (d *document) Serialize() ([]byte, error) {
 	if d.info.SpecFileType == datamodel.YAMLFileType {
 		return yaml.Marshal(d.info.RootNode)
 	} else {
-		yamlData, _ := yaml.Marshal(d.info.RootNode)
-		return utils.ConvertYAMLtoJSON(yamlData)
+		return utils.ConvertYAMLNodeToJSON(d.info.RootNode, "")
+	}
+}
+
+// render will render a high-level model as YAML, unless the input format is being kept and the document is JSON.
+func (d *document) render(model interface {
+	Render() ([]byte, error)
+	RenderJSON(indent string) ([]byte, error)
+}) ([]byte, error) {
+	if d.config != nil && d.config.KeepInputFormat && d.info.SpecFileType == datamodel.JSONFileType {
+		return model.RenderJSON("  ")
+	}
+	return model.Render()
+}
+
+func (d *document) Bundle() ([]byte, error) {
+	if d.info == nil || d.info.SpecBytes == nil {
+		return nil, fmt.Errorf("unable to bundle, document has not yet been initialized")
 	}
+	return bundler.BundleBytes(*d.info.SpecBytes, d.config)
 }
 
 func (d *document) RenderAndReload() ([]byte, Document, *DocumentModel[v3high.Document], []error) {
 	if d.highSwaggerModel != nil && d.highOpenAPI3Model == nil {
 		return nil, nil, nil, []error{errors.New("this method only supports OpenAPI 3 documents, not Swagger")}
 	}
-	newBytes, err := d.highOpenAPI3Model.Model.Render()
+	if d.highOpenAPI3Model == nil {
+		return nil, nil, nil, []error{errors.New("unable to render, the model has not been built, call BuildV3Model() first")}
+	}
+	newBytes, err := d.render(&d.highOpenAPI3Model.Model)
 	if err != nil {
 		return newBytes, nil, nil, []error{err}
 	}
@@ -177,7 +263,45 @@ func (d *document) RenderAndReload() ([]byte, Document, *DocumentModel[v3high.Do
 	return newBytes, newDoc, model, nil
 }
 
+func (d *document) RenderAndReloadSwagger() ([]byte, Document, *DocumentModel[v2high.Swagger], []error) {
+	if d.highOpenAPI3Model != nil && d.highSwaggerModel == nil {
+		return nil, nil, nil, []error{errors.New("this method only supports Swagger documents, not OpenAPI 3")}
+	}
+	if d.highSwaggerModel == nil {
+		return nil, nil, nil, []error{errors.New("unable to render, the model has not been built, call BuildV2Model() first")}
+	}
+	newBytes, err := d.render(&d.highSwaggerModel.Model)
+	if err != nil {
+		return newBytes, nil, nil, []error{err}
+	}
+	newDoc, err := NewDocumentWithConfiguration(newBytes, d.config)
+	if err != nil {
+		return newBytes, newDoc, nil, []error{err}
+	}
+	model, errs := newDoc.BuildV2Model()
+	if errs != nil {
+		return newBytes, newDoc, model, errs
+	}
+	return newBytes, newDoc, model, nil
+}
+
+func (d *document) ValidateStructure() []error {
+	violations, err := datamodel.ValidateSpecInfo(d.info)
+	if err != nil {
+		return []error{err}
+	}
+	var errs []error
+	for i := range violations {
+		errs = append(errs, violations[i])
+	}
+	return errs
+}
+
 func (d *document) BuildV2Model() (*DocumentModel[v2high.Swagger], []error) {
+	return d.BuildV2ModelWithContext(context.Background())
+}
+
+func (d *document) BuildV2ModelWithContext(ctx context.Context) (*DocumentModel[v2high.Swagger], []error) {
 	if d.highSwaggerModel != nil {
 		return d.highSwaggerModel, nil
 	}
@@ -200,7 +324,14 @@ func (d *document) BuildV2Model() (*DocumentModel[v2high.Swagger], []error) {
 		}
 	}
 
-	lowDoc, errors = v2low.CreateDocumentFromConfig(d.info, d.config)
+	lowDoc, errors = v2low.CreateDocumentFromConfigWithContext(ctx, d.info, d.config)
+	if ctx.Err() != nil {
+		// the model is incomplete, so it's returned, but not kept.
+		return &DocumentModel[v2high.Swagger]{
+			Model: *v2high.NewSwaggerDocument(lowDoc),
+			Index: lowDoc.Index,
+		}, errors
+	}
 	// Do not short-circuit on circular reference errors, so the client
 	// has the option of ignoring them.
 	for _, err := range errors {
@@ -221,6 +352,10 @@ func (d *document) BuildV2Model() (*DocumentModel[v2high.Swagger], []error) {
 }
 
 func (d *document) BuildV3Model() (*DocumentModel[v3high.Document], []error) {
+	return d.BuildV3ModelWithContext(context.Background())
+}
+
+func (d *document) BuildV3ModelWithContext(ctx context.Context) (*DocumentModel[v3high.Document], []error) {
 	if d.highOpenAPI3Model != nil {
 		return d.highOpenAPI3Model, nil
 	}
@@ -243,7 +378,14 @@ func (d *document) BuildV3Model() (*DocumentModel[v3high.Document], []error) {
 		}
 	}
 
-	lowDoc, errors = v3low.CreateDocumentFromConfig(d.info, d.config)
+	lowDoc, errors = v3low.CreateDocumentFromConfigWithContext(ctx, d.info, d.config)
+	if lowDoc != nil && ctx.Err() != nil {
+		// the model is incomplete, so it's returned, but not kept.
+		return &DocumentModel[v3high.Document]{
+			Model: *v3high.NewDocument(lowDoc),
+			Index: lowDoc.Index,
+		}, errors
+	}
 	// Do not short-circuit on circular reference errors, so the client
 	// has the option of ignoring them.
 	for _, err := range errors {
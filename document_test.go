@@ -3,15 +3,19 @@
 package libopenapi
 
 import (
+	"context"
 	"fmt"
 	"github.com/pb33f/libopenapi/datamodel"
 	"github.com/pb33f/libopenapi/datamodel/high/base"
+	"github.com/pb33f/libopenapi/index"
+	"github.com/pb33f/libopenapi/orderedmap"
 	"github.com/pb33f/libopenapi/what-changed/model"
 	"github.com/stretchr/testify/assert"
 	"io/ioutil"
 	"os"
 	"strings"
 	"testing"
+	"testing/fstest"
 )
 
 func TestLoadDocument_Simple_V2(t *testing.T) {
@@ -245,39 +249,155 @@ func TestDocument_RenderAndReload(t *testing.T) {
 
 	// mutate the model
 	h := m.Model
-	h.Paths.PathItems["/pet/findByStatus"].Get.OperationId = "findACakeInABakery"
-	h.Paths.PathItems["/pet/findByStatus"].Get.Responses.Codes["400"].Description = "a nice bucket of mice"
-	h.Paths.PathItems["/pet/findByTags"].Get.Tags =
-		append(h.Paths.PathItems["/pet/findByTags"].Get.Tags, "gurgle", "giggle")
+	h.Paths.PathItems.GetOrZero("/pet/findByStatus").Get.OperationId = "findACakeInABakery"
+	h.Paths.PathItems.GetOrZero("/pet/findByStatus").Get.Responses.Codes.GetOrZero("400").Description = "a nice bucket of mice"
+	h.Paths.PathItems.GetOrZero("/pet/findByTags").Get.Tags =
+		append(h.Paths.PathItems.GetOrZero("/pet/findByTags").Get.Tags, "gurgle", "giggle")
 
-	h.Paths.PathItems["/pet/{petId}"].Delete.Security = append(h.Paths.PathItems["/pet/{petId}"].Delete.Security,
-		&base.SecurityRequirement{Requirements: map[string][]string{
-			"pizza-and-cake": {"read:abook", "write:asong"},
-		}})
+	h.Paths.PathItems.GetOrZero("/pet/{petId}").Delete.Security = append(h.Paths.PathItems.GetOrZero("/pet/{petId}").Delete.Security,
+		&base.SecurityRequirement{Requirements: orderedmap.FromPairs(
+			orderedmap.Pair[string, []string]{Key: "pizza-and-cake", Value: []string{"read:abook", "write:asong"}},
+		)})
 
-	h.Components.Schemas["Order"].Schema().Properties["status"].Schema().Example = "I am a teapot, filled with love."
-	h.Components.SecuritySchemes["petstore_auth"].Flows.Implicit.AuthorizationUrl = "https://pb33f.io"
+	h.Components.Schemas.GetOrZero("Order").Schema().Properties.GetOrZero("status").Schema().Example = "I am a teapot, filled with love."
+	h.Components.SecuritySchemes.GetOrZero("petstore_auth").Flows.Implicit.AuthorizationUrl = "https://pb33f.io"
 
 	bytes, _, newDocModel, e := doc.RenderAndReload()
 	assert.Nil(t, e)
 	assert.NotNil(t, bytes)
 
 	h = newDocModel.Model
-	assert.Equal(t, "findACakeInABakery", h.Paths.PathItems["/pet/findByStatus"].Get.OperationId)
+	assert.Equal(t, "findACakeInABakery", h.Paths.PathItems.GetOrZero("/pet/findByStatus").Get.OperationId)
 	assert.Equal(t, "a nice bucket of mice",
-		h.Paths.PathItems["/pet/findByStatus"].Get.Responses.Codes["400"].Description)
-	assert.Len(t, h.Paths.PathItems["/pet/findByTags"].Get.Tags, 3)
+		h.Paths.PathItems.GetOrZero("/pet/findByStatus").Get.Responses.Codes.GetOrZero("400").Description)
+	assert.Len(t, h.Paths.PathItems.GetOrZero("/pet/findByTags").Get.Tags, 3)
 
-	assert.Len(t, h.Paths.PathItems["/pet/findByTags"].Get.Tags, 3)
-	yu := h.Paths.PathItems["/pet/{petId}"].Delete.Security
-	assert.Equal(t, "read:abook", yu[len(yu)-1].Requirements["pizza-and-cake"][0])
+	assert.Len(t, h.Paths.PathItems.GetOrZero("/pet/findByTags").Get.Tags, 3)
+	yu := h.Paths.PathItems.GetOrZero("/pet/{petId}").Delete.Security
+	assert.Equal(t, "read:abook", yu[len(yu)-1].Requirements.GetOrZero("pizza-and-cake")[0])
 	assert.Equal(t, "I am a teapot, filled with love.",
-		h.Components.Schemas["Order"].Schema().Properties["status"].Schema().Example)
+		h.Components.Schemas.GetOrZero("Order").Schema().Properties.GetOrZero("status").Schema().Example)
 
 	assert.Equal(t, "https://pb33f.io",
-		h.Components.SecuritySchemes["petstore_auth"].Flows.Implicit.AuthorizationUrl)
+		h.Components.SecuritySchemes.GetOrZero("petstore_auth").Flows.Implicit.AuthorizationUrl)
 
 }
+
+func TestDocument_RenderAndReload_RetainFormatting(t *testing.T) {
+	spec := `# burgers are good.
+openapi: 3.1.0
+info:
+  # the title was picked by a committee.
+  title: 'Burger Shop' # keep it quoted
+  version: "1.0"
+  description: |
+    all about
+    burgers
+paths:
+  # the original path
+  /burgers:
+    get:
+      tags: [burgers, food] # flow
+      responses:
+        "200": # it worked
+          description: ok
+components:
+  schemas:
+    Burger:
+      type: object
+      required: [name]
+      properties:
+        # every burger has a name
+        name:
+          type: string
+
+# that's all folks.`
+
+	doc, err := NewDocument([]byte(spec))
+	assert.NoError(t, err)
+	m, _ := doc.BuildV3Model()
+
+	// mutate the model, new values are added to the bottom and everything else is retained.
+	m.Model.Info.Version = "1.1"
+	m.Model.Paths.PathItems.GetOrZero("/burgers").Get.Summary = "list burgers"
+
+	rendered, _, _, errs := doc.RenderAndReload()
+	assert.Empty(t, errs)
+
+	expected := `# burgers are good.
+openapi: 3.1.0
+info:
+    # the title was picked by a committee.
+    title: 'Burger Shop' # keep it quoted
+    version: "1.1"
+    description: |
+        all about
+        burgers
+paths:
+    # the original path
+    /burgers:
+        get:
+            tags: [burgers, food] # flow
+            responses:
+                "200": # it worked
+                    description: ok
+            summary: list burgers
+components:
+    schemas:
+        Burger:
+            type: object
+            required: [name]
+            properties:
+                # every burger has a name
+                name:
+                    type: string
+
+# that's all folks.
+`
+	assert.Equal(t, expected, string(rendered))
+
+	// the formatting of JSON is not retained, JSON is rendered as regular YAML.
+	petstore, _ := ioutil.ReadFile("test_specs/petstorev3.json")
+	doc, _ = NewDocument(petstore)
+	_, _ = doc.BuildV3Model()
+	rendered, _, _, errs = doc.RenderAndReload()
+	assert.Empty(t, errs)
+	assert.Contains(t, string(rendered), "\ninfo:\n    title: Swagger Petstore - OpenAPI 3.0\n")
+}
+func TestDocument_RenderAndReload_KeepInputFormat(t *testing.T) {
+	petstore, _ := ioutil.ReadFile("test_specs/petstorev3.json")
+	doc, _ := NewDocumentWithConfiguration(petstore, &datamodel.DocumentConfiguration{KeepInputFormat: true})
+	m, _ := doc.BuildV3Model()
+	m.Model.Info.Title = "Pizza Petstore"
+
+	rendered, newDoc, newModel, errs := doc.RenderAndReload()
+	assert.Empty(t, errs)
+	assert.True(t, strings.HasPrefix(string(rendered), "{\n  \"openapi\": \"3.0.2\",\n  \"info\": {\n"))
+	assert.Equal(t, datamodel.JSONFileType, newDoc.GetSpecInfo().SpecFileType)
+	assert.Equal(t, "Pizza Petstore", newModel.Model.Info.Title)
+
+	// the format is kept for the new document too.
+	rendered, _, _, errs = newDoc.RenderAndReload()
+	assert.Empty(t, errs)
+	assert.True(t, strings.HasPrefix(string(rendered), "{\n"))
+
+	// swagger documents keep their format as well.
+	petstore, _ = ioutil.ReadFile("test_specs/petstorev2.json")
+	doc, _ = NewDocumentWithConfiguration(petstore, &datamodel.DocumentConfiguration{KeepInputFormat: true})
+	_, _ = doc.BuildV2Model()
+	rendered, newDoc, _, errs = doc.RenderAndReloadSwagger()
+	assert.Empty(t, errs)
+	assert.True(t, strings.HasPrefix(string(rendered), "{\n  \"swagger\": \"2.0\",\n"))
+	assert.Equal(t, datamodel.JSONFileType, newDoc.GetSpecInfo().SpecFileType)
+
+	// YAML documents are rendered as YAML.
+	burgers, _ := ioutil.ReadFile("test_specs/burgershop.openapi.yaml")
+	doc, _ = NewDocumentWithConfiguration(burgers, &datamodel.DocumentConfiguration{KeepInputFormat: true})
+	_, _ = doc.BuildV3Model()
+	rendered, _, _, _ = doc.RenderAndReload()
+	assert.True(t, strings.HasPrefix(string(rendered), "openapi: 3.1.0\n"))
+}
+
 func TestDocument_RenderAndReload_Swagger(t *testing.T) {
 	petstore, _ := ioutil.ReadFile("test_specs/petstorev2.json")
 	doc, _ := NewDocument(petstore)
@@ -289,6 +409,51 @@ func TestDocument_RenderAndReload_Swagger(t *testing.T) {
 
 }
 
+func TestDocument_RenderAndReloadSwagger(t *testing.T) {
+	petstore, _ := ioutil.ReadFile("test_specs/petstorev2.json")
+	doc, _ := NewDocument(petstore)
+	m, _ := doc.BuildV2Model()
+
+	// mutate the model
+	h := m.Model
+	h.Paths.PathItems.GetOrZero("/pet/findByStatus").Get.OperationId = "findACakeInABakery"
+	h.Paths.PathItems.GetOrZero("/pet/findByStatus").Get.Responses.Codes.GetOrZero("400").Description = "a nice bucket of mice"
+	h.Paths.PathItems.GetOrZero("/pet/findByTags").Get.Tags =
+		append(h.Paths.PathItems.GetOrZero("/pet/findByTags").Get.Tags, "gurgle", "giggle")
+	h.Definitions.Definitions.GetOrZero("Order").Schema().Properties.GetOrZero("status").Schema().Example = "I am a teapot"
+	h.SecurityDefinitions.Definitions.GetOrZero("petstore_auth").Scopes.Values.Set("eat:pets", "eat all the pets")
+
+	bytes, _, newDocModel, e := doc.RenderAndReloadSwagger()
+	assert.Nil(t, e)
+	assert.NotNil(t, bytes)
+
+	h = newDocModel.Model
+	assert.Equal(t, "findACakeInABakery", h.Paths.PathItems.GetOrZero("/pet/findByStatus").Get.OperationId)
+	assert.Equal(t, "a nice bucket of mice",
+		h.Paths.PathItems.GetOrZero("/pet/findByStatus").Get.Responses.Codes.GetOrZero("400").Description)
+	assert.Len(t, h.Paths.PathItems.GetOrZero("/pet/findByTags").Get.Tags, 3)
+	assert.Equal(t, "I am a teapot", h.Definitions.Definitions.GetOrZero("Order").Schema().Properties.GetOrZero("status").Schema().Example)
+	assert.Equal(t, "eat all the pets", h.SecurityDefinitions.Definitions.GetOrZero("petstore_auth").Scopes.Values.GetOrZero("eat:pets"))
+
+	// the order of the original specification is retained.
+	rendered := string(bytes)
+	assert.Less(t, strings.Index(rendered, "/pet/{petId}/uploadImage:"), strings.Index(rendered, "/pet/findByStatus:"))
+	assert.Less(t, strings.Index(rendered, "read:pets:"), strings.Index(rendered, "eat:pets:"))
+}
+
+func TestDocument_RenderAndReloadSwagger_OpenAPI(t *testing.T) {
+	petstore, _ := ioutil.ReadFile("test_specs/petstorev3.json")
+	doc, _ := NewDocument(petstore)
+	_, _, _, e := doc.RenderAndReloadSwagger()
+	assert.Len(t, e, 1)
+	assert.Equal(t, "unable to render, the model has not been built, call BuildV2Model() first", e[0].Error())
+
+	doc.BuildV3Model()
+	_, _, _, e = doc.RenderAndReloadSwagger()
+	assert.Len(t, e, 1)
+	assert.Equal(t, "this method only supports Swagger documents, not OpenAPI 3", e[0].Error())
+}
+
 func TestDocument_BuildModelPreBuild(t *testing.T) {
 	petstore, _ := ioutil.ReadFile("test_specs/petstorev3.json")
 	doc, _ := NewDocument(petstore)
@@ -322,7 +487,7 @@ func TestDocument_Serialize_JSON_Modified(t *testing.T) {
  }
 }
 `
-	jsonModified := `{"info":{"title":"The magic API - but now, altered!"},"openapi":"3.0"}`
+	jsonModified := `{"openapi":"3.0","info":{"title":"The magic API - but now, altered!"}}`
 	doc, _ := NewDocument([]byte(json))
 
 	v3Doc, _ := doc.BuildV3Model()
@@ -363,7 +528,7 @@ paths:
 	}
 
 	// extract operation.
-	operation := result.Model.Paths.PathItems["/something"].Get
+	operation := result.Model.Paths.PathItems.GetOrZero("/something").Get
 
 	// print it out.
 	fmt.Printf("param1: %s, is reference? %t, original reference %s",
@@ -441,16 +606,16 @@ func TestSchemaRefIsFollowed(t *testing.T) {
 
 	// get a count of the number of paths and schemas.
 	schemas := v3Model.Model.Components.Schemas
-	assert.Equal(t, 4, len(schemas))
+	assert.Equal(t, 4, schemas.Len())
 
-	fp := schemas["FP"]
-	fbsref := schemas["FBSRef"]
+	fp := schemas.GetOrZero("FP")
+	fbsref := schemas.GetOrZero("FBSRef")
 
 	assert.Equal(t, fp.Schema().Pattern, fbsref.Schema().Pattern)
 	assert.Equal(t, fp.Schema().Example, fbsref.Schema().Example)
 
-	byte := schemas["Byte"]
-	uint64 := schemas["UInt64"]
+	byte := schemas.GetOrZero("Byte")
+	uint64 := schemas.GetOrZero("UInt64")
 
 	assert.Equal(t, uint64.Schema().Format, byte.Schema().Format)
 	assert.Equal(t, uint64.Schema().Type, byte.Schema().Type)
@@ -566,3 +731,178 @@ paths:
 
 	assert.Equal(t, d, strings.TrimSpace(string(rend)))
 }
+
+func TestDocument_ValidateStructure(t *testing.T) {
+	petstore, _ := ioutil.ReadFile("test_specs/petstorev3.json")
+	doc, err := NewDocument(petstore)
+	assert.NoError(t, err)
+	assert.Nil(t, doc.ValidateStructure())
+}
+
+func TestDocument_ValidateStructure_Invalid(t *testing.T) {
+	yml := `openapi: 3.0.3
+info:
+  title: pizza
+paths: {}`
+	doc, err := NewDocument([]byte(yml))
+	assert.NoError(t, err)
+	errs := doc.ValidateStructure()
+	assert.Len(t, errs, 1)
+	violation := errs[0].(*datamodel.SchemaValidationError)
+	assert.Equal(t, "/info", violation.Location)
+	assert.Equal(t, 3, violation.Line)
+}
+
+func TestDocument_ValidateStructure_NoSchema(t *testing.T) {
+	doc, _ := NewDocument([]byte(`asyncapi: 2.0.0`))
+	assert.Len(t, doc.ValidateStructure(), 1)
+}
+
+func TestDocument_Bundle(t *testing.T) {
+	spec, _ := ioutil.ReadFile("test_specs/bundle/openapi.yaml")
+	doc, err := NewDocumentWithConfiguration(spec, &datamodel.DocumentConfiguration{
+		BasePath:            "test_specs/bundle",
+		AllowFileReferences: true,
+	})
+	assert.NoError(t, err)
+
+	bundled, err := doc.Bundle()
+	assert.NoError(t, err)
+
+	// the bundled document is self-contained, so it builds without any file references.
+	bundledDoc, err := NewDocument(bundled)
+	assert.NoError(t, err)
+	v3Doc, errs := bundledDoc.BuildV3Model()
+	assert.Empty(t, errs)
+	assert.Equal(t, "listBurgers", v3Doc.Model.Paths.PathItems.GetOrZero("/burgers").Get.OperationId)
+	assert.Equal(t, 5, v3Doc.Model.Components.Schemas.Len())
+	assert.Equal(t, "#/components/schemas/burger_1",
+		v3Doc.Model.Paths.PathItems.GetOrZero("/burgers").Get.Responses.Codes.GetOrZero("200").Content.GetOrZero("application/json").Schema.Schema().
+			Items.A.GetReference())
+}
+
+func TestDocument_BuildV3Model_FS(t *testing.T) {
+	spec, _ := ioutil.ReadFile("test_specs/bundle/openapi.yaml")
+	doc, err := NewDocumentWithConfiguration(spec, &datamodel.DocumentConfiguration{
+		FS:                  os.DirFS("test_specs"),
+		BasePath:            "bundle",
+		AllowFileReferences: true,
+	})
+	assert.NoError(t, err)
+
+	v3Doc, errs := doc.BuildV3Model()
+	assert.Empty(t, errs)
+	assert.Equal(t, "listBurgers", v3Doc.Model.Paths.PathItems.GetOrZero("/burgers").Get.OperationId)
+
+	bundled, err := doc.Bundle()
+	assert.NoError(t, err)
+	assert.NotContains(t, string(bundled), "burger.yaml")
+}
+
+func TestDocument_BuildV3ModelWithContext(t *testing.T) {
+	petstore, _ := ioutil.ReadFile("test_specs/petstorev3.json")
+	doc, err := NewDocumentWithContext(context.Background(), petstore)
+	assert.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	partial, errs := doc.BuildV3ModelWithContext(ctx)
+	assert.NotNil(t, partial)
+	assert.NotNil(t, partial.Index)
+	assert.ErrorIs(t, errs[len(errs)-1], context.Canceled)
+
+	// the partial model is not kept.
+	v3Doc, errs := doc.BuildV3ModelWithContext(context.Background())
+	assert.Empty(t, errs)
+	assert.Equal(t, "Swagger Petstore - OpenAPI 3.0", v3Doc.Model.Info.Title)
+}
+
+func TestDocument_BuildV2ModelWithContext(t *testing.T) {
+	petstore, _ := ioutil.ReadFile("test_specs/petstorev2.json")
+	doc, _ := NewDocument(petstore)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	partial, errs := doc.BuildV2ModelWithContext(ctx)
+	assert.NotNil(t, partial)
+	assert.ErrorIs(t, errs[len(errs)-1], context.Canceled)
+
+	v2Doc, errs := doc.BuildV2ModelWithContext(context.Background())
+	assert.Empty(t, errs)
+	assert.Equal(t, "Swagger Petstore", v2Doc.Model.Info.Title)
+}
+
+func TestNewDocumentWithContext_Cancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	doc, err := NewDocumentWithContext(ctx, []byte("openapi: 3.1.0"))
+	assert.Nil(t, doc)
+	assert.ErrorIs(t, err, context.Canceled)
+}
+
+func TestDocument_BuildV3Model_ReferenceLimits(t *testing.T) {
+	spec := `openapi: 3.1.0
+components:
+  schemas:
+    Pet:
+      $ref: 'https://evil.example.com/pets.yaml#/Pet'`
+
+	doc, _ := NewDocumentWithConfiguration([]byte(spec), &datamodel.DocumentConfiguration{
+		AllowRemoteReferences: true,
+		ReferenceLimits:       &index.ReferenceLimits{AllowedHosts: []string{"pb33f.io"}},
+	})
+	v3Doc, errs := doc.BuildV3Model()
+	assert.Nil(t, v3Doc)
+	assert.NotEmpty(t, errs)
+	assert.ErrorIs(t, errs[0], index.ErrHostNotAllowed)
+}
+
+func TestDocument_BuildV3Model_RemoteFetcher(t *testing.T) {
+	spec := `openapi: 3.1.0
+components:
+  schemas:
+    Pet:
+      $ref: 'https://registry.pb33f.io/pets.yaml#/Pet'`
+
+	doc, _ := NewDocumentWithConfiguration([]byte(spec), &datamodel.DocumentConfiguration{
+		AllowRemoteReferences: true,
+		RemoteFetcher: index.RemoteFetcherFunc(func(ctx context.Context, location string) ([]byte, error) {
+			return []byte("Pet:\n  type: object\n  description: a pet"), nil
+		}),
+	})
+	v3Doc, errs := doc.BuildV3Model()
+	assert.Empty(t, errs)
+	assert.Equal(t, "a pet", v3Doc.Model.Components.Schemas.GetOrZero("Pet").Schema().Description)
+}
+
+func TestDocument_BuildV2Model_FS(t *testing.T) {
+	spec := `swagger: "2.0"
+info:
+  title: pets
+  version: "1"
+paths:
+  /pets:
+    get:
+      responses:
+        "200":
+          description: ok
+          schema:
+            $ref: 'models.yaml#/Pet'`
+
+	files := fstest.MapFS{
+		"models.yaml": {Data: []byte("Pet:\n  type: object\n  description: a pet")},
+	}
+	doc, _ := NewDocumentWithConfiguration([]byte(spec), &datamodel.DocumentConfiguration{
+		FS:                  files,
+		AllowFileReferences: true,
+	})
+	v2Doc, errs := doc.BuildV2Model()
+	assert.Empty(t, errs)
+	assert.Equal(t, "a pet",
+		v2Doc.Model.Paths.PathItems.GetOrZero("/pets").Get.Responses.Codes.GetOrZero("200").Schema.Schema().Description)
+}
+
+func TestDocument_Bundle_NotInitialized(t *testing.T) {
+	_, err := new(document).Bundle()
+	assert.Error(t, err)
+}
@@ -14,7 +14,7 @@ import (
 // ExtractRefs will return a deduplicated slice of references for every unique ref found in the document.
 // The total number of refs, will generally be much higher, you can extract those from GetRawReferenceCount()
 func (index *SpecIndex) ExtractRefs(node, parent *yaml.Node, seenPath []string, level int, poly bool, pName string) []*Reference {
-    if node == nil {
+    if node == nil || index.isCancelled() {
         return nil
     }
     var found []*Reference
@@ -95,7 +95,8 @@ func (index *SpecIndex) ExtractRefs(node, parent *yaml.Node, seenPath []string,
                 }
             }
 
-            if i%2 == 0 && n.Value == "$ref" {
+            // a '$ref' in a sequence (like a list of required properties) is a value, not a reference.
+            if i%2 == 0 && n.Value == "$ref" && node.Kind == yaml.MappingNode {
 
                 // only look at scalar values, not maps (looking at you k8s)
                 if !utils.IsNodeStringValue(node.Content[i+1]) {
@@ -336,7 +337,7 @@ func (index *SpecIndex) ExtractRefs(node, parent *yaml.Node, seenPath []string,
             if i < len(node.Content)-1 {
                 next := node.Content[i+1]
 
-                if i%2 != 0 && next != nil && !utils.IsNodeArray(next) && !utils.IsNodeMap(next) {
+                if i%2 != 0 && len(seenPath) > 0 && next != nil && !utils.IsNodeArray(next) && !utils.IsNodeMap(next) {
                     seenPath = seenPath[:len(seenPath)-1]
                 }
             }
@@ -376,7 +377,7 @@ func (index *SpecIndex) ExtractComponentsFromRefs(refs []*Reference) []*Referenc
                 }
             }
             index.refLock.Unlock()
-        } else {
+        } else if !index.isCancelled() { // cancelled lookups are not errors, the context error is returned instead.
 
             _, path := utils.ConvertComponentIdIntoFriendlyPathSearch(ref.Definition)
             indexError := &IndexingError{
@@ -4,17 +4,19 @@
 package index
 
 import (
+    "errors"
     "fmt"
     "github.com/pb33f/libopenapi/utils"
     "github.com/vmware-labs/yaml-jsonpath/pkg/yamlpath"
     "gopkg.in/yaml.v3"
-    "io/ioutil"
-    "net/http"
+    "io/fs"
     "net/url"
     "os"
+    "path"
     "path/filepath"
     "strings"
-    "time"
+    "sync"
+    "sync/atomic"
 )
 
 // FindComponent will locate a component by its reference, returns nil if nothing is found.
@@ -85,21 +87,24 @@ func (index *SpecIndex) FindComponent(componentId string, parent *yaml.Node) *Re
     return nil
 }
 
-var httpClient = &http.Client{Timeout: time.Duration(60) * time.Second}
+// remoteFetcher returns the RemoteFetcher configured for the index. If there isn't one, the fetcher created for the
+// ReferenceLimits is used, or a HTTPRemoteFetcher using the DefaultRemoteClient if there are no limits.
+func (index *SpecIndex) remoteFetcher() RemoteFetcher {
+    if index.config != nil && index.config.RemoteFetcher != nil {
+        return index.config.RemoteFetcher
+    }
+    if index.config != nil && index.config.limitedFetcher != nil {
+        return index.config.limitedFetcher
+    }
+    return NewHTTPRemoteFetcher(nil)
+}
 
-func getRemoteDoc(u string, d chan []byte, e chan error) {
-    resp, err := httpClient.Get(u)
-    if err != nil {
-        e <- err
-        close(e)
-        close(d)
-        return
+// limits returns the ReferenceLimits of the index, which may be nil.
+func (index *SpecIndex) limits() *ReferenceLimits {
+    if index.config == nil {
+        return nil
     }
-    var body []byte
-    body, _ = ioutil.ReadAll(resp.Body)
-    d <- body
-    close(e)
-    close(d)
+    return index.config.ReferenceLimits
 }
 
 func (index *SpecIndex) lookupRemoteReference(ref string) (*yaml.Node, *yaml.Node, error) {
@@ -113,43 +118,29 @@ func (index *SpecIndex) lookupRemoteReference(ref string) (*yaml.Node, *yaml.Nod
     if alreadySeen {
         parsedRemoteDocument = foundDocument
     } else {
-
-        d := make(chan bool)
-        var body []byte
-        var err error
-
-        go func(uri string) {
-            bc := make(chan []byte)
-            ec := make(chan error)
-            go getRemoteDoc(uri, bc, ec)
-            select {
-            case v := <-bc:
-                body = v
-                break
-            case er := <-ec:
-                err = er
-                break
-            }
-            var remoteDoc yaml.Node
-            er := yaml.Unmarshal(body, &remoteDoc)
-            if er != nil {
-                err = er
-                d <- true
-                return
-            }
-            parsedRemoteDocument = &remoteDoc
-            if index.config != nil {
-                index.config.seenRemoteSources.Store(uri, &remoteDoc)
-            }
-            d <- true
-        }(uri[0])
-
-        // wait for double go fun.
-        <-d
+        if err := index.limits().checkRemoteLocation(index.GetContext(), uri[0]); err != nil {
+            return nil, nil, err
+        }
+        body, err := index.remoteFetcher().FetchRemoteDocument(index.GetContext(), uri[0])
         if err != nil {
             // no bueno.
             return nil, nil, err
         }
+        // custom fetchers may not limit the size of what they fetch.
+        if err = index.limits().checkDocumentSize(uri[0], int64(len(body))); err != nil {
+            return nil, nil, err
+        }
+        var remoteDoc yaml.Node
+        if err = yaml.Unmarshal(body, &remoteDoc); err != nil {
+            return nil, nil, err
+        }
+        if err = index.limits().checkAliases(uri[0], &remoteDoc); err != nil {
+            return nil, nil, err
+        }
+        parsedRemoteDocument = &remoteDoc
+        if index.config != nil && index.config.seenRemoteSources != nil {
+            index.config.seenRemoteSources.Store(uri[0], &remoteDoc)
+        }
     }
 
     // lookup item from reference by using a path query.
@@ -193,9 +184,12 @@ func (index *SpecIndex) lookupFileReference(ref string) (*yaml.Node, *yaml.Node,
 
         // try and read the file off the local file system, if it fails
         // check for a baseURL and then ask our remote lookup function to go try and get it.
-        body, err := os.ReadFile(fileToRead)
+        body, err := index.readFile(fileToRead)
 
         if err != nil {
+            if errors.Is(err, ErrDocumentTooLarge) {
+                return nil, nil, err
+            }
 
             // if we have a baseURL, then we can try and get the file from there.
             if index.config != nil && index.config.BaseURL != nil {
@@ -220,6 +214,9 @@ func (index *SpecIndex) lookupFileReference(ref string) (*yaml.Node, *yaml.Node,
         if err != nil {
             return nil, nil, err
         }
+        if err = index.limits().checkAliases(fileToRead, &remoteDoc); err != nil {
+            return nil, nil, err
+        }
         parsedRemoteDocument = &remoteDoc
         if index.seenLocalSources != nil {
             index.sourceLock.Lock()
@@ -252,6 +249,33 @@ func (index *SpecIndex) lookupFileReference(ref string) (*yaml.Node, *yaml.Node,
     return nil, parsedRemoteDocument, nil
 }
 
+// readFile reads a file that has been referenced by the specification. If the index has been configured with
+// an fs.FS, then the file is read from it, otherwise it's read from the local file system.
+func (index *SpecIndex) readFile(location string) ([]byte, error) {
+    if l := index.limits(); l != nil && l.MaxDocumentSize > 0 {
+        size, err := index.statFile(location)
+        if err != nil {
+            return nil, err
+        }
+        if err = l.checkDocumentSize(location, size); err != nil {
+            return nil, err
+        }
+    }
+    if index.config == nil || index.config.FS == nil {
+        return os.ReadFile(location)
+    }
+    return fs.ReadFile(index.config.FS, fsPath(location))
+}
+
+// fsPath converts a location into an io/fs path, which are slash separated, and never rooted.
+func fsPath(location string) string {
+    p := strings.TrimPrefix(path.Clean(filepath.ToSlash(location)), "/")
+    if p == "" {
+        return "."
+    }
+    return p
+}
+
 func (index *SpecIndex) FindComponentInRoot(componentId string) *Reference {
     if index.root != nil {
 
@@ -285,13 +309,25 @@ func (index *SpecIndex) FindComponentInRoot(componentId string) *Reference {
 func (index *SpecIndex) performExternalLookup(uri []string, componentId string,
     lookupFunction ExternalLookupFunction, parent *yaml.Node,
 ) *Reference {
-    if len(uri) > 0 {
+    // nothing new is looked up once indexing has been cancelled.
+    if len(uri) > 0 && !index.isCancelled() {
         index.externalLock.RLock()
         externalSpecIndex := index.externalSpecIndex[uri[0]]
         index.externalLock.RUnlock()
 
+        // the document may have already been indexed somewhere else in the tree, this happens when files
+        // reference each other (circular file references), re-indexing would never end.
+        if externalSpecIndex == nil {
+            if seen := index.findSeenExternalIndex(uri[0]); seen != nil {
+                index.externalLock.Lock()
+                index.externalSpecIndex[uri[0]] = seen
+                index.externalLock.Unlock()
+                externalSpecIndex = seen
+            }
+        }
+
         if externalSpecIndex == nil {
-            _, newRoot, err := lookupFunction(componentId)
+            _, newRoot, err := index.lookupExternalDocument(lookupFunction, componentId)
             if err != nil {
                 indexError := &IndexingError{
                     Err:  err,
@@ -311,6 +347,9 @@ func (index *SpecIndex) performExternalLookup(uri []string, componentId string,
 
             if index.config.BaseURL != nil {
                 bp = index.config.BaseURL
+            } else if DetermineReferenceResolveType(uri[0]) == HttpResolve {
+                // an absolute remote reference doesn't need a base URL, the remote document is the base.
+                bp, _ = url.Parse(uri[0])
             }
             if index.config.BasePath != "" {
                 bd = index.config.BasePath
@@ -324,7 +363,8 @@ func (index *SpecIndex) performExternalLookup(uri []string, componentId string,
                 newUrl, _ = url.Parse(path)
                 newBasePath = filepath.Dir(filepath.Join(index.config.BasePath, filepath.Dir(newUrl.Path)))
             }
-            if bd != "" {
+            // an empty base path is the root of a file system.
+            if bd != "" || (bp == nil && index.config.FS != nil) {
                 newBasePath = filepath.Dir(filepath.Join(bd, uri[0]))
             }
 
@@ -334,20 +374,31 @@ func (index *SpecIndex) performExternalLookup(uri []string, componentId string,
                     BasePath: newBasePath,
                     AllowRemoteLookup: index.config.AllowRemoteLookup,
                     AllowFileLookup:   index.config.AllowFileLookup,
+                    FS:                index.config.FS,
+                    RemoteFetcher:     index.config.RemoteFetcher,
                     seenRemoteSources: index.config.seenRemoteSources,
+                    seenExternalIndexes: index.config.seenExternalIndexes,
                     remoteLock:        index.config.remoteLock,
+                    ReferenceLimits:   index.config.ReferenceLimits,
+                    externalDocuments: index.config.externalDocuments,
+                    limitedFetcher:    index.config.limitedFetcher,
                 }
-                
-                var newIndex *SpecIndex
-                newIndex = NewSpecIndexWithConfig(newRoot, newConfig)
+
+                // register the new index before it's built, so any circular file references find it.
+                newIndex, seen := index.registerExternalIndex(uri[0], newRoot, newConfig)
                 index.refLock.Lock()
                 index.externalLock.Lock()
                 index.externalSpecIndex[uri[0]] = newIndex
                 index.externalLock.Unlock()
-                newIndex.relativePath = path
-                newIndex.parentIndex = index
-                index.AddChild(newIndex)
+                if !seen {
+                    newIndex.relativePath = path
+                    newIndex.parentIndex = index
+                    index.AddChild(newIndex)
+                }
                 index.refLock.Unlock()
+                if !seen {
+                    createNewIndex(newRoot, newIndex)
+                }
                 externalSpecIndex = newIndex
             }
         }
@@ -355,6 +406,10 @@ func (index *SpecIndex) performExternalLookup(uri []string, componentId string,
         if externalSpecIndex != nil {
             foundRef := externalSpecIndex.FindComponentInRoot(uri[1])
             if foundRef != nil {
+                // a reference to an entire document points to the content of that document.
+                if foundRef.Node.Kind == yaml.DocumentNode && len(foundRef.Node.Content) > 0 {
+                    foundRef.Node = foundRef.Node.Content[0]
+                }
                 nameSegs := strings.Split(uri[1], "/")
                 ref := &Reference{
                     Definition:     componentId,
@@ -370,3 +425,63 @@ func (index *SpecIndex) performExternalLookup(uri []string, componentId string,
     }
     return nil
 }
+
+// lookupExternalDocument checks that another external document can be read without breaking the ReferenceLimits,
+// and then uses the lookup function to read it.
+func (index *SpecIndex) lookupExternalDocument(lookupFunction ExternalLookupFunction,
+    componentId string) (*yaml.Node, *yaml.Node, error) {
+    if l := index.limits(); l != nil {
+        if l.MaxReferenceDepth > 0 && index.depth+1 > l.MaxReferenceDepth {
+            return nil, nil, fmt.Errorf("%w: unable to read '%s', the maximum depth is %d",
+                ErrReferenceDepthReached, componentId, l.MaxReferenceDepth)
+        }
+        if l.MaxExternalDocuments > 0 && index.config.externalDocuments != nil &&
+            atomic.AddInt64(index.config.externalDocuments, 1) > int64(l.MaxExternalDocuments) {
+            return nil, nil, fmt.Errorf("%w: unable to read '%s', the maximum is %d",
+                ErrTooManyDocuments, componentId, l.MaxExternalDocuments)
+        }
+    }
+    return lookupFunction(componentId)
+}
+
+// externalIndexKey returns the location of an external document, used to identify documents across the index tree.
+func (index *SpecIndex) externalIndexKey(uri string) string {
+    if DetermineReferenceResolveType(uri) == HttpResolve {
+        return uri
+    }
+    if index.config.BasePath != "" {
+        return filepath.Join(index.config.BasePath, strings.ReplaceAll(uri, "file:", ""))
+    }
+    if index.config.BaseURL != nil {
+        return GenerateCleanSpecConfigBaseURL(index.config.BaseURL, uri, false)
+    }
+    return uri
+}
+
+// findSeenExternalIndex returns the index of an external document, if it has already been indexed.
+func (index *SpecIndex) findSeenExternalIndex(uri string) *SpecIndex {
+    if index.config == nil || index.config.seenExternalIndexes == nil {
+        return nil
+    }
+    if seen, ok := index.config.seenExternalIndexes.Load(index.externalIndexKey(uri)); ok {
+        return seen.(*SpecIndex)
+    }
+    return nil
+}
+
+// registerExternalIndex creates (but does not build) a new index for an external document, and registers it so
+// it can be found by any other index in the tree. If another index registered the same document first, then
+// that index is returned instead and the boolean is true.
+func (index *SpecIndex) registerExternalIndex(uri string, root *yaml.Node, config *SpecIndexConfig) (*SpecIndex, bool) {
+    newIndex := new(SpecIndex)
+    config.remoteLock = &sync.Mutex{}
+    newIndex.config = config
+    newIndex.ctx = index.ctx
+    newIndex.depth = index.depth + 1
+    boostrapIndexCollections(root, newIndex)
+    if index.config.seenExternalIndexes == nil {
+        return newIndex, false
+    }
+    seen, loaded := index.config.seenExternalIndexes.LoadOrStore(index.externalIndexKey(uri), newIndex)
+    return seen.(*SpecIndex), loaded
+}
@@ -4,8 +4,10 @@
 package index
 
 import (
+    "context"
     "golang.org/x/sync/syncmap"
     "gopkg.in/yaml.v3"
+    "io/fs"
     "net/http"
     "net/url"
     "os"
@@ -72,9 +74,31 @@ type SpecIndexConfig struct {
     AllowRemoteLookup bool // Allow remote lookups for references. Defaults to false
     AllowFileLookup   bool // Allow file lookups for references. Defaults to false
 
+    // FS is the file system that file references are read from. If it's not set, file references are read from
+    // the local file system. Any fs.FS can be used, such as an embed.FS, an fstest.MapFS or a zip.Reader, which
+    // means specifications don't need to exist on disk.
+    //
+    // When an FS is used, the BasePath is a path inside the FS (use "" or "." for the root of it). Paths are
+    // always slash separated, and a leading slash is ignored.
+    FS fs.FS
+
+    // RemoteFetcher is used to fetch remote documents, when AllowRemoteLookup is enabled. It can be used to add
+    // authentication headers, use a custom transport (mTLS, proxies) or retry failed requests. If it's not set,
+    // documents are fetched using a HTTPRemoteFetcher with the DefaultRemoteClient. Use a CachingRemoteFetcher to
+    // keep fetched documents on disk, so they can be re-used by other indexes (and other processes).
+    RemoteFetcher RemoteFetcher
+
+    // ReferenceLimits are limits and protections (allowed hosts, document sizes, nesting depth and so on) that
+    // are applied when resolving file and remote references. Use them when indexing untrusted specifications.
+    // If they are not set, there are no limits.
+    ReferenceLimits *ReferenceLimits
+
     // private fields
-    seenRemoteSources *syncmap.Map
-    remoteLock        *sync.Mutex
+    seenRemoteSources   *syncmap.Map
+    seenExternalIndexes *syncmap.Map // external documents indexed anywhere in the tree, keyed by location.
+    remoteLock          *sync.Mutex
+    externalDocuments   *int64 // the number of external documents read anywhere in the tree.
+    limitedFetcher      *HTTPRemoteFetcher // the fetcher used for the ReferenceLimits, shared by the tree.
 }
 
 // CreateOpenAPIIndexConfig is a helper function to create a new SpecIndexConfig with the AllowRemoteLookup and
@@ -222,6 +246,12 @@ type SpecIndex struct {
     // cto avoid re-downloading sources.
     parentIndex *SpecIndex
     children    []*SpecIndex
+
+    // ctx is used to cancel indexing, external indexes share the context of the root index.
+    ctx context.Context
+
+    // depth is how deep this index is in the tree of external documents, the root index has a depth of 0.
+    depth int
 }
 
 func (index *SpecIndex) AddChild(child *SpecIndex) {
@@ -248,6 +278,11 @@ func (i *IndexingError) Error() string {
     return i.Err.Error()
 }
 
+// Unwrap returns the error that caused the IndexingError, so errors.Is and errors.As can be used.
+func (i *IndexingError) Unwrap() error {
+    return i.Err
+}
+
 // DescriptionReference holds data about a description that was found and where it was found.
 type DescriptionReference struct {
     Content   string
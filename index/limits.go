@@ -0,0 +1,209 @@
+// Copyright 2023 Princess B33f Heavy Industries / Dave Shanley
+// SPDX-License-Identifier: MIT
+
+package index
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"syscall"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Errors returned when a reference breaks one of the ReferenceLimits. They are always wrapped by an IndexingError,
+// use errors.Is to check for them, for example:
+//
+//	errors.Is(err, index.ErrHostNotAllowed)
+var (
+	ErrHostNotAllowed        = errors.New("host is not allowed")
+	ErrPrivateNetwork        = errors.New("private network addresses are not allowed")
+	ErrDocumentTooLarge      = errors.New("document is too large")
+	ErrTooManyDocuments      = errors.New("too many external documents")
+	ErrReferenceDepthReached = errors.New("external references are nested too deeply")
+	ErrTooManyAliases        = errors.New("document contains too many YAML aliases")
+)
+
+// ReferenceLimits are guards applied when the index resolves file and remote references. They exist to protect
+// against specifications that cannot be trusted, that may try to reach internal services (SSRF) or exhaust
+// resources by referencing huge (or endless) chains of documents.
+//
+// Zero values mean there is no limit. Violations are reported as IndexingErrors, wrapping one of the Err* errors.
+type ReferenceLimits struct {
+	// AllowedHosts are the only hosts remote documents can be fetched from, if it's empty, any host is allowed.
+	// A host starting with '*.' will match any subdomain, for example, '*.pb33f.io'.
+	AllowedHosts []string
+
+	// DeniedHosts are hosts that remote documents are never fetched from, they use the same format as AllowedHosts.
+	DeniedHosts []string
+
+	// BlockPrivateNetworks will stop remote documents being fetched from loopback, private, link-local and
+	// unspecified IP addresses. Host names are resolved to check their addresses. When the default RemoteFetcher
+	// is used, the address is checked again when connecting, which also prevents DNS rebinding.
+	BlockPrivateNetworks bool
+
+	// MaxDocumentSize is the maximum size (in bytes) of any external document, remote or file.
+	MaxDocumentSize int64
+
+	// MaxExternalDocuments is the maximum number of external documents that will be read, across all indexes.
+	MaxExternalDocuments int
+
+	// MaxReferenceDepth is the maximum depth of external documents. A document referenced by the root
+	// specification has a depth of 1, a document that it references has a depth of 2, and so on.
+	MaxReferenceDepth int
+
+	// MaxYAMLAliases is the maximum number of aliases that will be expanded in any document (including the root
+	// document). Aliases within anchors are counted every time the anchor is used, which catches 'billion laughs'
+	// style documents.
+	MaxYAMLAliases int
+}
+
+// checkRemoteLocation checks a remote location is allowed to be fetched.
+func (l *ReferenceLimits) checkRemoteLocation(ctx context.Context, location string) error {
+	if l == nil {
+		return nil
+	}
+	u, err := url.Parse(location)
+	if err != nil {
+		return err
+	}
+	host := strings.ToLower(u.Hostname())
+	if matchesHost(l.DeniedHosts, host) || (len(l.AllowedHosts) > 0 && !matchesHost(l.AllowedHosts, host)) {
+		return fmt.Errorf("%w: unable to fetch '%s'", ErrHostNotAllowed, location)
+	}
+	if !l.BlockPrivateNetworks {
+		return nil
+	}
+	var ips []net.IP
+	if ip := net.ParseIP(host); ip != nil {
+		ips = append(ips, ip)
+	} else {
+		addrs, er := net.DefaultResolver.LookupIPAddr(ctx, host)
+		if er != nil {
+			return er
+		}
+		for _, a := range addrs {
+			ips = append(ips, a.IP)
+		}
+	}
+	for _, ip := range ips {
+		if isPrivateIP(ip) {
+			return fmt.Errorf("%w: unable to fetch '%s' (%s)", ErrPrivateNetwork, location, ip)
+		}
+	}
+	return nil
+}
+
+// checkDocumentSize checks the size of a document is within the limit.
+func (l *ReferenceLimits) checkDocumentSize(location string, size int64) error {
+	if l == nil || l.MaxDocumentSize <= 0 || size <= l.MaxDocumentSize {
+		return nil
+	}
+	return fmt.Errorf("%w: '%s' is larger than %d bytes", ErrDocumentTooLarge, location, l.MaxDocumentSize)
+}
+
+// checkAliases checks that the number of aliases in a document is within the limit.
+func (l *ReferenceLimits) checkAliases(location string, node *yaml.Node) error {
+	if l == nil || l.MaxYAMLAliases <= 0 {
+		return nil
+	}
+	if countAliases(node, l.MaxYAMLAliases) > l.MaxYAMLAliases {
+		return fmt.Errorf("%w: '%s' contains more than %d aliases", ErrTooManyAliases, location, l.MaxYAMLAliases)
+	}
+	return nil
+}
+
+// countAliases counts the aliases in a node, following aliases into their anchors. Counting stops as soon as the
+// limit is exceeded, so documents that would expand exponentially are not expanded.
+func countAliases(node *yaml.Node, limit int) int {
+	count := 0
+	var walk func(n *yaml.Node)
+	walk = func(n *yaml.Node) {
+		if n == nil || count > limit {
+			return
+		}
+		if n.Kind == yaml.AliasNode {
+			count++
+			walk(n.Alias)
+			return
+		}
+		for _, c := range n.Content {
+			walk(c)
+		}
+	}
+	walk(node)
+	return count
+}
+
+// matchesHost returns true if the host matches any of the supplied hosts.
+func matchesHost(hosts []string, host string) bool {
+	for _, h := range hosts {
+		h = strings.ToLower(h)
+		if h == host || (strings.HasPrefix(h, "*.") && strings.HasSuffix(host, h[1:])) {
+			return true
+		}
+	}
+	return false
+}
+
+// isPrivateIP returns true if an IP address is not a public address.
+func isPrivateIP(ip net.IP) bool {
+	return ip.IsLoopback() || ip.IsPrivate() || ip.IsUnspecified() || ip.IsLinkLocalUnicast() ||
+		ip.IsLinkLocalMulticast() || ip.IsInterfaceLocalMulticast()
+}
+
+// newLimitedRemoteFetcher creates the default RemoteFetcher used when limits have been set. The size of responses
+// is limited, and the addresses and hosts of every connection (including redirects) are checked.
+func newLimitedRemoteFetcher(l *ReferenceLimits) *HTTPRemoteFetcher {
+	dialer := &net.Dialer{Timeout: 30 * time.Second}
+	if l.BlockPrivateNetworks {
+		dialer.Control = func(network, address string, _ syscall.RawConn) error {
+			host, _, err := net.SplitHostPort(address)
+			if err != nil {
+				return err
+			}
+			if ip := net.ParseIP(host); ip != nil && isPrivateIP(ip) {
+				return fmt.Errorf("%w: unable to connect to %s", ErrPrivateNetwork, ip)
+			}
+			return nil
+		}
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.DialContext = dialer.DialContext
+	return &HTTPRemoteFetcher{
+		Client: &http.Client{
+			Timeout:   DefaultRemoteClient.Timeout,
+			Transport: transport,
+			CheckRedirect: func(req *http.Request, via []*http.Request) error {
+				if len(via) >= 10 {
+					return errors.New("stopped after 10 redirects")
+				}
+				return l.checkRemoteLocation(req.Context(), req.URL.String())
+			},
+		},
+		MaxResponseSize: l.MaxDocumentSize,
+	}
+}
+
+// statFile returns the size of a file that is about to be read.
+func (index *SpecIndex) statFile(location string) (int64, error) {
+	var info fs.FileInfo
+	var err error
+	if index.config == nil || index.config.FS == nil {
+		info, err = os.Stat(location)
+	} else {
+		info, err = fs.Stat(index.config.FS, fsPath(location))
+	}
+	if err != nil {
+		return 0, err
+	}
+	return info.Size(), nil
+}
@@ -0,0 +1,260 @@
+// Copyright 2023 Princess B33f Heavy Industries / Dave Shanley
+// SPDX-License-Identifier: MIT
+
+package index
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/yaml.v3"
+)
+
+func indexWithLimits(t *testing.T, spec string, config *SpecIndexConfig) *SpecIndex {
+	var rootNode yaml.Node
+	assert.NoError(t, yaml.Unmarshal([]byte(spec), &rootNode))
+	return NewSpecIndexWithConfig(&rootNode, config)
+}
+
+// limitError returns the first reference error that wraps the target error.
+func limitError(index *SpecIndex, target error) *IndexingError {
+	for _, err := range index.GetReferenceIndexErrors() {
+		var indexingError *IndexingError
+		if errors.As(err, &indexingError) && errors.Is(err, target) {
+			return indexingError
+		}
+	}
+	return nil
+}
+
+func staticFetcher(content string) RemoteFetcher {
+	return RemoteFetcherFunc(func(ctx context.Context, location string) ([]byte, error) {
+		return []byte(content), nil
+	})
+}
+
+func TestReferenceLimits_Hosts(t *testing.T) {
+	spec := `openapi: 3.0.1
+components:
+  schemas:
+    Pet:
+      $ref: 'https://schemas.pb33f.io/pets.yaml#/Pet'
+    Owner:
+      $ref: 'https://evil.example.com/owners.yaml#/Owner'`
+
+	config := &SpecIndexConfig{
+		AllowRemoteLookup: true,
+		RemoteFetcher:     staticFetcher("Pet:\n  type: object\nOwner:\n  type: object"),
+		ReferenceLimits:   &ReferenceLimits{AllowedHosts: []string{"*.pb33f.io"}},
+	}
+	index := indexWithLimits(t, spec, config)
+	err := limitError(index, ErrHostNotAllowed)
+	assert.NotNil(t, err)
+	assert.Equal(t, "https://evil.example.com/owners.yaml#/Owner", err.Path)
+	assert.Equal(t, "host is not allowed: unable to fetch 'https://evil.example.com/owners.yaml'", err.Error())
+	assert.Len(t, index.GetAllExternalIndexes(), 1)
+
+	config = &SpecIndexConfig{
+		AllowRemoteLookup: true,
+		RemoteFetcher:     staticFetcher("Pet:\n  type: object\nOwner:\n  type: object"),
+		ReferenceLimits:   &ReferenceLimits{DeniedHosts: []string{"SCHEMAS.pb33f.io"}},
+	}
+	index = indexWithLimits(t, spec, config)
+	assert.NotNil(t, limitError(index, ErrHostNotAllowed))
+	assert.Len(t, index.GetAllExternalIndexes(), 1)
+}
+
+func TestReferenceLimits_BlockPrivateNetworks(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte("Pet:\n  type: object"))
+	}))
+	defer server.Close()
+
+	spec := `openapi: 3.0.1
+components:
+  schemas:
+    Pet:
+      $ref: '` + server.URL + `/pets.yaml#/Pet'`
+
+	index := indexWithLimits(t, spec, &SpecIndexConfig{
+		AllowRemoteLookup: true,
+		ReferenceLimits:   &ReferenceLimits{BlockPrivateNetworks: true},
+	})
+	assert.NotNil(t, limitError(index, ErrPrivateNetwork))
+	assert.Empty(t, index.GetAllExternalIndexes())
+
+	// the default fetcher also checks addresses when connecting, in case a host name resolves differently.
+	fetcher := newLimitedRemoteFetcher(&ReferenceLimits{BlockPrivateNetworks: true})
+	_, err := fetcher.FetchRemoteDocument(context.Background(), server.URL)
+	assert.ErrorIs(t, err, ErrPrivateNetwork)
+
+	// without the block, the local server can be used.
+	fetcher = newLimitedRemoteFetcher(&ReferenceLimits{})
+	body, err := fetcher.FetchRemoteDocument(context.Background(), server.URL)
+	assert.NoError(t, err)
+	assert.NotEmpty(t, body)
+}
+
+func TestReferenceLimits_RemoteFetcherReused(t *testing.T) {
+	config := &SpecIndexConfig{AllowRemoteLookup: true, ReferenceLimits: &ReferenceLimits{}}
+	index := indexWithLimits(t, "openapi: 3.1.0", config)
+
+	// the fetcher (and the connections of its transport) is created once, and shared by every lookup.
+	fetcher := index.remoteFetcher()
+	assert.NotNil(t, fetcher)
+	assert.Same(t, fetcher, index.remoteFetcher())
+	assert.Same(t, config.limitedFetcher, fetcher)
+
+	// a configured fetcher is always used instead.
+	config.RemoteFetcher = NewHTTPRemoteFetcher(nil)
+	assert.Same(t, config.RemoteFetcher, index.remoteFetcher())
+}
+
+func TestReferenceLimits_MaxDocumentSize(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(strings.Repeat("a", 100)))
+	}))
+	defer server.Close()
+
+	fetcher := &HTTPRemoteFetcher{MaxResponseSize: 99}
+	_, err := fetcher.FetchRemoteDocument(context.Background(), server.URL)
+	assert.ErrorIs(t, err, ErrDocumentTooLarge)
+
+	fetcher.MaxResponseSize = 100
+	body, err := fetcher.FetchRemoteDocument(context.Background(), server.URL)
+	assert.NoError(t, err)
+	assert.Len(t, body, 100)
+
+	// custom fetchers are checked after fetching.
+	spec := `openapi: 3.0.1
+components:
+  schemas:
+    Pet:
+      $ref: 'https://schemas.pb33f.io/pets.yaml#/Pet'
+    Owner:
+      $ref: 'models/owner.yaml#/Owner'`
+
+	index := indexWithLimits(t, spec, &SpecIndexConfig{
+		AllowRemoteLookup: true,
+		AllowFileLookup:   true,
+		RemoteFetcher:     staticFetcher("Pet:\n  type: object\n  description: " + strings.Repeat("a", 100)),
+		FS: fstest.MapFS{
+			"models/owner.yaml": {Data: []byte("Owner:\n  type: object\n  description: " + strings.Repeat("a", 100))},
+		},
+		ReferenceLimits: &ReferenceLimits{MaxDocumentSize: 64},
+	})
+	var tooLarge int
+	for _, err := range index.GetReferenceIndexErrors() {
+		if errors.Is(err, ErrDocumentTooLarge) {
+			tooLarge++
+		}
+	}
+	assert.Equal(t, 2, tooLarge)
+	assert.Empty(t, index.GetAllExternalIndexes())
+}
+
+func TestReferenceLimits_MaxExternalDocuments(t *testing.T) {
+	spec := `openapi: 3.0.1
+components:
+  schemas:
+    Pet:
+      $ref: 'pet.yaml'
+    Owner:
+      $ref: 'owner.yaml'
+    Toy:
+      $ref: 'toy.yaml'`
+
+	files := fstest.MapFS{
+		"pet.yaml":   {Data: []byte("type: object")},
+		"owner.yaml": {Data: []byte("type: object")},
+		"toy.yaml":   {Data: []byte("type: object")},
+	}
+	index := indexWithLimits(t, spec, &SpecIndexConfig{
+		AllowFileLookup: true,
+		FS:              files,
+		ReferenceLimits: &ReferenceLimits{MaxExternalDocuments: 2},
+	})
+	assert.NotNil(t, limitError(index, ErrTooManyDocuments))
+	assert.Len(t, index.GetAllExternalIndexes(), 2)
+}
+
+func TestReferenceLimits_MaxReferenceDepth(t *testing.T) {
+	spec := `openapi: 3.0.1
+components:
+  schemas:
+    Pet:
+      $ref: 'one.yaml'`
+
+	files := fstest.MapFS{
+		"one.yaml":   {Data: []byte("type: object\nproperties:\n  two:\n    $ref: 'two.yaml'")},
+		"two.yaml":   {Data: []byte("type: object\nproperties:\n  three:\n    $ref: 'three.yaml'")},
+		"three.yaml": {Data: []byte("type: string")},
+	}
+	config := func(depth int) *SpecIndexConfig {
+		return &SpecIndexConfig{
+			AllowFileLookup: true,
+			FS:              files,
+			ReferenceLimits: &ReferenceLimits{MaxReferenceDepth: depth},
+		}
+	}
+
+	index := indexWithLimits(t, spec, config(2))
+	err := limitError(index.GetChildren()[0].GetChildren()[0], ErrReferenceDepthReached)
+	assert.NotNil(t, err)
+	assert.Equal(t, "three.yaml#", err.Path)
+
+	index = indexWithLimits(t, spec, config(3))
+	assert.Nil(t, limitError(index.GetChildren()[0].GetChildren()[0], ErrReferenceDepthReached))
+	assert.Len(t, index.GetChildren()[0].GetChildren()[0].GetChildren(), 1)
+}
+
+const billionLaughs = `openapi: 3.0.1
+x-a: &a ["lol", "lol", "lol", "lol", "lol", "lol", "lol", "lol", "lol"]
+x-b: &b [*a, *a, *a, *a, *a, *a, *a, *a, *a]
+x-c: &c [*b, *b, *b, *b, *b, *b, *b, *b, *b]
+x-d: &d [*c, *c, *c, *c, *c, *c, *c, *c, *c]
+x-e: &e [*d, *d, *d, *d, *d, *d, *d, *d, *d]
+x-f: &f [*e, *e, *e, *e, *e, *e, *e, *e, *e]
+x-g: &g [*f, *f, *f, *f, *f, *f, *f, *f, *f]
+x-h: &h [*g, *g, *g, *g, *g, *g, *g, *g, *g]
+x-i: &i [*h, *h, *h, *h, *h, *h, *h, *h, *h]
+paths: {}`
+
+func TestReferenceLimits_MaxYAMLAliases(t *testing.T) {
+	index := indexWithLimits(t, billionLaughs, &SpecIndexConfig{
+		ReferenceLimits: &ReferenceLimits{MaxYAMLAliases: 1000},
+	})
+	err := limitError(index, ErrTooManyAliases)
+	assert.NotNil(t, err)
+	assert.Equal(t, "$", err.Path)
+	assert.Equal(t, "document contains too many YAML aliases: 'root' contains more than 1000 aliases", err.Error())
+
+	// external documents are checked as well.
+	spec := `openapi: 3.0.1
+components:
+  schemas:
+    Pet:
+      $ref: 'laughs.yaml'`
+
+	index = indexWithLimits(t, spec, &SpecIndexConfig{
+		AllowFileLookup: true,
+		FS:              fstest.MapFS{"laughs.yaml": {Data: []byte(billionLaughs)}},
+		ReferenceLimits: &ReferenceLimits{MaxYAMLAliases: 1000},
+	})
+	assert.NotNil(t, limitError(index, ErrTooManyAliases))
+}
+
+func TestCountAliases(t *testing.T) {
+	var node yaml.Node
+	_ = yaml.Unmarshal([]byte(billionLaughs), &node)
+	assert.Equal(t, 11, countAliases(&node, 10))
+
+	_ = yaml.Unmarshal([]byte("a: &a [1]\nb: [*a, *a]"), &node)
+	assert.Equal(t, 2, countAliases(&node, 10))
+}
@@ -0,0 +1,190 @@
+// Copyright 2023 Princess B33f Heavy Industries / Dave Shanley
+// SPDX-License-Identifier: MIT
+
+package index
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// ErrNotCached is returned by a CachingRemoteFetcher in offline mode, when a document has not been cached.
+var ErrNotCached = errors.New("document is not cached")
+
+// CachingRemoteFetcher is a RemoteFetcher that keeps a copy of every remote document it fetches in a directory, so
+// the same documents don't need to be downloaded again, by any index, or any process that uses the same directory.
+//
+// A cached document is used without checking it with the server until its TTL expires. Once it has expired, the
+// document is revalidated using the ETag and Last-Modified headers sent by the server, and it's only downloaded
+// again if it has changed. If the server can't be reached, an expired document is used rather than failing.
+//
+// In Offline mode, documents are only ever read from the cache, nothing is fetched.
+type CachingRemoteFetcher struct {
+	// Dir is the directory that documents are cached in, it's created if it does not exist.
+	Dir string
+
+	// TTL is how long a cached document is used without being revalidated, zero means it's always revalidated.
+	TTL time.Duration
+
+	// Offline will only serve documents from the cache, documents that are not cached return an error that wraps
+	// ErrNotCached.
+	Offline bool
+
+	// Fetcher is used to fetch and revalidate documents, if it's nil, a HTTPRemoteFetcher using the
+	// DefaultRemoteClient is used.
+	Fetcher *HTTPRemoteFetcher
+
+	now func() time.Time // used by tests to move time along.
+}
+
+// cacheEntry is the metadata stored alongside every cached document.
+type cacheEntry struct {
+	URL          string    `json:"url"`
+	ETag         string    `json:"etag,omitempty"`
+	LastModified string    `json:"lastModified,omitempty"`
+	Fetched      time.Time `json:"fetched"`
+}
+
+// NewCachingRemoteFetcher creates a new CachingRemoteFetcher that caches documents in the supplied directory, for
+// the duration of the TTL.
+func NewCachingRemoteFetcher(dir string, ttl time.Duration) *CachingRemoteFetcher {
+	return &CachingRemoteFetcher{Dir: dir, TTL: ttl}
+}
+
+// FetchRemoteDocument returns a document from the cache, if it's cached and has not expired. Otherwise, the document
+// is fetched (or revalidated) and then stored in the cache.
+func (c *CachingRemoteFetcher) FetchRemoteDocument(ctx context.Context, location string) ([]byte, error) {
+	entry, body := c.load(location)
+	if c.Offline {
+		if entry == nil {
+			return nil, fmt.Errorf("%w: unable to fetch '%s' in offline mode", ErrNotCached, location)
+		}
+		return body, nil
+	}
+	if entry != nil && c.TTL > 0 && c.timeNow().Sub(entry.Fetched) < c.TTL {
+		return body, nil
+	}
+
+	fetcher := c.Fetcher
+	if fetcher == nil {
+		fetcher = NewHTTPRemoteFetcher(nil)
+	}
+	conditional := http.Header{}
+	if entry != nil {
+		if entry.ETag != "" {
+			conditional.Set("If-None-Match", entry.ETag)
+		}
+		if entry.LastModified != "" {
+			conditional.Set("If-Modified-Since", entry.LastModified)
+		}
+	}
+	resp, err := fetcher.send(ctx, location, conditional)
+	if err != nil {
+		// an out-of-date document is better than no document at all.
+		if entry != nil && ctx.Err() == nil {
+			return body, nil
+		}
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	// failing to write to the cache does not stop the document being used.
+	if resp.StatusCode == http.StatusNotModified && entry != nil {
+		entry.Fetched = c.timeNow()
+		_ = c.store(entry, body)
+		return body, nil
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return nil, fmt.Errorf("unable to fetch remote document '%s': %s", location, resp.Status)
+	}
+	body, err = fetcher.readBody(location, resp)
+	if err != nil {
+		return nil, err
+	}
+	entry = &cacheEntry{
+		URL:          location,
+		ETag:         resp.Header.Get("ETag"),
+		LastModified: resp.Header.Get("Last-Modified"),
+		Fetched:      c.timeNow(),
+	}
+	_ = c.store(entry, body)
+	return body, nil
+}
+
+// Clear removes every cached document.
+func (c *CachingRemoteFetcher) Clear() error {
+	return os.RemoveAll(c.Dir)
+}
+
+// load reads a document and its metadata from the cache, nil is returned if it's not cached.
+func (c *CachingRemoteFetcher) load(location string) (*cacheEntry, []byte) {
+	key := c.key(location)
+	meta, err := os.ReadFile(key + ".json")
+	if err != nil {
+		return nil, nil
+	}
+	var entry cacheEntry
+	if json.Unmarshal(meta, &entry) != nil || entry.URL != location {
+		return nil, nil
+	}
+	body, err := os.ReadFile(key + ".body")
+	if err != nil {
+		return nil, nil
+	}
+	return &entry, body
+}
+
+// store writes a document and its metadata to the cache. Files are written to a temporary file first, and then
+// renamed, so other processes never read a partially written document.
+func (c *CachingRemoteFetcher) store(entry *cacheEntry, body []byte) error {
+	if err := os.MkdirAll(c.Dir, 0o755); err != nil {
+		return err
+	}
+	meta, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+	key := c.key(entry.URL)
+	if err = writeFileAtomic(key+".body", body); err != nil {
+		return err
+	}
+	return writeFileAtomic(key+".json", meta)
+}
+
+// key returns the path (without an extension) of the files used to cache a location.
+func (c *CachingRemoteFetcher) key(location string) string {
+	sum := sha256.Sum256([]byte(location))
+	return filepath.Join(c.Dir, hex.EncodeToString(sum[:]))
+}
+
+func (c *CachingRemoteFetcher) timeNow() time.Time {
+	if c.now != nil {
+		return c.now()
+	}
+	return time.Now()
+}
+
+func writeFileAtomic(name string, data []byte) error {
+	tmp, err := os.CreateTemp(filepath.Dir(name), filepath.Base(name)+".*.tmp")
+	if err != nil {
+		return err
+	}
+	if _, err = tmp.Write(data); err != nil {
+		_ = tmp.Close()
+		_ = os.Remove(tmp.Name())
+		return err
+	}
+	if err = tmp.Close(); err != nil {
+		_ = os.Remove(tmp.Name())
+		return err
+	}
+	return os.Rename(tmp.Name(), name)
+}
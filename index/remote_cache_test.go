@@ -0,0 +1,146 @@
+// Copyright 2023 Princess B33f Heavy Industries / Dave Shanley
+// SPDX-License-Identifier: MIT
+
+package index
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/yaml.v3"
+)
+
+type versionedServer struct {
+	*httptest.Server
+	version  atomic.Value
+	requests int32
+}
+
+func newVersionedServer() *versionedServer {
+	s := &versionedServer{}
+	s.version.Store("v1")
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&s.requests, 1)
+		etag := `"` + s.version.Load().(string) + `"`
+		if r.Header.Get("If-None-Match") == etag {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("ETag", etag)
+		_, _ = w.Write([]byte("Pet:\n  type: object\n  description: " + s.version.Load().(string)))
+	}))
+	return s
+}
+
+func TestCachingRemoteFetcher(t *testing.T) {
+	server := newVersionedServer()
+	defer server.Close()
+
+	now := time.Now()
+	fetcher := NewCachingRemoteFetcher(t.TempDir(), time.Hour)
+	fetcher.now = func() time.Time { return now }
+	location := server.URL + "/pets.yaml"
+
+	body, err := fetcher.FetchRemoteDocument(context.Background(), location)
+	assert.NoError(t, err)
+	assert.Contains(t, string(body), "description: v1")
+	assert.Equal(t, int32(1), atomic.LoadInt32(&server.requests))
+
+	// still fresh, the server is not used.
+	body, err = fetcher.FetchRemoteDocument(context.Background(), location)
+	assert.NoError(t, err)
+	assert.Contains(t, string(body), "description: v1")
+	assert.Equal(t, int32(1), atomic.LoadInt32(&server.requests))
+
+	// expired, but not modified.
+	now = now.Add(2 * time.Hour)
+	body, err = fetcher.FetchRemoteDocument(context.Background(), location)
+	assert.NoError(t, err)
+	assert.Contains(t, string(body), "description: v1")
+	assert.Equal(t, int32(2), atomic.LoadInt32(&server.requests))
+
+	// expired and modified.
+	server.version.Store("v2")
+	now = now.Add(2 * time.Hour)
+	body, err = fetcher.FetchRemoteDocument(context.Background(), location)
+	assert.NoError(t, err)
+	assert.Contains(t, string(body), "description: v2")
+	assert.Equal(t, int32(3), atomic.LoadInt32(&server.requests))
+
+	// the server has gone away, so the expired document is used.
+	server.Close()
+	now = now.Add(2 * time.Hour)
+	body, err = fetcher.FetchRemoteDocument(context.Background(), location)
+	assert.NoError(t, err)
+	assert.Contains(t, string(body), "description: v2")
+
+	// nothing to fall back on.
+	_, err = fetcher.FetchRemoteDocument(context.Background(), server.URL+"/owners.yaml")
+	assert.Error(t, err)
+}
+
+func TestCachingRemoteFetcher_Offline(t *testing.T) {
+	server := newVersionedServer()
+	defer server.Close()
+
+	dir := t.TempDir()
+	location := server.URL + "/pets.yaml"
+	_, err := NewCachingRemoteFetcher(dir, 0).FetchRemoteDocument(context.Background(), location)
+	assert.NoError(t, err)
+
+	offline := &CachingRemoteFetcher{Dir: dir, Offline: true}
+	body, err := offline.FetchRemoteDocument(context.Background(), location)
+	assert.NoError(t, err)
+	assert.Contains(t, string(body), "description: v1")
+
+	_, err = offline.FetchRemoteDocument(context.Background(), server.URL+"/owners.yaml")
+	assert.ErrorIs(t, err, ErrNotCached)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&server.requests))
+
+	assert.NoError(t, offline.Clear())
+	_, err = offline.FetchRemoteDocument(context.Background(), location)
+	assert.ErrorIs(t, err, ErrNotCached)
+}
+
+func TestCachingRemoteFetcher_ErrorStatus(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	fetcher := NewCachingRemoteFetcher(t.TempDir(), time.Hour)
+	_, err := fetcher.FetchRemoteDocument(context.Background(), server.URL)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "404 Not Found")
+}
+
+func TestSpecIndex_CachingRemoteFetcher(t *testing.T) {
+	server := newVersionedServer()
+	defer server.Close()
+
+	spec := `openapi: 3.0.1
+components:
+  schemas:
+    Pet:
+      $ref: '` + server.URL + `/pets.yaml#/Pet'`
+
+	fetcher := NewCachingRemoteFetcher(t.TempDir(), time.Hour)
+
+	// every index shares the same cache.
+	for i := 0; i < 3; i++ {
+		var rootNode yaml.Node
+		_ = yaml.Unmarshal([]byte(spec), &rootNode)
+		index := NewSpecIndexWithConfig(&rootNode, &SpecIndexConfig{
+			AllowRemoteLookup: true,
+			RemoteFetcher:     fetcher,
+		})
+		assert.Empty(t, index.GetReferenceIndexErrors())
+		assert.Len(t, index.GetAllExternalIndexes(), 1)
+	}
+	assert.Equal(t, int32(1), atomic.LoadInt32(&server.requests))
+}
@@ -0,0 +1,115 @@
+// Copyright 2023 Princess B33f Heavy Industries / Dave Shanley
+// SPDX-License-Identifier: MIT
+
+package index
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+)
+
+// RemoteFetcher is used by the index to fetch the content of remote documents, when a specification contains
+// remote references (and AllowRemoteLookup is enabled). A custom RemoteFetcher can be set on the SpecIndexConfig
+// to control how documents are fetched, for example, to add authentication, use a proxy or retry failed requests.
+//
+// If no RemoteFetcher is configured, a HTTPRemoteFetcher using the default HTTP client is used.
+type RemoteFetcher interface {
+	// FetchRemoteDocument returns the raw bytes of the document found at the supplied location.
+	FetchRemoteDocument(ctx context.Context, location string) ([]byte, error)
+}
+
+// RemoteFetcherFunc allows a plain function to be used as a RemoteFetcher.
+type RemoteFetcherFunc func(ctx context.Context, location string) ([]byte, error)
+
+// FetchRemoteDocument will call the function.
+func (f RemoteFetcherFunc) FetchRemoteDocument(ctx context.Context, location string) ([]byte, error) {
+	return f(ctx, location)
+}
+
+// HTTPRemoteFetcher is a RemoteFetcher that fetches documents using an *http.Client. Requests can be modified
+// before they are sent by adding Headers (such as an Authorization header), or by using a RequestDecorator.
+//
+// Anything that can be configured on an *http.Client (custom transports, mTLS, proxies, timeouts) can be used
+// by supplying a Client.
+type HTTPRemoteFetcher struct {
+	// Client is used to send requests, if it's nil then DefaultRemoteClient is used.
+	Client *http.Client
+
+	// Headers are added to every request.
+	Headers http.Header
+
+	// RequestDecorator is called with every request before it's sent, if an error is returned the request
+	// is not sent, and the error is returned instead.
+	RequestDecorator func(req *http.Request) error
+
+	// MaxResponseSize is the maximum size (in bytes) of a response body, larger responses return an error that
+	// wraps ErrDocumentTooLarge. Zero means there is no limit.
+	MaxResponseSize int64
+}
+
+// DefaultRemoteClient is the *http.Client used to fetch remote documents when no client has been configured.
+var DefaultRemoteClient = &http.Client{Timeout: time.Duration(60) * time.Second}
+
+// NewHTTPRemoteFetcher creates a new HTTPRemoteFetcher that uses the supplied client, if the client is nil then
+// DefaultRemoteClient is used.
+func NewHTTPRemoteFetcher(client *http.Client) *HTTPRemoteFetcher {
+	return &HTTPRemoteFetcher{Client: client}
+}
+
+// FetchRemoteDocument will send a GET request to the location, and return the body of the response. Any response
+// that is not successful (a 2xx status code) is returned as an error.
+func (f *HTTPRemoteFetcher) FetchRemoteDocument(ctx context.Context, location string) ([]byte, error) {
+	resp, err := f.send(ctx, location, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return nil, fmt.Errorf("unable to fetch remote document '%s': %s", location, resp.Status)
+	}
+	return f.readBody(location, resp)
+}
+
+// send will create a GET request for the location (with any extra headers), decorate it and send it.
+func (f *HTTPRemoteFetcher) send(ctx context.Context, location string, extra http.Header) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, location, nil)
+	if err != nil {
+		return nil, err
+	}
+	for _, headers := range []http.Header{f.Headers, extra} {
+		for name, values := range headers {
+			for _, v := range values {
+				req.Header.Add(name, v)
+			}
+		}
+	}
+	if f.RequestDecorator != nil {
+		if err = f.RequestDecorator(req); err != nil {
+			return nil, err
+		}
+	}
+	client := f.Client
+	if client == nil {
+		client = DefaultRemoteClient
+	}
+	return client.Do(req)
+}
+
+// readBody reads the body of a response, as long as it's not larger than the MaxResponseSize.
+func (f *HTTPRemoteFetcher) readBody(location string, resp *http.Response) ([]byte, error) {
+	if f.MaxResponseSize <= 0 {
+		return io.ReadAll(resp.Body)
+	}
+	// read one more byte than allowed, to know if the body is too large.
+	body, err := io.ReadAll(io.LimitReader(resp.Body, f.MaxResponseSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if int64(len(body)) > f.MaxResponseSize {
+		return nil, fmt.Errorf("%w: '%s' is larger than %d bytes", ErrDocumentTooLarge, location, f.MaxResponseSize)
+	}
+	return body, nil
+}
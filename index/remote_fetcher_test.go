@@ -0,0 +1,122 @@
+// Copyright 2023 Princess B33f Heavy Industries / Dave Shanley
+// SPDX-License-Identifier: MIT
+
+package index
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/yaml.v3"
+)
+
+const remotePets = `Pet:
+  type: object
+  description: a pet`
+
+func newAuthServer(token string) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer "+token {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		_, _ = w.Write([]byte(remotePets))
+	}))
+}
+
+func TestHTTPRemoteFetcher_Headers(t *testing.T) {
+	server := newAuthServer("burger")
+	defer server.Close()
+
+	fetcher := NewHTTPRemoteFetcher(server.Client())
+	_, err := fetcher.FetchRemoteDocument(context.Background(), server.URL)
+	assert.Error(t, err)
+	assert.Equal(t, fmt.Sprintf("unable to fetch remote document '%s': 401 Unauthorized", server.URL), err.Error())
+
+	fetcher.Headers = http.Header{"Authorization": []string{"Bearer burger"}}
+	body, err := fetcher.FetchRemoteDocument(context.Background(), server.URL)
+	assert.NoError(t, err)
+	assert.Equal(t, remotePets, string(body))
+}
+
+func TestHTTPRemoteFetcher_RequestDecorator(t *testing.T) {
+	server := newAuthServer("fries")
+	defer server.Close()
+
+	fetcher := &HTTPRemoteFetcher{
+		RequestDecorator: func(req *http.Request) error {
+			req.Header.Set("Authorization", "Bearer fries")
+			return nil
+		},
+	}
+	body, err := fetcher.FetchRemoteDocument(context.Background(), server.URL)
+	assert.NoError(t, err)
+	assert.Equal(t, remotePets, string(body))
+
+	fetcher.RequestDecorator = func(req *http.Request) error {
+		return errors.New("no token")
+	}
+	_, err = fetcher.FetchRemoteDocument(context.Background(), server.URL)
+	assert.EqualError(t, err, "no token")
+
+	_, err = fetcher.FetchRemoteDocument(context.Background(), "::not a url")
+	assert.Error(t, err)
+}
+
+func TestSpecIndex_RemoteFetcher(t *testing.T) {
+	server := newAuthServer("shake")
+	defer server.Close()
+
+	spec := fmt.Sprintf(`openapi: 3.0.1
+components:
+  schemas:
+    Pet:
+      $ref: '%s/pets.yaml#/Pet'`, server.URL)
+
+	var rootNode yaml.Node
+	_ = yaml.Unmarshal([]byte(spec), &rootNode)
+
+	// without the header, the server refuses the request.
+	index := NewSpecIndexWithConfig(&rootNode, &SpecIndexConfig{AllowRemoteLookup: true})
+	assert.NotEmpty(t, index.GetReferenceIndexErrors())
+	assert.Contains(t, index.GetReferenceIndexErrors()[0].Error(), "401 Unauthorized")
+
+	index = NewSpecIndexWithConfig(&rootNode, &SpecIndexConfig{
+		AllowRemoteLookup: true,
+		RemoteFetcher: &HTTPRemoteFetcher{
+			Client:  server.Client(),
+			Headers: http.Header{"Authorization": []string{"Bearer shake"}},
+		},
+	})
+	assert.Empty(t, index.GetReferenceIndexErrors())
+	assert.Len(t, index.GetAllExternalIndexes(), 1)
+	pet := index.FindComponent(fmt.Sprintf("%s/pets.yaml#/Pet", server.URL), nil)
+	assert.NotNil(t, pet)
+}
+
+func TestSpecIndex_RemoteFetcherFunc(t *testing.T) {
+	spec := `openapi: 3.0.1
+components:
+  schemas:
+    Pet:
+      $ref: 'https://registry.pb33f.io/pets.yaml#/Pet'`
+
+	var rootNode yaml.Node
+	_ = yaml.Unmarshal([]byte(spec), &rootNode)
+
+	var fetched []string
+	index := NewSpecIndexWithConfig(&rootNode, &SpecIndexConfig{
+		AllowRemoteLookup: true,
+		RemoteFetcher: RemoteFetcherFunc(func(ctx context.Context, location string) ([]byte, error) {
+			fetched = append(fetched, location)
+			return []byte(remotePets), nil
+		}),
+	})
+	assert.Empty(t, index.GetReferenceIndexErrors())
+	assert.Equal(t, []string{"https://registry.pb33f.io/pets.yaml"}, fetched)
+}
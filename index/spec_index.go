@@ -13,6 +13,7 @@
 package index
 
 import (
+	"context"
 	"fmt"
 	"github.com/pb33f/libopenapi/utils"
 	"github.com/vmware-labs/yaml-jsonpath/pkg/yamlpath"
@@ -26,17 +27,44 @@ import (
 // except it sets a base URL for resolving relative references, except it also allows for granular control over
 // how the index is set up.
 func NewSpecIndexWithConfig(rootNode *yaml.Node, config *SpecIndexConfig) *SpecIndex {
+	index, _ := NewSpecIndexWithContext(context.Background(), rootNode, config)
+	return index
+}
+
+// NewSpecIndexWithContext is the same as NewSpecIndexWithConfig, except indexing can be cancelled (or time out)
+// using the supplied context. The context is also used when fetching remote documents, and it's shared with
+// every external document that is indexed.
+//
+// If the context is done before indexing completes, then whatever has been indexed so far is returned along
+// with the error from the context.
+func NewSpecIndexWithContext(ctx context.Context, rootNode *yaml.Node, config *SpecIndexConfig) (*SpecIndex, error) {
 	index := new(SpecIndex)
+	index.ctx = ctx
 	if config != nil && config.seenRemoteSources == nil {
 		config.seenRemoteSources = &syncmap.Map{}
 	}
+	if config != nil && config.seenExternalIndexes == nil {
+		config.seenExternalIndexes = &syncmap.Map{}
+	}
+	if config != nil && config.externalDocuments == nil {
+		config.externalDocuments = new(int64)
+	}
+	if config != nil && config.limitedFetcher == nil && config.ReferenceLimits != nil {
+		config.limitedFetcher = newLimitedRemoteFetcher(config.ReferenceLimits)
+	}
 	config.remoteLock = &sync.Mutex{}
 	index.config = config
 	if rootNode == nil || len(rootNode.Content) <= 0 {
-		return index
+		return index, ctx.Err()
 	}
 	boostrapIndexCollections(rootNode, index)
-	return createNewIndex(rootNode, index)
+
+	// a document with too many aliases is not indexed at all.
+	if err := config.ReferenceLimits.checkAliases("root", rootNode); err != nil {
+		index.refErrors = append(index.refErrors, &IndexingError{Err: err, Node: rootNode, Path: "$"})
+		return index, ctx.Err()
+	}
+	return createNewIndex(rootNode, index), ctx.Err()
 }
 
 // NewSpecIndex will create a new index of an OpenAPI or Swagger spec. It's not resolved or converted into anything
@@ -76,6 +104,11 @@ func createNewIndex(rootNode *yaml.Node, index *SpecIndex) *SpecIndex {
 	index.ExtractComponentsFromRefs(results)
 	index.ExtractComponentsFromRefs(poly)
 
+	// if indexing has been cancelled, there is no point counting anything.
+	if index.isCancelled() {
+		return index
+	}
+
 	index.ExtractExternalDocuments(index.root)
 	index.GetPathCount()
 
@@ -117,6 +150,20 @@ func createNewIndex(rootNode *yaml.Node, index *SpecIndex) *SpecIndex {
 	return index
 }
 
+// GetContext returns the context used to create the index, if no context was used, then context.Background()
+// is returned.
+func (index *SpecIndex) GetContext() context.Context {
+	if index.ctx == nil {
+		return context.Background()
+	}
+	return index.ctx
+}
+
+// isCancelled returns true if the context used to create the index is done.
+func (index *SpecIndex) isCancelled() bool {
+	return index.ctx != nil && index.ctx.Err() != nil
+}
+
 // GetRootNode returns document root node.
 func (index *SpecIndex) GetRootNode() *yaml.Node {
 	return index.root
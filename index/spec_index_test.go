@@ -4,6 +4,7 @@
 package index
 
 import (
+	"context"
 	"fmt"
 	"io/ioutil"
 	"log"
@@ -12,6 +13,8 @@ import (
 	"os/exec"
 	"path/filepath"
 	"testing"
+	"testing/fstest"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"gopkg.in/yaml.v3"
@@ -125,6 +128,63 @@ func TestSpecIndex_DigitalOcean_FullCheckoutLocalResolve(t *testing.T) {
 	assert.NotNil(t, index)
 }
 
+func TestSpecIndex_CircularFileReferences(t *testing.T) {
+	spec, _ := ioutil.ReadFile("../test_specs/bundle/openapi.yaml")
+	var rootNode yaml.Node
+	_ = yaml.Unmarshal(spec, &rootNode)
+
+	basePath, _ := filepath.Abs("../test_specs/bundle")
+	index := NewSpecIndexWithConfig(&rootNode, &SpecIndexConfig{BasePath: basePath, AllowFileLookup: true})
+	assert.Empty(t, index.GetReferenceIndexErrors())
+	assert.Len(t, index.GetAllExternalIndexes(), 4)
+
+	// burger.yaml and ingredient.yaml reference each other, both share the same index.
+	burger := index.GetAllExternalIndexes()["schemas/burger.yaml"]
+	ingredient := burger.GetAllExternalIndexes()["ingredient.yaml"]
+	assert.Same(t, burger, ingredient.GetAllExternalIndexes()["burger.yaml"])
+	assert.Same(t, burger, index.GetAllExternalIndexes()["paths/burgers.yaml"].
+		GetAllExternalIndexes()["../schemas/burger.yaml"])
+}
+
+func TestSpecIndex_CircularFileReferences_FS(t *testing.T) {
+	spec, _ := ioutil.ReadFile("../test_specs/bundle/openapi.yaml")
+	var rootNode yaml.Node
+	_ = yaml.Unmarshal(spec, &rootNode)
+
+	// the same specification, read from an fs.FS, resolves exactly the same way.
+	index := NewSpecIndexWithConfig(&rootNode, &SpecIndexConfig{FS: os.DirFS("../test_specs/bundle"),
+		AllowFileLookup: true})
+	assert.Empty(t, index.GetReferenceIndexErrors())
+	assert.Len(t, index.GetAllExternalIndexes(), 4)
+	assert.NotNil(t, index.GetAllExternalIndexes()["paths/burgers.yaml"].
+		GetAllExternalIndexes()["../schemas/burger.yaml"])
+}
+
+func TestSpecIndex_FileReferences_MapFS(t *testing.T) {
+	spec := `openapi: 3.1.0
+components:
+  schemas:
+    Pet:
+      $ref: 'models/pet.yaml#/Pet'
+    Missing:
+      $ref: 'models/missing.yaml#/Missing'`
+
+	var rootNode yaml.Node
+	_ = yaml.Unmarshal([]byte(spec), &rootNode)
+
+	files := fstest.MapFS{
+		"api/models/pet.yaml": {Data: []byte("Pet:\n  type: object\n  description: a pet")},
+	}
+	index := NewSpecIndexWithConfig(&rootNode, &SpecIndexConfig{FS: files, BasePath: "/api", AllowFileLookup: true})
+
+	pet := index.FindComponent("models/pet.yaml#/Pet", nil)
+	assert.NotNil(t, pet)
+	assert.Equal(t, "a pet", pet.Node.Content[3].Value)
+	assert.Nil(t, index.FindComponent("models/missing.yaml#/Missing", nil))
+	assert.NotEmpty(t, index.GetReferenceIndexErrors())
+	assert.Equal(t, "open api/models/missing.yaml: file does not exist", index.GetReferenceIndexErrors()[0].Error())
+}
+
 func TestSpecIndex_DigitalOcean_LookupsNotAllowed(t *testing.T) {
 	asana, _ := ioutil.ReadFile("../test_specs/digitalocean.yaml")
 	var rootNode yaml.Node
@@ -1092,3 +1152,47 @@ func ExampleNewSpecIndex() {
 	// 1516 enums
 	// 828 polymorphic references
 }
+
+func TestNewSpecIndexWithContext(t *testing.T) {
+	petstore, _ := ioutil.ReadFile("../test_specs/petstorev3.json")
+	var rootNode yaml.Node
+	_ = yaml.Unmarshal(petstore, &rootNode)
+
+	index, err := NewSpecIndexWithContext(context.Background(), &rootNode, CreateClosedAPIIndexConfig())
+	assert.NoError(t, err)
+	assert.Equal(t, 13, index.GetPathCount())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	index, err = NewSpecIndexWithContext(ctx, &rootNode, CreateClosedAPIIndexConfig())
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.NotNil(t, index)
+	assert.Empty(t, index.GetReferenceIndexErrors())
+	assert.Equal(t, ctx, index.GetContext())
+}
+
+func TestNewSpecIndexWithContext_RemoteTimeout(t *testing.T) {
+	spec := `openapi: 3.0.1
+components:
+  schemas:
+    Pet:
+      $ref: 'https://registry.pb33f.io/pets.yaml#/Pet'
+    Owner:
+      $ref: 'https://registry.pb33f.io/owners.yaml#/Owner'`
+
+	var rootNode yaml.Node
+	_ = yaml.Unmarshal([]byte(spec), &rootNode)
+
+	// the fetcher never returns, until the deadline passes.
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	index, err := NewSpecIndexWithContext(ctx, &rootNode, &SpecIndexConfig{
+		AllowRemoteLookup: true,
+		RemoteFetcher: RemoteFetcherFunc(func(ctx context.Context, location string) ([]byte, error) {
+			<-ctx.Done()
+			return nil, ctx.Err()
+		}),
+	})
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Empty(t, index.GetAllExternalIndexes())
+}
@@ -0,0 +1,210 @@
+// Copyright 2023 Princess B33f Heavy Industries / Dave Shanley
+// SPDX-License-Identifier: MIT
+
+// Package orderedmap contains a map that remembers the order in which its keys were added.
+//
+// The high-level models use ordered maps for everything that is a map in an OpenAPI specification (paths, schemas,
+// properties, responses and so on). The order of the keys is the order they appear in the specification, so
+// iterating and rendering a model always produces the same result, in the same order as the original document.
+// New keys are added to the end of a map.
+package orderedmap
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Map is a map that retains the order that keys are added. Keys are kept in their original position when their
+// values are replaced. A nil *Map is an empty map that can be read from, but not written to.
+type Map[K comparable, V any] struct {
+	keys   []K
+	values map[K]V
+}
+
+// Pair is a single key and value from a Map.
+type Pair[K comparable, V any] struct {
+	Key   K
+	Value V
+}
+
+// MapUntyped is implemented by every Map, it allows a map to be used when the types of the keys and values are
+// not known, like when rendering a model using reflection.
+type MapUntyped interface {
+	// Len returns the number of entries in the map.
+	Len() int
+
+	// RangeUntyped calls f for every key and value in order, until f returns false.
+	RangeUntyped(f func(key any, value any) bool)
+}
+
+// New creates a new, empty Map.
+func New[K comparable, V any]() *Map[K, V] {
+	return &Map[K, V]{values: make(map[K]V)}
+}
+
+// FromPairs creates a new Map containing the supplied pairs, in order.
+func FromPairs[K comparable, V any](pairs ...Pair[K, V]) *Map[K, V] {
+	m := New[K, V]()
+	for _, p := range pairs {
+		m.Set(p.Key, p.Value)
+	}
+	return m
+}
+
+// Len returns the number of entries in the map.
+func (m *Map[K, V]) Len() int {
+	if m == nil {
+		return 0
+	}
+	return len(m.keys)
+}
+
+// Get returns the value for a key, and true if the key exists.
+func (m *Map[K, V]) Get(key K) (V, bool) {
+	if m == nil {
+		var zero V
+		return zero, false
+	}
+	v, ok := m.values[key]
+	return v, ok
+}
+
+// GetOrZero returns the value for a key, or the zero value of V (nil for pointers) if the key does not exist.
+func (m *Map[K, V]) GetOrZero(key K) V {
+	v, _ := m.Get(key)
+	return v
+}
+
+// Set sets the value of a key. New keys are added to the end of the map, existing keys keep their position.
+func (m *Map[K, V]) Set(key K, value V) {
+	if m.values == nil {
+		m.values = make(map[K]V)
+	}
+	if _, ok := m.values[key]; !ok {
+		m.keys = append(m.keys, key)
+	}
+	m.values[key] = value
+}
+
+// Delete removes a key (and its value) from the map, if it exists.
+func (m *Map[K, V]) Delete(key K) {
+	if m == nil {
+		return
+	}
+	if _, ok := m.values[key]; !ok {
+		return
+	}
+	delete(m.values, key)
+	for i := range m.keys {
+		if m.keys[i] == key {
+			m.keys = append(m.keys[:i], m.keys[i+1:]...)
+			break
+		}
+	}
+}
+
+// Keys returns every key in order. The slice is a copy, changing it does not change the map.
+func (m *Map[K, V]) Keys() []K {
+	if m == nil {
+		return nil
+	}
+	keys := make([]K, len(m.keys))
+	copy(keys, m.keys)
+	return keys
+}
+
+// Values returns every value in the order of the keys.
+func (m *Map[K, V]) Values() []V {
+	if m == nil {
+		return nil
+	}
+	values := make([]V, len(m.keys))
+	for i, k := range m.keys {
+		values[i] = m.values[k]
+	}
+	return values
+}
+
+// Pairs returns every key and value in order.
+func (m *Map[K, V]) Pairs() []Pair[K, V] {
+	if m == nil {
+		return nil
+	}
+	pairs := make([]Pair[K, V], len(m.keys))
+	for i, k := range m.keys {
+		pairs[i] = Pair[K, V]{Key: k, Value: m.values[k]}
+	}
+	return pairs
+}
+
+// Range calls f for every key and value in order, until f returns false. The map can be changed by f, the keys
+// that are ranged over are the keys that existed when Range was called.
+func (m *Map[K, V]) Range(f func(key K, value V) bool) {
+	for _, p := range m.Pairs() {
+		if !f(p.Key, p.Value) {
+			return
+		}
+	}
+}
+
+// RangeUntyped is the same as Range, except the key and value are not typed.
+func (m *Map[K, V]) RangeUntyped(f func(key any, value any) bool) {
+	m.Range(func(key K, value V) bool {
+		return f(key, value)
+	})
+}
+
+// ToMap returns a copy of the map as a plain Go map, which does not have an order.
+func (m *Map[K, V]) ToMap() map[K]V {
+	if m == nil {
+		return nil
+	}
+	plain := make(map[K]V, len(m.keys))
+	for k, v := range m.values {
+		plain[k] = v
+	}
+	return plain
+}
+
+// MarshalYAML renders the map as a YAML mapping, in order.
+func (m *Map[K, V]) MarshalYAML() (interface{}, error) {
+	node := &yaml.Node{Kind: yaml.MappingNode, Tag: "!!map"}
+	for _, p := range m.Pairs() {
+		key := &yaml.Node{}
+		if err := key.Encode(p.Key); err != nil {
+			return nil, err
+		}
+		value := &yaml.Node{}
+		if err := value.Encode(p.Value); err != nil {
+			return nil, err
+		}
+		node.Content = append(node.Content, key, value)
+	}
+	return node, nil
+}
+
+// MarshalJSON renders the map as a JSON object, in order. Keys that are not strings are formatted using fmt.
+func (m *Map[K, V]) MarshalJSON() ([]byte, error) {
+	buf := []byte{'{'}
+	for i, p := range m.Pairs() {
+		if i > 0 {
+			buf = append(buf, ',')
+		}
+		var key any = p.Key
+		if _, ok := key.(string); !ok {
+			key = fmt.Sprint(p.Key)
+		}
+		k, err := json.Marshal(key)
+		if err != nil {
+			return nil, err
+		}
+		v, err := json.Marshal(p.Value)
+		if err != nil {
+			return nil, err
+		}
+		buf = append(append(append(buf, k...), ':'), v...)
+	}
+	return append(buf, '}'), nil
+}
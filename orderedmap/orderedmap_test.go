@@ -0,0 +1,86 @@
+// Copyright 2023 Princess B33f Heavy Industries / Dave Shanley
+// SPDX-License-Identifier: MIT
+
+package orderedmap
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/yaml.v3"
+)
+
+func TestMap(t *testing.T) {
+	m := New[string, int]()
+	m.Set("zebra", 1)
+	m.Set("apple", 2)
+	m.Set("mango", 3)
+
+	assert.Equal(t, 3, m.Len())
+	assert.Equal(t, []string{"zebra", "apple", "mango"}, m.Keys())
+	assert.Equal(t, []int{1, 2, 3}, m.Values())
+
+	// replacing a value keeps the position.
+	m.Set("zebra", 4)
+	assert.Equal(t, []string{"zebra", "apple", "mango"}, m.Keys())
+	v, ok := m.Get("zebra")
+	assert.True(t, ok)
+	assert.Equal(t, 4, v)
+
+	m.Delete("apple")
+	m.Delete("pear")
+	assert.Equal(t, []Pair[string, int]{{"zebra", 4}, {"mango", 3}}, m.Pairs())
+	assert.Equal(t, 0, m.GetOrZero("apple"))
+	assert.Equal(t, map[string]int{"zebra": 4, "mango": 3}, m.ToMap())
+
+	m.Set("apple", 5)
+	var keys []string
+	m.Range(func(key string, value int) bool {
+		keys = append(keys, key)
+		return key != "mango"
+	})
+	assert.Equal(t, []string{"zebra", "mango"}, keys)
+}
+
+func TestMap_Nil(t *testing.T) {
+	var m *Map[string, *int]
+	assert.Equal(t, 0, m.Len())
+	assert.Nil(t, m.GetOrZero("a"))
+	_, ok := m.Get("a")
+	assert.False(t, ok)
+	assert.Nil(t, m.Keys())
+	assert.Nil(t, m.Values())
+	assert.Nil(t, m.ToMap())
+	m.Delete("a")
+	m.Range(func(key string, value *int) bool {
+		t.Fail()
+		return true
+	})
+
+	// the zero value can be written to.
+	var z Map[string, int]
+	z.Set("a", 1)
+	assert.Equal(t, 1, z.GetOrZero("a"))
+}
+
+func TestMap_Marshal(t *testing.T) {
+	m := FromPairs(Pair[string, any]{"zebra", 1}, Pair[string, any]{"apple", []string{"a", "b"}},
+		Pair[string, any]{"mango", FromPairs(Pair[int, string]{2, "two"}, Pair[int, string]{1, "one"})})
+
+	out, err := yaml.Marshal(m)
+	assert.NoError(t, err)
+	assert.Equal(t, "zebra: 1\napple:\n    - a\n    - b\nmango:\n    2: two\n    1: one\n", string(out))
+
+	out, err = json.Marshal(m)
+	assert.NoError(t, err)
+	assert.Equal(t, `{"zebra":1,"apple":["a","b"],"mango":{"2":"two","1":"one"}}`, string(out))
+
+	var untyped MapUntyped = m
+	var keys []any
+	untyped.RangeUntyped(func(key any, value any) bool {
+		keys = append(keys, key)
+		return true
+	})
+	assert.Equal(t, []any{"zebra", "apple", "mango"}, keys)
+}
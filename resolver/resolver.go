@@ -4,6 +4,7 @@
 package resolver
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/pb33f/libopenapi/index"
@@ -42,6 +43,7 @@ type Resolver struct {
 	indexesVisited     int
 	journeysTaken      int
 	relativesSeen      int
+	ctx                context.Context
 }
 
 // NewResolver will create a new resolver from a *index.SpecIndex
@@ -121,7 +123,15 @@ func (resolver *Resolver) GetRelativesSeen() int {
 // re-organize the node tree. Make sure you have copied your original tree before running this (if you want to preserve
 // original data)
 func (resolver *Resolver) Resolve() []*ResolvingError {
+	errs, _ := resolver.ResolveWithContext(context.Background())
+	return errs
+}
 
+// ResolveWithContext is the same as Resolve, except resolving stops when the context is done. If it's done
+// before resolving completes, then the errors found so far are returned with the error from the context, and the
+// node tree is only partially resolved.
+func (resolver *Resolver) ResolveWithContext(ctx context.Context) ([]*ResolvingError, error) {
+	resolver.ctx = ctx
 	visitIndex(resolver, resolver.specIndex)
 
 	for _, circRef := range resolver.circularReferences {
@@ -137,11 +147,20 @@ func (resolver *Resolver) Resolve() []*ResolvingError {
 		})
 	}
 
-	return resolver.resolvingErrors
+	return resolver.resolvingErrors, ctx.Err()
 }
 
 // CheckForCircularReferences Check for circular references, without resolving, a non-destructive run.
 func (resolver *Resolver) CheckForCircularReferences() []*ResolvingError {
+	errs, _ := resolver.CheckForCircularReferencesWithContext(context.Background())
+	return errs
+}
+
+// CheckForCircularReferencesWithContext is the same as CheckForCircularReferences, except the check stops when
+// the context is done. If it's done before the check completes, then the circular references found so far are
+// returned with the error from the context.
+func (resolver *Resolver) CheckForCircularReferencesWithContext(ctx context.Context) ([]*ResolvingError, error) {
+	resolver.ctx = ctx
 	visitIndexWithoutDamagingIt(resolver, resolver.specIndex)
 	for _, circRef := range resolver.circularReferences {
 		// If the circular reference is not required, we can ignore it, as it's a terminable loop rather than an infinite one
@@ -158,7 +177,12 @@ func (resolver *Resolver) CheckForCircularReferences() []*ResolvingError {
 	}
 	// update our index with any circular refs we found.
 	resolver.specIndex.SetCircularReferences(resolver.circularReferences)
-	return resolver.resolvingErrors
+	return resolver.resolvingErrors, ctx.Err()
+}
+
+// isCancelled returns true if the context used for resolving is done.
+func (resolver *Resolver) isCancelled() bool {
+	return resolver.ctx != nil && resolver.ctx.Err() != nil
 }
 
 func visitIndexWithoutDamagingIt(res *Resolver, idx *index.SpecIndex) {
@@ -166,6 +190,9 @@ func visitIndexWithoutDamagingIt(res *Resolver, idx *index.SpecIndex) {
 	mappedIndex := idx.GetMappedReferences()
 	res.indexesVisited++
 	for _, ref := range mapped {
+		if res.isCancelled() {
+			return
+		}
 		seenReferences := make(map[string]bool)
 		var journey []*index.Reference
 		res.journeysTaken++
@@ -173,6 +200,9 @@ func visitIndexWithoutDamagingIt(res *Resolver, idx *index.SpecIndex) {
 	}
 	schemas := idx.GetAllComponentSchemas()
 	for s, schemaRef := range schemas {
+		if res.isCancelled() {
+			return
+		}
 		if mappedIndex[s] == nil {
 			seenReferences := make(map[string]bool)
 			var journey []*index.Reference
@@ -191,6 +221,9 @@ func visitIndex(res *Resolver, idx *index.SpecIndex) {
 	res.indexesVisited++
 
 	for _, ref := range mapped {
+		if res.isCancelled() {
+			return
+		}
 		seenReferences := make(map[string]bool)
 		var journey []*index.Reference
 		res.journeysTaken++
@@ -201,6 +234,9 @@ func visitIndex(res *Resolver, idx *index.SpecIndex) {
 
 	schemas := idx.GetAllComponentSchemas()
 	for s, schemaRef := range schemas {
+		if res.isCancelled() {
+			return
+		}
 		if mappedIndex[s] == nil {
 			seenReferences := make(map[string]bool)
 			var journey []*index.Reference
@@ -226,7 +262,7 @@ func visitIndex(res *Resolver, idx *index.SpecIndex) {
 // VisitReference will visit a reference as part of a journey and will return resolved nodes.
 func (resolver *Resolver) VisitReference(ref *index.Reference, seen map[string]bool, journey []*index.Reference, resolve bool) []*yaml.Node {
 	resolver.referencesVisited++
-	if ref.Resolved || ref.Seen {
+	if ref.Resolved || ref.Seen || resolver.isCancelled() {
 		return ref.Node.Content
 	}
 
@@ -1,6 +1,7 @@
 package resolver
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"io/ioutil"
@@ -63,6 +64,38 @@ func TestResolver_CheckForCircularReferences(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestResolver_CheckForCircularReferencesWithContext(t *testing.T) {
+	circular, _ := ioutil.ReadFile("../test_specs/circular-tests.yaml")
+	var rootNode yaml.Node
+	_ = yaml.Unmarshal(circular, &rootNode)
+
+	idx := index.NewSpecIndexWithConfig(&rootNode, index.CreateClosedAPIIndexConfig())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	circ, err := NewResolver(idx).CheckForCircularReferencesWithContext(ctx)
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Empty(t, circ)
+
+	circ, err = NewResolver(idx).CheckForCircularReferencesWithContext(context.Background())
+	assert.NoError(t, err)
+	assert.Len(t, circ, 3)
+}
+
+func TestResolver_ResolveWithContext(t *testing.T) {
+	circular, _ := ioutil.ReadFile("../test_specs/circular-tests.yaml")
+	var rootNode yaml.Node
+	_ = yaml.Unmarshal(circular, &rootNode)
+
+	resolver := NewResolver(index.NewSpecIndexWithConfig(&rootNode, index.CreateClosedAPIIndexConfig()))
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	circ, err := resolver.ResolveWithContext(ctx)
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Empty(t, circ)
+	assert.Zero(t, resolver.GetJourneysTaken())
+}
+
 func TestResolver_CheckForCircularReferences_DigitalOcean(t *testing.T) {
 	circular, _ := ioutil.ReadFile("../test_specs/digitalocean.yaml")
 	var rootNode yaml.Node
@@ -0,0 +1,108 @@
+// Copyright 2023 Princess B33f Heavy Industries / Dave Shanley
+// SPDX-License-Identifier: MIT
+
+package utils
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	"gopkg.in/yaml.v3"
+)
+
+// ConvertYAMLNodeToJSON will render a YAML node as JSON. Unlike ConvertYAMLtoJSON, the order of every key is the same
+// as the order in the node. If indent is empty, compact JSON is returned, otherwise each level of the JSON is
+// indented using indent (for example, two spaces or a tab).
+//
+// Scalars are rendered using their YAML type, so numbers, booleans and nulls remain numbers, booleans and nulls. Values
+// that cannot be expressed in JSON (like infinity) are rendered as strings.
+func ConvertYAMLNodeToJSON(node *yaml.Node, indent string) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := writeJSONNode(&buf, node); err != nil {
+		return nil, err
+	}
+	if indent == "" {
+		return buf.Bytes(), nil
+	}
+	var indented bytes.Buffer
+	if err := json.Indent(&indented, buf.Bytes(), "", indent); err != nil {
+		return nil, err
+	}
+	return indented.Bytes(), nil
+}
+
+func writeJSONNode(buf *bytes.Buffer, node *yaml.Node) error {
+	if node == nil || node.Kind == 0 {
+		buf.WriteString("null")
+		return nil
+	}
+	switch node.Kind {
+	case yaml.DocumentNode:
+		if len(node.Content) == 0 {
+			buf.WriteString("null")
+			return nil
+		}
+		return writeJSONNode(buf, node.Content[0])
+	case yaml.AliasNode:
+		return writeJSONNode(buf, node.Alias)
+	case yaml.MappingNode:
+		buf.WriteByte('{')
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			if i > 0 {
+				buf.WriteByte(',')
+			}
+			key := node.Content[i]
+			if key.Kind == yaml.AliasNode && key.Alias != nil {
+				key = key.Alias
+			}
+			writeJSONString(buf, key.Value)
+			buf.WriteByte(':')
+			if err := writeJSONNode(buf, node.Content[i+1]); err != nil {
+				return err
+			}
+		}
+		buf.WriteByte('}')
+	case yaml.SequenceNode:
+		buf.WriteByte('[')
+		for i := range node.Content {
+			if i > 0 {
+				buf.WriteByte(',')
+			}
+			if err := writeJSONNode(buf, node.Content[i]); err != nil {
+				return err
+			}
+		}
+		buf.WriteByte(']')
+	case yaml.ScalarNode:
+		switch node.ShortTag() {
+		case "!!null":
+			buf.WriteString("null")
+		case "!!bool", "!!int", "!!float":
+			var v any
+			if err := node.Decode(&v); err != nil {
+				return err
+			}
+			b, err := json.Marshal(v)
+			if err != nil {
+				// infinity and NaN have no JSON equivalent.
+				writeJSONString(buf, node.Value)
+				return nil
+			}
+			buf.Write(b)
+		default:
+			writeJSONString(buf, node.Value)
+		}
+	default:
+		return fmt.Errorf("unable to convert YAML node of kind %d to JSON", node.Kind)
+	}
+	return nil
+}
+
+// writeJSONString writes a JSON string, without escaping HTML (descriptions are full of it).
+func writeJSONString(buf *bytes.Buffer, s string) {
+	enc := json.NewEncoder(buf)
+	enc.SetEscapeHTML(false)
+	_ = enc.Encode(s)
+	buf.Truncate(buf.Len() - 1) // the encoder adds a newline.
+}
@@ -0,0 +1,48 @@
+// Copyright 2023 Princess B33f Heavy Industries / Dave Shanley
+// SPDX-License-Identifier: MIT
+
+package utils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/yaml.v3"
+)
+
+func TestConvertYAMLNodeToJSON(t *testing.T) {
+	yml := `zebra: <b>striped</b>
+apple: &fruit
+  count: 12
+  price: 1.5
+  fresh: true
+  seeds: ~
+  size: .inf
+mango: *fruit
+list: [a, '1', 2]
+"404": quoted`
+
+	var node yaml.Node
+	_ = yaml.Unmarshal([]byte(yml), &node)
+
+	out, err := ConvertYAMLNodeToJSON(&node, "")
+	assert.NoError(t, err)
+	assert.Equal(t, `{"zebra":"<b>striped</b>","apple":{"count":12,"price":1.5,"fresh":true,"seeds":null,"size":".inf"},`+
+		`"mango":{"count":12,"price":1.5,"fresh":true,"seeds":null,"size":".inf"},"list":["a","1",2],"404":"quoted"}`,
+		string(out))
+
+	out, err = ConvertYAMLNodeToJSON(node.Content[0].Content[7], "  ")
+	assert.NoError(t, err)
+	assert.Equal(t, "[\n  \"a\",\n  \"1\",\n  2\n]", string(out))
+
+	out, err = ConvertYAMLNodeToJSON(&yaml.Node{Kind: yaml.DocumentNode}, "")
+	assert.NoError(t, err)
+	assert.Equal(t, "null", string(out))
+}
+
+func TestConvertYAMLNodeToJSON_BadNode(t *testing.T) {
+	out, err := ConvertYAMLNodeToJSON(&yaml.Node{Kind: yaml.MappingNode,
+		Content: []*yaml.Node{CreateStringNode("bad"), {Kind: yaml.Kind(99)}}}, "")
+	assert.Error(t, err)
+	assert.Nil(t, out)
+}
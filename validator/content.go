@@ -0,0 +1,153 @@
+// Copyright 2023 Princess B33f Heavy Industries / Dave Shanley
+// SPDX-License-Identifier: MIT
+
+package validator
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime"
+	"net/url"
+	"sort"
+	"strings"
+
+	"github.com/pb33f/libopenapi/datamodel/high/base"
+	v3 "github.com/pb33f/libopenapi/datamodel/high/v3"
+	"github.com/pb33f/libopenapi/orderedmap"
+	"gopkg.in/yaml.v3"
+)
+
+// validateContent locates the MediaType for a body using the content type, then decodes the body and checks it
+// against the schema of the MediaType. The noun is used to describe the body in messages ('request body' or
+// 'response body') and the node is used as the spec location when nothing more specific is available.
+func validateContent(validationType, noun string, content *orderedmap.Map[string, *v3.MediaType], contentType string,
+	body []byte, failMessage string, node *yaml.Node, context base.ValidationContext) []*ValidationError {
+
+	mediaTypeName, mediaType := findMediaType(content, contentType)
+	if mediaType == nil {
+		return []*ValidationError{newValidationError(validationType, "contentType",
+			fmt.Sprintf("%s content type '%s' is not supported", noun, contentType),
+			fmt.Sprintf("the %s must use one of the following content types: %s", noun,
+				strings.Join(sortedContentTypes(content), ", ")), node)}
+	}
+	if mediaType.Schema == nil {
+		return nil
+	}
+	schema, err := mediaType.Schema.BuildSchema()
+	if err != nil || schema == nil {
+		return nil
+	}
+
+	value, decoded, err := decodeBody(mediaTypeName, schema, body)
+	if err != nil {
+		return []*ValidationError{newValidationError(validationType, "decode",
+			fmt.Sprintf("%s could not be decoded as '%s'", noun, mediaTypeName), err.Error(), node)}
+	}
+	if !decoded {
+		return nil
+	}
+	return schemaFailureErrors(validationType, "schema", failMessage, schema.ValidateWithContext(value, context), node)
+}
+
+// schemaFailureErrors converts schema validation errors into ValidationErrors, the fallback node is used when a
+// failure has no node of its own.
+func schemaFailureErrors(validationType, subType, message string, failures []base.ValidationError,
+	fallback *yaml.Node) []*ValidationError {
+
+	var errs []*ValidationError
+	for _, f := range failures {
+		node := f.Node
+		if node == nil {
+			node = fallback
+		}
+		ve := newValidationError(validationType, subType, message, f.Reason, node)
+		ve.Location = f.Location
+		errs = append(errs, ve)
+	}
+	return errs
+}
+
+// readBody reads a body and then replaces it with a new reader, so it can be read again.
+func readBody(body *io.ReadCloser) ([]byte, error) {
+	if *body == nil {
+		return nil, nil
+	}
+	b, err := io.ReadAll(*body)
+	_ = (*body).Close()
+	*body = io.NopCloser(bytes.NewReader(b))
+	return b, err
+}
+
+// findMediaType locates the MediaType for a Content-Type header value. Exact matches are preferred, followed by
+// a sub-type wildcard ('application/*') and then a full wildcard ('*/*'). The name of the matched media type is
+// returned along with the MediaType.
+func findMediaType(content *orderedmap.Map[string, *v3.MediaType], contentType string) (string, *v3.MediaType) {
+	mt, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		mt = strings.ToLower(strings.TrimSpace(contentType))
+	}
+	for _, pair := range content.Pairs() {
+		if strings.EqualFold(pair.Key, mt) {
+			return mt, pair.Value
+		}
+	}
+	if i := strings.Index(mt, "/"); i > 0 {
+		if v, ok := content.Get(mt[:i] + "/*"); ok {
+			return mt, v
+		}
+	}
+	if v, ok := content.Get("*/*"); ok {
+		return mt, v
+	}
+	return mt, nil
+}
+
+// sortedContentTypes returns the keys of a content map in order.
+func sortedContentTypes(content *orderedmap.Map[string, *v3.MediaType]) []string {
+	keys := content.Keys()
+	sort.Strings(keys)
+	return keys
+}
+
+// isJSONMediaType returns true for 'application/json' and any media type using the '+json' suffix.
+func isJSONMediaType(mediaType string) bool {
+	mediaType = strings.ToLower(mediaType)
+	return mediaType == "application/json" || strings.HasSuffix(mediaType, "+json")
+}
+
+// decodeBody decodes a body for the supported media types, it returns false if the media type can't be decoded.
+func decodeBody(mediaType string, schema *base.Schema, body []byte) (any, bool, error) {
+	switch {
+	case isJSONMediaType(mediaType):
+		var value any
+		if err := json.Unmarshal(body, &value); err != nil {
+			return nil, true, err
+		}
+		return value, true, nil
+	case mediaType == "application/x-www-form-urlencoded":
+		form, err := url.ParseQuery(string(body))
+		if err != nil {
+			return nil, true, err
+		}
+		obj := make(map[string]any)
+		for k, values := range form {
+			propSchema := propertySchema(schema, k)
+			var value any
+			if schemaKind(propSchema) == kindArray {
+				value, err = decodeValue(propSchema, strings.Join(values, ","), ",", false, nil)
+			} else {
+				value, err = coerceValue(propSchema, values[0])
+			}
+			if err != nil {
+				return nil, true, fmt.Errorf("form field '%s': %s", k, err.Error())
+			}
+			obj[k] = value
+		}
+		return obj, true, nil
+	case strings.HasPrefix(mediaType, "text/plain"):
+		return string(body), true, nil
+	}
+	return nil, false, nil
+}
@@ -0,0 +1,82 @@
+// Copyright 2023 Princess B33f Heavy Industries / Dave Shanley
+// SPDX-License-Identifier: MIT
+
+package validator
+
+import (
+	"fmt"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Validation types are used to classify a ValidationError by the part of the HTTP exchange that failed.
+const (
+	PathValidation           = "path"
+	ParameterValidation      = "parameter"
+	RequestBodyValidation    = "requestBody"
+	ResponseValidation       = "response"
+	ResponseHeaderValidation = "responseHeader"
+	ResponseBodyValidation   = "responseBody"
+)
+
+// ValidationError represents a single failure found when validating an HTTP request or response against an
+// OpenAPI 3+ document.
+//
+// Where possible, the error will point back to the part of the specification that caused the failure, using the
+// low-level yaml.Node that was used to build the high-level model.
+type ValidationError struct {
+	// Message is a short, human-readable summary of the failure.
+	Message string
+
+	// Reason explains why the failure occurred.
+	Reason string
+
+	// ValidationType is the part of the HTTP exchange that failed, one of PathValidation, ParameterValidation,
+	// RequestBodyValidation, ResponseValidation, ResponseHeaderValidation or ResponseBodyValidation.
+	ValidationType string
+
+	// ValidationSubType narrows down the ValidationType. For parameters, this is the location of the parameter
+	// (path, query, header or cookie).
+	ValidationSubType string
+
+	// Location is a JSON Pointer to the failing value within a decoded body or parameter, if applicable.
+	Location string
+
+	// SpecLine and SpecCol locate the failure inside the specification, they are zero if no location is known.
+	SpecLine int
+	SpecCol  int
+
+	// SpecNode is the low-level yaml.Node from the specification that triggered the failure (if known).
+	SpecNode *yaml.Node `json:"-" yaml:"-"`
+}
+
+// Error returns a string representation of the ValidationError, this makes it compatible with the error interface.
+func (v *ValidationError) Error() string {
+	msg := v.Message
+	if v.Reason != "" {
+		msg = fmt.Sprintf("%s: %s", msg, v.Reason)
+	}
+	if v.Location != "" {
+		msg = fmt.Sprintf("%s (at '%s')", msg, v.Location)
+	}
+	if v.SpecLine > 0 {
+		msg = fmt.Sprintf("%s [spec line %d, column %d]", msg, v.SpecLine, v.SpecCol)
+	}
+	return msg
+}
+
+// newValidationError creates a new ValidationError, pulling the line and column from the supplied node (if not nil).
+func newValidationError(validationType, subType, message, reason string, node *yaml.Node) *ValidationError {
+	ve := &ValidationError{
+		Message:           message,
+		Reason:            reason,
+		ValidationType:    validationType,
+		ValidationSubType: subType,
+		SpecNode:          node,
+	}
+	if node != nil {
+		ve.SpecLine = node.Line
+		ve.SpecCol = node.Column
+	}
+	return ve
+}
@@ -0,0 +1,508 @@
+// Copyright 2023 Princess B33f Heavy Industries / Dave Shanley
+// SPDX-License-Identifier: MIT
+
+package validator
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/pb33f/libopenapi/datamodel/high/base"
+	v3 "github.com/pb33f/libopenapi/datamodel/high/v3"
+	"github.com/pb33f/libopenapi/orderedmap"
+	"gopkg.in/yaml.v3"
+)
+
+// Parameter locations, as defined by the 'in' property of a Parameter.
+const (
+	PathParameter   = "path"
+	QueryParameter  = "query"
+	HeaderParameter = "header"
+	CookieParameter = "cookie"
+)
+
+// Parameter serialization styles.
+const (
+	styleSimple         = "simple"
+	styleLabel          = "label"
+	styleMatrix         = "matrix"
+	styleForm           = "form"
+	styleSpaceDelimited = "spaceDelimited"
+	stylePipeDelimited  = "pipeDelimited"
+	styleDeepObject     = "deepObject"
+)
+
+// schema kinds used when decoding serialized parameters.
+const (
+	kindPrimitive = iota
+	kindArray
+	kindObject
+)
+
+// ValidateRequestParameters validates the path, query, header and cookie parameters of a request against the
+// parameters defined by the matched PathItem and Operation. Parameters defined by the Operation override those
+// defined by the PathItem (matched by name and location).
+//
+// Serialized values are decoded using the 'style' and 'explode' properties of each parameter before being checked
+// against the parameter schema, or against the schema of the media type when 'content' is used. Header parameters
+// named 'Accept', 'Content-Type' or 'Authorization' are ignored, as per the specification.
+func (v *Validator) ValidateRequestParameters(request *http.Request, match *PathMatch) []*ValidationError {
+	if match == nil || match.Operation == nil {
+		return nil
+	}
+	query := request.URL.Query()
+	var errs []*ValidationError
+	for _, param := range collectParameters(match.PathItem, match.Operation) {
+		switch param.In {
+		case PathParameter:
+			if raw, ok := match.PathValues[param.Name]; ok {
+				errs = append(errs, checkParameter(param, raw, func(schema *base.Schema) (any, error) {
+					return decodePathParameter(param, schema, raw)
+				})...)
+			}
+		case QueryParameter:
+			errs = append(errs, validateQueryParameter(param, query)...)
+		case HeaderParameter:
+			if ignoredHeader(param.Name) {
+				continue
+			}
+			values := request.Header.Values(param.Name)
+			if len(values) == 0 {
+				errs = append(errs, missingParameter(param)...)
+				continue
+			}
+			raw := strings.Join(values, ",")
+			errs = append(errs, checkParameter(param, raw, func(schema *base.Schema) (any, error) {
+				return decodeValue(schema, raw, ",", explodeParameter(param), strings.TrimSpace)
+			})...)
+		case CookieParameter:
+			cookie, err := request.Cookie(param.Name)
+			if err != nil {
+				errs = append(errs, missingParameter(param)...)
+				continue
+			}
+			raw := cookie.Value
+			errs = append(errs, checkParameter(param, raw, func(schema *base.Schema) (any, error) {
+				return decodeValue(schema, raw, ",", false, unescapeQuery)
+			})...)
+		}
+	}
+	return errs
+}
+
+// collectParameters merges the parameters of a PathItem and an Operation, Operation parameters take precedence.
+func collectParameters(pathItem *v3.PathItem, operation *v3.Operation) []*v3.Parameter {
+	var params []*v3.Parameter
+	overrides := make(map[string]*v3.Parameter)
+	for _, p := range operation.Parameters {
+		overrides[p.In+":"+p.Name] = p
+	}
+	if pathItem != nil {
+		for _, p := range pathItem.Parameters {
+			if o, ok := overrides[p.In+":"+p.Name]; ok {
+				p = o
+				delete(overrides, p.In+":"+p.Name)
+			}
+			params = append(params, p)
+		}
+	}
+	for _, p := range operation.Parameters {
+		if _, ok := overrides[p.In+":"+p.Name]; ok {
+			params = append(params, p)
+		}
+	}
+	return params
+}
+
+// validateQueryParameter locates and validates a single query parameter.
+func validateQueryParameter(param *v3.Parameter, query url.Values) []*ValidationError {
+	schema := parameterSchema(param)
+	style := parameterStyle(param)
+	explode := explodeParameter(param)
+
+	// exploded objects and deep objects are spread across multiple query keys.
+	if param.Content.Len() == 0 && schema != nil && schemaKind(schema) == kindObject &&
+		(style == styleDeepObject || (style == styleForm && explode)) {
+		obj, found, err := decodeSpreadObject(param, schema, query, style == styleDeepObject)
+		if !found {
+			return missingParameter(param)
+		}
+		if err != nil {
+			return []*ValidationError{parameterDecodeError(param, err)}
+		}
+		return parameterSchemaErrors(param, schema.ValidateWithContext(obj, base.ValidateRequest))
+	}
+
+	values, ok := query[param.Name]
+	if !ok {
+		return missingParameter(param)
+	}
+	if len(values) > 0 && values[0] == "" && !param.AllowEmptyValue {
+		return []*ValidationError{newValidationError(ParameterValidation, param.In,
+			fmt.Sprintf("query parameter '%s' has an empty value", param.Name),
+			"empty values are not allowed, unless 'allowEmptyValue' is set to true", parameterNode(param))}
+	}
+	return checkParameter(param, values[0], func(schema *base.Schema) (any, error) {
+		sep := ","
+		switch style {
+		case styleSpaceDelimited:
+			sep = " "
+		case stylePipeDelimited:
+			sep = "|"
+		}
+		// exploded arrays are repeated instead of delimited, for example '?id=1&id=2', so every value is an item.
+		if explode && schemaKind(schema) == kindArray && (len(values) > 1 || values[0] != "") {
+			return decodeArray(schema, values)
+		}
+		return decodeValue(schema, values[0], sep, false, nil)
+	})
+}
+
+// checkParameter decodes a raw parameter value and checks it against the parameter schema. If the parameter uses
+// 'content' instead of 'schema', then the value is decoded using the media type instead.
+func checkParameter(param *v3.Parameter, raw string, decode func(schema *base.Schema) (any, error)) []*ValidationError {
+	if param.Schema == nil {
+		return checkParameterContent(param, raw)
+	}
+	schema, err := param.Schema.BuildSchema()
+	if err != nil || schema == nil {
+		return nil
+	}
+	value, err := decode(schema)
+	if err != nil {
+		return []*ValidationError{parameterDecodeError(param, err)}
+	}
+	return parameterSchemaErrors(param, schema.ValidateWithContext(value, base.ValidateRequest))
+}
+
+// checkParameterContent checks a parameter that is serialized using a media type rather than a style.
+func checkParameterContent(param *v3.Parameter, raw string) []*ValidationError {
+	if param.In == PathParameter {
+		raw = unescapePath(raw)
+	}
+	proxy, value, err := decodeContentValue(param.Content, raw)
+	if err != nil {
+		return []*ValidationError{parameterDecodeError(param, err)}
+	}
+	return parameterSchemaErrors(param, proxy.ValidateWithContext(value, base.ValidateRequest))
+}
+
+// decodeContentValue decodes a raw value serialized using the (single) media type of a content map. JSON media
+// types are unmarshalled, anything else is kept as a string. The schema of the media type is returned with the value.
+func decodeContentValue(content *orderedmap.Map[string, *v3.MediaType], raw string) (*base.SchemaProxy, any, error) {
+	for _, pair := range content.Pairs() {
+		mediaType, mt := pair.Key, pair.Value
+		if mt.Schema == nil {
+			return nil, nil, nil
+		}
+		var value any = raw
+		if isJSONMediaType(mediaType) {
+			if err := json.Unmarshal([]byte(raw), &value); err != nil {
+				return nil, nil, fmt.Errorf("the value is not valid JSON: %s", err.Error())
+			}
+		}
+		return mt.Schema, value, nil
+	}
+	return nil, nil, nil
+}
+
+// decodePathParameter decodes a templated path value using the 'simple', 'label' or 'matrix' styles.
+func decodePathParameter(param *v3.Parameter, schema *base.Schema, raw string) (any, error) {
+	explode := explodeParameter(param)
+	switch parameterStyle(param) {
+	case styleLabel:
+		if !strings.HasPrefix(raw, ".") {
+			return nil, fmt.Errorf("label style values must start with '.', got '%s'", raw)
+		}
+		sep := ","
+		if explode {
+			sep = "."
+		}
+		return decodeValue(schema, raw[1:], sep, explode, unescapePath)
+	case styleMatrix:
+		if !strings.HasPrefix(raw, ";") {
+			return nil, fmt.Errorf("matrix style values must start with ';', got '%s'", raw)
+		}
+		kind := schemaKind(schema)
+		if explode && kind == kindObject {
+			return decodeValue(schema, raw[1:], ";", true, unescapePath)
+		}
+		prefix := param.Name + "="
+		var parts []string
+		if explode && kind == kindArray {
+			for _, p := range strings.Split(raw[1:], ";") {
+				if !strings.HasPrefix(p, prefix) {
+					return nil, fmt.Errorf("matrix style value '%s' is not prefixed with '%s'", p, prefix)
+				}
+				parts = append(parts, strings.TrimPrefix(p, prefix))
+			}
+			return decodeValue(schema, strings.Join(parts, ","), ",", false, unescapePath)
+		}
+		if !strings.HasPrefix(raw[1:], prefix) {
+			return nil, fmt.Errorf("matrix style value '%s' is not prefixed with '%s'", raw, ";"+prefix)
+		}
+		return decodeValue(schema, raw[1+len(prefix):], ",", false, unescapePath)
+	}
+	return decodeValue(schema, raw, ",", explode, unescapePath)
+}
+
+// decodeSpreadObject builds an object from query keys. Deep objects use keys like 'name[property]', exploded form
+// objects use the property names directly as keys.
+func decodeSpreadObject(param *v3.Parameter, schema *base.Schema, query url.Values, deep bool) (map[string]any, bool, error) {
+	obj := make(map[string]any)
+	var keys []string
+	for k := range query {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		name := k
+		if deep {
+			if !strings.HasPrefix(k, param.Name+"[") || !strings.HasSuffix(k, "]") {
+				continue
+			}
+			name = k[len(param.Name)+1 : len(k)-1]
+		} else if _, ok := schema.Properties.Get(name); !ok {
+			continue
+		}
+		propSchema := propertySchema(schema, name)
+		var value any
+		var err error
+		if propSchema != nil && schemaKind(propSchema) == kindArray {
+			value, err = decodeValue(propSchema, strings.Join(query[k], ","), ",", false, nil)
+		} else {
+			value, err = coerceValue(propSchema, query[k][0])
+		}
+		if err != nil {
+			return nil, true, fmt.Errorf("property '%s': %s", name, err.Error())
+		}
+		obj[name] = value
+	}
+	return obj, len(obj) > 0, nil
+}
+
+// decodeValue decodes a serialized value into a primitive, array or object (depending on the schema). Items are
+// separated by sep. When exploded, objects are serialized as 'key=value' pairs, otherwise keys and values alternate.
+func decodeValue(schema *base.Schema, raw, sep string, explodedObject bool, unescape func(string) string) (any, error) {
+	if unescape == nil {
+		unescape = func(s string) string { return s }
+	}
+	switch schemaKind(schema) {
+	case kindArray:
+		if raw == "" {
+			return []any{}, nil
+		}
+		parts := strings.Split(raw, sep)
+		for i := range parts {
+			parts[i] = unescape(parts[i])
+		}
+		return decodeArray(schema, parts)
+	case kindObject:
+		obj := make(map[string]any)
+		if raw == "" {
+			return obj, nil
+		}
+		parts := strings.Split(raw, sep)
+		if !explodedObject && len(parts)%2 != 0 {
+			return nil, fmt.Errorf("object value '%s' does not contain matching keys and values", raw)
+		}
+		for i := 0; i < len(parts); i++ {
+			var key, val string
+			if explodedObject {
+				kv := strings.SplitN(parts[i], "=", 2)
+				if len(kv) != 2 {
+					return nil, fmt.Errorf("object property '%s' is not in the format 'key=value'", parts[i])
+				}
+				key, val = unescape(kv[0]), unescape(kv[1])
+			} else {
+				key, val = unescape(parts[i]), unescape(parts[i+1])
+				i++
+			}
+			value, err := coerceValue(propertySchema(schema, key), val)
+			if err != nil {
+				return nil, fmt.Errorf("property '%s': %s", key, err.Error())
+			}
+			obj[key] = value
+		}
+		return obj, nil
+	}
+	return coerceValue(schema, unescape(raw))
+}
+
+// decodeArray converts every item of an array value into the type defined by the items schema.
+func decodeArray(schema *base.Schema, parts []string) ([]any, error) {
+	var itemSchema *base.Schema
+	if schema.Items != nil && schema.Items.IsA() && schema.Items.A != nil {
+		itemSchema = schema.Items.A.Schema()
+	}
+	items := make([]any, 0, len(parts))
+	for _, part := range parts {
+		item, err := coerceValue(itemSchema, part)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, item)
+	}
+	return items, nil
+}
+
+// coerceValue converts a raw string into the primitive type defined by the schema. If the schema allows more than
+// one type, each is tried in turn.
+func coerceValue(schema *base.Schema, raw string) (any, error) {
+	if schema == nil || len(schema.Type) == 0 {
+		return raw, nil
+	}
+	for _, t := range schema.Type {
+		switch t {
+		case "string":
+			return raw, nil
+		case "integer":
+			if i, err := strconv.ParseInt(raw, 10, 64); err == nil {
+				return i, nil
+			}
+		case "number":
+			if f, err := strconv.ParseFloat(raw, 64); err == nil {
+				return f, nil
+			}
+		case "boolean":
+			if raw == "true" || raw == "false" {
+				return raw == "true", nil
+			}
+		case "null":
+			if raw == "" || raw == "null" {
+				return nil, nil
+			}
+		case "array", "object":
+			// nested structures cannot be serialized in a parameter, keep the raw value.
+			return raw, nil
+		}
+	}
+	return nil, fmt.Errorf("the value '%s' is not a valid %s", raw, strings.Join(schema.Type, " or "))
+}
+
+// schemaKind determines if a schema describes an array, an object or a primitive value.
+func schemaKind(schema *base.Schema) int {
+	if schema == nil {
+		return kindPrimitive
+	}
+	if containsType(schema.Type, "array") || (len(schema.Type) == 0 && schema.Items != nil) {
+		return kindArray
+	}
+	if containsType(schema.Type, "object") || (len(schema.Type) == 0 && schema.Properties.Len() > 0) {
+		return kindObject
+	}
+	return kindPrimitive
+}
+
+// propertySchema returns the schema for a named property, falling back to additionalProperties if it's a schema.
+func propertySchema(schema *base.Schema, name string) *base.Schema {
+	if prop, ok := schema.Properties.Get(name); ok {
+		return prop.Schema()
+	}
+	if ap, ok := schema.AdditionalProperties.(*base.SchemaProxy); ok {
+		return ap.Schema()
+	}
+	return nil
+}
+
+// parameterSchema returns the schema of a parameter, or nil if it uses 'content' or can't be built.
+func parameterSchema(param *v3.Parameter) *base.Schema {
+	if param.Schema == nil {
+		return nil
+	}
+	return param.Schema.Schema()
+}
+
+// parameterStyle returns the style of a parameter, applying the default for its location if not set.
+func parameterStyle(param *v3.Parameter) string {
+	if param.Style != "" {
+		return param.Style
+	}
+	switch param.In {
+	case QueryParameter, CookieParameter:
+		return styleForm
+	}
+	return styleSimple
+}
+
+// explodeParameter returns the explode value of a parameter, 'form' style parameters default to true.
+func explodeParameter(param *v3.Parameter) bool {
+	if param.Explode != nil {
+		return *param.Explode
+	}
+	return parameterStyle(param) == styleForm
+}
+
+// parameterNode returns the node that best represents the parameter in the specification.
+func parameterNode(param *v3.Parameter) *yaml.Node {
+	if low := param.GoLow(); low != nil {
+		return low.Name.KeyNode
+	}
+	return nil
+}
+
+// missingParameter returns an error if the parameter is required, path parameters are always required.
+func missingParameter(param *v3.Parameter) []*ValidationError {
+	if !param.Required && param.In != PathParameter {
+		return nil
+	}
+	var node *yaml.Node
+	if low := param.GoLow(); low != nil && low.Required.KeyNode != nil {
+		node = low.Required.KeyNode
+	} else {
+		node = parameterNode(param)
+	}
+	return []*ValidationError{newValidationError(ParameterValidation, param.In,
+		fmt.Sprintf("%s parameter '%s' is missing", param.In, param.Name),
+		fmt.Sprintf("the %s parameter '%s' is required, but was not found in the request", param.In, param.Name),
+		node)}
+}
+
+// parameterDecodeError creates a ValidationError for a value that could not be decoded.
+func parameterDecodeError(param *v3.Parameter, err error) *ValidationError {
+	return newValidationError(ParameterValidation, param.In,
+		fmt.Sprintf("%s parameter '%s' could not be decoded", param.In, param.Name),
+		err.Error(), parameterNode(param))
+}
+
+// parameterSchemaErrors converts schema failures into parameter ValidationErrors.
+func parameterSchemaErrors(param *v3.Parameter, failures []base.ValidationError) []*ValidationError {
+	return schemaFailureErrors(ParameterValidation, param.In,
+		fmt.Sprintf("%s parameter '%s' failed to validate", param.In, param.Name), failures, parameterNode(param))
+}
+
+func unescapePath(s string) string {
+	if u, err := url.PathUnescape(s); err == nil {
+		return u
+	}
+	return s
+}
+
+func unescapeQuery(s string) string {
+	if u, err := url.QueryUnescape(s); err == nil {
+		return u
+	}
+	return s
+}
+
+// ignoredHeader returns true for header parameters that must be ignored, as per the specification, because they are
+// described elsewhere ('Accept' and 'Content-Type') or by security schemes ('Authorization').
+func ignoredHeader(name string) bool {
+	return strings.EqualFold(name, "Accept") || strings.EqualFold(name, "Content-Type") ||
+		strings.EqualFold(name, "Authorization")
+}
+
+// containsType returns true if a list of schema types contains the supplied type.
+func containsType(types []string, t string) bool {
+	for _, x := range types {
+		if x == t {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,263 @@
+// Copyright 2023 Princess B33f Heavy Industries / Dave Shanley
+// SPDX-License-Identifier: MIT
+
+package validator
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var paramSpec = `openapi: 3.1.0
+info:
+  title: Parameters
+  version: 1.0.0
+paths:
+  /label/{color}:
+    get:
+      parameters:
+        - name: color
+          in: path
+          required: true
+          style: label
+          explode: true
+          schema:
+            type: array
+            items:
+              type: string
+              enum: [red, green, blue]
+  /matrix/{point}:
+    get:
+      parameters:
+        - name: point
+          in: path
+          required: true
+          style: matrix
+          explode: true
+          schema:
+            type: object
+            properties:
+              x:
+                type: integer
+              y:
+                type: integer
+                maximum: 10
+  /matrix-array/{ids}:
+    get:
+      parameters:
+        - name: ids
+          in: path
+          required: true
+          style: matrix
+          schema:
+            type: array
+            items:
+              type: integer
+  /simple/{point}:
+    get:
+      parameters:
+        - name: point
+          in: path
+          required: true
+          schema:
+            type: object
+            properties:
+              x:
+                type: number
+  /query:
+    get:
+      parameters:
+        - name: ids
+          in: query
+          required: true
+          schema:
+            type: array
+            maxItems: 3
+            items:
+              type: integer
+        - name: names
+          in: query
+          style: pipeDelimited
+          explode: false
+          schema:
+            type: array
+            items:
+              type: string
+        - name: filter
+          in: query
+          style: deepObject
+          schema:
+            type: object
+            required: [size]
+            properties:
+              size:
+                type: integer
+              active:
+                type: boolean
+        - name: page
+          in: query
+          schema:
+            type: object
+            properties:
+              offset:
+                type: integer
+              limit:
+                type: integer
+                maximum: 50
+        - name: search
+          in: query
+          content:
+            application/json:
+              schema:
+                type: object
+                required: [term]
+                properties:
+                  term:
+                    type: string
+        - name: empty
+          in: query
+          schema:
+            type: string
+  /headers:
+    get:
+      parameters:
+        - name: X-Coords
+          in: header
+          explode: true
+          schema:
+            type: object
+            properties:
+              lat:
+                type: number
+              long:
+                type: number
+        - name: session
+          in: cookie
+          required: true
+          schema:
+            type: integer
+        - name: Accept
+          in: header
+          required: true
+          schema:
+            type: integer
+        - name: content-type
+          in: header
+          required: true
+          schema:
+            type: integer
+        - name: Authorization
+          in: header
+          required: true
+          schema:
+            type: integer`
+
+func validateParams(t *testing.T, request *http.Request) []*ValidationError {
+	v := newTestValidator(t, paramSpec)
+	match, err := v.FindPath(request)
+	assert.Nil(t, err)
+	return v.ValidateRequestParameters(request, match)
+}
+
+func TestValidateRequestParameters_Label(t *testing.T) {
+	errs := validateParams(t, httptest.NewRequest(http.MethodGet, "/label/.red.green", nil))
+	assert.Empty(t, errs)
+
+	errs = validateParams(t, httptest.NewRequest(http.MethodGet, "/label/.red.purple", nil))
+	assert.Len(t, errs, 1)
+	assert.Equal(t, "/1", errs[0].Location)
+	assert.Equal(t, PathParameter, errs[0].ValidationSubType)
+
+	errs = validateParams(t, httptest.NewRequest(http.MethodGet, "/label/red", nil))
+	assert.Len(t, errs, 1)
+	assert.Equal(t, "label style values must start with '.', got 'red'", errs[0].Reason)
+}
+
+func TestValidateRequestParameters_Matrix(t *testing.T) {
+	errs := validateParams(t, httptest.NewRequest(http.MethodGet, "/matrix/;x=1;y=2", nil))
+	assert.Empty(t, errs)
+
+	errs = validateParams(t, httptest.NewRequest(http.MethodGet, "/matrix/;x=1;y=20", nil))
+	assert.Len(t, errs, 1)
+	assert.Equal(t, "/y", errs[0].Location)
+	assert.Equal(t, "value 20 is greater than the maximum of 10", errs[0].Reason)
+
+	errs = validateParams(t, httptest.NewRequest(http.MethodGet, "/matrix-array/;ids=1,2,3", nil))
+	assert.Empty(t, errs)
+
+	errs = validateParams(t, httptest.NewRequest(http.MethodGet, "/matrix-array/;id=1,2,3", nil))
+	assert.Len(t, errs, 1)
+	assert.Equal(t, "matrix style value ';id=1,2,3' is not prefixed with ';ids='", errs[0].Reason)
+}
+
+func TestValidateRequestParameters_Simple(t *testing.T) {
+	errs := validateParams(t, httptest.NewRequest(http.MethodGet, "/simple/x,1.5", nil))
+	assert.Empty(t, errs)
+
+	errs = validateParams(t, httptest.NewRequest(http.MethodGet, "/simple/x,1.5,y", nil))
+	assert.Len(t, errs, 1)
+	assert.Equal(t, "object value 'x,1.5,y' does not contain matching keys and values", errs[0].Reason)
+}
+
+func TestValidateRequestParameters_Query(t *testing.T) {
+	errs := validateParams(t, httptest.NewRequest(http.MethodGet,
+		"/query?ids=1&ids=2&names=a|b&filter[size]=2&filter[active]=true&offset=10&limit=20"+
+			"&search=%7B%22term%22%3A%22burger%22%7D", nil))
+	assert.Empty(t, errs)
+}
+
+func TestValidateRequestParameters_Query_Invalid(t *testing.T) {
+	errs := validateParams(t, httptest.NewRequest(http.MethodGet,
+		"/query?ids=1&ids=2&ids=3&ids=4&filter[active]=yes&limit=100&search=%7B%7D&empty=", nil))
+	assert.Len(t, errs, 5)
+	assert.Equal(t, "array has 4 items, the maximum is 3", errs[0].Reason)
+	assert.Equal(t, "property 'active': the value 'yes' is not a valid boolean", errs[1].Reason)
+	assert.Equal(t, "value 100 is greater than the maximum of 50", errs[2].Reason)
+	assert.Equal(t, "/limit", errs[2].Location)
+	assert.Equal(t, "required property 'term' is missing", errs[3].Reason)
+	assert.Equal(t, "query parameter 'empty' has an empty value", errs[4].Message)
+}
+
+func TestValidateRequestParameters_Query_ExplodedArray(t *testing.T) {
+	// exploded arrays are sent as repeated keys, a comma is part of the value.
+	errs := validateParams(t, httptest.NewRequest(http.MethodGet, "/query?ids=1,2", nil))
+	assert.Len(t, errs, 1)
+	assert.Equal(t, "query parameter 'ids' could not be decoded", errs[0].Message)
+	assert.Equal(t, "the value '1,2' is not a valid integer", errs[0].Reason)
+
+	errs = validateParams(t, httptest.NewRequest(http.MethodGet, "/query?ids=1", nil))
+	assert.Empty(t, errs)
+}
+
+func TestValidateRequestParameters_Query_Missing(t *testing.T) {
+	errs := validateParams(t, httptest.NewRequest(http.MethodGet, "/query", nil))
+	assert.Len(t, errs, 1)
+	assert.Equal(t, "query parameter 'ids' is missing", errs[0].Message)
+	assert.Equal(t, 62, errs[0].SpecLine)
+}
+
+func TestValidateRequestParameters_HeaderAndCookie(t *testing.T) {
+	// Accept, Content-Type and Authorization header parameters are ignored.
+	request := httptest.NewRequest(http.MethodGet, "/headers", nil)
+	request.Header.Set("X-Coords", "lat=51.5, long=-0.12")
+	request.Header.Set("Accept", "application/json")
+	request.AddCookie(&http.Cookie{Name: "session", Value: "1234"})
+	errs := validateParams(t, request)
+	assert.Empty(t, errs)
+
+	request = httptest.NewRequest(http.MethodGet, "/headers", nil)
+	request.Header.Set("X-Coords", "lat=north")
+	request.AddCookie(&http.Cookie{Name: "session", Value: "abc"})
+	errs = validateParams(t, request)
+	assert.Len(t, errs, 2)
+	assert.Equal(t, HeaderParameter, errs[0].ValidationSubType)
+	assert.Equal(t, "property 'lat': the value 'north' is not a valid number", errs[0].Reason)
+	assert.Equal(t, CookieParameter, errs[1].ValidationSubType)
+
+	errs = validateParams(t, httptest.NewRequest(http.MethodGet, "/headers", nil))
+	assert.Len(t, errs, 1)
+	assert.Equal(t, "cookie parameter 'session' is missing", errs[0].Message)
+}
@@ -0,0 +1,245 @@
+// Copyright 2023 Princess B33f Heavy Industries / Dave Shanley
+// SPDX-License-Identifier: MIT
+
+package validator
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+
+	v3 "github.com/pb33f/libopenapi/datamodel/high/v3"
+	"gopkg.in/yaml.v3"
+)
+
+// PathMatch is the result of matching an HTTP request to a path in an OpenAPI 3+ document.
+type PathMatch struct {
+	// Path is the templated path key from the specification, for example '/pets/{petId}'
+	Path string
+
+	// PathItem is the matched PathItem.
+	PathItem *v3.PathItem
+
+	// Operation is the Operation for the request method, it will be nil if the method is not defined.
+	Operation *v3.Operation
+
+	// PathValues holds the raw (still encoded) values of each templated segment, keyed by the parameter name.
+	PathValues map[string]string
+}
+
+// pathMatcher holds a compiled version of a templated path, split into segments.
+type pathMatcher struct {
+	path     string
+	segments []*pathSegment
+	literals int
+}
+
+// pathSegment is a single segment of a templated path, literal segments have no expression.
+type pathSegment struct {
+	literal string
+	expr    *regexp.Regexp
+	params  []string
+}
+
+var templateParamRegex = regexp.MustCompile(`{([^}]+)}`)
+
+// compilePath breaks a templated path into segments, each templated segment is converted into a regular expression
+// that captures the values of every parameter in that segment.
+func compilePath(path string) *pathMatcher {
+	pm := &pathMatcher{path: path}
+	for _, seg := range splitPath(path) {
+		if !strings.Contains(seg, "{") {
+			pm.segments = append(pm.segments, &pathSegment{literal: seg})
+			pm.literals++
+			continue
+		}
+		ps := new(pathSegment)
+		var expr strings.Builder
+		expr.WriteString("^")
+		last := 0
+		for _, loc := range templateParamRegex.FindAllStringSubmatchIndex(seg, -1) {
+			expr.WriteString(regexp.QuoteMeta(seg[last:loc[0]]))
+			expr.WriteString("(.+?)")
+			ps.params = append(ps.params, seg[loc[2]:loc[3]])
+			last = loc[1]
+		}
+		expr.WriteString(regexp.QuoteMeta(seg[last:]))
+		expr.WriteString("$")
+		ps.expr = regexp.MustCompile(expr.String())
+		pm.segments = append(pm.segments, ps)
+	}
+	return pm
+}
+
+// match checks the supplied (escaped) request segments against the templated path, returning the captured
+// parameter values if the segments match.
+func (pm *pathMatcher) match(segments []string) (map[string]string, bool) {
+	if len(segments) != len(pm.segments) {
+		return nil, false
+	}
+	values := make(map[string]string)
+	for i, seg := range pm.segments {
+		if seg.expr == nil {
+			unescaped, err := url.PathUnescape(segments[i])
+			if err != nil {
+				unescaped = segments[i]
+			}
+			if unescaped != seg.literal {
+				return nil, false
+			}
+			continue
+		}
+		found := seg.expr.FindStringSubmatch(segments[i])
+		if found == nil {
+			return nil, false
+		}
+		for j, name := range seg.params {
+			values[name] = found[j+1]
+		}
+	}
+	return values, true
+}
+
+// splitPath splits a path into segments, ignoring leading and trailing slashes.
+func splitPath(path string) []string {
+	path = strings.Trim(path, "/")
+	if path == "" {
+		return []string{}
+	}
+	return strings.Split(path, "/")
+}
+
+// serverBasePaths extracts the path component from every server URL defined by the document. Server variables
+// are kept as templates, so they can match any single segment.
+func serverBasePaths(servers []*v3.Server) [][]string {
+	var bases [][]string
+	for _, s := range servers {
+		u := s.URL
+		if i := strings.Index(u, "://"); i >= 0 {
+			u = u[i+3:]
+			if j := strings.Index(u, "/"); j >= 0 {
+				u = u[j:]
+			} else {
+				u = ""
+			}
+		}
+		if q := strings.IndexAny(u, "?#"); q >= 0 {
+			u = u[:q]
+		}
+		if segs := splitPath(u); len(segs) > 0 {
+			bases = append(bases, segs)
+		}
+	}
+	return bases
+}
+
+// stripBasePath removes the server base path from the front of the request segments, returns false if the
+// request does not start with the base path.
+func stripBasePath(segments, base []string) ([]string, bool) {
+	if len(segments) < len(base) {
+		return nil, false
+	}
+	for i := range base {
+		if strings.Contains(base[i], "{") {
+			continue
+		}
+		if base[i] != segments[i] {
+			return nil, false
+		}
+	}
+	return segments[len(base):], true
+}
+
+// FindPath will locate the PathItem and Operation that matches the supplied HTTP request. The request path may
+// include the base path of any server defined by the document. Literal path segments are preferred over templated
+// ones, so '/pets/mine' will match before '/pets/{petId}'.
+//
+// If no path matches, or the path exists but the method is not defined, then a ValidationError is returned. When
+// only the method is missing, the PathMatch is returned with a nil Operation.
+func (v *Validator) FindPath(request *http.Request) (*PathMatch, *ValidationError) {
+	reqSegments := splitPath(request.URL.EscapedPath())
+	candidates := [][]string{reqSegments}
+	for _, base := range serverBasePaths(v.document.Servers) {
+		if stripped, ok := stripBasePath(reqSegments, base); ok {
+			candidates = append(candidates, stripped)
+		}
+	}
+
+	var best *pathMatcher
+	var bestValues map[string]string
+	for _, pm := range v.paths {
+		for _, c := range candidates {
+			if values, ok := pm.match(c); ok {
+				if best == nil || pm.literals > best.literals {
+					best = pm
+					bestValues = values
+				}
+			}
+		}
+	}
+	if best == nil {
+		return nil, newValidationError(PathValidation, "missing",
+			fmt.Sprintf("path '%s' was not found", request.URL.Path),
+			fmt.Sprintf("the path '%s' does not match any path defined by the specification", request.URL.Path), nil)
+	}
+
+	pathItem := v.document.Paths.PathItems.GetOrZero(best.path)
+	match := &PathMatch{
+		Path:       best.path,
+		PathItem:   pathItem,
+		Operation:  operationForMethod(pathItem, request.Method),
+		PathValues: bestValues,
+	}
+	if match.Operation == nil {
+		var keyNode *yaml.Node
+		if low := v.document.Paths.GoLow(); low != nil {
+			if k, _ := low.FindPathAndKey(best.path); k != nil {
+				keyNode = k.KeyNode
+			}
+		}
+		return match, newValidationError(PathValidation, "method",
+			fmt.Sprintf("method '%s' is not allowed for path '%s'", request.Method, best.path),
+			fmt.Sprintf("the path '%s' only supports %s", best.path,
+				strings.Join(allowedMethods(pathItem), ", ")), keyNode)
+	}
+	return match, nil
+}
+
+// operationForMethod returns the Operation of a PathItem for the supplied HTTP method.
+func operationForMethod(pathItem *v3.PathItem, method string) *v3.Operation {
+	switch strings.ToUpper(method) {
+	case http.MethodGet:
+		return pathItem.Get
+	case http.MethodPut:
+		return pathItem.Put
+	case http.MethodPost:
+		return pathItem.Post
+	case http.MethodDelete:
+		return pathItem.Delete
+	case http.MethodOptions:
+		return pathItem.Options
+	case http.MethodHead:
+		return pathItem.Head
+	case http.MethodPatch:
+		return pathItem.Patch
+	case http.MethodTrace:
+		return pathItem.Trace
+	}
+	return nil
+}
+
+// allowedMethods returns a sorted slice of every HTTP method defined by a PathItem.
+func allowedMethods(pathItem *v3.PathItem) []string {
+	var methods []string
+	for _, m := range []string{http.MethodGet, http.MethodPut, http.MethodPost, http.MethodDelete,
+		http.MethodOptions, http.MethodHead, http.MethodPatch, http.MethodTrace} {
+		if operationForMethod(pathItem, m) != nil {
+			methods = append(methods, m)
+		}
+	}
+	sort.Strings(methods)
+	return methods
+}
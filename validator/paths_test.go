@@ -0,0 +1,74 @@
+// Copyright 2023 Princess B33f Heavy Industries / Dave Shanley
+// SPDX-License-Identifier: MIT
+
+package validator
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidator_FindPath(t *testing.T) {
+	v := newTestValidator(t, testSpec)
+	match, err := v.FindPath(httptest.NewRequest(http.MethodGet, "/burgers/1234", nil))
+	assert.Nil(t, err)
+	assert.Equal(t, "/burgers/{burgerId}", match.Path)
+	assert.Equal(t, "1234", match.PathValues["burgerId"])
+	assert.NotNil(t, match.Operation)
+	assert.Equal(t, match.PathItem.Get, match.Operation)
+}
+
+func TestValidator_FindPath_PreferLiteral(t *testing.T) {
+	v := newTestValidator(t, testSpec)
+	match, err := v.FindPath(httptest.NewRequest(http.MethodGet, "/burgers/mine", nil))
+	assert.Nil(t, err)
+	assert.Equal(t, "/burgers/mine", match.Path)
+	assert.Empty(t, match.PathValues)
+}
+
+func TestValidator_FindPath_ServerBasePath(t *testing.T) {
+	v := newTestValidator(t, testSpec)
+	match, err := v.FindPath(httptest.NewRequest(http.MethodGet, "https://api.pb33f.io/v1/burgers/mine", nil))
+	assert.Nil(t, err)
+	assert.Equal(t, "/burgers/mine", match.Path)
+
+	// server variables match any segment.
+	match, err = v.FindPath(httptest.NewRequest(http.MethodGet, "/v3/shop/burgers/99", nil))
+	assert.Nil(t, err)
+	assert.Equal(t, "/burgers/{burgerId}", match.Path)
+	assert.Equal(t, "99", match.PathValues["burgerId"])
+}
+
+func TestValidator_FindPath_MethodNotAllowed(t *testing.T) {
+	v := newTestValidator(t, testSpec)
+	match, err := v.FindPath(httptest.NewRequest(http.MethodPatch, "/burgers/1", nil))
+	assert.NotNil(t, err)
+	assert.NotNil(t, match)
+	assert.Nil(t, match.Operation)
+	assert.Equal(t, PathValidation, err.ValidationType)
+	assert.Equal(t, "method 'PATCH' is not allowed for path '/burgers/{burgerId}'", err.Message)
+	assert.Equal(t, "the path '/burgers/{burgerId}' only supports DELETE, GET", err.Reason)
+	assert.Equal(t, 47, err.SpecLine)
+}
+
+func TestValidator_FindPath_NotFound(t *testing.T) {
+	v := newTestValidator(t, testSpec)
+	match, err := v.FindPath(httptest.NewRequest(http.MethodGet, "/burgers/1/fries", nil))
+	assert.Nil(t, match)
+	assert.NotNil(t, err)
+	assert.Equal(t, "missing", err.ValidationSubType)
+}
+
+func TestCompilePath_MultipleParamsInSegment(t *testing.T) {
+	pm := compilePath("/reports/{year}-{month}.json")
+	values, ok := pm.match([]string{"reports", "2023-04.json"})
+	assert.True(t, ok)
+	assert.Equal(t, "2023", values["year"])
+	assert.Equal(t, "04", values["month"])
+
+	_, ok = pm.match([]string{"reports", "2023-04.xml"})
+	assert.False(t, ok)
+}
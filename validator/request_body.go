@@ -0,0 +1,52 @@
+// Copyright 2023 Princess B33f Heavy Industries / Dave Shanley
+// SPDX-License-Identifier: MIT
+
+package validator
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/pb33f/libopenapi/datamodel/high/base"
+	"gopkg.in/yaml.v3"
+)
+
+// ValidateRequestBody validates the body of a request against the RequestBody of the matched Operation.
+//
+// The Content-Type of the request is used to locate the MediaType, wildcards such as 'application/*' and '*/*' are
+// supported. JSON (including '+json' suffixes), 'application/x-www-form-urlencoded' and 'text/plain' bodies are
+// decoded and checked against the schema of the MediaType, other media types are only checked for presence.
+//
+// The body of the request is read and then replaced, so it can be read again by the caller.
+func (v *Validator) ValidateRequestBody(request *http.Request, match *PathMatch) []*ValidationError {
+	if match == nil || match.Operation == nil || match.Operation.RequestBody == nil {
+		return nil
+	}
+	rb := match.Operation.RequestBody
+	var rbNode *yaml.Node
+	if low := rb.GoLow(); low != nil {
+		rbNode = low.Content.KeyNode
+	}
+
+	body, err := readBody(&request.Body)
+	if err != nil {
+		return []*ValidationError{newValidationError(RequestBodyValidation, "read",
+			"request body could not be read", err.Error(), rbNode)}
+	}
+	if len(body) == 0 {
+		if rb.Required != nil && *rb.Required {
+			var node *yaml.Node
+			if low := rb.GoLow(); low != nil {
+				node = low.Required.KeyNode
+			}
+			return []*ValidationError{newValidationError(RequestBodyValidation, "missing",
+				"request body is missing",
+				fmt.Sprintf("the request body is required for '%s %s'", request.Method, match.Path), node)}
+		}
+		return nil
+	}
+	return validateContent(RequestBodyValidation, "request body", rb.Content,
+		request.Header.Get("Content-Type"), body,
+		fmt.Sprintf("request body for '%s %s' failed to validate", request.Method, match.Path), rbNode,
+		base.ValidateRequest)
+}
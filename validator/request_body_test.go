@@ -0,0 +1,147 @@
+// Copyright 2023 Princess B33f Heavy Industries / Dave Shanley
+// SPDX-License-Identifier: MIT
+
+package validator
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var bodySpec = `openapi: 3.0.3
+info:
+  title: Bodies
+  version: 1.0.0
+paths:
+  /things:
+    post:
+      requestBody:
+        required: true
+        content:
+          application/vnd.thing+json:
+            schema:
+              type: object
+              required: [id]
+              properties:
+                id:
+                  type: integer
+                label:
+                  type: string
+                  nullable: true
+          text/*:
+            schema:
+              type: string
+              maxLength: 5
+    put:
+      requestBody:
+        content:
+          application/x-www-form-urlencoded:
+            schema:
+              type: object
+              properties:
+                count:
+                  type: integer
+                  minimum: 1
+                colors:
+                  type: array
+                  items:
+                    type: string
+          '*/*':
+            schema:
+              type: string
+              format: binary`
+
+func validateBody(t *testing.T, method, contentType, body string) []*ValidationError {
+	v := newTestValidator(t, bodySpec)
+	var reader io.Reader
+	if body != "" {
+		reader = bytes.NewBufferString(body)
+	}
+	request := httptest.NewRequest(method, "/things", reader)
+	if contentType != "" {
+		request.Header.Set("Content-Type", contentType)
+	}
+	match, err := v.FindPath(request)
+	assert.Nil(t, err)
+	return v.ValidateRequestBody(request, match)
+}
+
+func TestValidateRequestBody_JSONSuffix(t *testing.T) {
+	errs := validateBody(t, http.MethodPost, "application/vnd.thing+json; charset=utf-8", `{"id":1,"label":null}`)
+	assert.Empty(t, errs)
+
+	errs = validateBody(t, http.MethodPost, "application/vnd.thing+json", `{"id":1.5}`)
+	assert.Len(t, errs, 1)
+	assert.Equal(t, "/id", errs[0].Location)
+	assert.Equal(t, "expected type 'integer', got 'number'", errs[0].Reason)
+	assert.Equal(t, 17, errs[0].SpecLine)
+}
+
+func TestValidateRequestBody_InvalidJSON(t *testing.T) {
+	errs := validateBody(t, http.MethodPost, "application/vnd.thing+json", `{"id":`)
+	assert.Len(t, errs, 1)
+	assert.Equal(t, "decode", errs[0].ValidationSubType)
+	assert.Equal(t, "request body could not be decoded as 'application/vnd.thing+json'", errs[0].Message)
+}
+
+func TestValidateRequestBody_Wildcard(t *testing.T) {
+	errs := validateBody(t, http.MethodPost, "text/plain", "hello")
+	assert.Empty(t, errs)
+
+	errs = validateBody(t, http.MethodPost, "text/plain", "hello there")
+	assert.Len(t, errs, 1)
+	assert.Equal(t, "string length 11 is greater than the maximum length of 5", errs[0].Reason)
+
+	// binary content is not decoded.
+	errs = validateBody(t, http.MethodPut, "image/png", "\x89PNG")
+	assert.Empty(t, errs)
+}
+
+func TestValidateRequestBody_UnsupportedContentType(t *testing.T) {
+	errs := validateBody(t, http.MethodPost, "application/xml", "<thing/>")
+	assert.Len(t, errs, 1)
+	assert.Equal(t, "request body content type 'application/xml' is not supported", errs[0].Message)
+	assert.Equal(t, "the request body must use one of the following content types: "+
+		"application/vnd.thing+json, text/*", errs[0].Reason)
+}
+
+func TestValidateRequestBody_Missing(t *testing.T) {
+	errs := validateBody(t, http.MethodPost, "", "")
+	assert.Len(t, errs, 1)
+	assert.Equal(t, "request body is missing", errs[0].Message)
+	assert.Equal(t, 9, errs[0].SpecLine)
+
+	// not required
+	errs = validateBody(t, http.MethodPut, "", "")
+	assert.Empty(t, errs)
+}
+
+func TestValidateRequestBody_Form(t *testing.T) {
+	errs := validateBody(t, http.MethodPut, "application/x-www-form-urlencoded", "count=2&colors=red&colors=blue")
+	assert.Empty(t, errs)
+
+	errs = validateBody(t, http.MethodPut, "application/x-www-form-urlencoded", "count=0")
+	assert.Len(t, errs, 1)
+	assert.Equal(t, "/count", errs[0].Location)
+
+	errs = validateBody(t, http.MethodPut, "application/x-www-form-urlencoded", "count=many")
+	assert.Len(t, errs, 1)
+	assert.Equal(t, "form field 'count': the value 'many' is not a valid integer", errs[0].Reason)
+}
+
+func TestValidateRequestBody_BodyCanBeReadAgain(t *testing.T) {
+	v := newTestValidator(t, bodySpec)
+	request := httptest.NewRequest(http.MethodPost, "/things", bytes.NewBufferString(`{"id":1}`))
+	request.Header.Set("Content-Type", "application/vnd.thing+json")
+	valid, errs := v.ValidateRequest(request)
+	assert.True(t, valid)
+	assert.Empty(t, errs)
+
+	body, _ := io.ReadAll(request.Body)
+	assert.Equal(t, `{"id":1}`, string(body))
+}
@@ -0,0 +1,158 @@
+// Copyright 2023 Princess B33f Heavy Industries / Dave Shanley
+// SPDX-License-Identifier: MIT
+
+package validator
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/pb33f/libopenapi/datamodel/high/base"
+	v3 "github.com/pb33f/libopenapi/datamodel/high/v3"
+	"gopkg.in/yaml.v3"
+)
+
+// ValidateResponse will validate an HTTP response against the document. The request that produced the response
+// is used to locate the Operation, then the status code, headers and body of the response are validated against the
+// Responses of that Operation. Returns true if the response is valid, if not then all the failures found are returned.
+func (v *Validator) ValidateResponse(request *http.Request, response *http.Response) (bool, []*ValidationError) {
+	match, err := v.FindPath(request)
+	if err != nil {
+		return false, []*ValidationError{err}
+	}
+	errs := v.ValidateOperationResponse(match.Operation, response)
+	return len(errs) == 0, errs
+}
+
+// ValidateOperationResponse validates an HTTP response against the Responses of an Operation.
+//
+// The Response is located using the status code. Exact codes are preferred, followed by range codes (for example
+// '2XX') and then the 'default' response. Headers defined by the Response are decoded using the 'simple' style and
+// checked against their schemas ('Content-Type' is ignored, as per the specification). The body is checked in the
+// same way as a request body, if the Response defines content.
+//
+// The body of the response is read and then replaced, so it can be read again by the caller.
+func (v *Validator) ValidateOperationResponse(operation *v3.Operation, response *http.Response) []*ValidationError {
+	if operation == nil || operation.Responses == nil {
+		return nil
+	}
+	var responsesNode *yaml.Node
+	if low := operation.GoLow(); low != nil {
+		responsesNode = low.Responses.KeyNode
+	}
+
+	resp := operation.Responses.FindResponseByCodeOrDefault(response.StatusCode)
+	if resp == nil {
+		return []*ValidationError{newValidationError(ResponseValidation, "status",
+			fmt.Sprintf("response status code %d is not defined", response.StatusCode),
+			fmt.Sprintf("the operation only defines responses for the following codes: %s",
+				strings.Join(definedCodes(operation.Responses), ", ")), responsesNode)}
+	}
+
+	var errs []*ValidationError
+	errs = append(errs, validateResponseHeaders(resp, response)...)
+
+	if resp.Content.Len() == 0 {
+		return errs
+	}
+	var contentNode *yaml.Node
+	if low := resp.GoLow(); low != nil {
+		contentNode = low.Content.KeyNode
+	}
+	body, err := readBody(&response.Body)
+	if err != nil {
+		return append(errs, newValidationError(ResponseBodyValidation, "read",
+			"response body could not be read", err.Error(), contentNode))
+	}
+	// HEAD requests never return a body.
+	if len(body) == 0 && response.Request != nil && response.Request.Method == http.MethodHead {
+		return errs
+	}
+	if len(body) == 0 {
+		return append(errs, newValidationError(ResponseBodyValidation, "missing", "response body is missing",
+			fmt.Sprintf("the response for status code %d defines content, but the body is empty",
+				response.StatusCode), contentNode))
+	}
+	return append(errs, validateContent(ResponseBodyValidation, "response body", resp.Content,
+		response.Header.Get("Content-Type"), body,
+		fmt.Sprintf("response body for status code %d failed to validate", response.StatusCode), contentNode,
+		base.ValidateResponse)...)
+}
+
+// validateResponseHeaders checks the headers of an HTTP response against the headers defined by a Response.
+func validateResponseHeaders(resp *v3.Response, response *http.Response) []*ValidationError {
+	names := resp.Headers.Keys()
+	sort.Strings(names)
+
+	var errs []*ValidationError
+	for _, name := range names {
+		if strings.EqualFold(name, "Content-Type") {
+			continue
+		}
+		header := resp.Headers.GetOrZero(name)
+		node := responseHeaderNode(resp, name)
+		values := response.Header.Values(name)
+		if len(values) == 0 {
+			if header.Required {
+				errs = append(errs, newValidationError(ResponseHeaderValidation, "missing",
+					fmt.Sprintf("response header '%s' is missing", name),
+					fmt.Sprintf("the response header '%s' is required, but was not found in the response", name),
+					node))
+			}
+			continue
+		}
+		raw := strings.Join(values, ",")
+		message := fmt.Sprintf("response header '%s' failed to validate", name)
+
+		if header.Schema == nil {
+			proxy, value, err := decodeContentValue(header.Content, raw)
+			if err != nil {
+				errs = append(errs, newValidationError(ResponseHeaderValidation, "decode",
+					fmt.Sprintf("response header '%s' could not be decoded", name), err.Error(), node))
+				continue
+			}
+			errs = append(errs, schemaFailureErrors(ResponseHeaderValidation, "schema", message,
+				proxy.ValidateWithContext(value, base.ValidateResponse), node)...)
+			continue
+		}
+		schema, err := header.Schema.BuildSchema()
+		if err != nil || schema == nil {
+			continue
+		}
+		value, err := decodeValue(schema, raw, ",", header.Explode, strings.TrimSpace)
+		if err != nil {
+			errs = append(errs, newValidationError(ResponseHeaderValidation, "decode",
+				fmt.Sprintf("response header '%s' could not be decoded", name), err.Error(), node))
+			continue
+		}
+		errs = append(errs, schemaFailureErrors(ResponseHeaderValidation, "schema", message,
+			schema.ValidateWithContext(value, base.ValidateResponse), node)...)
+	}
+	return errs
+}
+
+// responseHeaderNode locates the key node of a named header in the low-level Response.
+func responseHeaderNode(resp *v3.Response, name string) *yaml.Node {
+	low := resp.GoLow()
+	if low == nil {
+		return nil
+	}
+	for k := range low.Headers.Value {
+		if k.Value == name {
+			return k.KeyNode
+		}
+	}
+	return low.Headers.KeyNode
+}
+
+// definedCodes returns the sorted response codes of a Responses object, including 'default' if set.
+func definedCodes(responses *v3.Responses) []string {
+	codes := responses.Codes.Keys()
+	sort.Strings(codes)
+	if responses.Default != nil {
+		codes = append(codes, "default")
+	}
+	return codes
+}
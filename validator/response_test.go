@@ -0,0 +1,162 @@
+// Copyright 2023 Princess B33f Heavy Industries / Dave Shanley
+// SPDX-License-Identifier: MIT
+
+package validator
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var responseSpec = `openapi: 3.1.0
+info:
+  title: Responses
+  version: 1.0.0
+paths:
+  /burgers/{burgerId}:
+    get:
+      responses:
+        "200":
+          description: a burger
+          headers:
+            X-Rate-Limit:
+              required: true
+              schema:
+                type: integer
+                maximum: 100
+            Content-Type:
+              required: true
+              schema:
+                type: string
+          content:
+            application/json:
+              schema:
+                type: object
+                required: [name]
+                properties:
+                  name:
+                    type: string
+        "2XX":
+          description: some other success
+        "4XX":
+          description: bad request
+          content:
+            application/problem+json:
+              schema:
+                type: object
+                required: [title]
+        default:
+          description: error
+          content:
+            text/plain:
+              schema:
+                type: string
+    delete:
+      responses:
+        "204":
+          description: deleted`
+
+func newTestResponse(code int, contentType, body string) *http.Response {
+	response := &http.Response{
+		StatusCode: code,
+		Header:     http.Header{},
+		Body:       io.NopCloser(bytes.NewBufferString(body)),
+	}
+	if contentType != "" {
+		response.Header.Set("Content-Type", contentType)
+	}
+	return response
+}
+
+func TestValidator_ValidateResponse(t *testing.T) {
+	v := newTestValidator(t, responseSpec)
+	request := httptest.NewRequest(http.MethodGet, "/burgers/1", nil)
+	response := newTestResponse(200, "application/json", `{"name":"Whopper"}`)
+	response.Header.Set("X-Rate-Limit", "10")
+
+	valid, errs := v.ValidateResponse(request, response)
+	assert.True(t, valid)
+	assert.Empty(t, errs)
+
+	// body can be read again.
+	body, _ := io.ReadAll(response.Body)
+	assert.Equal(t, `{"name":"Whopper"}`, string(body))
+}
+
+func TestValidator_ValidateResponse_Invalid(t *testing.T) {
+	v := newTestValidator(t, responseSpec)
+	request := httptest.NewRequest(http.MethodGet, "/burgers/1", nil)
+	response := newTestResponse(200, "application/json", `{"name":1}`)
+	response.Header.Set("X-Rate-Limit", "500")
+
+	valid, errs := v.ValidateResponse(request, response)
+	assert.False(t, valid)
+	assert.Len(t, errs, 2)
+	assert.Equal(t, ResponseHeaderValidation, errs[0].ValidationType)
+	assert.Equal(t, "value 500 is greater than the maximum of 100", errs[0].Reason)
+	assert.Equal(t, ResponseBodyValidation, errs[1].ValidationType)
+	assert.Equal(t, "/name", errs[1].Location)
+	assert.Equal(t, "response body for status code 200 failed to validate", errs[1].Message)
+}
+
+func TestValidator_ValidateResponse_MissingHeaderAndBody(t *testing.T) {
+	v := newTestValidator(t, responseSpec)
+	request := httptest.NewRequest(http.MethodGet, "/burgers/1", nil)
+
+	valid, errs := v.ValidateResponse(request, newTestResponse(200, "", ""))
+	assert.False(t, valid)
+	assert.Len(t, errs, 2)
+	assert.Equal(t, "response header 'X-Rate-Limit' is missing", errs[0].Message)
+	assert.Equal(t, 12, errs[0].SpecLine)
+	assert.Equal(t, "response body is missing", errs[1].Message)
+}
+
+func TestValidator_ValidateResponse_RangeAndDefault(t *testing.T) {
+	v := newTestValidator(t, responseSpec)
+	request := httptest.NewRequest(http.MethodGet, "/burgers/1", nil)
+
+	// 2XX has no content.
+	valid, errs := v.ValidateResponse(request, newTestResponse(202, "", ""))
+	assert.True(t, valid)
+	assert.Empty(t, errs)
+
+	valid, errs = v.ValidateResponse(request, newTestResponse(404, "application/problem+json", `{"status":404}`))
+	assert.False(t, valid)
+	assert.Len(t, errs, 1)
+	assert.Equal(t, "required property 'title' is missing", errs[0].Reason)
+
+	valid, errs = v.ValidateResponse(request, newTestResponse(500, "text/plain", "oh no"))
+	assert.True(t, valid)
+	assert.Empty(t, errs)
+
+	valid, errs = v.ValidateResponse(request, newTestResponse(500, "application/json", "{}"))
+	assert.False(t, valid)
+	assert.Len(t, errs, 1)
+	assert.Equal(t, "response body content type 'application/json' is not supported", errs[0].Message)
+}
+
+func TestValidator_ValidateResponse_UndefinedStatus(t *testing.T) {
+	v := newTestValidator(t, responseSpec)
+	request := httptest.NewRequest(http.MethodDelete, "/burgers/1", nil)
+
+	valid, errs := v.ValidateResponse(request, newTestResponse(200, "", ""))
+	assert.False(t, valid)
+	assert.Len(t, errs, 1)
+	assert.Equal(t, "response status code 200 is not defined", errs[0].Message)
+	assert.Equal(t, "the operation only defines responses for the following codes: 204", errs[0].Reason)
+	assert.Equal(t, 45, errs[0].SpecLine)
+}
+
+func TestValidator_ValidateResponse_PathNotFound(t *testing.T) {
+	v := newTestValidator(t, responseSpec)
+	request := httptest.NewRequest(http.MethodGet, "/fries", nil)
+
+	valid, errs := v.ValidateResponse(request, newTestResponse(200, "", ""))
+	assert.False(t, valid)
+	assert.Equal(t, PathValidation, errs[0].ValidationType)
+}
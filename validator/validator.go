@@ -0,0 +1,57 @@
+// Copyright 2023 Princess B33f Heavy Industries / Dave Shanley
+// SPDX-License-Identifier: MIT
+
+// Package validator validates HTTP traffic against an OpenAPI 3+ document model.
+//
+// A Validator is created from a v3 DocumentModel, and can then be used to check an *http.Request or an
+// *http.Response. The request is matched against the paths of the document, and then every parameter and the request
+// body is checked against the schemas defined by the matched Operation. Responses are checked against the Responses
+// of the same Operation. Failures are returned as ValidationError instances that point back to the location in the
+// specification that caused the failure.
+package validator
+
+import (
+	"net/http"
+	"sort"
+
+	"github.com/pb33f/libopenapi"
+	v3 "github.com/pb33f/libopenapi/datamodel/high/v3"
+)
+
+// Validator validates HTTP requests and responses against an OpenAPI 3+ document.
+type Validator struct {
+	document *v3.Document
+	paths    []*pathMatcher
+}
+
+// NewValidator creates a new Validator from a built OpenAPI 3+ DocumentModel.
+func NewValidator(model *libopenapi.DocumentModel[v3.Document]) *Validator {
+	return NewValidatorFromDocument(&model.Model)
+}
+
+// NewValidatorFromDocument creates a new Validator from a high-level OpenAPI 3+ Document.
+func NewValidatorFromDocument(document *v3.Document) *Validator {
+	v := &Validator{document: document}
+	if document.Paths != nil {
+		keys := document.Paths.PathItems.Keys()
+		sort.Strings(keys)
+		for _, k := range keys {
+			v.paths = append(v.paths, compilePath(k))
+		}
+	}
+	return v
+}
+
+// ValidateRequest will validate an HTTP request against the document. The request path and method are matched
+// first, if that succeeds then parameters and the request body are validated. Returns true if the request is
+// valid, if not then all the failures found are returned.
+func (v *Validator) ValidateRequest(request *http.Request) (bool, []*ValidationError) {
+	match, err := v.FindPath(request)
+	if err != nil {
+		return false, []*ValidationError{err}
+	}
+	var errs []*ValidationError
+	errs = append(errs, v.ValidateRequestParameters(request, match)...)
+	errs = append(errs, v.ValidateRequestBody(request, match)...)
+	return len(errs) == 0, errs
+}
@@ -0,0 +1,177 @@
+// Copyright 2023 Princess B33f Heavy Industries / Dave Shanley
+// SPDX-License-Identifier: MIT
+
+package validator
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/pb33f/libopenapi"
+	"github.com/stretchr/testify/assert"
+)
+
+var testSpec = `openapi: 3.1.0
+info:
+  title: Burger Shop
+  version: 1.0.0
+servers:
+  - url: https://api.pb33f.io/v1
+  - url: https://{region}.pb33f.io/{version}/shop
+    variables:
+      region:
+        default: eu
+      version:
+        default: v2
+paths:
+  /burgers:
+    get:
+      parameters:
+        - name: limit
+          in: query
+          schema:
+            type: integer
+            minimum: 1
+            maximum: 100
+        - name: tags
+          in: query
+          schema:
+            type: array
+            items:
+              type: string
+    post:
+      requestBody:
+        required: true
+        content:
+          application/json:
+            schema:
+              $ref: '#/components/schemas/Burger'
+          application/x-www-form-urlencoded:
+            schema:
+              $ref: '#/components/schemas/Burger'
+      responses:
+        "201":
+          description: created
+  /burgers/mine:
+    get:
+      responses:
+        "200":
+          description: mine
+  /burgers/{burgerId}:
+    parameters:
+      - name: burgerId
+        in: path
+        required: true
+        schema:
+          type: integer
+    get:
+      responses:
+        "200":
+          description: a burger
+    delete:
+      parameters:
+        - name: X-Api-Key
+          in: header
+          required: true
+          schema:
+            type: string
+            minLength: 5
+      responses:
+        "204":
+          description: deleted
+components:
+  schemas:
+    Burger:
+      type: object
+      required:
+        - name
+      properties:
+        name:
+          type: string
+          minLength: 2
+        numPatties:
+          type: integer
+          minimum: 1
+        fries:
+          $ref: '#/components/schemas/Fries'
+    Fries:
+      type: object
+      properties:
+        seasoning:
+          type: string
+          enum: [salt, pepper]
+        size:
+          type: number
+          exclusiveMinimum: 0`
+
+func newTestValidator(t *testing.T, spec string) *Validator {
+	doc, err := libopenapi.NewDocument([]byte(spec))
+	assert.NoError(t, err)
+	model, errs := doc.BuildV3Model()
+	assert.Empty(t, errs)
+	return NewValidator(model)
+}
+
+func TestValidator_ValidateRequest(t *testing.T) {
+	v := newTestValidator(t, testSpec)
+	request := httptest.NewRequest(http.MethodPost, "https://api.pb33f.io/v1/burgers",
+		bytes.NewBufferString(`{"name":"Big Mac","numPatties":2,"fries":{"seasoning":"salt","size":1.5}}`))
+	request.Header.Set("Content-Type", "application/json")
+
+	valid, errs := v.ValidateRequest(request)
+	assert.True(t, valid)
+	assert.Empty(t, errs)
+}
+
+func TestValidator_ValidateRequest_Invalid(t *testing.T) {
+	v := newTestValidator(t, testSpec)
+	request := httptest.NewRequest(http.MethodPost, "/burgers",
+		bytes.NewBufferString(`{"numPatties":0,"fries":{"seasoning":"vinegar","size":0}}`))
+	request.Header.Set("Content-Type", "application/json")
+
+	valid, errs := v.ValidateRequest(request)
+	assert.False(t, valid)
+	assert.Len(t, errs, 4)
+	for _, e := range errs {
+		assert.Equal(t, RequestBodyValidation, e.ValidationType)
+		assert.NotZero(t, e.SpecLine)
+	}
+}
+
+func TestValidator_ValidateRequest_PathNotFound(t *testing.T) {
+	v := newTestValidator(t, testSpec)
+	request := httptest.NewRequest(http.MethodGet, "/pizza", nil)
+
+	valid, errs := v.ValidateRequest(request)
+	assert.False(t, valid)
+	assert.Len(t, errs, 1)
+	assert.Equal(t, PathValidation, errs[0].ValidationType)
+	assert.Equal(t, "path '/pizza' was not found", errs[0].Message)
+}
+
+func TestValidator_ValidateRequest_Parameters(t *testing.T) {
+	v := newTestValidator(t, testSpec)
+	request := httptest.NewRequest(http.MethodDelete, "/burgers/abc", nil)
+	request.Header.Set("X-Api-Key", "123")
+
+	valid, errs := v.ValidateRequest(request)
+	assert.False(t, valid)
+	assert.Len(t, errs, 2)
+	assert.Equal(t, "path parameter 'burgerId' could not be decoded", errs[0].Message)
+	assert.Equal(t, "header parameter 'X-Api-Key' failed to validate", errs[1].Message)
+	assert.Equal(t, "string length 3 is less than the minimum length of 5", errs[1].Reason)
+}
+
+func TestValidationError_Error(t *testing.T) {
+	ve := &ValidationError{
+		Message:  "request body failed to validate",
+		Reason:   "required property 'name' is missing",
+		Location: "/fries",
+		SpecLine: 10,
+		SpecCol:  5,
+	}
+	assert.Equal(t, "request body failed to validate: required property 'name' is missing (at '/fries') "+
+		"[spec line 10, column 5]", ve.Error())
+}
@@ -0,0 +1,176 @@
+// Copyright 2023 Princess B33f Heavy Industries / Dave Shanley
+// SPDX-License-Identifier: MIT
+
+package model
+
+import (
+	"fmt"
+
+	"gopkg.in/yaml.v3"
+)
+
+// AnyProperty can be used as the property of a rule, to match every property of an object.
+const AnyProperty = "*"
+
+// The names of the objects used by BreakingRules, an object is named after the type of changes reported for it.
+const (
+	CallbackObject            = "callback"
+	ComponentsObject          = "components"
+	ContactObject             = "contact"
+	DiscriminatorObject       = "discriminator"
+	DocumentObject            = "document"
+	EncodingObject            = "encoding"
+	ExampleObject             = "example"
+	ExamplesObject            = "examples"
+	ExtensionObject           = "extension"
+	ExternalDocObject         = "externalDoc"
+	HeaderObject              = "header"
+	InfoObject                = "info"
+	ItemsObject               = "items"
+	LicenseObject             = "license"
+	LinkObject                = "link"
+	MediaTypeObject           = "mediaType"
+	OAuthFlowObject           = "oauthFlow"
+	OAuthFlowsObject          = "oauthFlows"
+	OperationObject           = "operation"
+	ParameterObject           = "parameter"
+	PathItemObject            = "pathItem"
+	PathsObject               = "paths"
+	RequestBodyObject         = "requestBody"
+	ResponseObject            = "response"
+	ResponsesObject           = "responses"
+	SchemaObject              = "schema"
+	ScopesObject              = "scopes"
+	SecurityRequirementObject = "securityRequirement"
+	SecuritySchemeObject      = "securityScheme"
+	ServerObject              = "server"
+	ServerVariableObject      = "serverVariable"
+	TagObject                 = "tag"
+	XMLObject                 = "xml"
+)
+
+// changeTypeNames are the names used for each type of change in a set of BreakingRules.
+var changeTypeNames = map[int]string{
+	Modified:        "modified",
+	PropertyAdded:   "propertyAdded",
+	ObjectAdded:     "objectAdded",
+	ObjectRemoved:   "objectRemoved",
+	PropertyRemoved: "propertyRemoved",
+}
+
+// ChangeTypeName returns the name of a change type (like 'propertyAdded'), as it's used in a set of BreakingRules.
+func ChangeTypeName(changeType int) string {
+	return changeTypeNames[changeType]
+}
+
+// BreakingRules is a table of rules that decide if a change is breaking or not. Rules are keyed by the type of object
+// that was changed (like 'schema', 'parameter' or 'operation'), the property that was changed (like 'enum' or
+// 'required') and the type of change (one of 'modified', 'propertyAdded', 'objectAdded', 'objectRemoved' or
+// 'propertyRemoved').
+//
+// The default rules (see DefaultBreakingRules) are used by every comparison, BreakingRules only need to contain the
+// rules that should be different. Any change that does not match a rule keeps the default. Rules can be loaded from
+// YAML (or JSON), using LoadBreakingRules:
+//
+//	schema:
+//	  enum:
+//	    propertyAdded: true   # adding an enum value is a breaking change.
+//	    propertyRemoved: false
+//	operation:
+//	  "*":                    # every property of an operation.
+//	    modified: false
+//
+// The name of an object is the name of the type of changes that are reported for it, without 'Changes', for example
+// SchemaChanges is 'schema' (SchemaObject) and MediaTypeChanges is 'mediaType' (MediaTypeObject).
+//
+// Changes made to something that is named by the document (like a tag, an example, an extension, a callback or a
+// mapping of a discriminator) only use the AnyProperty rule of the object, so a tag named 'externalDocs' does not use
+// the rule for the externalDocs of a tag.
+type BreakingRules map[string]map[string]map[string]bool
+
+// DefaultBreakingRules returns a copy of the rules every comparison uses to decide if a change is breaking.
+func DefaultBreakingRules() BreakingRules {
+	rules := make(BreakingRules, len(defaultBreakingRules))
+	for object, properties := range defaultBreakingRules {
+		for property, changes := range properties {
+			for change, breaking := range changes {
+				if rules[object] == nil {
+					rules[object] = make(map[string]map[string]bool)
+				}
+				if rules[object][property] == nil {
+					rules[object][property] = make(map[string]bool)
+				}
+				rules[object][property][change] = breaking
+			}
+		}
+	}
+	return rules
+}
+
+// LoadBreakingRules will load a set of BreakingRules from YAML or JSON. An error is returned if a rule uses a type of
+// change that does not exist.
+func LoadBreakingRules(data []byte) (BreakingRules, error) {
+	var rules BreakingRules
+	if err := yaml.Unmarshal(data, &rules); err != nil {
+		return nil, fmt.Errorf("unable to load breaking rules: %w", err)
+	}
+	for object, properties := range rules {
+		for property, changes := range properties {
+			for change := range changes {
+				if !isChangeTypeName(change) {
+					return nil, fmt.Errorf("unable to load breaking rules: '%s' (%s.%s) is not a type of change",
+						change, object, property)
+				}
+			}
+		}
+	}
+	return rules, nil
+}
+
+// Set adds (or replaces) a rule, so a type of change made to the property of an object is breaking (or not).
+func (r BreakingRules) Set(object, property string, changeType int, breaking bool) {
+	if r[object] == nil {
+		r[object] = make(map[string]map[string]bool)
+	}
+	if r[object][property] == nil {
+		r[object][property] = make(map[string]bool)
+	}
+	r[object][property][ChangeTypeName(changeType)] = breaking
+}
+
+// IsBreaking returns the rule for a type of change made to the property of an object, and true if there is a rule.
+// A rule for the property is used before a rule for AnyProperty.
+func (r BreakingRules) IsBreaking(object, property string, changeType int) (breaking bool, found bool) {
+	name := ChangeTypeName(changeType)
+	for _, p := range []string{property, AnyProperty} {
+		if breaking, found = r[object][p][name]; found {
+			return breaking, found
+		}
+	}
+	return false, false
+}
+
+// CompareDocumentsWithRules is the same as CompareDocuments, except the supplied rules decide which changes are
+// breaking. Changes that don't match a rule keep the default.
+func CompareDocumentsWithRules(l, r any, rules BreakingRules) *DocumentChanges {
+	return compareDocuments(l, r, rules)
+}
+
+// isBreaking returns true if a type of change made to the property of an object is breaking, using the rules and
+// then the default rules. The rules can be nil, to only use the default rules.
+func (r BreakingRules) isBreaking(object, property string, changeType int) bool {
+	if breaking, found := r.IsBreaking(object, property, changeType); found {
+		return breaking
+	}
+	breaking, _ := defaultBreakingRules.IsBreaking(object, property, changeType)
+	return breaking
+}
+
+func isChangeTypeName(name string) bool {
+	for _, n := range changeTypeNames {
+		if n == name {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,258 @@
+// Copyright 2023 Princess B33f Heavy Industries / Dave Shanley
+// SPDX-License-Identifier: MIT
+
+package model
+
+import (
+	"os"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/pb33f/libopenapi/datamodel"
+	"github.com/pb33f/libopenapi/datamodel/low"
+	"github.com/pb33f/libopenapi/datamodel/low/base"
+	v2 "github.com/pb33f/libopenapi/datamodel/low/v2"
+	v3 "github.com/pb33f/libopenapi/datamodel/low/v3"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/yaml.v3"
+)
+
+func TestLoadBreakingRules(t *testing.T) {
+	rules, err := LoadBreakingRules([]byte(`schema:
+  enum:
+    propertyAdded: true
+    propertyRemoved: false
+parameter:
+  "*":
+    modified: false
+  required:
+    modified: true`))
+	assert.NoError(t, err)
+
+	breaking, found := rules.IsBreaking("schema", "enum", PropertyAdded)
+	assert.True(t, found)
+	assert.True(t, breaking)
+	breaking, found = rules.IsBreaking("schema", "enum", PropertyRemoved)
+	assert.True(t, found)
+	assert.False(t, breaking)
+	_, found = rules.IsBreaking("schema", "enum", Modified)
+	assert.False(t, found)
+
+	// a rule for the property is used before a rule for every property.
+	breaking, _ = rules.IsBreaking("parameter", "required", Modified)
+	assert.True(t, breaking)
+	breaking, found = rules.IsBreaking("parameter", "name", Modified)
+	assert.True(t, found)
+	assert.False(t, breaking)
+
+	rules.Set("operation", "tags", ObjectRemoved, true)
+	breaking, found = rules.IsBreaking("operation", "tags", ObjectRemoved)
+	assert.True(t, found)
+	assert.True(t, breaking)
+}
+
+func TestLoadBreakingRules_Errors(t *testing.T) {
+	_, err := LoadBreakingRules([]byte(`schema:
+  enum:
+    added: true`))
+	assert.EqualError(t, err, "unable to load breaking rules: 'added' (schema.enum) is not a type of change")
+
+	_, err = LoadBreakingRules([]byte(`schema: [enum]`))
+	assert.Error(t, err)
+}
+
+func TestCompareWithRules(t *testing.T) {
+	left := `name: pet
+in: query
+required: true
+schema:
+  type: string
+  enum: [dog, cat]`
+	right := `name: pet
+in: query
+required: false
+schema:
+  type: string
+  enum: [dog, fish]`
+
+	var lNode, rNode yaml.Node
+	_ = yaml.Unmarshal([]byte(left), &lNode)
+	_ = yaml.Unmarshal([]byte(right), &rNode)
+
+	var lDoc, rDoc v3.Parameter
+	_ = low.BuildModel(lNode.Content[0], &lDoc)
+	_ = low.BuildModel(rNode.Content[0], &rDoc)
+	_ = lDoc.Build(lNode.Content[0], nil)
+	_ = rDoc.Build(rNode.Content[0], nil)
+
+	changes := CompareParametersV3(&lDoc, &rDoc)
+	assert.Equal(t, 3, changes.TotalChanges())
+	assert.Equal(t, 2, changes.TotalBreakingChanges()) // changing required, and removing an enum value.
+
+	rules := BreakingRules{}
+	rules.Set(SchemaObject, "enum", PropertyAdded, true)
+	rules.Set(ParameterObject, AnyProperty, Modified, false)
+	changes = compareParameters(&lDoc, &rDoc, SchemaUsageRequest, rules)
+	assert.Equal(t, 2, changes.TotalBreakingChanges())
+	assert.False(t, changes.Changes[0].Breaking)
+	for _, c := range changes.SchemaChanges.Changes {
+		assert.True(t, c.Breaking)
+	}
+
+	// the rules are only used by the comparison that was given them.
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			assert.False(t, compareParameters(&lDoc, &rDoc, SchemaUsageRequest, rules).Changes[0].Breaking)
+		}()
+		go func() {
+			defer wg.Done()
+			assert.True(t, CompareParametersV3(&lDoc, &rDoc).Changes[0].Breaking)
+		}()
+	}
+	wg.Wait()
+}
+
+func TestBreakingRules_NamedByDocument(t *testing.T) {
+	left := `openapi: 3.1.0
+tags:
+  - name: externalDocs
+  - name: pizza`
+	right := `openapi: 3.1.0
+tags:
+  - name: pizza`
+
+	lInfo, _ := datamodel.ExtractSpecInfo([]byte(left))
+	rInfo, _ := datamodel.ExtractSpecInfo([]byte(right))
+	lDoc, _ := v3.CreateDocument(lInfo)
+	rDoc, _ := v3.CreateDocument(rInfo)
+
+	// a tag named 'externalDocs' does not use the rule for the externalDocs of a tag.
+	changes := CompareTags(lDoc.Tags.Value, rDoc.Tags.Value)
+	assert.Len(t, changes, 1)
+	assert.Equal(t, ObjectRemoved, changes[0].Changes[0].ChangeType)
+	assert.True(t, changes[0].Changes[0].Breaking)
+
+	// the same goes for a mapping of a discriminator named 'propertyName'.
+	var lNode, rNode yaml.Node
+	_ = yaml.Unmarshal([]byte(`mapping:
+  propertyName: pizza`), &lNode)
+	_ = yaml.Unmarshal([]byte(`mapping:
+  propertyName: burger`), &rNode)
+	var lDisc, rDisc base.Discriminator
+	_ = low.BuildModel(lNode.Content[0], &lDisc)
+	_ = low.BuildModel(rNode.Content[0], &rDisc)
+
+	rules := BreakingRules{}
+	rules.Set(DiscriminatorObject, v3.PropertyNameLabel, Modified, false)
+	dc := compareDiscriminator(&lDisc, &rDisc, rules)
+	assert.Equal(t, 1, dc.TotalBreakingChanges())
+
+	rules.Set(DiscriminatorObject, AnyProperty, Modified, false)
+	dc = compareDiscriminator(&lDisc, &rDisc, rules)
+	assert.Equal(t, 0, dc.TotalBreakingChanges())
+}
+
+func TestDefaultBreakingRules(t *testing.T) {
+	rules := DefaultBreakingRules()
+	assert.Equal(t, defaultBreakingRules, rules)
+
+	breaking, found := rules.IsBreaking(SchemaObject, "enum", PropertyRemoved)
+	assert.True(t, found)
+	assert.True(t, breaking)
+	breaking, found = rules.IsBreaking(ExtensionObject, "x-pizza", ObjectAdded)
+	assert.True(t, found)
+	assert.False(t, breaking)
+
+	// the defaults are a copy.
+	rules.Set(SchemaObject, "enum", PropertyRemoved, false)
+	breaking, _ = defaultBreakingRules.IsBreaking(SchemaObject, "enum", PropertyRemoved)
+	assert.True(t, breaking)
+
+	// the defaults survive a trip through YAML.
+	out, err := yaml.Marshal(DefaultBreakingRules())
+	assert.NoError(t, err)
+	loaded, err := LoadBreakingRules(out)
+	assert.NoError(t, err)
+	assert.Equal(t, defaultBreakingRules, loaded)
+}
+
+func TestDefaultBreakingRules_EveryChange(t *testing.T) {
+	load := func(name string) *datamodel.SpecInfo {
+		spec, _ := os.ReadFile("../../test_specs/" + name)
+		info, _ := datamodel.ExtractSpecInfo(spec)
+		return info
+	}
+	lV3, _ := v3.CreateDocument(load("burgershop.openapi.yaml"))
+	rV3, _ := v3.CreateDocument(load("burgershop.openapi-modified.yaml"))
+	lV2, _ := v2.CreateDocument(load("petstorev2-complete.yaml"))
+	rV2, _ := v2.CreateDocument(load("petstorev2-complete-modified.yaml"))
+
+	// every change made by the test documents has a default rule, so no change is breaking by accident.
+	for _, changes := range []*DocumentChanges{CompareDocuments(lV3, rV3), CompareDocuments(lV2, rV2)} {
+		walkChanges(reflect.ValueOf(changes), make(map[uintptr]bool), func(v reflect.Value) bool {
+			object := strings.TrimSuffix(v.Type().Name(), "Changes")
+			switch {
+			case strings.HasPrefix(object, "OAuth"):
+				object = "oauth" + strings.TrimPrefix(object, "OAuth")
+			case object == "XML":
+				object = XMLObject
+			default:
+				object = strings.ToLower(object[:1]) + object[1:]
+			}
+			for _, c := range propertyChanges(v) {
+				_, found := defaultBreakingRules.IsBreaking(object, c.Property, c.ChangeType)
+				assert.True(t, found, "%s.%s %s", object, c.Property, ChangeTypeName(c.ChangeType))
+			}
+			return true
+		})
+	}
+}
+
+// walkChanges calls visit for every set of changes (like SchemaChanges) found in v, including v. If visit returns
+// false, the changes inside that set of changes are not walked.
+func walkChanges(v reflect.Value, seen map[uintptr]bool, visit func(v reflect.Value) bool) {
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if v.IsNil() {
+			return
+		}
+		if v.Kind() == reflect.Ptr {
+			if seen[v.Pointer()] {
+				return
+			}
+			seen[v.Pointer()] = true
+		}
+		walkChanges(v.Elem(), seen, visit)
+	case reflect.Slice:
+		for i := 0; i < v.Len(); i++ {
+			walkChanges(v.Index(i), seen, visit)
+		}
+	case reflect.Map:
+		iter := v.MapRange()
+		for iter.Next() {
+			walkChanges(iter.Value(), seen, visit)
+		}
+	case reflect.Struct:
+		if _, ok := v.Type().FieldByName("PropertyChanges"); !ok || !visit(v) {
+			return
+		}
+		for i := 0; i < v.NumField(); i++ {
+			if v.Type().Field(i).IsExported() && v.Type().Field(i).Name != "PropertyChanges" {
+				walkChanges(v.Field(i), seen, visit)
+			}
+		}
+	}
+}
+
+// propertyChanges returns the changes made to the properties of a set of changes (like SchemaChanges).
+func propertyChanges(v reflect.Value) []*Change {
+	if pc, ok := v.FieldByName("PropertyChanges").Interface().(*PropertyChanges); ok && pc != nil {
+		return pc.Changes
+	}
+	return nil
+}
@@ -54,7 +54,15 @@ func (c *CallbackChanges) TotalBreakingChanges() int {
 
 // CompareCallback will compare two Callback objects and return a pointer to CallbackChanges with all the things
 // that have changed between them.
+//
+// The requests of a callback are sent by a server (and the responses by a client), so the schemas of the requests
+// are classified as a response, and the schemas of the responses as a request (see SchemaUsage).
 func CompareCallback(l, r *v3.Callback) *CallbackChanges {
+    return compareCallback(l, r, SchemaUsageResponse, nil)
+}
+
+// compareCallback compares two Callback objects, using usage as the usage of the requests made to the callback.
+func compareCallback(l, r *v3.Callback, usage SchemaUsage, rules BreakingRules) *CallbackChanges {
 
     cc := new(CallbackChanges)
     var changes []*Change
@@ -82,7 +90,7 @@ func CompareCallback(l, r *v3.Callback) *CallbackChanges {
         rhash := rHashes[k]
         if rhash == "" {
             CreateChange(&changes, ObjectRemoved, k,
-                lValues[k].GetValueNode(), nil, true,
+                lValues[k].GetValueNode(), nil, rules.isBreaking(CallbackObject, AnyProperty, ObjectRemoved),
                 lValues[k].GetValue(), nil)
             continue
         }
@@ -90,7 +98,7 @@ func CompareCallback(l, r *v3.Callback) *CallbackChanges {
             continue
         }
         // run comparison.
-        expChanges[k] = ComparePathItems(lValues[k].Value, rValues[k].Value)
+        expChanges[k] = comparePathItems(lValues[k].Value, rValues[k].Value, usage, rules)
     }
 
     //check right path item hashes
@@ -98,13 +106,13 @@ func CompareCallback(l, r *v3.Callback) *CallbackChanges {
         lhash := lHashes[k]
         if lhash == "" {
             CreateChange(&changes, ObjectAdded, k,
-                nil, rValues[k].GetValueNode(), false,
+                nil, rValues[k].GetValueNode(), rules.isBreaking(CallbackObject, AnyProperty, ObjectAdded),
                 nil, rValues[k].GetValue())
             continue
         }
     }
     cc.ExpressionChanges = expChanges
-    cc.ExtensionChanges = CompareExtensions(l.Extensions, r.Extensions)
+    cc.ExtensionChanges = compareExtensions(l.Extensions, r.Extensions, rules)
     cc.PropertyChanges = NewPropertyChanges(changes)
     if cc.TotalChanges() <= 0 {
         return nil
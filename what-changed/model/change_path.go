@@ -0,0 +1,75 @@
+// Copyright 2023 Princess B33f Heavy Industries / Dave Shanley
+// SPDX-License-Identifier: MIT
+
+package model
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/pb33f/libopenapi/index"
+	"gopkg.in/yaml.v3"
+)
+
+// SetChangePaths sets the OriginalPath and NewPath of every change, by finding the original and new values in the
+// original and new documents, to get the JSON Pointer to each value. Changes are given paths automatically when
+// comparing documents, this is only needed when comparing objects directly, using the root nodes the objects were
+// built from.
+//
+// The path is where a change lives in the document, so a change made to a referenced object (like a component
+// schema) has the path of the referenced object, not the path of the object that references it. Changes made to
+// objects in other files (like a schema referenced from 'models/pet.yaml') have no path, as a JSON Pointer can't
+// point into another file.
+func SetChangePaths(changes []*Change, original, updated *yaml.Node) {
+	lPaths, rPaths := nodePaths(original), nodePaths(updated)
+	for _, c := range changes {
+		if c.originalNode != nil {
+			c.OriginalPath = lPaths[c.originalNode]
+		}
+		if c.newNode != nil {
+			c.NewPath = rPaths[c.newNode]
+		}
+	}
+}
+
+// nodePaths maps every node in a document to its JSON Pointer. Mapping keys have the same path as their values.
+// Nodes are matched by identity (not by position), so nodes read from other files are never given a path.
+func nodePaths(root *yaml.Node) map[*yaml.Node]string {
+	paths := make(map[*yaml.Node]string)
+	if root == nil {
+		return paths
+	}
+	if root.Kind == yaml.DocumentNode && len(root.Content) > 0 {
+		root = root.Content[0]
+	}
+	var walk func(node *yaml.Node, path string)
+	walk = func(node *yaml.Node, path string) {
+		paths[node] = path
+		switch node.Kind {
+		case yaml.MappingNode:
+			for i := 0; i+1 < len(node.Content); i += 2 {
+				keyPath := path + "/" + escapePointer(node.Content[i].Value)
+				paths[node.Content[i]] = keyPath
+				walk(node.Content[i+1], keyPath)
+			}
+		case yaml.SequenceNode:
+			for i := range node.Content {
+				walk(node.Content[i], path+"/"+strconv.Itoa(i))
+			}
+		}
+	}
+	walk(root, "")
+	return paths
+}
+
+// escapePointer escapes a reference token of a JSON Pointer (RFC 6901).
+func escapePointer(token string) string {
+	return strings.ReplaceAll(strings.ReplaceAll(token, "~", "~0"), "/", "~1")
+}
+
+func rootNode(idx *index.SpecIndex) *yaml.Node {
+	if idx == nil {
+		return nil
+	}
+	return idx.GetRootNode()
+}
@@ -0,0 +1,128 @@
+// Copyright 2023 Princess B33f Heavy Industries / Dave Shanley
+// SPDX-License-Identifier: MIT
+
+package model
+
+import (
+	"fmt"
+	"testing"
+	"testing/fstest"
+
+	"github.com/pb33f/libopenapi/datamodel"
+	v3 "github.com/pb33f/libopenapi/datamodel/low/v3"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/yaml.v3"
+)
+
+func TestSetChangePaths(t *testing.T) {
+	left := `paths:
+  /pets/{id}:
+    get:
+      parameters:
+        - name: limit
+          schema:
+            maximum: 10
+  a~b: [1, 2]`
+	right := `paths:
+  /pets/{id}:
+    get:
+      parameters:
+        - name: limit
+          schema:
+            maximum: 20
+            minimum: 1`
+
+	var lNode, rNode yaml.Node
+	_ = yaml.Unmarshal([]byte(left), &lNode)
+	_ = yaml.Unmarshal([]byte(right), &rNode)
+
+	schema := func(n *yaml.Node) *yaml.Node {
+		return n.Content[0].Content[1].Content[1].Content[1].Content[1].Content[0].Content[3]
+	}
+	lSchema, rSchema := schema(&lNode), schema(&rNode)
+
+	var changes []*Change
+	CreateChange(&changes, Modified, "maximum", lSchema.Content[1], rSchema.Content[1], true, nil, nil)
+	CreateChange(&changes, PropertyAdded, "minimum", nil, rSchema.Content[3], true, nil, nil)
+	CreateChange(&changes, PropertyRemoved, "a~b", lNode.Content[0].Content[1].Content[3].Content[1], nil, true, nil, nil)
+	CreateChange(&changes, ObjectAdded, "schema", nil, rSchema, true, nil, nil)
+	changes = append(changes, &Change{Property: "nothing"})
+
+	SetChangePaths(changes, &lNode, &rNode)
+
+	assert.Equal(t, "/paths/~1pets~1{id}/get/parameters/0/schema/maximum", changes[0].OriginalPath)
+	assert.Equal(t, "/paths/~1pets~1{id}/get/parameters/0/schema/maximum", changes[0].NewPath)
+	assert.Equal(t, "", changes[1].OriginalPath)
+	assert.Equal(t, "/paths/~1pets~1{id}/get/parameters/0/schema/minimum", changes[1].NewPath)
+	assert.Equal(t, "/paths/~1pets~1{id}/get/parameters/0/schema/minimum", changes[1].Path())
+	assert.Equal(t, "/paths/a~0b/1", changes[2].Path())
+	assert.Equal(t, "/paths/~1pets~1{id}/get/parameters/0/schema", changes[3].NewPath)
+	assert.Empty(t, changes[4].Path())
+
+	// nothing can be found without a document.
+	SetChangePaths(changes, nil, nil)
+	assert.Empty(t, changes[0].Path())
+}
+
+func TestNodePaths_Keys(t *testing.T) {
+	var node yaml.Node
+	_ = yaml.Unmarshal([]byte(`pets:
+  dog: woof`), &node)
+
+	paths := nodePaths(&node)
+	pets := node.Content[0].Content[1]
+	assert.Equal(t, "", paths[node.Content[0]])
+	assert.Equal(t, "/pets", paths[node.Content[0].Content[0]]) // the 'pets' key.
+	assert.Equal(t, "/pets", paths[pets])
+	assert.Equal(t, "/pets/dog", paths[pets.Content[0]])
+	assert.Equal(t, "/pets/dog", paths[pets.Content[1]])
+
+	// a node with the same position, that is not part of the document.
+	assert.NotContains(t, paths, &yaml.Node{Line: pets.Line, Column: pets.Column})
+}
+
+func TestSetChangePaths_MultipleFiles(t *testing.T) {
+	spec := `openapi: 3.1.0
+info:
+  title: pets
+  description: %s
+  version: 1.0.0
+paths:
+  /pets:
+    get:
+      responses:
+        "200":
+          $ref: 'responses.yaml#/Ok'`
+
+	// the description of the response is on the same line and column as the description of the root document.
+	responses := `# responses shared by every operation.
+# the description is the only property.
+Ok:
+  description: %s`
+
+	build := func(description string) *v3.Document {
+		info, _ := datamodel.ExtractSpecInfo([]byte(fmt.Sprintf(spec, description)))
+		doc, errs := v3.CreateDocumentFromConfig(info, &datamodel.DocumentConfiguration{
+			AllowFileReferences: true,
+			FS:                  fstest.MapFS{"responses.yaml": {Data: []byte(fmt.Sprintf(responses, description))}},
+		})
+		assert.Empty(t, errs)
+		return doc
+	}
+
+	changes := CompareDocuments(build("old"), build("new"))
+	assert.Equal(t, 2, changes.TotalChanges())
+
+	root := changes.InfoChanges.Changes[0]
+	external := changes.PathsChanges.PathItemsChanges["/pets"].GetChanges.ResponsesChanges.
+		ResponseChanges["200"].Changes[0]
+	assert.Equal(t, *root.Context.NewLine, *external.Context.NewLine)
+	assert.Equal(t, *root.Context.NewColumn, *external.Context.NewColumn)
+
+	// only the change made to the root document has a path.
+	assert.Equal(t, "/info/description", root.OriginalPath)
+	assert.Equal(t, "/info/description", root.NewPath)
+	assert.Equal(t, v3.DescriptionLabel, external.Property)
+	assert.Empty(t, external.OriginalPath)
+	assert.Empty(t, external.NewPath)
+}
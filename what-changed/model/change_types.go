@@ -85,11 +85,38 @@ type Change struct {
     // Breaking determines if the change is a breaking one or not.
     Breaking bool `json:"breaking" yaml:"breaking"`
 
+    // OriginalPath is the JSON Pointer to the original value in the original document (like
+    // '/paths/~1pets/get/parameters/0/schema/maximum'). It's empty if there is no original value.
+    OriginalPath string `json:"originalPath,omitempty" yaml:"originalPath,omitempty"`
+
+    // NewPath is the JSON Pointer to the new value in the new document. It's empty if there is no new value.
+    NewPath string `json:"newPath,omitempty" yaml:"newPath,omitempty"`
+
     // OriginalObject represents the original object that was changed.
     OriginalObject any `json:"-" yaml:"-"`
 
     // NewObject represents the new object that has been modified.
     NewObject any `json:"-" yaml:"-"`
+
+    // originalNode and newNode are the nodes of the original and new values, used to find their paths.
+    originalNode, newNode *yaml.Node
+
+    // nonBinding is set for changes made to extensions, they are never counted as breaking.
+    nonBinding bool
+}
+
+// IsBreaking returns true if the change is breaking and is counted as such. Changes made to extensions
+// are non-binding, so they are never counted, even if Breaking is set.
+func (c *Change) IsBreaking() bool {
+    return c.Breaking && !c.nonBinding
+}
+
+// Path returns the JSON Pointer to the new value of a change, or the original value if it was removed.
+func (c *Change) Path() string {
+    if c.NewPath != "" {
+        return c.NewPath
+    }
+    return c.OriginalPath
 }
 
 // PropertyChanges holds a slice of Change pointers
@@ -134,6 +161,10 @@ type PropertyCheck struct {
     // Breaking determines if the check is a breaking change (modifications or removals etc.)
     Breaking bool
 
+    // Object is the name of the object that owns the property (like 'schema'). If set, the BreakingRules for the
+    // property of the object decide if a change is breaking, instead of Breaking.
+    Object string
+
     // Changes represents a pointer to the slice to contain all changes found.
     Changes *[]*Change
 }
@@ -42,6 +42,8 @@ func CreateChange(changes *[]*Change, changeType int, property string, leftValue
 	// original and new objects
 	c.OriginalObject = originalObject
 	c.NewObject = newObject
+	c.originalNode = leftValueNode
+	c.newNode = rightValueNode
 
 	// add the change to supplied changes slice
 	*changes = append(*changes, c)
@@ -73,11 +75,11 @@ func FlattenLowLevelMap[T any](
 	return flat
 }
 
-// CountBreakingChanges counts the number of changes in a slice that are breaking
+// CountBreakingChanges counts the number of changes in a slice that are breaking, see Change.IsBreaking
 func CountBreakingChanges(changes []*Change) int {
 	b := 0
 	for i := range changes {
-		if changes[i].Breaking {
+		if changes[i].IsBreaking() {
 			b++
 		}
 	}
@@ -121,12 +123,29 @@ func CheckSpecificObjectAdded[T any](l, r map[string]*T, label string) bool {
 //
 //	CheckPropertyAdditionOrRemoval
 //	CheckForModification
+//
+// If a check has an Object, the default BreakingRules for the object decide if each type of change is breaking,
+// otherwise Breaking is used for every type of change.
 func CheckProperties(properties []*PropertyCheck) {
+	checkProperties(properties, nil)
+}
+
+// checkProperties runs CheckProperties, using rules (before the default rules) for checks that have an Object.
+func checkProperties(properties []*PropertyCheck, rules BreakingRules) {
 
 	// todo: make this async to really speed things up.
 	for _, n := range properties {
-		CheckPropertyAdditionOrRemoval(n.LeftNode, n.RightNode, n.Label, n.Changes, n.Breaking, n.Original, n.New)
-		CheckForModification(n.LeftNode, n.RightNode, n.Label, n.Changes, n.Breaking, n.Original, n.New)
+		if n.Object == "" {
+			CheckPropertyAdditionOrRemoval(n.LeftNode, n.RightNode, n.Label, n.Changes, n.Breaking, n.Original, n.New)
+			CheckForModification(n.LeftNode, n.RightNode, n.Label, n.Changes, n.Breaking, n.Original, n.New)
+			continue
+		}
+		CheckForRemoval(n.LeftNode, n.RightNode, n.Label, n.Changes,
+			rules.isBreaking(n.Object, n.Label, PropertyRemoved), n.Original, n.New)
+		CheckForAddition(n.LeftNode, n.RightNode, n.Label, n.Changes,
+			rules.isBreaking(n.Object, n.Label, PropertyAdded), n.Original, n.New)
+		CheckForModification(n.LeftNode, n.RightNode, n.Label, n.Changes,
+			rules.isBreaking(n.Object, n.Label, Modified), n.Original, n.New)
 	}
 }
 
@@ -178,19 +197,21 @@ func CheckForModification[T any](l, r *yaml.Node, label string, changes *[]*Chan
 }
 
 // CheckMapForChanges checks a left and right low level map for any additions, subtractions or modifications to
-// values. The compareFunc argument should reference the correct comparison function for the generic type.
+// values. The compareFunc argument should reference the correct comparison function for the generic type. The
+// rules (and then the default BreakingRules) for the label of the object decide if additions and removals are
+// breaking, rules can be nil.
 func CheckMapForChanges[T any, R any](expLeft, expRight map[low.KeyReference[string]]low.ValueReference[T],
-	changes *[]*Change, label string, compareFunc func(l, r T) R) map[string]R {
-	return CheckMapForChangesWithComp(expLeft, expRight, changes, label, compareFunc, true)
+	changes *[]*Change, label, object string, rules BreakingRules, compareFunc func(l, r T) R) map[string]R {
+	return CheckMapForChangesWithComp(expLeft, expRight, changes, label, object, rules, compareFunc, true)
 }
 
 func CheckMapForAdditionRemoval[T any](expLeft, expRight map[low.KeyReference[string]]low.ValueReference[T],
-	changes *[]*Change, label string) any {
+	changes *[]*Change, label, object string, rules BreakingRules) any {
 	// do nothing
 	doNothing := func(l, r T) any {
 		return nil
 	}
-	return CheckMapForChangesWithComp(expLeft, expRight, changes, label, doNothing, false)
+	return CheckMapForChangesWithComp(expLeft, expRight, changes, label, object, rules, doNothing, false)
 }
 
 //// CheckMapForAdditionRemoval checks a left and right low level map for any additions or subtractions, but not modifications
@@ -206,9 +227,11 @@ func CheckMapForAdditionRemoval[T any](expLeft, expRight map[low.KeyReference[st
 
 // CheckMapForChangesWithComp checks a left and right low level map for any additions, subtractions or modifications to
 // values. The compareFunc argument should reference the correct comparison function for the generic type. The compare
-// bit determines if the comparison should be run or not.
+// bit determines if the comparison should be run or not. The rules (and then the default BreakingRules) for the label
+// of the object decide if additions and removals are breaking, rules can be nil.
 func CheckMapForChangesWithComp[T any, R any](expLeft, expRight map[low.KeyReference[string]]low.ValueReference[T],
-	changes *[]*Change, label string, compareFunc func(l, r T) R, compare bool) map[string]R {
+	changes *[]*Change, label, object string, rules BreakingRules, compareFunc func(l, r T) R,
+	compare bool) map[string]R {
 
 	// stop concurrent threads screwing up changes.
 	var chLock sync.Mutex
@@ -238,7 +261,7 @@ func CheckMapForChangesWithComp[T any, R any](expLeft, expRight map[low.KeyRefer
 			}
 			chLock.Lock()
 			CreateChange(changes, ObjectRemoved, label,
-				p[k].GetValueNode(), nil, true,
+				p[k].GetValueNode(), nil, rules.isBreaking(object, label, ObjectRemoved),
 				p[k].GetValue(), nil)
 			chLock.Unlock()
 			doneChan <- true
@@ -274,7 +297,7 @@ func CheckMapForChangesWithComp[T any, R any](expLeft, expRight map[low.KeyRefer
 	//check right example hashes
 	for k := range rHashes {
 		count++
-		go checkRightValue(k, doneChan, lHashes, rValues, changes, label, &chLock)
+		go checkRightValue(k, doneChan, lHashes, rValues, changes, label, object, rules, &chLock)
 	}
 
 	// wait for all done signals.
@@ -289,7 +312,7 @@ func CheckMapForChangesWithComp[T any, R any](expLeft, expRight map[low.KeyRefer
 }
 
 func checkRightValue[T any](k string, doneChan chan bool, f map[string]string, p map[string]low.ValueReference[T],
-	changes *[]*Change, label string, lock *sync.Mutex) {
+	changes *[]*Change, label, object string, rules BreakingRules, lock *sync.Mutex) {
 
 	lhash := f[k]
 	if lhash == "" {
@@ -298,16 +321,17 @@ func checkRightValue[T any](k string, doneChan chan bool, f map[string]string, p
 		}
 		lock.Lock()
 		CreateChange(changes, ObjectAdded, label,
-			nil, p[k].GetValueNode(), false,
+			nil, p[k].GetValueNode(), rules.isBreaking(object, label, ObjectAdded),
 			nil, p[k].GetValue())
 		lock.Unlock()
 	}
 	doneChan <- true
 }
 
-// ExtractStringValueSliceChanges will compare two low level string slices for changes.
+// ExtractStringValueSliceChanges will compare two low level string slices for changes. The rules (and then the default
+// BreakingRules) for the label of the object decide if additions and removals are breaking, rules can be nil.
 func ExtractStringValueSliceChanges(lParam, rParam []low.ValueReference[string],
-	changes *[]*Change, label string, breaking bool) {
+	changes *[]*Change, label, object string, rules BreakingRules) {
 	lKeys := make([]string, len(lParam))
 	rKeys := make([]string, len(rParam))
 	lValues := make(map[string]low.ValueReference[string])
@@ -325,7 +349,7 @@ func ExtractStringValueSliceChanges(lParam, rParam []low.ValueReference[string],
 			CreateChange(changes, PropertyRemoved, label,
 				lValues[i].ValueNode,
 				nil,
-				breaking,
+				rules.isBreaking(object, label, PropertyRemoved),
 				lValues[i].Value,
 				nil)
 		}
@@ -335,16 +359,17 @@ func ExtractStringValueSliceChanges(lParam, rParam []low.ValueReference[string],
 			CreateChange(changes, PropertyAdded, label,
 				nil,
 				rValues[i].ValueNode,
-				false,
+				rules.isBreaking(object, label, PropertyAdded),
 				nil,
 				rValues[i].Value)
 		}
 	}
 }
 
-// ExtractRawValueSliceChanges will compare two low level interface{} slices for changes.
+// ExtractRawValueSliceChanges will compare two low level interface{} slices for changes. The rules (and then the
+// default BreakingRules) for the label of the object decide if additions and removals are breaking, rules can be nil.
 func ExtractRawValueSliceChanges(lParam, rParam []low.ValueReference[any],
-	changes *[]*Change, label string, breaking bool) {
+	changes *[]*Change, label, object string, rules BreakingRules) {
 	lKeys := make([]string, len(lParam))
 	rKeys := make([]string, len(rParam))
 	lValues := make(map[string]low.ValueReference[any])
@@ -362,7 +387,7 @@ func ExtractRawValueSliceChanges(lParam, rParam []low.ValueReference[any],
 			CreateChange(changes, PropertyRemoved, label,
 				lValues[i].ValueNode,
 				nil,
-				breaking,
+				rules.isBreaking(object, label, PropertyRemoved),
 				lValues[i].Value,
 				nil)
 		}
@@ -372,7 +397,7 @@ func ExtractRawValueSliceChanges(lParam, rParam []low.ValueReference[any],
 			CreateChange(changes, PropertyAdded, label,
 				nil,
 				rValues[i].ValueNode,
-				false,
+				rules.isBreaking(object, label, PropertyAdded),
 				nil,
 				rValues[i].Value)
 		}
@@ -45,6 +45,12 @@ type ComponentsChanges struct {
 // CompareComponents will compare OpenAPI components for any changes. Accepts Swagger Definition objects
 // like ParameterDefinitions or Definitions etc.
 func CompareComponents(l, r any) *ComponentsChanges {
+	return compareComponents(l, r, nil)
+}
+
+// compareComponents compares a left and right Swagger or OpenAPI Components object, using rules before the default
+// BreakingRules.
+func compareComponents(l, r any, rules BreakingRules) *ComponentsChanges {
 
 	var changes []*Change
 
@@ -62,7 +68,7 @@ func CompareComponents(l, r any) *ComponentsChanges {
 		if rDef != nil {
 			b = rDef.Definitions
 		}
-		CheckMapForAdditionRemoval(a, b, &changes, v3.ParametersLabel)
+		CheckMapForAdditionRemoval(a, b, &changes, v3.ParametersLabel, ComponentsObject, rules)
 	}
 
 	// Swagger Responses
@@ -77,7 +83,7 @@ func CompareComponents(l, r any) *ComponentsChanges {
 		if rDef != nil {
 			b = rDef.Definitions
 		}
-		CheckMapForAdditionRemoval(a, b, &changes, v3.ResponsesLabel)
+		CheckMapForAdditionRemoval(a, b, &changes, v3.ResponsesLabel, ComponentsObject, rules)
 	}
 
 	// Swagger Schemas
@@ -92,7 +98,10 @@ func CompareComponents(l, r any) *ComponentsChanges {
 		if rDef != nil {
 			b = rDef.Schemas
 		}
-		cc.SchemaChanges = CheckMapForChanges(a, b, &changes, v2.DefinitionsLabel, CompareSchemas)
+		cc.SchemaChanges = CheckMapForChanges(a, b, &changes, v2.DefinitionsLabel, ComponentsObject, rules,
+			func(l, r *base.SchemaProxy) *SchemaChanges {
+				return compareSchemas(l, r, SchemaUsageUnknown, rules)
+			})
 	}
 
 	// Swagger Security Definitions
@@ -108,7 +117,9 @@ func CompareComponents(l, r any) *ComponentsChanges {
 			b = rDef.Definitions
 		}
 		cc.SecuritySchemeChanges = CheckMapForChanges(a, b, &changes,
-			v3.SecurityDefinitionLabel, CompareSecuritySchemesV2)
+			v3.SecurityDefinitionLabel, ComponentsObject, rules, func(l, r *v2.SecurityScheme) *SecuritySchemeChanges {
+				return compareSecuritySchemes(l, r, rules)
+			})
 	}
 
 	// OpenAPI Components
@@ -129,58 +140,76 @@ func CompareComponents(l, r any) *ComponentsChanges {
 		if !lComponents.Schemas.IsEmpty() || !rComponents.Schemas.IsEmpty() {
 			comparisons++
 			go runComparison(lComponents.Schemas.Value, rComponents.Schemas.Value,
-				&changes, v3.SchemasLabel, CompareSchemas, doneChan)
+				&changes, v3.SchemasLabel, rules, func(l, r *base.SchemaProxy) *SchemaChanges {
+					return compareSchemas(l, r, SchemaUsageUnknown, rules)
+				}, doneChan)
 		}
 
 		if !lComponents.Responses.IsEmpty() || !rComponents.Responses.IsEmpty() {
 			comparisons++
 			go runComparison(lComponents.Responses.Value, rComponents.Responses.Value,
-				&changes, v3.ResponsesLabel, CompareResponseV3, doneChan)
+				&changes, v3.ResponsesLabel, rules, func(l, r *v3.Response) *ResponseChanges {
+					return compareResponse(l, r, SchemaUsageResponse, rules)
+				}, doneChan)
 		}
 
 		if !lComponents.Parameters.IsEmpty() || !rComponents.Parameters.IsEmpty() {
 			comparisons++
 			go runComparison(lComponents.Parameters.Value, rComponents.Parameters.Value,
-				&changes, v3.ParametersLabel, CompareParametersV3, doneChan)
+				&changes, v3.ParametersLabel, rules, func(l, r *v3.Parameter) *ParameterChanges {
+					return compareParameters(l, r, SchemaUsageRequest, rules)
+				}, doneChan)
 		}
 
 		if !lComponents.Examples.IsEmpty() || !rComponents.Examples.IsEmpty() {
 			comparisons++
 			go runComparison(lComponents.Examples.Value, rComponents.Examples.Value,
-				&changes, v3.ExamplesLabel, CompareExamples, doneChan)
+				&changes, v3.ExamplesLabel, rules, func(l, r *base.Example) *ExampleChanges {
+					return compareExamples(l, r, rules)
+				}, doneChan)
 		}
 
 		if !lComponents.RequestBodies.IsEmpty() || !rComponents.RequestBodies.IsEmpty() {
 			comparisons++
 			go runComparison(lComponents.RequestBodies.Value, rComponents.RequestBodies.Value,
-				&changes, v3.RequestBodiesLabel, CompareRequestBodies, doneChan)
+				&changes, v3.RequestBodiesLabel, rules, func(l, r *v3.RequestBody) *RequestBodyChanges {
+					return compareRequestBodies(l, r, SchemaUsageRequest, rules)
+				}, doneChan)
 		}
 
 		if !lComponents.Headers.IsEmpty() || !rComponents.Headers.IsEmpty() {
 			comparisons++
 			go runComparison(lComponents.Headers.Value, rComponents.Headers.Value,
-				&changes, v3.HeadersLabel, CompareHeadersV3, doneChan)
+				&changes, v3.HeadersLabel, rules, func(l, r *v3.Header) *HeaderChanges {
+					return compareHeaders(l, r, SchemaUsageUnknown, rules)
+				}, doneChan)
 		}
 
 		if !lComponents.SecuritySchemes.IsEmpty() || !rComponents.SecuritySchemes.IsEmpty() {
 			comparisons++
 			go runComparison(lComponents.SecuritySchemes.Value, rComponents.SecuritySchemes.Value,
-				&changes, v3.SecuritySchemesLabel, CompareSecuritySchemesV3, doneChan)
+				&changes, v3.SecuritySchemesLabel, rules, func(l, r *v3.SecurityScheme) *SecuritySchemeChanges {
+					return compareSecuritySchemes(l, r, rules)
+				}, doneChan)
 		}
 
 		if !lComponents.Links.IsEmpty() || !rComponents.Links.IsEmpty() {
 			comparisons++
 			go runComparison(lComponents.Links.Value, rComponents.Links.Value,
-				&changes, v3.LinksLabel, CompareLinks, doneChan)
+				&changes, v3.LinksLabel, rules, func(l, r *v3.Link) *LinkChanges {
+					return compareLinks(l, r, rules)
+				}, doneChan)
 		}
 
 		if !lComponents.Callbacks.IsEmpty() || !rComponents.Callbacks.IsEmpty() {
 			comparisons++
 			go runComparison(lComponents.Callbacks.Value, rComponents.Callbacks.Value,
-				&changes, v3.CallbacksLabel, CompareCallback, doneChan)
+				&changes, v3.CallbacksLabel, rules, func(l, r *v3.Callback) *CallbackChanges {
+					return compareCallback(l, r, SchemaUsageResponse, rules)
+				}, doneChan)
 		}
 
-		cc.ExtensionChanges = CompareExtensions(lComponents.Extensions, rComponents.Extensions)
+		cc.ExtensionChanges = compareExtensions(lComponents.Extensions, rComponents.Extensions, rules)
 
 		completedComponents := 0
 		for completedComponents < comparisons {
@@ -218,19 +247,20 @@ type componentComparison struct {
 
 // run a generic comparison in a thread which in turn splits checks into further threads.
 func runComparison[T any, R any](l, r map[low.KeyReference[string]]low.ValueReference[T],
-	changes *[]*Change, label string, compareFunc func(l, r T) R, doneChan chan componentComparison) {
+	changes *[]*Change, label string, rules BreakingRules, compareFunc func(l, r T) R,
+	doneChan chan componentComparison) {
 
 	// for schemas
 	if label == v3.SchemasLabel || label == v2.DefinitionsLabel || label == v3.SecuritySchemesLabel {
 		doneChan <- componentComparison{
 			prop:   label,
-			result: CheckMapForChanges(l, r, changes, label, compareFunc),
+			result: CheckMapForChanges(l, r, changes, label, ComponentsObject, rules, compareFunc),
 		}
 		return
 	} else {
 		doneChan <- componentComparison{
 			prop:   label,
-			result: CheckMapForAdditionRemoval(l, r, changes, label),
+			result: CheckMapForAdditionRemoval(l, r, changes, label, ComponentsObject, rules),
 		}
 	}
 }
@@ -32,6 +32,11 @@ func (c *ContactChanges) TotalBreakingChanges() int {
 // were any, a pointer to a ContactChanges object is returned, otherwise if nothing changed - the function
 // returns nil.
 func CompareContact(l, r *base.Contact) *ContactChanges {
+    return compareContact(l, r, nil)
+}
+
+// compareContact compares a left and right Contact object, using rules before the default BreakingRules.
+func compareContact(l, r *base.Contact, rules BreakingRules) *ContactChanges {
 
     var changes []*Change
     var props []*PropertyCheck
@@ -42,7 +47,7 @@ func CompareContact(l, r *base.Contact) *ContactChanges {
         RightNode: r.URL.ValueNode,
         Label:     v3.URLLabel,
         Changes:   &changes,
-        Breaking:  false,
+        Object:    ContactObject,
         Original:  l,
         New:       r,
     })
@@ -53,7 +58,7 @@ func CompareContact(l, r *base.Contact) *ContactChanges {
         RightNode: r.Name.ValueNode,
         Label:     v3.NameLabel,
         Changes:   &changes,
-        Breaking:  false,
+        Object:    ContactObject,
         Original:  l,
         New:       r,
     })
@@ -64,13 +69,13 @@ func CompareContact(l, r *base.Contact) *ContactChanges {
         RightNode: r.Email.ValueNode,
         Label:     v3.EmailLabel,
         Changes:   &changes,
-        Breaking:  false,
+        Object:    ContactObject,
         Original:  l,
         New:       r,
     })
 
     // check everything.
-    CheckProperties(props)
+    checkProperties(props, rules)
 
     dc := new(ContactChanges)
     dc.PropertyChanges = NewPropertyChanges(changes)
@@ -0,0 +1,346 @@
+// Copyright 2023 Princess B33f Heavy Industries / Dave Shanley
+// SPDX-License-Identifier: MIT
+
+package model
+
+import (
+	v2 "github.com/pb33f/libopenapi/datamodel/low/v2"
+	v3 "github.com/pb33f/libopenapi/datamodel/low/v3"
+)
+
+// defaultBreakingRules decide which changes are breaking, for every comparison. A change that does not have a rule
+// is not breaking. Dynamic properties (like the name of a tag, an extension, a callback expression or a mapping of a
+// discriminator) only use AnyProperty.
+var defaultBreakingRules = BreakingRules{
+	CallbackObject: {
+		AnyProperty: {"objectAdded": false, "objectRemoved": true},
+	},
+	ComponentsObject: {
+		v3.ParametersLabel:         {"objectAdded": false, "objectRemoved": true},
+		v3.ResponsesLabel:          {"objectAdded": false, "objectRemoved": true},
+		v2.DefinitionsLabel:        {"objectAdded": false, "objectRemoved": true},
+		v3.SecurityDefinitionLabel: {"objectAdded": false, "objectRemoved": true},
+		v3.SchemasLabel:            {"objectAdded": false, "objectRemoved": true},
+		v3.ExamplesLabel:           {"objectAdded": false, "objectRemoved": true},
+		v3.RequestBodiesLabel:      {"objectAdded": false, "objectRemoved": true},
+		v3.HeadersLabel:            {"objectAdded": false, "objectRemoved": true},
+		v3.SecuritySchemesLabel:    {"objectAdded": false, "objectRemoved": true},
+		v3.LinksLabel:              {"objectAdded": false, "objectRemoved": true},
+		v3.CallbacksLabel:          {"objectAdded": false, "objectRemoved": true},
+	},
+	ContactObject: {
+		v3.URLLabel:   propertyRule(false),
+		v3.NameLabel:  propertyRule(false),
+		v3.EmailLabel: propertyRule(false),
+	},
+	DiscriminatorObject: {
+		v3.PropertyNameLabel: propertyRule(true),
+		AnyProperty:          {"modified": true, "objectAdded": false, "objectRemoved": true},
+	},
+	DocumentObject: {
+		v3.ComponentsLabel:        {"propertyAdded": false, "propertyRemoved": true},
+		v3.ExternalDocsLabel:      {"propertyAdded": false, "propertyRemoved": false},
+		v3.InfoLabel:              {"propertyAdded": false, "propertyRemoved": false},
+		v3.SchemesLabel:           {"propertyAdded": false, "propertyRemoved": true},
+		v3.ConsumesLabel:          {"propertyAdded": false, "propertyRemoved": true},
+		v3.ProducesLabel:          {"propertyAdded": false, "propertyRemoved": true},
+		v3.WebhooksLabel:          {"objectAdded": false, "objectRemoved": true},
+		v3.SwaggerLabel:           propertyRule(true),
+		v3.HostLabel:              propertyRule(true),
+		v3.BasePathLabel:          propertyRule(true),
+		v3.OpenAPILabel:           propertyRule(true),
+		v3.JSONSchemaDialectLabel: propertyRule(true),
+		v3.SecurityLabel:          {"objectAdded": false, "objectRemoved": true},
+	},
+	EncodingObject: {
+		v3.ContentTypeLabel:   propertyRule(true),
+		v3.ExplodeLabel:       propertyRule(true),
+		v3.AllowReservedLabel: propertyRule(false),
+		v3.HeadersLabel:       {"objectAdded": false, "objectRemoved": true},
+	},
+	ExampleObject: {
+		v3.SummaryLabel:     propertyRule(false),
+		v3.DescriptionLabel: propertyRule(false),
+		v3.ValueLabel:       propertyRule(false),
+		v3.ExternalValue:    propertyRule(false),
+	},
+	ExamplesObject: {
+		AnyProperty: {"modified": false, "objectAdded": false, "objectRemoved": false},
+	},
+	ExtensionObject: {
+		AnyProperty: {"modified": false, "propertyAdded": false, "propertyRemoved": false, "objectAdded": false, "objectRemoved": true},
+	},
+	ExternalDocObject: {
+		v3.URLLabel:         propertyRule(false),
+		v3.DescriptionLabel: propertyRule(false),
+	},
+	HeaderObject: {
+		v3.ItemsLabel:            {"objectAdded": true},
+		v3.SchemaLabel:           {"objectRemoved": true},
+		v3.EnumLabel:             {"propertyAdded": false, "propertyRemoved": true},
+		v3.ExamplesLabel:         {"objectAdded": false, "objectRemoved": true},
+		v3.ContentLabel:          {"objectAdded": false, "objectRemoved": true},
+		v3.StyleLabel:            propertyRule(false),
+		v3.AllowReservedLabel:    propertyRule(false),
+		v3.AllowEmptyValueLabel:  propertyRule(true),
+		v3.ExplodeLabel:          propertyRule(false),
+		v3.ExampleLabel:          propertyRule(false),
+		v3.DeprecatedLabel:       propertyRule(false),
+		v3.RequiredLabel:         propertyRule(true),
+		v3.TypeLabel:             propertyRule(true),
+		v3.FormatLabel:           propertyRule(true),
+		v3.CollectionFormatLabel: propertyRule(true),
+		v3.MaximumLabel:          propertyRule(true),
+		v3.MinimumLabel:          propertyRule(true),
+		v3.ExclusiveMaximumLabel: propertyRule(true),
+		v3.ExclusiveMinimumLabel: propertyRule(true),
+		v3.MaxLengthLabel:        propertyRule(true),
+		v3.MinLengthLabel:        propertyRule(true),
+		v3.PatternLabel:          propertyRule(true),
+		v3.MaxItemsLabel:         propertyRule(true),
+		v3.MinItemsLabel:         propertyRule(true),
+		v3.UniqueItemsLabel:      propertyRule(true),
+		v3.MultipleOfLabel:       propertyRule(true),
+		v3.DescriptionLabel:      propertyRule(false),
+	},
+	InfoObject: {
+		v3.TitleLabel:          propertyRule(false),
+		v3.SummaryLabel:        propertyRule(false),
+		v3.DescriptionLabel:    propertyRule(false),
+		v3.TermsOfServiceLabel: propertyRule(false),
+		v3.VersionLabel:        propertyRule(false),
+		v3.ContactLabel:        {"objectAdded": false, "objectRemoved": false},
+		v3.LicenseLabel:        {"objectAdded": false, "objectRemoved": false},
+	},
+	ItemsObject: {
+		v3.TypeLabel:             propertyRule(true),
+		v3.FormatLabel:           propertyRule(true),
+		v3.CollectionFormatLabel: propertyRule(true),
+		v3.MaximumLabel:          propertyRule(true),
+		v3.MinimumLabel:          propertyRule(true),
+		v3.ExclusiveMaximumLabel: propertyRule(true),
+		v3.ExclusiveMinimumLabel: propertyRule(true),
+		v3.MaxLengthLabel:        propertyRule(true),
+		v3.MinLengthLabel:        propertyRule(true),
+		v3.PatternLabel:          propertyRule(true),
+		v3.MaxItemsLabel:         propertyRule(true),
+		v3.MinItemsLabel:         propertyRule(true),
+		v3.UniqueItemsLabel:      propertyRule(true),
+		v3.MultipleOfLabel:       propertyRule(true),
+		v3.ItemsLabel:            {"propertyAdded": true, "propertyRemoved": true},
+	},
+	LicenseObject: {
+		v3.URLLabel:  propertyRule(false),
+		v3.NameLabel: propertyRule(false),
+	},
+	LinkObject: {
+		v3.OperationRefLabel: propertyRule(true),
+		v3.OperationIdLabel:  propertyRule(true),
+		v3.RequestBodyLabel:  propertyRule(true),
+		v3.DescriptionLabel:  propertyRule(false),
+		v3.ServerLabel:       {"propertyAdded": true, "propertyRemoved": true},
+		v3.ParametersLabel:   {"modified": true, "objectAdded": true, "objectRemoved": true},
+	},
+	MediaTypeObject: {
+		v3.SchemaLabel:   {"objectAdded": true, "objectRemoved": true},
+		v3.ExamplesLabel: {"objectAdded": false, "objectRemoved": true},
+		v3.EncodingLabel: {"objectAdded": false, "objectRemoved": true},
+		v3.ExampleLabel:  propertyRule(false),
+	},
+	OAuthFlowObject: {
+		v3.AuthorizationUrlLabel: propertyRule(true),
+		v3.TokenUrlLabel:         propertyRule(true),
+		v3.RefreshUrlLabel:       propertyRule(true),
+		v3.Scopes:                {"modified": true, "objectAdded": false, "objectRemoved": true},
+	},
+	OAuthFlowsObject: {
+		v3.ClientCredentialsLabel: {"objectAdded": false, "objectRemoved": true},
+		v3.ImplicitLabel:          {"objectAdded": false, "objectRemoved": true},
+		v3.PasswordLabel:          {"objectAdded": false, "objectRemoved": true},
+		v3.AuthorizationCodeLabel: {"objectAdded": false, "objectRemoved": true},
+	},
+	OperationObject: {
+		v3.ExternalDocsLabel: {"propertyAdded": false, "propertyRemoved": false},
+		v3.ResponsesLabel:    {"propertyAdded": false, "propertyRemoved": true},
+		v3.ParametersLabel:   {"propertyAdded": true, "propertyRemoved": true, "objectAdded": true, "objectRemoved": true},
+		v3.RequestBodyLabel:  {"propertyAdded": true, "propertyRemoved": true},
+		v3.CallbacksLabel:    {"propertyAdded": false, "propertyRemoved": true, "objectAdded": false, "objectRemoved": true},
+		v3.SecurityLabel:     {"objectAdded": false, "objectRemoved": true},
+		v3.TagsLabel:         {"propertyAdded": false, "propertyRemoved": false},
+		v3.ProducesLabel:     {"propertyAdded": false, "propertyRemoved": true},
+		v3.ConsumesLabel:     {"propertyAdded": false, "propertyRemoved": true},
+		v3.SchemesLabel:      {"propertyAdded": false, "propertyRemoved": true},
+		v3.SummaryLabel:      propertyRule(false),
+		v3.DescriptionLabel:  propertyRule(false),
+		v3.DeprecatedLabel:   propertyRule(false),
+		v3.OperationIdLabel:  propertyRule(true),
+	},
+	ParameterObject: {
+		v3.ItemsLabel:            {"objectAdded": true, "objectRemoved": true},
+		v3.SchemaLabel:           {"objectAdded": true, "objectRemoved": true},
+		v3.ExampleLabel:          propertyRule(false),
+		v3.EnumLabel:             {"propertyAdded": false, "propertyRemoved": true},
+		v3.ExamplesLabel:         {"objectAdded": false, "objectRemoved": true},
+		v3.ContentLabel:          {"objectAdded": false, "objectRemoved": true},
+		v3.StyleLabel:            propertyRule(false),
+		v3.AllowReservedLabel:    propertyRule(true),
+		v3.ExplodeLabel:          propertyRule(false),
+		v3.DeprecatedLabel:       propertyRule(false),
+		v3.TypeLabel:             propertyRule(true),
+		v3.FormatLabel:           propertyRule(true),
+		v3.CollectionFormatLabel: propertyRule(true),
+		v3.MaximumLabel:          propertyRule(true),
+		v3.MinimumLabel:          propertyRule(true),
+		v3.ExclusiveMaximumLabel: propertyRule(true),
+		v3.ExclusiveMinimumLabel: propertyRule(true),
+		v3.MaxLengthLabel:        propertyRule(true),
+		v3.MinLengthLabel:        propertyRule(true),
+		v3.PatternLabel:          propertyRule(true),
+		v3.MaxItemsLabel:         propertyRule(true),
+		v3.MinItemsLabel:         propertyRule(true),
+		v3.UniqueItemsLabel:      propertyRule(true),
+		v3.DefaultLabel:          propertyRule(true),
+		v3.MultipleOfLabel:       propertyRule(true),
+		v3.NameLabel:             propertyRule(true),
+		v3.InLabel:               propertyRule(true),
+		v3.DescriptionLabel:      propertyRule(false),
+		v3.RequiredLabel:         propertyRule(true),
+		v3.AllowEmptyValueLabel:  propertyRule(true),
+	},
+	PathItemObject: {
+		v3.DescriptionLabel: propertyRule(false),
+		v3.SummaryLabel:     propertyRule(false),
+		v3.GetLabel:         {"propertyAdded": false, "propertyRemoved": true},
+		v3.PutLabel:         {"propertyAdded": false, "propertyRemoved": true},
+		v3.PostLabel:        {"propertyAdded": false, "propertyRemoved": true},
+		v3.DeleteLabel:      {"propertyAdded": false, "propertyRemoved": true},
+		v3.OptionsLabel:     {"propertyAdded": false, "propertyRemoved": true},
+		v3.HeadLabel:        {"propertyAdded": false, "propertyRemoved": true},
+		v3.PatchLabel:       {"propertyAdded": false, "propertyRemoved": true},
+		v3.ParametersLabel:  {"propertyAdded": true, "propertyRemoved": true, "objectAdded": true, "objectRemoved": true},
+		v3.TraceLabel:       {"propertyAdded": false, "propertyRemoved": true},
+	},
+	PathsObject: {
+		v3.PathLabel: {"objectAdded": false, "objectRemoved": true},
+	},
+	RequestBodyObject: {
+		v3.DescriptionLabel: propertyRule(false),
+		v3.RequiredLabel:    propertyRule(true),
+		v3.ContentLabel:     {"objectAdded": false, "objectRemoved": true},
+	},
+	ResponseObject: {
+		v3.SchemaLabel:      {"objectAdded": true, "objectRemoved": true},
+		v3.ExamplesLabel:    {"propertyRemoved": false, "objectAdded": false},
+		v3.HeadersLabel:     {"objectAdded": false, "objectRemoved": true},
+		v3.ContentLabel:     {"objectAdded": false, "objectRemoved": true},
+		v3.LinksLabel:       {"objectAdded": false, "objectRemoved": true},
+		v3.DescriptionLabel: propertyRule(false),
+	},
+	ResponsesObject: {
+		v3.DefaultLabel: {"objectAdded": false, "objectRemoved": true},
+		v3.CodesLabel:   {"objectAdded": false, "objectRemoved": true},
+	},
+	SchemaObject: {
+		v3.SchemaDialectLabel:         propertyRule(true),
+		v3.ExclusiveMaximumLabel:      propertyRule(true),
+		v3.ExclusiveMinimumLabel:      propertyRule(true),
+		v3.TypeLabel:                  propertyRule(true),
+		v3.TitleLabel:                 propertyRule(false),
+		v3.MultipleOfLabel:            propertyRule(true),
+		v3.MaximumLabel:               propertyRule(true),
+		v3.MinimumLabel:               propertyRule(true),
+		v3.MaxLengthLabel:             propertyRule(true),
+		v3.MinLengthLabel:             propertyRule(true),
+		v3.PatternLabel:               propertyRule(true),
+		v3.FormatLabel:                propertyRule(true),
+		v3.MaxItemsLabel:              propertyRule(true),
+		v3.MinItemsLabel:              propertyRule(true),
+		v3.MaxPropertiesLabel:         propertyRule(true),
+		v3.MinPropertiesLabel:         propertyRule(true),
+		v3.UniqueItemsLabel:           propertyRule(true),
+		v3.AdditionalPropertiesLabel:  propertyRule(false),
+		v3.DescriptionLabel:           propertyRule(false),
+		v3.ContentEncodingLabel:       propertyRule(true),
+		v3.ContentMediaType:           propertyRule(true),
+		v3.DefaultLabel:               propertyRule(true),
+		v3.NullableLabel:              propertyRule(true),
+		v3.ReadOnlyLabel:              propertyRule(true),
+		v3.WriteOnlyLabel:             propertyRule(true),
+		v3.ExampleLabel:               propertyRule(false),
+		v3.DeprecatedLabel:            propertyRule(false),
+		v3.SchemaLabel:                {"objectAdded": true, "objectRemoved": true},
+		v3.RefLabel:                   {"modified": true},
+		v3.XMLLabel:                   {"objectAdded": false, "objectRemoved": true},
+		v3.PropertiesLabel:            {"objectAdded": false, "objectRemoved": true},
+		v3.RequiredLabel:              {"propertyAdded": true, "propertyRemoved": true},
+		v3.EnumLabel:                  {"propertyAdded": false, "propertyRemoved": true},
+		v3.DiscriminatorLabel:         {"objectAdded": true, "objectRemoved": true},
+		v3.ExternalDocsLabel:          {"objectAdded": false, "objectRemoved": false},
+		v3.IfLabel:                    {"objectAdded": true, "objectRemoved": true},
+		v3.ElseLabel:                  {"objectAdded": true, "objectRemoved": true},
+		v3.ThenLabel:                  {"objectAdded": true, "objectRemoved": true},
+		v3.PropertyNamesLabel:         {"objectAdded": true, "objectRemoved": true},
+		v3.ContainsLabel:              {"objectAdded": true, "objectRemoved": true},
+		v3.UnevaluatedItemsLabel:      {"objectAdded": true, "objectRemoved": true},
+		v3.UnevaluatedPropertiesLabel: {"objectAdded": true, "objectRemoved": true},
+		v3.NotLabel:                   {"objectAdded": true, "objectRemoved": true},
+		v3.ItemsLabel:                 {"modified": true, "objectAdded": true, "objectRemoved": true},
+		v3.ExamplesLabel:              {"modified": false, "objectAdded": false, "objectRemoved": false},
+		v3.AllOfLabel:                 {"objectAdded": false, "objectRemoved": true},
+		v3.AnyOfLabel:                 {"objectAdded": false, "objectRemoved": true},
+		v3.OneOfLabel:                 {"objectAdded": false, "objectRemoved": true},
+	},
+	ScopesObject: {
+		v3.Scopes: {"modified": true, "objectAdded": false, "objectRemoved": true},
+	},
+	SecurityRequirementObject: {
+		v3.SecurityLabel: {"objectAdded": false, "objectRemoved": true},
+	},
+	SecuritySchemeObject: {
+		v3.ScopesLabel:           {"objectAdded": false, "objectRemoved": true},
+		v3.FlowsLabel:            {"objectAdded": false},
+		v3.TypeLabel:             propertyRule(true),
+		v3.DescriptionLabel:      propertyRule(false),
+		v3.NameLabel:             propertyRule(true),
+		v3.InLabel:               propertyRule(true),
+		v3.FlowLabel:             propertyRule(true),
+		v3.AuthorizationUrlLabel: propertyRule(true),
+		v3.TokenUrlLabel:         propertyRule(true),
+		v3.OpenIdConnectUrlLabel: propertyRule(false),
+		v3.SchemeLabel:           propertyRule(true),
+		v3.BearerFormatLabel:     propertyRule(false),
+	},
+	ServerObject: {
+		v3.ServersLabel:     {"propertyAdded": false, "propertyRemoved": true, "objectAdded": false, "objectRemoved": true},
+		v3.URLLabel:         propertyRule(true),
+		v3.DescriptionLabel: propertyRule(false),
+		v3.VariablesLabel:   {"objectAdded": false, "objectRemoved": true},
+	},
+	ServerVariableObject: {
+		v3.DefaultLabel:     propertyRule(true),
+		v3.DescriptionLabel: propertyRule(false),
+		v3.EnumLabel:        {"objectAdded": false, "objectRemoved": true},
+	},
+	TagObject: {
+		v3.NameLabel:         propertyRule(true),
+		v3.DescriptionLabel:  propertyRule(false),
+		v3.ExternalDocsLabel: {"objectAdded": false, "objectRemoved": false},
+		AnyProperty:          {"objectAdded": false, "objectRemoved": true},
+	},
+	XMLObject: {
+		v3.NameLabel:      propertyRule(true),
+		v3.NamespaceLabel: propertyRule(true),
+		v3.PrefixLabel:    propertyRule(true),
+		v3.AttributeLabel: propertyRule(true),
+		v3.WrappedLabel:   propertyRule(true),
+	},
+}
+
+// propertyRule returns a rule for a property that is breaking (or not) when it's added, modified or removed.
+func propertyRule(breaking bool) map[string]bool {
+	return map[string]bool{
+		ChangeTypeName(Modified):        breaking,
+		ChangeTypeName(PropertyAdded):   breaking,
+		ChangeTypeName(PropertyRemoved): breaking,
+	}
+}
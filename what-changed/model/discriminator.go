@@ -44,6 +44,11 @@ func (d *DiscriminatorChanges) TotalBreakingChanges() int {
 // CompareDiscriminator will check a left (original) and right (new) Discriminator object for changes
 // and will return a pointer to DiscriminatorChanges
 func CompareDiscriminator(l, r *base.Discriminator) *DiscriminatorChanges {
+    return compareDiscriminator(l, r, nil)
+}
+
+// compareDiscriminator compares a left and right Discriminator object, using rules before the default BreakingRules.
+func compareDiscriminator(l, r *base.Discriminator, rules BreakingRules) *DiscriminatorChanges {
     dc := new(DiscriminatorChanges)
     var changes []*Change
     var props []*PropertyCheck
@@ -55,13 +60,13 @@ func CompareDiscriminator(l, r *base.Discriminator) *DiscriminatorChanges {
         RightNode: r.PropertyName.ValueNode,
         Label:     v3.PropertyNameLabel,
         Changes:   &changes,
-        Breaking:  true,
+        Object:    DiscriminatorObject,
         Original:  l,
         New:       r,
     })
 
     // check properties
-    CheckProperties(props)
+    checkProperties(props, rules)
 
     // flatten maps
     lMap := FlattenLowLevelMap[string](l.Mapping.Value)
@@ -69,12 +74,15 @@ func CompareDiscriminator(l, r *base.Discriminator) *DiscriminatorChanges {
 
     // check for removals, modifications and moves
     for i := range lMap {
-        CheckForObjectAdditionOrRemoval[string](lMap, rMap, i, &mappingChanges, false, true)
+        CheckForObjectAdditionOrRemoval[string](lMap, rMap, i, &mappingChanges,
+            rules.isBreaking(DiscriminatorObject, AnyProperty, ObjectAdded),
+            rules.isBreaking(DiscriminatorObject, AnyProperty, ObjectRemoved))
         // if the existing tag exists, let's check it.
         if rMap[i] != nil {
             if lMap[i].Value != rMap[i].Value {
-                CreateChange(&mappingChanges, Modified, i, lMap[i].GetValueNode(),
-                    rMap[i].GetValueNode(), true, lMap[i].GetValue(), rMap[i].GetValue())
+                CreateChange(&mappingChanges, Modified, i, lMap[i].GetValueNode(), rMap[i].GetValueNode(),
+                    rules.isBreaking(DiscriminatorObject, AnyProperty, Modified), lMap[i].GetValue(),
+                    rMap[i].GetValue())
             }
         }
     }
@@ -82,7 +90,8 @@ func CompareDiscriminator(l, r *base.Discriminator) *DiscriminatorChanges {
     for i := range rMap {
         if lMap[i] == nil {
             CreateChange(&mappingChanges, ObjectAdded, i, nil,
-                rMap[i].GetValueNode(), false, nil, rMap[i].GetValue())
+                rMap[i].GetValueNode(), rules.isBreaking(DiscriminatorObject, AnyProperty, ObjectAdded), nil,
+                rMap[i].GetValue())
         }
     }
 
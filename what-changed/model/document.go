@@ -17,6 +17,7 @@ import (
 	"github.com/pb33f/libopenapi/datamodel/low/base"
 	v2 "github.com/pb33f/libopenapi/datamodel/low/v2"
 	v3 "github.com/pb33f/libopenapi/datamodel/low/v3"
+	"github.com/pb33f/libopenapi/index"
 )
 
 // DocumentChanges represents all the changes made to an OpenAPI document.
@@ -133,61 +134,67 @@ func (d *DocumentChanges) TotalBreakingChanges() int {
 // CompareDocuments will compare any two OpenAPI documents (either Swagger or OpenAPI) and return a pointer to
 // DocumentChanges that outlines everything that was found to have changed.
 func CompareDocuments(l, r any) *DocumentChanges {
+	return compareDocuments(l, r, nil)
+}
+
+func compareDocuments(l, r any, rules BreakingRules) *DocumentChanges {
 
 	var changes []*Change
 	var props []*PropertyCheck
 
 	dc := new(DocumentChanges)
+	var lIdx, rIdx *index.SpecIndex
 
 	if reflect.TypeOf(&v2.Swagger{}) == reflect.TypeOf(l) && reflect.TypeOf(&v2.Swagger{}) == reflect.TypeOf(r) {
 		lDoc := l.(*v2.Swagger)
 		rDoc := r.(*v2.Swagger)
+		lIdx, rIdx = lDoc.Index, rDoc.Index
 
 		// version
 		addPropertyCheck(&props, lDoc.Swagger.ValueNode, rDoc.Swagger.ValueNode,
-			lDoc.Swagger.Value, rDoc.Swagger.Value, &changes, v3.SwaggerLabel, true)
+			lDoc.Swagger.Value, rDoc.Swagger.Value, &changes, v3.SwaggerLabel, DocumentObject)
 
 		// host
 		addPropertyCheck(&props, lDoc.Host.ValueNode, rDoc.Host.ValueNode,
-			lDoc.Host.Value, rDoc.Host.Value, &changes, v3.HostLabel, true)
+			lDoc.Host.Value, rDoc.Host.Value, &changes, v3.HostLabel, DocumentObject)
 
 		// base path
 		addPropertyCheck(&props, lDoc.BasePath.ValueNode, rDoc.BasePath.ValueNode,
-			lDoc.BasePath.Value, rDoc.BasePath.Value, &changes, v3.BasePathLabel, true)
+			lDoc.BasePath.Value, rDoc.BasePath.Value, &changes, v3.BasePathLabel, DocumentObject)
 
 		// schemes
 		if len(lDoc.Schemes.Value) > 0 || len(rDoc.Schemes.Value) > 0 {
 			ExtractStringValueSliceChanges(lDoc.Schemes.Value, rDoc.Schemes.Value,
-				&changes, v3.SchemesLabel, true)
+				&changes, v3.SchemesLabel, DocumentObject, rules)
 		}
 		// consumes
 		if len(lDoc.Consumes.Value) > 0 || len(rDoc.Consumes.Value) > 0 {
 			ExtractStringValueSliceChanges(lDoc.Consumes.Value, rDoc.Consumes.Value,
-				&changes, v3.ConsumesLabel, true)
+				&changes, v3.ConsumesLabel, DocumentObject, rules)
 		}
 		// produces
 		if len(lDoc.Produces.Value) > 0 || len(rDoc.Produces.Value) > 0 {
 			ExtractStringValueSliceChanges(lDoc.Produces.Value, rDoc.Produces.Value,
-				&changes, v3.ProducesLabel, true)
+				&changes, v3.ProducesLabel, DocumentObject, rules)
 		}
 
 		// tags
-		dc.TagChanges = CompareTags(lDoc.Tags.Value, rDoc.Tags.Value)
+		dc.TagChanges = compareTags(lDoc.Tags.Value, rDoc.Tags.Value, rules)
 
 		// paths
 		if !lDoc.Paths.IsEmpty() || !rDoc.Paths.IsEmpty() {
-			dc.PathsChanges = ComparePaths(lDoc.Paths.Value, rDoc.Paths.Value)
+			dc.PathsChanges = comparePaths(lDoc.Paths.Value, rDoc.Paths.Value, rules)
 		}
 
 		// external docs
-		compareDocumentExternalDocs(lDoc, rDoc, dc, &changes)
+		compareDocumentExternalDocs(lDoc, rDoc, dc, &changes, rules)
 
 		// info
-		compareDocumentInfo(&lDoc.Info, &rDoc.Info, dc, &changes)
+		compareDocumentInfo(&lDoc.Info, &rDoc.Info, dc, &changes, rules)
 
 		// security
 		if !lDoc.Security.IsEmpty() || !rDoc.Security.IsEmpty() {
-			checkSecurity(lDoc.Security, rDoc.Security, &changes, dc)
+			checkSecurity(lDoc.Security, rDoc.Security, &changes, dc, rules)
 		}
 
 		// components / definitions
@@ -196,19 +203,19 @@ func CompareDocuments(l, r any) *DocumentChanges {
 		// creating a new set of changes and then morphing them into a single changes object.
 		cc := new(ComponentsChanges)
 		cc.PropertyChanges = new(PropertyChanges)
-		if n := CompareComponents(lDoc.Definitions.Value, rDoc.Definitions.Value); n != nil {
+		if n := compareComponents(lDoc.Definitions.Value, rDoc.Definitions.Value, rules); n != nil {
 			cc.SchemaChanges = n.SchemaChanges
 		}
-		if n := CompareComponents(lDoc.SecurityDefinitions.Value, rDoc.SecurityDefinitions.Value); n != nil {
+		if n := compareComponents(lDoc.SecurityDefinitions.Value, rDoc.SecurityDefinitions.Value, rules); n != nil {
 			cc.SecuritySchemeChanges = n.SecuritySchemeChanges
 		}
-		if n := CompareComponents(lDoc.Parameters.Value, rDoc.Parameters.Value); n != nil {
+		if n := compareComponents(lDoc.Parameters.Value, rDoc.Parameters.Value, rules); n != nil {
 			cc.PropertyChanges.Changes = append(cc.PropertyChanges.Changes, n.Changes...)
 		}
-		if n := CompareComponents(lDoc.Responses.Value, rDoc.Responses.Value); n != nil {
+		if n := compareComponents(lDoc.Responses.Value, rDoc.Responses.Value, rules); n != nil {
 			cc.Changes = append(cc.Changes, n.Changes...)
 		}
-		dc.ExtensionChanges = CompareExtensions(lDoc.Extensions, rDoc.Extensions)
+		dc.ExtensionChanges = compareExtensions(lDoc.Extensions, rDoc.Extensions, rules)
 		if cc.TotalChanges() > 0 {
 			dc.ComponentsChanges = cc
 		}
@@ -217,108 +224,115 @@ func CompareDocuments(l, r any) *DocumentChanges {
 	if reflect.TypeOf(&v3.Document{}) == reflect.TypeOf(l) && reflect.TypeOf(&v3.Document{}) == reflect.TypeOf(r) {
 		lDoc := l.(*v3.Document)
 		rDoc := r.(*v3.Document)
+		lIdx, rIdx = lDoc.Index, rDoc.Index
 
 		// version
 		addPropertyCheck(&props, lDoc.Version.ValueNode, rDoc.Version.ValueNode,
-			lDoc.Version.Value, rDoc.Version.Value, &changes, v3.OpenAPILabel, true)
+			lDoc.Version.Value, rDoc.Version.Value, &changes, v3.OpenAPILabel, DocumentObject)
 
 		// schema dialect
 		addPropertyCheck(&props, lDoc.JsonSchemaDialect.ValueNode, rDoc.JsonSchemaDialect.ValueNode,
-			lDoc.JsonSchemaDialect.Value, rDoc.JsonSchemaDialect.Value, &changes, v3.JSONSchemaDialectLabel, true)
+			lDoc.JsonSchemaDialect.Value, rDoc.JsonSchemaDialect.Value, &changes, v3.JSONSchemaDialectLabel,
+			DocumentObject)
 
 		// tags
-		dc.TagChanges = CompareTags(lDoc.Tags.Value, rDoc.Tags.Value)
+		dc.TagChanges = compareTags(lDoc.Tags.Value, rDoc.Tags.Value, rules)
 
 		// paths
 		if !lDoc.Paths.IsEmpty() || !rDoc.Paths.IsEmpty() {
-			dc.PathsChanges = ComparePaths(lDoc.Paths.Value, rDoc.Paths.Value)
+			dc.PathsChanges = comparePaths(lDoc.Paths.Value, rDoc.Paths.Value, rules)
 		}
 
 		// external docs
-		compareDocumentExternalDocs(lDoc, rDoc, dc, &changes)
+		compareDocumentExternalDocs(lDoc, rDoc, dc, &changes, rules)
 
 		// info
-		compareDocumentInfo(&lDoc.Info, &rDoc.Info, dc, &changes)
+		compareDocumentInfo(&lDoc.Info, &rDoc.Info, dc, &changes, rules)
 
 		// security
 		if !lDoc.Security.IsEmpty() || !rDoc.Security.IsEmpty() {
-			checkSecurity(lDoc.Security, rDoc.Security, &changes, dc)
+			checkSecurity(lDoc.Security, rDoc.Security, &changes, dc, rules)
 		}
 
 		// compare components.
 		if !lDoc.Components.IsEmpty() && !rDoc.Components.IsEmpty() {
-			if n := CompareComponents(lDoc.Components.Value, rDoc.Components.Value); n != nil {
+			if n := compareComponents(lDoc.Components.Value, rDoc.Components.Value, rules); n != nil {
 				dc.ComponentsChanges = n
 			}
 		}
 		if !lDoc.Components.IsEmpty() && rDoc.Components.IsEmpty() {
-			CreateChange(&changes, PropertyRemoved, v3.ComponentsLabel,
-				lDoc.Components.ValueNode, nil, true, lDoc.Components.Value, nil)
+			CreateChange(&changes, PropertyRemoved, v3.ComponentsLabel, lDoc.Components.ValueNode, nil,
+				rules.isBreaking(DocumentObject, v3.ComponentsLabel, PropertyRemoved), lDoc.Components.Value, nil)
 		}
 		if lDoc.Components.IsEmpty() && !rDoc.Components.IsEmpty() {
-			CreateChange(&changes, PropertyAdded, v3.ComponentsLabel,
-				rDoc.Components.ValueNode, nil, false, nil, lDoc.Components.Value)
+			CreateChange(&changes, PropertyAdded, v3.ComponentsLabel, rDoc.Components.ValueNode, nil,
+				rules.isBreaking(DocumentObject, v3.ComponentsLabel, PropertyAdded), nil, lDoc.Components.Value)
 		}
 
 		// compare servers
-		if n := checkServers(lDoc.Servers, rDoc.Servers); n != nil {
+		if n := checkServers(lDoc.Servers, rDoc.Servers, rules); n != nil {
 			dc.ServerChanges = n
 		}
 
 		// compare webhooks
 		dc.WebhookChanges = CheckMapForChanges(lDoc.Webhooks.Value, rDoc.Webhooks.Value, &changes,
-			v3.WebhooksLabel, ComparePathItemsV3)
+			v3.WebhooksLabel, DocumentObject, rules, func(lw, rw *v3.PathItem) *PathItemChanges {
+				// webhook requests are sent by the server.
+				return comparePathItems(lw, rw, SchemaUsageResponse, rules)
+			})
 
 		// extensions
-		dc.ExtensionChanges = CompareExtensions(lDoc.Extensions, rDoc.Extensions)
+		dc.ExtensionChanges = compareExtensions(lDoc.Extensions, rDoc.Extensions, rules)
 	}
 
-	CheckProperties(props)
+	checkProperties(props, rules)
 	dc.PropertyChanges = NewPropertyChanges(changes)
 	if dc.TotalChanges() <= 0 {
 		return nil
 	}
+	SetChangePaths(dc.GetAllChanges(), rootNode(lIdx), rootNode(rIdx))
 	return dc
 }
 
-func compareDocumentExternalDocs(l, r low.HasExternalDocs, dc *DocumentChanges, changes *[]*Change) {
+func compareDocumentExternalDocs(l, r low.HasExternalDocs, dc *DocumentChanges, changes *[]*Change,
+	rules BreakingRules) {
 	// external docs
 	if !l.GetExternalDocs().IsEmpty() && !r.GetExternalDocs().IsEmpty() {
 		lExtDoc := l.GetExternalDocs().Value.(*base.ExternalDoc)
 		rExtDoc := r.GetExternalDocs().Value.(*base.ExternalDoc)
 		if !low.AreEqual(lExtDoc, rExtDoc) {
-			dc.ExternalDocChanges = CompareExternalDocs(lExtDoc, rExtDoc)
+			dc.ExternalDocChanges = compareExternalDocs(lExtDoc, rExtDoc, rules)
 		}
 	}
 	if l.GetExternalDocs().IsEmpty() && !r.GetExternalDocs().IsEmpty() {
 		CreateChange(changes, PropertyAdded, v3.ExternalDocsLabel,
-			nil, r.GetExternalDocs().ValueNode, false, nil,
-			r.GetExternalDocs().Value)
+			nil, r.GetExternalDocs().ValueNode,
+			rules.isBreaking(DocumentObject, v3.ExternalDocsLabel, PropertyAdded), nil, r.GetExternalDocs().Value)
 	}
 	if !l.GetExternalDocs().IsEmpty() && r.GetExternalDocs().IsEmpty() {
-		CreateChange(changes, PropertyRemoved, v3.ExternalDocsLabel,
-			l.GetExternalDocs().ValueNode, nil, false, l.GetExternalDocs().Value,
-			nil)
+		CreateChange(changes, PropertyRemoved, v3.ExternalDocsLabel, l.GetExternalDocs().ValueNode, nil,
+			rules.isBreaking(DocumentObject, v3.ExternalDocsLabel, PropertyRemoved), l.GetExternalDocs().Value, nil)
 	}
 }
 
-func compareDocumentInfo(l, r *low.NodeReference[*base.Info], dc *DocumentChanges, changes *[]*Change) {
+func compareDocumentInfo(l, r *low.NodeReference[*base.Info], dc *DocumentChanges, changes *[]*Change,
+	rules BreakingRules) {
 	// info
 	if !l.IsEmpty() && !r.IsEmpty() {
 		lInfo := l.Value
 		rInfo := r.Value
 		if !low.AreEqual(lInfo, rInfo) {
-			dc.InfoChanges = CompareInfo(lInfo, rInfo)
+			dc.InfoChanges = compareInfo(lInfo, rInfo, rules)
 		}
 	}
 	if l.IsEmpty() && !r.IsEmpty() {
 		CreateChange(changes, PropertyAdded, v3.InfoLabel,
-			nil, r.ValueNode, false, nil,
+			nil, r.ValueNode, rules.isBreaking(DocumentObject, v3.InfoLabel, PropertyAdded), nil,
 			r.Value)
 	}
 	if !l.IsEmpty() && r.IsEmpty() {
 		CreateChange(changes, PropertyRemoved, v3.InfoLabel,
-			l.ValueNode, nil, false, l.Value,
-			nil)
+			l.ValueNode, nil,
+			rules.isBreaking(DocumentObject, v3.InfoLabel, PropertyRemoved), l.Value, nil)
 	}
 }
@@ -48,6 +48,12 @@ func (e *EncodingChanges) TotalBreakingChanges() int {
 // CompareEncoding returns a pointer to *EncodingChanges that contain all changes made between a left and right
 // set of Encoding objects.
 func CompareEncoding(l, r *v3.Encoding) *EncodingChanges {
+    return compareEncoding(l, r, SchemaUsageUnknown, nil)
+}
+
+// compareEncoding compares a left and right Encoding object, classifying changes made to the headers using where
+// the encoding is used.
+func compareEncoding(l, r *v3.Encoding, usage SchemaUsage, rules BreakingRules) *EncodingChanges {
 
     var changes []*Change
     var props []*PropertyCheck
@@ -58,7 +64,7 @@ func CompareEncoding(l, r *v3.Encoding) *EncodingChanges {
         RightNode: r.ContentType.ValueNode,
         Label:     v3.ContentTypeLabel,
         Changes:   &changes,
-        Breaking:  true,
+        Object:    EncodingObject,
         Original:  l,
         New:       r,
     })
@@ -69,7 +75,7 @@ func CompareEncoding(l, r *v3.Encoding) *EncodingChanges {
         RightNode: r.Explode.ValueNode,
         Label:     v3.ExplodeLabel,
         Changes:   &changes,
-        Breaking:  true,
+        Object:    EncodingObject,
         Original:  l,
         New:       r,
     })
@@ -80,17 +86,21 @@ func CompareEncoding(l, r *v3.Encoding) *EncodingChanges {
         RightNode: r.AllowReserved.ValueNode,
         Label:     v3.AllowReservedLabel,
         Changes:   &changes,
-        Breaking:  false,
+        Object:    EncodingObject,
         Original:  l,
         New:       r,
     })
 
     // check everything.
-    CheckProperties(props)
+    checkProperties(props, rules)
     ec := new(EncodingChanges)
 
     // headers
-    ec.HeaderChanges = CheckMapForChanges(l.Headers.Value, r.Headers.Value, &changes, v3.HeadersLabel, CompareHeadersV3)
+    ec.HeaderChanges = CheckMapForChanges(l.Headers.Value, r.Headers.Value, &changes, v3.HeadersLabel, EncodingObject,
+        rules,
+        func(lh, rh *v3.Header) *HeaderChanges {
+            return compareHeaders(lh, rh, usage, rules)
+        })
     ec.PropertyChanges = NewPropertyChanges(changes)
     if ec.TotalChanges() <= 0 {
         return nil
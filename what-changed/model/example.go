@@ -49,6 +49,11 @@ func (e *ExampleChanges) TotalBreakingChanges() int {
 // CompareExamples returns a pointer to ExampleChanges that contains all changes made between
 // left and right Example instances.
 func CompareExamples(l, r *base.Example) *ExampleChanges {
+	return compareExamples(l, r, nil)
+}
+
+// compareExamples compares a left and right Example object, using rules before the default BreakingRules.
+func compareExamples(l, r *base.Example, rules BreakingRules) *ExampleChanges {
 
 	ec := new(ExampleChanges)
 	var changes []*Change
@@ -60,7 +65,7 @@ func CompareExamples(l, r *base.Example) *ExampleChanges {
 		RightNode: r.Summary.ValueNode,
 		Label:     v3.SummaryLabel,
 		Changes:   &changes,
-		Breaking:  false,
+		Object:    ExampleObject,
 		Original:  l,
 		New:       r,
 	})
@@ -71,7 +76,7 @@ func CompareExamples(l, r *base.Example) *ExampleChanges {
 		RightNode: r.Description.ValueNode,
 		Label:     v3.DescriptionLabel,
 		Changes:   &changes,
-		Breaking:  false,
+		Object:    ExampleObject,
 		Original:  l,
 		New:       r,
 	})
@@ -139,8 +144,8 @@ func CompareExamples(l, r *base.Example) *ExampleChanges {
 					r.Value.ValueNode.Value = string(rendered)
 				}
 
-				CreateChange(&changes, Modified, v3.ValueLabel,
-					l.Value.GetValueNode(), r.Value.GetValueNode(), false, l.Value.GetValue(), r.Value.GetValue())
+				CreateChange(&changes, Modified, v3.ValueLabel, l.Value.GetValueNode(), r.Value.GetValueNode(),
+					rules.isBreaking(ExampleObject, v3.ValueLabel, Modified), l.Value.GetValue(), r.Value.GetValue())
 				continue
 			}
 			if k >= len(rKeys) {
@@ -157,8 +162,8 @@ func CompareExamples(l, r *base.Example) *ExampleChanges {
 					r.Value.ValueNode.Value = string(rendered)
 				}
 
-				CreateChange(&changes, PropertyRemoved, v3.ValueLabel,
-					l.Value.ValueNode, r.Value.ValueNode, false, l.Value.Value, r.Value.Value)
+				CreateChange(&changes, PropertyRemoved, v3.ValueLabel, l.Value.ValueNode, r.Value.ValueNode,
+					rules.isBreaking(ExampleObject, v3.ValueLabel, PropertyRemoved), l.Value.Value, r.Value.Value)
 			}
 		}
 		for k := range rKeys {
@@ -176,8 +181,8 @@ func CompareExamples(l, r *base.Example) *ExampleChanges {
 					r.Value.ValueNode.Value = string(rendered)
 				}
 
-				CreateChange(&changes, PropertyAdded, v3.ValueLabel,
-					l.Value.ValueNode, r.Value.ValueNode, false, l.Value.Value, r.Value.Value)
+				CreateChange(&changes, PropertyAdded, v3.ValueLabel, l.Value.ValueNode, r.Value.ValueNode,
+					rules.isBreaking(ExampleObject, v3.ValueLabel, PropertyAdded), l.Value.Value, r.Value.Value)
 			}
 		}
 	} else {
@@ -186,7 +191,7 @@ func CompareExamples(l, r *base.Example) *ExampleChanges {
 			RightNode: r.Value.ValueNode,
 			Label:     v3.ValueLabel,
 			Changes:   &changes,
-			Breaking:  false,
+			Object:    ExampleObject,
 			Original:  l,
 			New:       r,
 		})
@@ -197,16 +202,16 @@ func CompareExamples(l, r *base.Example) *ExampleChanges {
 		RightNode: r.ExternalValue.ValueNode,
 		Label:     v3.ExternalValue,
 		Changes:   &changes,
-		Breaking:  false,
+		Object:    ExampleObject,
 		Original:  l,
 		New:       r,
 	})
 
 	// check properties
-	CheckProperties(props)
+	checkProperties(props, rules)
 
 	// check extensions
-	ec.ExtensionChanges = CheckExtensions(l, r)
+	ec.ExtensionChanges = checkExtensions(l, r, rules)
 	ec.PropertyChanges = NewPropertyChanges(changes)
 	if ec.TotalChanges() <= 0 {
 		return nil
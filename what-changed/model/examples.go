@@ -31,6 +31,11 @@ func (a *ExamplesChanges) TotalBreakingChanges() int {
 // CompareExamplesV2 compares two Swagger Examples objects, returning a pointer to
 //ExamplesChanges if anything was found.
 func CompareExamplesV2(l, r *v2.Examples) *ExamplesChanges {
+    return compareExamplesV2(l, r, nil)
+}
+
+// compareExamplesV2 compares a left and right Swagger Examples object, using rules before the default BreakingRules.
+func compareExamplesV2(l, r *v2.Examples, rules BreakingRules) *ExamplesChanges {
 
     lHashes := make(map[string]string)
     rHashes := make(map[string]string)
@@ -53,7 +58,7 @@ func CompareExamplesV2(l, r *v2.Examples) *ExamplesChanges {
         rhash := rHashes[k]
         if rhash == "" {
             CreateChange(&changes, ObjectRemoved, k,
-                lValues[k].GetValueNode(), nil, false,
+                lValues[k].GetValueNode(), nil, rules.isBreaking(ExamplesObject, AnyProperty, ObjectRemoved),
                 lValues[k].GetValue(), nil)
             continue
         }
@@ -61,8 +66,8 @@ func CompareExamplesV2(l, r *v2.Examples) *ExamplesChanges {
             continue
         }
         CreateChange(&changes, Modified, k,
-            lValues[k].GetValueNode(), rValues[k].GetValueNode(), false,
-            lValues[k].GetValue(), lValues[k].GetValue())
+            lValues[k].GetValueNode(), rValues[k].GetValueNode(),
+            rules.isBreaking(ExamplesObject, AnyProperty, Modified), lValues[k].GetValue(), lValues[k].GetValue())
 
     }
 
@@ -71,7 +76,7 @@ func CompareExamplesV2(l, r *v2.Examples) *ExamplesChanges {
         lhash := lHashes[k]
         if lhash == "" {
             CreateChange(&changes, ObjectAdded, k,
-                nil, lValues[k].GetValueNode(), false,
+                nil, lValues[k].GetValueNode(), rules.isBreaking(ExamplesObject, AnyProperty, ObjectAdded),
                 nil, lValues[k].GetValue())
             continue
         }
@@ -25,7 +25,7 @@ func (e *ExtensionChanges) TotalChanges() int {
 
 // TotalBreakingChanges always returns 0 for Extension objects, they are non-binding.
 func (e *ExtensionChanges) TotalBreakingChanges() int {
-    return 0
+    return CountBreakingChanges(e.Changes)
 }
 
 // CompareExtensions will compare a left and right map of Tag/ValueReference models for any changes to
@@ -35,6 +35,12 @@ func (e *ExtensionChanges) TotalBreakingChanges() int {
 // A current limitation relates to extensions being objects and a property of the object changes,
 // there is currently no support for knowing anything changed - so it is ignored.
 func CompareExtensions(l, r map[low.KeyReference[string]]low.ValueReference[any]) *ExtensionChanges {
+    return compareExtensions(l, r, nil)
+}
+
+// compareExtensions compares a left and right map of extensions, using rules before the default BreakingRules.
+func compareExtensions(l, r map[low.KeyReference[string]]low.ValueReference[any],
+    rules BreakingRules) *ExtensionChanges {
 
     // look at the original and then look through the new.
     seenLeft := make(map[string]*low.ValueReference[any])
@@ -51,30 +57,31 @@ func CompareExtensions(l, r map[low.KeyReference[string]]low.ValueReference[any]
     var changes []*Change
     for i := range seenLeft {
 
-        CheckForObjectAdditionOrRemoval[any](seenLeft, seenRight, i, &changes, false, true)
+        CheckForObjectAdditionOrRemoval[any](seenLeft, seenRight, i, &changes,
+            rules.isBreaking(ExtensionObject, AnyProperty, ObjectAdded),
+            rules.isBreaking(ExtensionObject, AnyProperty, ObjectRemoved))
 
         if seenRight[i] != nil {
-            var props []*PropertyCheck
-
-            props = append(props, &PropertyCheck{
-                LeftNode:  seenLeft[i].ValueNode,
-                RightNode: seenRight[i].ValueNode,
-                Label:     i,
-                Changes:   &changes,
-                Breaking:  false,
-                Original:  seenLeft[i].Value,
-                New:       seenRight[i].Value,
-            })
-
-            // check properties
-            CheckProperties(props)
+            // the name of an extension is not a property, so only AnyProperty rules are used.
+            lv, rv := seenLeft[i], seenRight[i]
+            CheckForRemoval(lv.ValueNode, rv.ValueNode, i, &changes,
+                rules.isBreaking(ExtensionObject, AnyProperty, PropertyRemoved), lv.Value, rv.Value)
+            CheckForAddition(lv.ValueNode, rv.ValueNode, i, &changes,
+                rules.isBreaking(ExtensionObject, AnyProperty, PropertyAdded), lv.Value, rv.Value)
+            CheckForModification(lv.ValueNode, rv.ValueNode, i, &changes,
+                rules.isBreaking(ExtensionObject, AnyProperty, Modified), lv.Value, rv.Value)
         }
     }
     for i := range seenRight {
         if seenLeft[i] == nil {
-            CheckForObjectAdditionOrRemoval[any](seenLeft, seenRight, i, &changes, false, true)
+            CheckForObjectAdditionOrRemoval[any](seenLeft, seenRight, i, &changes,
+                rules.isBreaking(ExtensionObject, AnyProperty, ObjectAdded),
+                rules.isBreaking(ExtensionObject, AnyProperty, ObjectRemoved))
         }
     }
+    for i := range changes {
+        changes[i].nonBinding = true
+    }
     ex := new(ExtensionChanges)
     ex.PropertyChanges = NewPropertyChanges(changes)
     if ex.TotalChanges() <= 0 {
@@ -86,6 +93,11 @@ func CompareExtensions(l, r map[low.KeyReference[string]]low.ValueReference[any]
 // CheckExtensions is a helper method to un-pack a left and right model that contains extensions. Once unpacked
 // the extensions are compared and returns a pointer to ExtensionChanges. If nothing changed, nil is returned.
 func CheckExtensions[T low.HasExtensions[T]](l, r T) *ExtensionChanges {
+    return checkExtensions(l, r, nil)
+}
+
+// checkExtensions runs CheckExtensions, using rules before the default BreakingRules.
+func checkExtensions[T low.HasExtensions[T]](l, r T, rules BreakingRules) *ExtensionChanges {
     var lExt, rExt map[low.KeyReference[string]]low.ValueReference[any]
     if len(l.GetExtensions()) > 0 {
         lExt = l.GetExtensions()
@@ -93,5 +105,5 @@ func CheckExtensions[T low.HasExtensions[T]](l, r T) *ExtensionChanges {
     if len(r.GetExtensions()) > 0 {
         rExt = r.GetExtensions()
     }
-    return CompareExtensions(lExt, rExt)
+    return compareExtensions(lExt, rExt, rules)
 }
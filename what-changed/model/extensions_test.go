@@ -56,6 +56,9 @@ x-test: 1`
 	assert.Nil(t, extChanges.Changes[0].Context.NewLine)
 	assert.Equal(t, "1", extChanges.Changes[0].Original)
 	assert.True(t, extChanges.Changes[0].Context.HasChanged())
+	assert.True(t, extChanges.Changes[0].Breaking)
+	assert.False(t, extChanges.Changes[0].IsBreaking())
+	assert.Equal(t, 0, extChanges.TotalBreakingChanges())
 }
 
 func TestCompareExtensions_Added(t *testing.T) {
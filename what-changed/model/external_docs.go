@@ -42,6 +42,11 @@ func (e *ExternalDocChanges) TotalBreakingChanges() int {
 // nodes for any changes between them. If there are changes, then a pointer to ExternalDocChanges
 // is returned, otherwise if nothing changed - then nil is returned.
 func CompareExternalDocs(l, r *base.ExternalDoc) *ExternalDocChanges {
+    return compareExternalDocs(l, r, nil)
+}
+
+// compareExternalDocs compares a left and right ExternalDoc object, using rules before the default BreakingRules.
+func compareExternalDocs(l, r *base.ExternalDoc, rules BreakingRules) *ExternalDocChanges {
     var changes []*Change
     var props []*PropertyCheck
 
@@ -51,7 +56,7 @@ func CompareExternalDocs(l, r *base.ExternalDoc) *ExternalDocChanges {
         RightNode: r.URL.ValueNode,
         Label:     v3.URLLabel,
         Changes:   &changes,
-        Breaking:  false,
+        Object:    ExternalDocObject,
         Original:  l,
         New:       r,
     })
@@ -62,19 +67,19 @@ func CompareExternalDocs(l, r *base.ExternalDoc) *ExternalDocChanges {
         RightNode: r.Description.ValueNode,
         Label:     v3.DescriptionLabel,
         Changes:   &changes,
-        Breaking:  false,
+        Object:    ExternalDocObject,
         Original:  l,
         New:       r,
     })
 
     // check everything.
-    CheckProperties(props)
+    checkProperties(props, rules)
 
     dc := new(ExternalDocChanges)
     dc.PropertyChanges = NewPropertyChanges(changes)
 
     // check extensions
-    dc.ExtensionChanges = CheckExtensions(l, r)
+    dc.ExtensionChanges = checkExtensions(l, r, rules)
     if dc.TotalChanges() <= 0 {
         return nil
     }
@@ -5,6 +5,7 @@ package model
 
 import (
     "github.com/pb33f/libopenapi/datamodel/low"
+    "github.com/pb33f/libopenapi/datamodel/low/base"
     v2 "github.com/pb33f/libopenapi/datamodel/low/v2"
     "github.com/pb33f/libopenapi/datamodel/low/v3"
     "reflect"
@@ -87,94 +88,94 @@ func addOpenAPIHeaderProperties(left, right low.OpenAPIHeader, changes *[]*Chang
 
     // style
     addPropertyCheck(&props, left.GetStyle().ValueNode, right.GetStyle().ValueNode,
-        left.GetStyle(), right.GetStyle(), changes, v3.StyleLabel, false)
+        left.GetStyle(), right.GetStyle(), changes, v3.StyleLabel, HeaderObject)
 
     // allow reserved
     addPropertyCheck(&props, left.GetAllowReserved().ValueNode, right.GetAllowReserved().ValueNode,
-        left.GetAllowReserved(), right.GetAllowReserved(), changes, v3.AllowReservedLabel, false)
+        left.GetAllowReserved(), right.GetAllowReserved(), changes, v3.AllowReservedLabel, HeaderObject)
 
     // allow empty value
     addPropertyCheck(&props, left.GetAllowEmptyValue().ValueNode, right.GetAllowEmptyValue().ValueNode,
-        left.GetAllowEmptyValue(), right.GetAllowEmptyValue(), changes, v3.AllowEmptyValueLabel, true)
+        left.GetAllowEmptyValue(), right.GetAllowEmptyValue(), changes, v3.AllowEmptyValueLabel, HeaderObject)
 
     // explode
     addPropertyCheck(&props, left.GetExplode().ValueNode, right.GetExplode().ValueNode,
-        left.GetExplode(), right.GetExplode(), changes, v3.ExplodeLabel, false)
+        left.GetExplode(), right.GetExplode(), changes, v3.ExplodeLabel, HeaderObject)
 
     // example
     addPropertyCheck(&props, left.GetExample().ValueNode, right.GetExample().ValueNode,
-        left.GetExample(), right.GetExample(), changes, v3.ExampleLabel, false)
+        left.GetExample(), right.GetExample(), changes, v3.ExampleLabel, HeaderObject)
 
     // deprecated
     addPropertyCheck(&props, left.GetDeprecated().ValueNode, right.GetDeprecated().ValueNode,
-        left.GetDeprecated(), right.GetDeprecated(), changes, v3.DeprecatedLabel, false)
+        left.GetDeprecated(), right.GetDeprecated(), changes, v3.DeprecatedLabel, HeaderObject)
 
     // required
     addPropertyCheck(&props, left.GetRequired().ValueNode, right.GetRequired().ValueNode,
-        left.GetRequired(), right.GetRequired(), changes, v3.RequiredLabel, true)
+        left.GetRequired(), right.GetRequired(), changes, v3.RequiredLabel, HeaderObject)
 
     return props
 }
 
-// swagger only properties
-func addSwaggerHeaderProperties(left, right low.SwaggerHeader, changes *[]*Change) []*PropertyCheck {
+// swagger only properties, shared by headers and items (the object).
+func addSwaggerHeaderProperties(left, right low.SwaggerHeader, changes *[]*Change, object string) []*PropertyCheck {
     var props []*PropertyCheck
 
     // type
     addPropertyCheck(&props, left.GetType().ValueNode, right.GetType().ValueNode,
-        left.GetType(), right.GetType(), changes, v3.TypeLabel, true)
+        left.GetType(), right.GetType(), changes, v3.TypeLabel, object)
 
     // format
     addPropertyCheck(&props, left.GetFormat().ValueNode, right.GetFormat().ValueNode,
-        left.GetFormat(), right.GetFormat(), changes, v3.FormatLabel, true)
+        left.GetFormat(), right.GetFormat(), changes, v3.FormatLabel, object)
 
     // collection format
     addPropertyCheck(&props, left.GetCollectionFormat().ValueNode, right.GetCollectionFormat().ValueNode,
-        left.GetCollectionFormat(), right.GetCollectionFormat(), changes, v3.CollectionFormatLabel, true)
+        left.GetCollectionFormat(), right.GetCollectionFormat(), changes, v3.CollectionFormatLabel, object)
 
     // maximum
     addPropertyCheck(&props, left.GetMaximum().ValueNode, right.GetMaximum().ValueNode,
-        left.GetMaximum(), right.GetMaximum(), changes, v3.MaximumLabel, true)
+        left.GetMaximum(), right.GetMaximum(), changes, v3.MaximumLabel, object)
 
     // minimum
     addPropertyCheck(&props, left.GetMinimum().ValueNode, right.GetMinimum().ValueNode,
-        left.GetMinimum(), right.GetMinimum(), changes, v3.MinimumLabel, true)
+        left.GetMinimum(), right.GetMinimum(), changes, v3.MinimumLabel, object)
 
     // exclusive maximum
     addPropertyCheck(&props, left.GetExclusiveMaximum().ValueNode, right.GetExclusiveMaximum().ValueNode,
-        left.GetExclusiveMaximum(), right.GetExclusiveMaximum(), changes, v3.ExclusiveMaximumLabel, true)
+        left.GetExclusiveMaximum(), right.GetExclusiveMaximum(), changes, v3.ExclusiveMaximumLabel, object)
 
     // exclusive minimum
     addPropertyCheck(&props, left.GetExclusiveMinimum().ValueNode, right.GetExclusiveMinimum().ValueNode,
-        left.GetExclusiveMinimum(), right.GetExclusiveMinimum(), changes, v3.ExclusiveMinimumLabel, true)
+        left.GetExclusiveMinimum(), right.GetExclusiveMinimum(), changes, v3.ExclusiveMinimumLabel, object)
 
     // max length
     addPropertyCheck(&props, left.GetMaxLength().ValueNode, right.GetMaxLength().ValueNode,
-        left.GetMaxLength(), right.GetMaxLength(), changes, v3.MaxLengthLabel, true)
+        left.GetMaxLength(), right.GetMaxLength(), changes, v3.MaxLengthLabel, object)
 
     // min length
     addPropertyCheck(&props, left.GetMinLength().ValueNode, right.GetMinLength().ValueNode,
-        left.GetMinLength(), right.GetMinLength(), changes, v3.MinLengthLabel, true)
+        left.GetMinLength(), right.GetMinLength(), changes, v3.MinLengthLabel, object)
 
     // pattern
     addPropertyCheck(&props, left.GetPattern().ValueNode, right.GetPattern().ValueNode,
-        left.GetPattern(), right.GetPattern(), changes, v3.PatternLabel, true)
+        left.GetPattern(), right.GetPattern(), changes, v3.PatternLabel, object)
 
     // max items
     addPropertyCheck(&props, left.GetMaxItems().ValueNode, right.GetMaxItems().ValueNode,
-        left.GetMaxItems(), right.GetMaxItems(), changes, v3.MaxItemsLabel, true)
+        left.GetMaxItems(), right.GetMaxItems(), changes, v3.MaxItemsLabel, object)
 
     // min items
     addPropertyCheck(&props, left.GetMinItems().ValueNode, right.GetMinItems().ValueNode,
-        left.GetMinItems(), right.GetMinItems(), changes, v3.MinItemsLabel, true)
+        left.GetMinItems(), right.GetMinItems(), changes, v3.MinItemsLabel, object)
 
     // unique items
     addPropertyCheck(&props, left.GetUniqueItems().ValueNode, right.GetUniqueItems().ValueNode,
-        left.GetUniqueItems(), right.GetUniqueItems(), changes, v3.UniqueItemsLabel, true)
+        left.GetUniqueItems(), right.GetUniqueItems(), changes, v3.UniqueItemsLabel, object)
 
     // multiple of
     addPropertyCheck(&props, left.GetMultipleOf().ValueNode, right.GetMultipleOf().ValueNode,
-        left.GetMultipleOf(), right.GetMultipleOf(), changes, v3.MultipleOfLabel, true)
+        left.GetMultipleOf(), right.GetMultipleOf(), changes, v3.MultipleOfLabel, object)
 
     return props
 }
@@ -185,7 +186,7 @@ func addCommonHeaderProperties(left, right low.HasDescription, changes *[]*Chang
 
     // description
     addPropertyCheck(&props, left.GetDescription().ValueNode, right.GetDescription().ValueNode,
-        left.GetDescription(), right.GetDescription(), changes, v3.DescriptionLabel, false)
+        left.GetDescription(), right.GetDescription(), changes, v3.DescriptionLabel, HeaderObject)
 
     return props
 }
@@ -205,6 +206,11 @@ func CompareHeadersV3(l, r *v3.Header) *HeaderChanges {
 // CompareHeaders will compare left and right Header objects (any version of Swagger or OpenAPI) and return
 // a pointer to HeaderChanges with anything that has changed, or nil if nothing changed.
 func CompareHeaders(l, r any) *HeaderChanges {
+    return compareHeaders(l, r, SchemaUsageUnknown, nil)
+}
+
+// compareHeaders compares left and right Header objects, classifying changes using where the headers are used.
+func compareHeaders(l, r any, usage SchemaUsage, rules BreakingRules) *HeaderChanges {
 
     var changes []*Change
     var props []*PropertyCheck
@@ -221,28 +227,30 @@ func CompareHeaders(l, r any) *HeaderChanges {
         }
 
         props = append(props, addCommonHeaderProperties(lHeader, rHeader, &changes)...)
-        props = append(props, addSwaggerHeaderProperties(lHeader, rHeader, &changes)...)
+        props = append(props, addSwaggerHeaderProperties(lHeader, rHeader, &changes, HeaderObject)...)
 
         // enum
         if len(lHeader.Enum.Value) > 0 || len(rHeader.Enum.Value) > 0 {
-            ExtractRawValueSliceChanges(lHeader.Enum.Value, rHeader.Enum.Value, &changes, v3.EnumLabel, true)
+            ExtractRawValueSliceChanges(lHeader.Enum.Value, rHeader.Enum.Value,
+                &changes, v3.EnumLabel, HeaderObject, rules)
         }
 
         // items
         if !lHeader.Items.IsEmpty() && !rHeader.Items.IsEmpty() {
             if !low.AreEqual(lHeader.Items.Value, rHeader.Items.Value) {
-                hc.ItemsChanges = CompareItems(lHeader.Items.Value, rHeader.Items.Value)
+                hc.ItemsChanges = compareItems(lHeader.Items.Value, rHeader.Items.Value, usage, rules)
             }
         }
         if lHeader.Items.IsEmpty() && !rHeader.Items.IsEmpty() {
             CreateChange(&changes, ObjectAdded, v3.ItemsLabel, nil,
-                rHeader.Items.ValueNode, true, nil, rHeader.Items.Value)
+                rHeader.Items.ValueNode, rules.isBreaking(HeaderObject, v3.ItemsLabel, ObjectAdded), nil,
+                rHeader.Items.Value)
         }
         if !lHeader.Items.IsEmpty() && rHeader.Items.IsEmpty() {
             CreateChange(&changes, ObjectRemoved, v3.SchemaLabel, lHeader.Items.ValueNode,
-                nil, true, lHeader.Items.Value, nil)
+                nil, rules.isBreaking(HeaderObject, v3.SchemaLabel, ObjectRemoved), lHeader.Items.Value, nil)
         }
-        hc.ExtensionChanges = CompareExtensions(lHeader.Extensions, rHeader.Extensions)
+        hc.ExtensionChanges = compareExtensions(lHeader.Extensions, rHeader.Extensions, rules)
     }
 
     // handle OpenAPI
@@ -260,21 +268,26 @@ func CompareHeaders(l, r any) *HeaderChanges {
 
         // header
         if !lHeader.Schema.IsEmpty() || !rHeader.Schema.IsEmpty() {
-            hc.SchemaChanges = CompareSchemas(lHeader.Schema.Value, rHeader.Schema.Value)
+            hc.SchemaChanges = compareSchemas(lHeader.Schema.Value, rHeader.Schema.Value, usage, rules)
         }
 
         // examples
         hc.ExamplesChanges = CheckMapForChanges(lHeader.Examples.Value, rHeader.Examples.Value,
-            &changes, v3.ExamplesLabel, CompareExamples)
+            &changes, v3.ExamplesLabel, HeaderObject, rules, func(l, r *base.Example) *ExampleChanges {
+                return compareExamples(l, r, rules)
+            })
 
         // content
         hc.ContentChanges = CheckMapForChanges(lHeader.Content.Value, rHeader.Content.Value,
-            &changes, v3.ContentLabel, CompareMediaTypes)
+            &changes, v3.ContentLabel, HeaderObject, rules, func(lm, rm *v3.MediaType) *MediaTypeChanges {
+                return compareMediaTypes(lm, rm, usage, rules)
+            })
 
-        hc.ExtensionChanges = CompareExtensions(lHeader.Extensions, rHeader.Extensions)
+        hc.ExtensionChanges = compareExtensions(lHeader.Extensions, rHeader.Extensions, rules)
 
     }
-    CheckProperties(props)
+    checkProperties(props, rules)
+    applySchemaUsage(changes, HeaderObject, usage, rules)
     hc.PropertyChanges = NewPropertyChanges(changes)
     return hc
 }
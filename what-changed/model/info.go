@@ -49,6 +49,11 @@ func (i *InfoChanges) TotalBreakingChanges() int {
 // will be returned in a pointer to InfoChanges, otherwise if nothing is found, then nil is
 // returned instead.
 func CompareInfo(l, r *base.Info) *InfoChanges {
+	return compareInfo(l, r, nil)
+}
+
+// compareInfo compares a left and right Info object, using rules before the default BreakingRules.
+func compareInfo(l, r *base.Info, rules BreakingRules) *InfoChanges {
 	var changes []*Change
 	var props []*PropertyCheck
 
@@ -58,7 +63,7 @@ func CompareInfo(l, r *base.Info) *InfoChanges {
 		RightNode: r.Title.ValueNode,
 		Label:     v3.TitleLabel,
 		Changes:   &changes,
-		Breaking:  false,
+		Object:    InfoObject,
 		Original:  l,
 		New:       r,
 	})
@@ -69,7 +74,7 @@ func CompareInfo(l, r *base.Info) *InfoChanges {
 		RightNode: r.Summary.ValueNode,
 		Label:     v3.SummaryLabel,
 		Changes:   &changes,
-		Breaking:  false,
+		Object:    InfoObject,
 		Original:  l,
 		New:       r,
 	})
@@ -80,7 +85,7 @@ func CompareInfo(l, r *base.Info) *InfoChanges {
 		RightNode: r.Description.ValueNode,
 		Label:     v3.DescriptionLabel,
 		Changes:   &changes,
-		Breaking:  false,
+		Object:    InfoObject,
 		Original:  l,
 		New:       r,
 	})
@@ -91,7 +96,7 @@ func CompareInfo(l, r *base.Info) *InfoChanges {
 		RightNode: r.TermsOfService.ValueNode,
 		Label:     v3.TermsOfServiceLabel,
 		Changes:   &changes,
-		Breaking:  false,
+		Object:    InfoObject,
 		Original:  l,
 		New:       r,
 	})
@@ -102,41 +107,45 @@ func CompareInfo(l, r *base.Info) *InfoChanges {
 		RightNode: r.Version.ValueNode,
 		Label:     v3.VersionLabel,
 		Changes:   &changes,
-		Breaking:  false,
+		Object:    InfoObject,
 		Original:  l,
 		New:       r,
 	})
 
 	// check properties
-	CheckProperties(props)
+	checkProperties(props, rules)
 
 	i := new(InfoChanges)
 
 	// compare contact.
 	if l.Contact.Value != nil && r.Contact.Value != nil {
-		i.ContactChanges = CompareContact(l.Contact.Value, r.Contact.Value)
+		i.ContactChanges = compareContact(l.Contact.Value, r.Contact.Value, rules)
 	} else {
 		if l.Contact.Value == nil && r.Contact.Value != nil {
 			CreateChange(&changes, ObjectAdded, v3.ContactLabel,
-				nil, r.Contact.ValueNode, false, nil, r.Contact.Value)
+				nil, r.Contact.ValueNode, rules.isBreaking(InfoObject, v3.ContactLabel, ObjectAdded), nil,
+				r.Contact.Value)
 		}
 		if l.Contact.Value != nil && r.Contact.Value == nil {
 			CreateChange(&changes, ObjectRemoved, v3.ContactLabel,
-				l.Contact.ValueNode, nil, false, l.Contact.Value, nil)
+				l.Contact.ValueNode, nil,
+				rules.isBreaking(InfoObject, v3.ContactLabel, ObjectRemoved), l.Contact.Value, nil)
 		}
 	}
 
 	// compare license.
 	if l.License.Value != nil && r.License.Value != nil {
-		i.LicenseChanges = CompareLicense(l.License.Value, r.License.Value)
+		i.LicenseChanges = compareLicense(l.License.Value, r.License.Value, rules)
 	} else {
 		if l.License.Value == nil && r.License.Value != nil {
 			CreateChange(&changes, ObjectAdded, v3.LicenseLabel,
-				nil, r.License.ValueNode, false, nil, r.License.Value)
+				nil, r.License.ValueNode, rules.isBreaking(InfoObject, v3.LicenseLabel, ObjectAdded), nil,
+				r.License.Value)
 		}
 		if l.License.Value != nil && r.License.Value == nil {
 			CreateChange(&changes, ObjectRemoved, v3.LicenseLabel,
-				l.License.ValueNode, nil, false, r.License.Value, nil)
+				l.License.ValueNode, nil,
+				rules.isBreaking(InfoObject, v3.LicenseLabel, ObjectRemoved), r.License.Value, nil)
 		}
 	}
 	i.PropertyChanges = NewPropertyChanges(changes)
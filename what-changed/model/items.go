@@ -51,6 +51,11 @@ func (i *ItemsChanges) TotalBreakingChanges() int {
 // It is worth nothing that Items can contain Items. This means recursion is possible and has the potential for
 // runaway code if not using the resolver's circular reference checking.
 func CompareItems(l, r *v2.Items) *ItemsChanges {
+    return compareItems(l, r, SchemaUsageUnknown, nil)
+}
+
+// compareItems compares two sets of Swagger Item objects, classifying changes using where the items are used.
+func compareItems(l, r *v2.Items, usage SchemaUsage, rules BreakingRules) *ItemsChanges {
 
     var changes []*Change
     var props []*PropertyCheck
@@ -58,28 +63,31 @@ func CompareItems(l, r *v2.Items) *ItemsChanges {
     ic := new(ItemsChanges)
 
     // header is identical to items, except for a description.
-    props = append(props, addSwaggerHeaderProperties(l, r, &changes)...)
-    CheckProperties(props)
+    props = append(props, addSwaggerHeaderProperties(l, r, &changes, ItemsObject)...)
+    checkProperties(props, rules)
 
     if !l.Items.IsEmpty() && !r.Items.IsEmpty() {
         // inline, check hashes, if they don't match, compare.
         if l.Items.Value.Hash() != r.Items.Value.Hash() {
             // compare.
-            ic.ItemsChanges = CompareItems(l.Items.Value, r.Items.Value)
+            ic.ItemsChanges = compareItems(l.Items.Value, r.Items.Value, usage, rules)
         }
 
     }
     if l.Items.IsEmpty() && !r.Items.IsEmpty() {
         // added items
         CreateChange(&changes, PropertyAdded, v3.ItemsLabel,
-            nil, r.Items.GetValueNode(), true, nil, r.Items.GetValue())
+            nil, r.Items.GetValueNode(), rules.isBreaking(ItemsObject, v3.ItemsLabel, PropertyAdded), nil,
+            r.Items.GetValue())
     }
     if !l.Items.IsEmpty() && r.Items.IsEmpty() {
         // removed items
         CreateChange(&changes, PropertyRemoved, v3.ItemsLabel,
-            l.Items.GetValueNode(), nil, true, l.Items.GetValue(),
+            l.Items.GetValueNode(), nil, rules.isBreaking(ItemsObject, v3.ItemsLabel, PropertyRemoved),
+            l.Items.GetValue(),
             nil)
     }
+    applySchemaUsage(changes, ItemsObject, usage, rules)
     ic.PropertyChanges = NewPropertyChanges(changes)
     if ic.TotalChanges() <= 0 {
         return nil
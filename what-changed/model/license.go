@@ -32,6 +32,11 @@ func (l *LicenseChanges) TotalBreakingChanges() int {
 // were any, a pointer to a LicenseChanges object is returned, otherwise if nothing changed - the function
 // returns nil.
 func CompareLicense(l, r *base.License) *LicenseChanges {
+    return compareLicense(l, r, nil)
+}
+
+// compareLicense compares a left and right License object, using rules before the default BreakingRules.
+func compareLicense(l, r *base.License, rules BreakingRules) *LicenseChanges {
 
     var changes []*Change
     var props []*PropertyCheck
@@ -42,7 +47,7 @@ func CompareLicense(l, r *base.License) *LicenseChanges {
         RightNode: r.URL.ValueNode,
         Label:     v3.URLLabel,
         Changes:   &changes,
-        Breaking:  false,
+        Object:    LicenseObject,
         Original:  l,
         New:       r,
     })
@@ -53,13 +58,13 @@ func CompareLicense(l, r *base.License) *LicenseChanges {
         RightNode: r.Name.ValueNode,
         Label:     v3.NameLabel,
         Changes:   &changes,
-        Breaking:  false,
+        Object:    LicenseObject,
         Original:  l,
         New:       r,
     })
 
     // check everything.
-    CheckProperties(props)
+    checkProperties(props, rules)
 
     lc := new(LicenseChanges)
     lc.PropertyChanges = NewPropertyChanges(changes)
@@ -52,6 +52,11 @@ func (l *LinkChanges) TotalBreakingChanges() int {
 // CompareLinks checks a left and right OpenAPI Link for any changes. If they are found, returns a pointer to
 // LinkChanges, and returns nil if nothing is found.
 func CompareLinks(l, r *v3.Link) *LinkChanges {
+    return compareLinks(l, r, nil)
+}
+
+// compareLinks compares a left and right Link object, using rules before the default BreakingRules.
+func compareLinks(l, r *v3.Link, rules BreakingRules) *LinkChanges {
     if low.AreEqual(l, r) {
         return nil
     }
@@ -65,7 +70,7 @@ func CompareLinks(l, r *v3.Link) *LinkChanges {
         RightNode: r.OperationRef.ValueNode,
         Label:     v3.OperationRefLabel,
         Changes:   &changes,
-        Breaking:  true,
+        Object:    LinkObject,
         Original:  l,
         New:       r,
     })
@@ -76,7 +81,7 @@ func CompareLinks(l, r *v3.Link) *LinkChanges {
         RightNode: r.OperationId.ValueNode,
         Label:     v3.OperationIdLabel,
         Changes:   &changes,
-        Breaking:  true,
+        Object:    LinkObject,
         Original:  l,
         New:       r,
     })
@@ -87,7 +92,7 @@ func CompareLinks(l, r *v3.Link) *LinkChanges {
         RightNode: r.RequestBody.ValueNode,
         Label:     v3.RequestBodyLabel,
         Changes:   &changes,
-        Breaking:  true,
+        Object:    LinkObject,
         Original:  l,
         New:       r,
     })
@@ -98,29 +103,29 @@ func CompareLinks(l, r *v3.Link) *LinkChanges {
         RightNode: r.Description.ValueNode,
         Label:     v3.DescriptionLabel,
         Changes:   &changes,
-        Breaking:  false,
+        Object:    LinkObject,
         Original:  l,
         New:       r,
     })
 
-    CheckProperties(props)
+    checkProperties(props, rules)
     lc := new(LinkChanges)
-    lc.ExtensionChanges = CompareExtensions(l.Extensions, r.Extensions)
+    lc.ExtensionChanges = compareExtensions(l.Extensions, r.Extensions, rules)
 
     // server
     if !l.Server.IsEmpty() && !r.Server.IsEmpty() {
         if !low.AreEqual(l.Server.Value, r.Server.Value) {
-            lc.ServerChanges = CompareServers(l.Server.Value, r.Server.Value)
+            lc.ServerChanges = compareServers(l.Server.Value, r.Server.Value, rules)
         }
     }
     if !l.Server.IsEmpty() && r.Server.IsEmpty() {
         CreateChange(&changes, PropertyRemoved, v3.ServerLabel,
-            l.Server.ValueNode, nil, true,
+            l.Server.ValueNode, nil, rules.isBreaking(LinkObject, v3.ServerLabel, PropertyRemoved),
             l.Server.Value, nil)
     }
     if l.Server.IsEmpty() && !r.Server.IsEmpty() {
         CreateChange(&changes, PropertyAdded, v3.ServerLabel,
-            nil, r.Server.ValueNode, true,
+            nil, r.Server.ValueNode, rules.isBreaking(LinkObject, v3.ServerLabel, PropertyAdded),
             nil, r.Server.Value)
     }
 
@@ -136,13 +141,13 @@ func CompareLinks(l, r *v3.Link) *LinkChanges {
     for k := range lValues {
         if _, ok := rValues[k]; !ok {
             CreateChange(&changes, ObjectRemoved, v3.ParametersLabel,
-                lValues[k].ValueNode, nil, true,
+                lValues[k].ValueNode, nil, rules.isBreaking(LinkObject, v3.ParametersLabel, ObjectRemoved),
                 k, nil)
             continue
         }
         if lValues[k].Value != rValues[k].Value {
             CreateChange(&changes, Modified, v3.ParametersLabel,
-                lValues[k].ValueNode, rValues[k].ValueNode, true,
+                lValues[k].ValueNode, rValues[k].ValueNode, rules.isBreaking(LinkObject, v3.ParametersLabel, Modified),
                 k, k)
         }
 
@@ -150,8 +155,8 @@ func CompareLinks(l, r *v3.Link) *LinkChanges {
     for k := range rValues {
         if _, ok := lValues[k]; !ok {
             CreateChange(&changes, ObjectAdded, v3.ParametersLabel,
-                nil, rValues[k].ValueNode, true,
-                nil, k)
+                nil, rValues[k].ValueNode,
+                rules.isBreaking(LinkObject, v3.ParametersLabel, ObjectAdded), nil, k)
         }
     }
 
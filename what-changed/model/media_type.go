@@ -5,6 +5,7 @@ package model
 
 import (
     "github.com/pb33f/libopenapi/datamodel/low"
+    "github.com/pb33f/libopenapi/datamodel/low/base"
     "github.com/pb33f/libopenapi/datamodel/low/v3"
     "github.com/pb33f/libopenapi/utils"
     "gopkg.in/yaml.v3"
@@ -78,6 +79,12 @@ func (m *MediaTypeChanges) TotalBreakingChanges() int {
 // CompareMediaTypes compares a left and a right MediaType object for any changes. If found, a pointer to a
 // MediaTypeChanges instance is returned, otherwise nothing is returned.
 func CompareMediaTypes(l, r *v3.MediaType) *MediaTypeChanges {
+    return compareMediaTypes(l, r, SchemaUsageUnknown, nil)
+}
+
+// compareMediaTypes compares a left and a right MediaType object, classifying changes made to the schemas using
+// where the media type is used.
+func compareMediaTypes(l, r *v3.MediaType, usage SchemaUsage, rules BreakingRules) *MediaTypeChanges {
 
     var props []*PropertyCheck
     var changes []*Change
@@ -100,7 +107,7 @@ func CompareMediaTypes(l, r *v3.MediaType) *MediaTypeChanges {
             r.Example.ValueNode.Value = string(render)
         }
         addPropertyCheck(&props, l.Example.ValueNode, r.Example.ValueNode,
-            l.Example.Value, r.Example.Value, &changes, v3.ExampleLabel, false)
+            l.Example.Value, r.Example.Value, &changes, v3.ExampleLabel, MediaTypeObject)
 
     } else {
 
@@ -117,33 +124,37 @@ func CompareMediaTypes(l, r *v3.MediaType) *MediaTypeChanges {
         }
 
         addPropertyCheck(&props, l.Example.ValueNode, r.Example.ValueNode,
-            l.Example.Value, r.Example.Value, &changes, v3.ExampleLabel, false)
+            l.Example.Value, r.Example.Value, &changes, v3.ExampleLabel, MediaTypeObject)
     }
 
-    CheckProperties(props)
+    checkProperties(props, rules)
 
     // schema
     if !l.Schema.IsEmpty() && !r.Schema.IsEmpty() {
-        mc.SchemaChanges = CompareSchemas(l.Schema.Value, r.Schema.Value)
+        mc.SchemaChanges = compareSchemas(l.Schema.Value, r.Schema.Value, usage, rules)
     }
     if !l.Schema.IsEmpty() && r.Schema.IsEmpty() {
         CreateChange(&changes, ObjectRemoved, v3.SchemaLabel, l.Schema.ValueNode,
-            nil, true, l.Schema.Value, nil)
+            nil, rules.isBreaking(MediaTypeObject, v3.SchemaLabel, ObjectRemoved), l.Schema.Value, nil)
     }
     if l.Schema.IsEmpty() && !r.Schema.IsEmpty() {
         CreateChange(&changes, ObjectAdded, v3.SchemaLabel, nil,
-            r.Schema.ValueNode, true, nil, r.Schema.Value)
+            r.Schema.ValueNode, rules.isBreaking(MediaTypeObject, v3.SchemaLabel, ObjectAdded), nil, r.Schema.Value)
     }
 
     // examples
     mc.ExampleChanges = CheckMapForChanges(l.Examples.Value, r.Examples.Value,
-        &changes, v3.ExamplesLabel, CompareExamples)
+        &changes, v3.ExamplesLabel, MediaTypeObject, rules, func(l, r *base.Example) *ExampleChanges {
+            return compareExamples(l, r, rules)
+        })
 
     // encoding
     mc.EncodingChanges = CheckMapForChanges(l.Encoding.Value, r.Encoding.Value,
-        &changes, v3.EncodingLabel, CompareEncoding)
+        &changes, v3.EncodingLabel, MediaTypeObject, rules, func(le, re *v3.Encoding) *EncodingChanges {
+            return compareEncoding(le, re, usage, rules)
+        })
 
-    mc.ExtensionChanges = CompareExtensions(l.Extensions, r.Extensions)
+    mc.ExtensionChanges = compareExtensions(l.Extensions, r.Extensions, rules)
     mc.PropertyChanges = NewPropertyChanges(changes)
     return mc
 }
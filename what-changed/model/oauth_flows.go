@@ -82,6 +82,11 @@ func (o *OAuthFlowsChanges) TotalBreakingChanges() int {
 // CompareOAuthFlows compares a left and right OAuthFlows object. If changes are found a pointer to *OAuthFlowsChanges
 // is returned, otherwise nil is returned.
 func CompareOAuthFlows(l, r *v3.OAuthFlows) *OAuthFlowsChanges {
+    return compareOAuthFlows(l, r, nil)
+}
+
+// compareOAuthFlows compares a left and right OAuthFlows object, using rules before the default BreakingRules.
+func compareOAuthFlows(l, r *v3.OAuthFlows, rules BreakingRules) *OAuthFlowsChanges {
     if low.AreEqual(l, r) {
         return nil
     }
@@ -91,64 +96,68 @@ func CompareOAuthFlows(l, r *v3.OAuthFlows) *OAuthFlowsChanges {
 
     // client credentials
     if !l.ClientCredentials.IsEmpty() && !r.ClientCredentials.IsEmpty() {
-        oa.ClientCredentialsChanges = CompareOAuthFlow(l.ClientCredentials.Value, r.ClientCredentials.Value)
+        oa.ClientCredentialsChanges = compareOAuthFlow(l.ClientCredentials.Value, r.ClientCredentials.Value, rules)
     }
     if !l.ClientCredentials.IsEmpty() && r.ClientCredentials.IsEmpty() {
         CreateChange(&changes, ObjectRemoved, v3.ClientCredentialsLabel,
-            l.ClientCredentials.ValueNode, nil, true,
+            l.ClientCredentials.ValueNode, nil,
+            rules.isBreaking(OAuthFlowsObject, v3.ClientCredentialsLabel, ObjectRemoved),
             l.ClientCredentials.Value, nil)
     }
     if l.ClientCredentials.IsEmpty() && !r.ClientCredentials.IsEmpty() {
         CreateChange(&changes, ObjectAdded, v3.ClientCredentialsLabel,
-            nil, r.ClientCredentials.ValueNode, false,
+            nil, r.ClientCredentials.ValueNode,
+            rules.isBreaking(OAuthFlowsObject, v3.ClientCredentialsLabel, ObjectAdded),
             nil, r.ClientCredentials.Value)
     }
 
     // implicit
     if !l.Implicit.IsEmpty() && !r.Implicit.IsEmpty() {
-        oa.ImplicitChanges = CompareOAuthFlow(l.Implicit.Value, r.Implicit.Value)
+        oa.ImplicitChanges = compareOAuthFlow(l.Implicit.Value, r.Implicit.Value, rules)
     }
     if !l.Implicit.IsEmpty() && r.Implicit.IsEmpty() {
         CreateChange(&changes, ObjectRemoved, v3.ImplicitLabel,
-            l.Implicit.ValueNode, nil, true,
+            l.Implicit.ValueNode, nil, rules.isBreaking(OAuthFlowsObject, v3.ImplicitLabel, ObjectRemoved),
             l.Implicit.Value, nil)
     }
     if l.Implicit.IsEmpty() && !r.Implicit.IsEmpty() {
         CreateChange(&changes, ObjectAdded, v3.ImplicitLabel,
-            nil, r.Implicit.ValueNode, false,
-            nil, r.Implicit.Value)
+            nil, r.Implicit.ValueNode,
+            rules.isBreaking(OAuthFlowsObject, v3.ImplicitLabel, ObjectAdded), nil, r.Implicit.Value)
     }
 
     // password
     if !l.Password.IsEmpty() && !r.Password.IsEmpty() {
-        oa.PasswordChanges = CompareOAuthFlow(l.Password.Value, r.Password.Value)
+        oa.PasswordChanges = compareOAuthFlow(l.Password.Value, r.Password.Value, rules)
     }
     if !l.Password.IsEmpty() && r.Password.IsEmpty() {
         CreateChange(&changes, ObjectRemoved, v3.PasswordLabel,
-            l.Password.ValueNode, nil, true,
+            l.Password.ValueNode, nil, rules.isBreaking(OAuthFlowsObject, v3.PasswordLabel, ObjectRemoved),
             l.Password.Value, nil)
     }
     if l.Password.IsEmpty() && !r.Password.IsEmpty() {
         CreateChange(&changes, ObjectAdded, v3.PasswordLabel,
-            nil, r.Password.ValueNode, false,
-            nil, r.Password.Value)
+            nil, r.Password.ValueNode,
+            rules.isBreaking(OAuthFlowsObject, v3.PasswordLabel, ObjectAdded), nil, r.Password.Value)
     }
 
     // auth code
     if !l.AuthorizationCode.IsEmpty() && !r.AuthorizationCode.IsEmpty() {
-        oa.AuthorizationCodeChanges = CompareOAuthFlow(l.AuthorizationCode.Value, r.AuthorizationCode.Value)
+        oa.AuthorizationCodeChanges = compareOAuthFlow(l.AuthorizationCode.Value, r.AuthorizationCode.Value, rules)
     }
     if !l.AuthorizationCode.IsEmpty() && r.AuthorizationCode.IsEmpty() {
         CreateChange(&changes, ObjectRemoved, v3.AuthorizationCodeLabel,
-            l.AuthorizationCode.ValueNode, nil, true,
+            l.AuthorizationCode.ValueNode, nil,
+            rules.isBreaking(OAuthFlowsObject, v3.AuthorizationCodeLabel, ObjectRemoved),
             l.AuthorizationCode.Value, nil)
     }
     if l.AuthorizationCode.IsEmpty() && !r.AuthorizationCode.IsEmpty() {
         CreateChange(&changes, ObjectAdded, v3.AuthorizationCodeLabel,
-            nil, r.AuthorizationCode.ValueNode, false,
+            nil, r.AuthorizationCode.ValueNode,
+            rules.isBreaking(OAuthFlowsObject, v3.AuthorizationCodeLabel, ObjectAdded),
             nil, r.AuthorizationCode.Value)
     }
-    oa.ExtensionChanges = CompareExtensions(l.Extensions, r.Extensions)
+    oa.ExtensionChanges = compareExtensions(l.Extensions, r.Extensions, rules)
     oa.PropertyChanges = NewPropertyChanges(changes)
     return oa
 }
@@ -186,6 +195,11 @@ func (o *OAuthFlowChanges) TotalBreakingChanges() int {
 // CompareOAuthFlow checks a left and a right OAuthFlow object for changes. If found, returns a pointer to
 // an OAuthFlowChanges instance, or nil if nothing is found.
 func CompareOAuthFlow(l, r *v3.OAuthFlow) *OAuthFlowChanges {
+    return compareOAuthFlow(l, r, nil)
+}
+
+// compareOAuthFlow compares a left and right OAuthFlow object, using rules before the default BreakingRules.
+func compareOAuthFlow(l, r *v3.OAuthFlow, rules BreakingRules) *OAuthFlowChanges {
     if low.AreEqual(l, r) {
         return nil
     }
@@ -199,7 +213,7 @@ func CompareOAuthFlow(l, r *v3.OAuthFlow) *OAuthFlowChanges {
         RightNode: r.AuthorizationUrl.ValueNode,
         Label:     v3.AuthorizationUrlLabel,
         Changes:   &changes,
-        Breaking:  true,
+        Object:    OAuthFlowObject,
         Original:  l,
         New:       r,
     })
@@ -210,7 +224,7 @@ func CompareOAuthFlow(l, r *v3.OAuthFlow) *OAuthFlowChanges {
         RightNode: r.TokenUrl.ValueNode,
         Label:     v3.TokenUrlLabel,
         Changes:   &changes,
-        Breaking:  true,
+        Object:    OAuthFlowObject,
         Original:  l,
         New:       r,
     })
@@ -221,37 +235,37 @@ func CompareOAuthFlow(l, r *v3.OAuthFlow) *OAuthFlowChanges {
         RightNode: r.RefreshUrl.ValueNode,
         Label:     v3.RefreshUrlLabel,
         Changes:   &changes,
-        Breaking:  true,
+        Object:    OAuthFlowObject,
         Original:  l,
         New:       r,
     })
 
-    CheckProperties(props)
+    checkProperties(props, rules)
 
     for v := range l.Scopes.Value {
         if r != nil && r.FindScope(v.Value) == nil {
             CreateChange(&changes, ObjectRemoved, v3.Scopes,
-                l.Scopes.Value[v].ValueNode, nil, true,
+                l.Scopes.Value[v].ValueNode, nil, rules.isBreaking(OAuthFlowObject, v3.Scopes, ObjectRemoved),
                 v.Value, nil)
             continue
         }
         if r != nil && r.FindScope(v.Value) != nil {
             if l.Scopes.Value[v].Value != r.FindScope(v.Value).Value {
-                CreateChange(&changes, Modified, v3.Scopes,
-                    l.Scopes.Value[v].ValueNode, r.FindScope(v.Value).ValueNode, true,
-                    l.Scopes.Value[v].Value, r.FindScope(v.Value).Value)
+                CreateChange(&changes, Modified, v3.Scopes, l.Scopes.Value[v].ValueNode, r.FindScope(v.Value).ValueNode,
+                    rules.isBreaking(OAuthFlowObject, v3.Scopes, Modified), l.Scopes.Value[v].Value,
+                    r.FindScope(v.Value).Value)
             }
         }
     }
     for v := range r.Scopes.Value {
         if l != nil && l.FindScope(v.Value) == nil {
             CreateChange(&changes, ObjectAdded, v3.Scopes,
-                nil, r.Scopes.Value[v].ValueNode, false,
-                nil, v.Value)
+                nil, r.Scopes.Value[v].ValueNode,
+                rules.isBreaking(OAuthFlowObject, v3.Scopes, ObjectAdded), nil, v.Value)
         }
     }
     oa := new(OAuthFlowChanges)
     oa.PropertyChanges = NewPropertyChanges(changes)
-    oa.ExtensionChanges = CompareExtensions(l.Extensions, r.Extensions)
+    oa.ExtensionChanges = compareExtensions(l.Extensions, r.Extensions, rules)
     return oa
 }
@@ -120,76 +120,86 @@ func (o *OperationChanges) TotalBreakingChanges() int {
 }
 
 // check for properties shared between operations objects.
-func addSharedOperationProperties(left, right low.SharedOperations, changes *[]*Change) []*PropertyCheck {
+func addSharedOperationProperties(left, right low.SharedOperations, changes *[]*Change,
+	rules BreakingRules) []*PropertyCheck {
 	var props []*PropertyCheck
 
 	// tags
 	if len(left.GetTags().Value) > 0 || len(right.GetTags().Value) > 0 {
 		ExtractStringValueSliceChanges(left.GetTags().Value, right.GetTags().Value,
-			changes, v3.TagsLabel, false)
+			changes, v3.TagsLabel, OperationObject, rules)
 	}
 
 	// summary
 	addPropertyCheck(&props, left.GetSummary().ValueNode, right.GetSummary().ValueNode,
-		left.GetSummary(), right.GetSummary(), changes, v3.SummaryLabel, false)
+		left.GetSummary(), right.GetSummary(), changes, v3.SummaryLabel, OperationObject)
 
 	// description
 	addPropertyCheck(&props, left.GetDescription().ValueNode, right.GetDescription().ValueNode,
-		left.GetDescription(), right.GetDescription(), changes, v3.DescriptionLabel, false)
+		left.GetDescription(), right.GetDescription(), changes, v3.DescriptionLabel, OperationObject)
 
 	// deprecated
 	addPropertyCheck(&props, left.GetDeprecated().ValueNode, right.GetDeprecated().ValueNode,
-		left.GetDeprecated(), right.GetDeprecated(), changes, v3.DeprecatedLabel, false)
+		left.GetDeprecated(), right.GetDeprecated(), changes, v3.DeprecatedLabel, OperationObject)
 
 	// operation id
 	addPropertyCheck(&props, left.GetOperationId().ValueNode, right.GetOperationId().ValueNode,
-		left.GetOperationId(), right.GetOperationId(), changes, v3.OperationIdLabel, true)
+		left.GetOperationId(), right.GetOperationId(), changes, v3.OperationIdLabel, OperationObject)
 
 	return props
 }
 
 // check shared objects
-func compareSharedOperationObjects(l, r low.SharedOperations, changes *[]*Change, opChanges *OperationChanges) {
+func compareSharedOperationObjects(l, r low.SharedOperations, changes *[]*Change, opChanges *OperationChanges,
+	usage SchemaUsage, rules BreakingRules) {
 
 	// external docs
 	if !l.GetExternalDocs().IsEmpty() && !r.GetExternalDocs().IsEmpty() {
 		lExtDoc := l.GetExternalDocs().Value.(*base.ExternalDoc)
 		rExtDoc := r.GetExternalDocs().Value.(*base.ExternalDoc)
 		if !low.AreEqual(lExtDoc, rExtDoc) {
-			opChanges.ExternalDocChanges = CompareExternalDocs(lExtDoc, rExtDoc)
+			opChanges.ExternalDocChanges = compareExternalDocs(lExtDoc, rExtDoc, rules)
 		}
 	}
 	if l.GetExternalDocs().IsEmpty() && !r.GetExternalDocs().IsEmpty() {
 		CreateChange(changes, PropertyAdded, v3.ExternalDocsLabel,
-			nil, r.GetExternalDocs().ValueNode, false, nil,
-			r.GetExternalDocs().Value)
+			nil, r.GetExternalDocs().ValueNode,
+			rules.isBreaking(OperationObject, v3.ExternalDocsLabel, PropertyAdded), nil, r.GetExternalDocs().Value)
 	}
 	if !l.GetExternalDocs().IsEmpty() && r.GetExternalDocs().IsEmpty() {
-		CreateChange(changes, PropertyRemoved, v3.ExternalDocsLabel,
-			l.GetExternalDocs().ValueNode, nil, false, l.GetExternalDocs().Value,
-			nil)
+		CreateChange(changes, PropertyRemoved, v3.ExternalDocsLabel, l.GetExternalDocs().ValueNode, nil,
+			rules.isBreaking(OperationObject, v3.ExternalDocsLabel, PropertyRemoved), l.GetExternalDocs().Value, nil)
 	}
 
-	// responses
+	// responses (sent in the opposite direction to the requests)
 	if !l.GetResponses().IsEmpty() && !r.GetResponses().IsEmpty() {
-		opChanges.ResponsesChanges = CompareResponses(l.GetResponses().Value, r.GetResponses().Value)
+		opChanges.ResponsesChanges = compareResponses(l.GetResponses().Value,
+			r.GetResponses().Value, usage.invert(), rules)
 	}
 	if l.GetResponses().IsEmpty() && !r.GetResponses().IsEmpty() {
 		CreateChange(changes, PropertyAdded, v3.ResponsesLabel,
-			nil, r.GetResponses().ValueNode, false, nil,
+			nil, r.GetResponses().ValueNode, rules.isBreaking(OperationObject, v3.ResponsesLabel, PropertyAdded), nil,
 			r.GetResponses().Value)
 	}
 	if !l.GetResponses().IsEmpty() && r.GetResponses().IsEmpty() {
-		CreateChange(changes, PropertyRemoved, v3.ResponsesLabel,
-			l.GetResponses().ValueNode, nil, true, l.GetResponses().Value,
-			nil)
+		CreateChange(changes, PropertyRemoved, v3.ResponsesLabel, l.GetResponses().ValueNode, nil,
+			rules.isBreaking(OperationObject, v3.ResponsesLabel, PropertyRemoved), l.GetResponses().Value, nil)
 	}
 
 }
 
 // CompareOperations compares a left and right Swagger or OpenAPI Operation object. If changes are found, returns
 // a pointer to an OperationChanges instance, or nil if nothing is found.
+//
+// Parameters and request bodies are classified as sent by a client and responses as sent by a server (see
+// SchemaUsage). The direction is inverted for callbacks, as their requests are sent by the server.
 func CompareOperations(l, r any) *OperationChanges {
+	return compareOperations(l, r, SchemaUsageRequest, nil)
+}
+
+// compareOperations compares a left and right Swagger or OpenAPI Operation object, using usage as the usage of
+// the parameters and request bodies. Responses use the inverted usage.
+func compareOperations(l, r any, usage SchemaUsage, rules BreakingRules) *OperationChanges {
 
 	var changes []*Change
 	var props []*PropertyCheck
@@ -208,9 +218,9 @@ func CompareOperations(l, r any) *OperationChanges {
 			return nil
 		}
 
-		props = append(props, addSharedOperationProperties(lOperation, rOperation, &changes)...)
+		props = append(props, addSharedOperationProperties(lOperation, rOperation, &changes, rules)...)
 
-		compareSharedOperationObjects(lOperation, rOperation, &changes, oc)
+		compareSharedOperationObjects(lOperation, rOperation, &changes, oc, usage, rules)
 
 		// parameters
 		lParamsUntyped := lOperation.GetParameters()
@@ -235,62 +245,60 @@ func CompareOperations(l, r any) *OperationChanges {
 			for n := range lv {
 				if _, ok := rv[n]; ok {
 					if !low.AreEqual(lv[n], rv[n]) {
-						ch := CompareParameters(lv[n], rv[n])
+						ch := compareParameters(lv[n], rv[n], usage, rules)
 						if ch != nil {
 							paramChanges = append(paramChanges, ch)
 						}
 					}
 					continue
 				}
-				CreateChange(&changes, ObjectRemoved, v3.ParametersLabel,
-					lv[n].Name.ValueNode, nil, true, lv[n].Name.Value,
-					nil)
+				CreateChange(&changes, ObjectRemoved, v3.ParametersLabel, lv[n].Name.ValueNode, nil,
+					rules.isBreaking(OperationObject, v3.ParametersLabel, ObjectRemoved), lv[n].Name.Value, nil)
 
 			}
 			for n := range rv {
 				if _, ok := lv[n]; !ok {
 					CreateChange(&changes, ObjectAdded, v3.ParametersLabel,
-						nil, rv[n].Name.ValueNode, true, nil,
-						rv[n].Name.Value)
+						nil, rv[n].Name.ValueNode,
+						rules.isBreaking(OperationObject, v3.ParametersLabel, ObjectAdded), nil, rv[n].Name.Value)
 				}
 			}
 			oc.ParameterChanges = paramChanges
 		}
 		if !lParamsUntyped.IsEmpty() && rParamsUntyped.IsEmpty() {
-			CreateChange(&changes, PropertyRemoved, v3.ParametersLabel,
-				lParamsUntyped.ValueNode, nil, true, lParamsUntyped.Value,
-				nil)
+			CreateChange(&changes, PropertyRemoved, v3.ParametersLabel, lParamsUntyped.ValueNode, nil,
+				rules.isBreaking(OperationObject, v3.ParametersLabel, PropertyRemoved), lParamsUntyped.Value, nil)
 		}
 		if lParamsUntyped.IsEmpty() && !rParamsUntyped.IsEmpty() {
 			CreateChange(&changes, PropertyAdded, v3.ParametersLabel,
-				nil, rParamsUntyped.ValueNode, true, nil,
-				rParamsUntyped.Value)
+				nil, rParamsUntyped.ValueNode,
+				rules.isBreaking(OperationObject, v3.ParametersLabel, PropertyAdded), nil, rParamsUntyped.Value)
 		}
 
 		// security
 		if !lOperation.Security.IsEmpty() || !rOperation.Security.IsEmpty() {
-			checkSecurity(lOperation.Security, rOperation.Security, &changes, oc)
+			checkSecurity(lOperation.Security, rOperation.Security, &changes, oc, rules)
 		}
 
 		// produces
 		if len(lOperation.Produces.Value) > 0 || len(rOperation.Produces.Value) > 0 {
 			ExtractStringValueSliceChanges(lOperation.Produces.Value, rOperation.Produces.Value,
-				&changes, v3.ProducesLabel, true)
+				&changes, v3.ProducesLabel, OperationObject, rules)
 		}
 
 		// consumes
 		if len(lOperation.Consumes.Value) > 0 || len(rOperation.Consumes.Value) > 0 {
 			ExtractStringValueSliceChanges(lOperation.Consumes.Value, rOperation.Consumes.Value,
-				&changes, v3.ConsumesLabel, true)
+				&changes, v3.ConsumesLabel, OperationObject, rules)
 		}
 
 		// schemes
 		if len(lOperation.Schemes.Value) > 0 || len(rOperation.Schemes.Value) > 0 {
 			ExtractStringValueSliceChanges(lOperation.Schemes.Value, rOperation.Schemes.Value,
-				&changes, v3.SchemesLabel, true)
+				&changes, v3.SchemesLabel, OperationObject, rules)
 		}
 
-		oc.ExtensionChanges = CompareExtensions(lOperation.Extensions, rOperation.Extensions)
+		oc.ExtensionChanges = compareExtensions(lOperation.Extensions, rOperation.Extensions, rules)
 	}
 
 	// OpenAPI
@@ -305,8 +313,8 @@ func CompareOperations(l, r any) *OperationChanges {
 			return nil
 		}
 
-		props = append(props, addSharedOperationProperties(lOperation, rOperation, &changes)...)
-		compareSharedOperationObjects(lOperation, rOperation, &changes, oc)
+		props = append(props, addSharedOperationProperties(lOperation, rOperation, &changes, rules)...)
+		compareSharedOperationObjects(lOperation, rOperation, &changes, oc, usage, rules)
 
 		// parameters
 		lParamsUntyped := lOperation.GetParameters()
@@ -331,89 +339,92 @@ func CompareOperations(l, r any) *OperationChanges {
 			for n := range lv {
 				if _, ok := rv[n]; ok {
 					if !low.AreEqual(lv[n], rv[n]) {
-						ch := CompareParameters(lv[n], rv[n])
+						ch := compareParameters(lv[n], rv[n], usage, rules)
 						if ch != nil {
 							paramChanges = append(paramChanges, ch)
 						}
 					}
 					continue
 				}
-				CreateChange(&changes, ObjectRemoved, v3.ParametersLabel,
-					lv[n].Name.ValueNode, nil, true, lv[n].Name.Value,
-					nil)
+				CreateChange(&changes, ObjectRemoved, v3.ParametersLabel, lv[n].Name.ValueNode, nil,
+					rules.isBreaking(OperationObject, v3.ParametersLabel, ObjectRemoved), lv[n].Name.Value, nil)
 
 			}
 			for n := range rv {
 				if _, ok := lv[n]; !ok {
 					CreateChange(&changes, ObjectAdded, v3.ParametersLabel,
-						nil, rv[n].Name.ValueNode, true, nil,
-						rv[n].Name.Value)
+						nil, rv[n].Name.ValueNode,
+						rules.isBreaking(OperationObject, v3.ParametersLabel, ObjectAdded), nil, rv[n].Name.Value)
 				}
 			}
 			oc.ParameterChanges = paramChanges
 		}
 		if !lParamsUntyped.IsEmpty() && rParamsUntyped.IsEmpty() {
-			CreateChange(&changes, PropertyRemoved, v3.ParametersLabel,
-				lParamsUntyped.ValueNode, nil, true, lParamsUntyped.Value,
-				nil)
+			CreateChange(&changes, PropertyRemoved, v3.ParametersLabel, lParamsUntyped.ValueNode, nil,
+				rules.isBreaking(OperationObject, v3.ParametersLabel, PropertyRemoved), lParamsUntyped.Value, nil)
 		}
 		if lParamsUntyped.IsEmpty() && !rParamsUntyped.IsEmpty() {
 			CreateChange(&changes, PropertyAdded, v3.ParametersLabel,
-				nil, rParamsUntyped.ValueNode, true, nil,
-				rParamsUntyped.Value)
+				nil, rParamsUntyped.ValueNode,
+				rules.isBreaking(OperationObject, v3.ParametersLabel, PropertyAdded), nil, rParamsUntyped.Value)
 		}
 
 		// security
 		if !lOperation.Security.IsEmpty() || !rOperation.Security.IsEmpty() {
-			checkSecurity(lOperation.Security, rOperation.Security, &changes, oc)
+			checkSecurity(lOperation.Security, rOperation.Security, &changes, oc, rules)
 		}
 
 		// request body
 		if !lOperation.RequestBody.IsEmpty() && !rOperation.RequestBody.IsEmpty() {
 			if !low.AreEqual(lOperation.RequestBody.Value, rOperation.RequestBody.Value) {
-				oc.RequestBodyChanges = CompareRequestBodies(lOperation.RequestBody.Value, rOperation.RequestBody.Value)
+				oc.RequestBodyChanges = compareRequestBodies(lOperation.RequestBody.Value, rOperation.RequestBody.Value,
+					usage, rules)
 			}
 		}
 		if !lOperation.RequestBody.IsEmpty() && rOperation.RequestBody.IsEmpty() {
-			CreateChange(&changes, PropertyRemoved, v3.RequestBodyLabel,
-				lOperation.RequestBody.ValueNode, nil, true, lOperation.RequestBody.Value,
-				nil)
+			CreateChange(&changes, PropertyRemoved, v3.RequestBodyLabel, lOperation.RequestBody.ValueNode, nil,
+				rules.isBreaking(OperationObject, v3.RequestBodyLabel, PropertyRemoved),
+				lOperation.RequestBody.Value, nil)
 		}
 		if lOperation.RequestBody.IsEmpty() && !rOperation.RequestBody.IsEmpty() {
-			CreateChange(&changes, PropertyAdded, v3.RequestBodyLabel,
-				nil, rOperation.RequestBody.ValueNode, true, nil,
+			CreateChange(&changes, PropertyAdded, v3.RequestBodyLabel, nil, rOperation.RequestBody.ValueNode,
+				rules.isBreaking(OperationObject, v3.RequestBodyLabel, PropertyAdded), nil,
 				rOperation.RequestBody.Value)
 		}
 
 		// callbacks
 		if !lOperation.GetCallbacks().IsEmpty() && !rOperation.GetCallbacks().IsEmpty() {
 			oc.CallbackChanges = CheckMapForChanges(lOperation.Callbacks.Value, rOperation.Callbacks.Value, &changes,
-				v3.CallbacksLabel, CompareCallback)
+				v3.CallbacksLabel, OperationObject, rules, func(lc, rc *v3.Callback) *CallbackChanges {
+					// callback requests are sent by the server.
+					return compareCallback(lc, rc, usage.invert(), rules)
+				})
 		}
 		if !lOperation.GetCallbacks().IsEmpty() && rOperation.GetCallbacks().IsEmpty() {
-			CreateChange(&changes, PropertyRemoved, v3.CallbacksLabel,
-				lOperation.Callbacks.ValueNode, nil, true, lOperation.Callbacks.Value,
-				nil)
+			CreateChange(&changes, PropertyRemoved, v3.CallbacksLabel, lOperation.Callbacks.ValueNode, nil,
+				rules.isBreaking(OperationObject, v3.CallbacksLabel, PropertyRemoved), lOperation.Callbacks.Value, nil)
 		}
 		if lOperation.Callbacks.IsEmpty() && !rOperation.Callbacks.IsEmpty() {
 			CreateChange(&changes, PropertyAdded, v3.CallbacksLabel,
-				nil, rOperation.Callbacks.ValueNode, false, nil,
+				nil, rOperation.Callbacks.ValueNode,
+				rules.isBreaking(OperationObject, v3.CallbacksLabel, PropertyAdded), nil,
 				rOperation.Callbacks.Value)
 		}
 
 		// servers
-		oc.ServerChanges = checkServers(lOperation.Servers, rOperation.Servers)
-		oc.ExtensionChanges = CompareExtensions(lOperation.Extensions, rOperation.Extensions)
+		oc.ServerChanges = checkServers(lOperation.Servers, rOperation.Servers, rules)
+		oc.ExtensionChanges = compareExtensions(lOperation.Extensions, rOperation.Extensions, rules)
 
 		// todo: callbacks
 	}
-	CheckProperties(props)
+	checkProperties(props, rules)
 	oc.PropertyChanges = NewPropertyChanges(changes)
 	return oc
 }
 
 // check servers property
-func checkServers(lServers, rServers low.NodeReference[[]low.ValueReference[*v3.Server]]) []*ServerChanges {
+func checkServers(lServers, rServers low.NodeReference[[]low.ValueReference[*v3.Server]],
+	rules BreakingRules) []*ServerChanges {
 
 	var serverChanges []*ServerChanges
 
@@ -447,13 +458,14 @@ func checkServers(lServers, rServers low.NodeReference[[]low.ValueReference[*v3.
 
 			if _, ok := rv[k]; ok {
 				if !low.AreEqual(lv[k].Value, rv[k].Value) {
-					serverChanges = append(serverChanges, CompareServers(lv[k].Value, rv[k].Value))
+					serverChanges = append(serverChanges, compareServers(lv[k].Value, rv[k].Value, rules))
 				}
 				continue
 			}
 			lv[k].ValueNode.Value = lv[k].Value.URL.Value
 			CreateChange(&changes, ObjectRemoved, v3.ServersLabel,
-				lv[k].ValueNode, nil, true, lv[k].Value.URL.Value,
+				lv[k].ValueNode, nil, rules.isBreaking(ServerObject, v3.ServersLabel, ObjectRemoved),
+				lv[k].Value.URL.Value,
 				nil)
 			sc := new(ServerChanges)
 			sc.PropertyChanges = NewPropertyChanges(changes)
@@ -468,7 +480,7 @@ func checkServers(lServers, rServers low.NodeReference[[]low.ValueReference[*v3.
 				var changes []*Change
 				rv[k].ValueNode.Value = rv[k].Value.URL.Value
 				CreateChange(&changes, ObjectAdded, v3.ServersLabel,
-					nil, rv[k].ValueNode, false, nil,
+					nil, rv[k].ValueNode, rules.isBreaking(ServerObject, v3.ServersLabel, ObjectAdded), nil,
 					rv[k].Value.URL.Value)
 
 				sc := new(ServerChanges)
@@ -482,12 +494,12 @@ func checkServers(lServers, rServers low.NodeReference[[]low.ValueReference[*v3.
 	sc := new(ServerChanges)
 	if !lServers.IsEmpty() && rServers.IsEmpty() {
 		CreateChange(&changes, PropertyRemoved, v3.ServersLabel,
-			lServers.ValueNode, nil, true, lServers.Value,
-			nil)
+			lServers.ValueNode, nil,
+			rules.isBreaking(ServerObject, v3.ServersLabel, PropertyRemoved), lServers.Value, nil)
 	}
 	if lServers.IsEmpty() && !rServers.IsEmpty() {
 		CreateChange(&changes, PropertyAdded, v3.ServersLabel,
-			nil, rServers.ValueNode, false, nil,
+			nil, rServers.ValueNode, rules.isBreaking(ServerObject, v3.ServersLabel, PropertyAdded), nil,
 			rServers.Value)
 	}
 	sc.PropertyChanges = NewPropertyChanges(changes)
@@ -502,7 +514,13 @@ func checkServers(lServers, rServers low.NodeReference[[]low.ValueReference[*v3.
 
 // check security property.
 func checkSecurity(lSecurity, rSecurity low.NodeReference[[]low.ValueReference[*base.SecurityRequirement]],
-	changes *[]*Change, oc any) {
+	changes *[]*Change, oc any, rules BreakingRules) {
+
+	// the changes belong to the operation (or document) the security requirements are part of.
+	object := OperationObject
+	if _, ok := oc.(*DocumentChanges); ok {
+		object = DocumentObject
+	}
 
 	lv := make(map[string]*base.SecurityRequirement, len(lSecurity.Value))
 	rv := make(map[string]*base.SecurityRequirement, len(rSecurity.Value))
@@ -529,7 +547,7 @@ func checkSecurity(lSecurity, rSecurity low.NodeReference[[]low.ValueReference[*
 	for n := range lv {
 		if _, ok := rv[n]; ok {
 			if !low.AreEqual(lv[n], rv[n]) {
-				ch := CompareSecurityRequirement(lv[n], rv[n])
+				ch := compareSecurityRequirement(lv[n], rv[n], rules)
 				if ch != nil {
 					secChanges = append(secChanges, ch)
 				}
@@ -538,7 +556,7 @@ func checkSecurity(lSecurity, rSecurity low.NodeReference[[]low.ValueReference[*
 		}
 		lvn[n].Value = strings.Join(lv[n].GetKeys(), ", ")
 		CreateChange(changes, ObjectRemoved, v3.SecurityLabel,
-			lvn[n], nil, true, lv[n],
+			lvn[n], nil, rules.isBreaking(object, v3.SecurityLabel, ObjectRemoved), lv[n],
 			nil)
 
 	}
@@ -546,7 +564,7 @@ func checkSecurity(lSecurity, rSecurity low.NodeReference[[]low.ValueReference[*
 		if _, ok := lv[n]; !ok {
 			rvn[n].Value = strings.Join(rv[n].GetKeys(), ", ")
 			CreateChange(changes, ObjectAdded, v3.SecurityLabel,
-				nil, rvn[n], false, nil,
+				nil, rvn[n], rules.isBreaking(object, v3.SecurityLabel, ObjectAdded), nil,
 				rv[n])
 		}
 	}
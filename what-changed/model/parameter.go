@@ -85,13 +85,13 @@ func (p *ParameterChanges) TotalBreakingChanges() int {
 }
 
 func addPropertyCheck(props *[]*PropertyCheck,
-    lvn, rvn *yaml.Node, lv, rv any, changes *[]*Change, label string, breaking bool) {
+    lvn, rvn *yaml.Node, lv, rv any, changes *[]*Change, label, object string) {
     *props = append(*props, &PropertyCheck{
         LeftNode:  lvn,
         RightNode: rvn,
         Label:     label,
         Changes:   changes,
-        Breaking:  breaking,
+        Object:    object,
         Original:  lv,
         New:       rv,
     })
@@ -102,23 +102,23 @@ func addOpenAPIParameterProperties(left, right low.OpenAPIParameter, changes *[]
 
     // style
     addPropertyCheck(&props, left.GetStyle().ValueNode, right.GetStyle().ValueNode,
-        left.GetStyle(), right.GetStyle(), changes, v3.StyleLabel, false)
+        left.GetStyle(), right.GetStyle(), changes, v3.StyleLabel, ParameterObject)
 
     // allow reserved
     addPropertyCheck(&props, left.GetAllowReserved().ValueNode, right.GetAllowReserved().ValueNode,
-        left.GetAllowReserved(), right.GetAllowReserved(), changes, v3.AllowReservedLabel, true)
+        left.GetAllowReserved(), right.GetAllowReserved(), changes, v3.AllowReservedLabel, ParameterObject)
 
     // explode
     addPropertyCheck(&props, left.GetExplode().ValueNode, right.GetExplode().ValueNode,
-        left.GetExplode(), right.GetExplode(), changes, v3.ExplodeLabel, false)
+        left.GetExplode(), right.GetExplode(), changes, v3.ExplodeLabel, ParameterObject)
 
     // deprecated
     addPropertyCheck(&props, left.GetDeprecated().ValueNode, right.GetDeprecated().ValueNode,
-        left.GetDeprecated(), right.GetDeprecated(), changes, v3.DeprecatedLabel, false)
+        left.GetDeprecated(), right.GetDeprecated(), changes, v3.DeprecatedLabel, ParameterObject)
 
     // example
     addPropertyCheck(&props, left.GetExample().ValueNode, right.GetExample().ValueNode,
-        left.GetExample(), right.GetExample(), changes, v3.ExampleLabel, false)
+        left.GetExample(), right.GetExample(), changes, v3.ExampleLabel, ParameterObject)
 
     return props
 }
@@ -128,63 +128,63 @@ func addSwaggerParameterProperties(left, right low.SwaggerParameter, changes *[]
 
     // type
     addPropertyCheck(&props, left.GetType().ValueNode, right.GetType().ValueNode,
-        left.GetType(), right.GetType(), changes, v3.TypeLabel, true)
+        left.GetType(), right.GetType(), changes, v3.TypeLabel, ParameterObject)
 
     // format
     addPropertyCheck(&props, left.GetFormat().ValueNode, right.GetFormat().ValueNode,
-        left.GetFormat(), right.GetFormat(), changes, v3.FormatLabel, true)
+        left.GetFormat(), right.GetFormat(), changes, v3.FormatLabel, ParameterObject)
 
     // collection format
     addPropertyCheck(&props, left.GetCollectionFormat().ValueNode, right.GetCollectionFormat().ValueNode,
-        left.GetCollectionFormat(), right.GetCollectionFormat(), changes, v3.CollectionFormatLabel, true)
+        left.GetCollectionFormat(), right.GetCollectionFormat(), changes, v3.CollectionFormatLabel, ParameterObject)
 
     // maximum
     addPropertyCheck(&props, left.GetMaximum().ValueNode, right.GetMaximum().ValueNode,
-        left.GetMaximum(), right.GetMaximum(), changes, v3.MaximumLabel, true)
+        left.GetMaximum(), right.GetMaximum(), changes, v3.MaximumLabel, ParameterObject)
 
     // minimum
     addPropertyCheck(&props, left.GetMinimum().ValueNode, right.GetMinimum().ValueNode,
-        left.GetMinimum(), right.GetMinimum(), changes, v3.MinimumLabel, true)
+        left.GetMinimum(), right.GetMinimum(), changes, v3.MinimumLabel, ParameterObject)
 
     // exclusive maximum
     addPropertyCheck(&props, left.GetExclusiveMaximum().ValueNode, right.GetExclusiveMaximum().ValueNode,
-        left.GetExclusiveMaximum(), right.GetExclusiveMaximum(), changes, v3.ExclusiveMaximumLabel, true)
+        left.GetExclusiveMaximum(), right.GetExclusiveMaximum(), changes, v3.ExclusiveMaximumLabel, ParameterObject)
 
     // exclusive minimum
     addPropertyCheck(&props, left.GetExclusiveMinimum().ValueNode, right.GetExclusiveMinimum().ValueNode,
-        left.GetExclusiveMinimum(), right.GetExclusiveMinimum(), changes, v3.ExclusiveMinimumLabel, true)
+        left.GetExclusiveMinimum(), right.GetExclusiveMinimum(), changes, v3.ExclusiveMinimumLabel, ParameterObject)
 
     // max length
     addPropertyCheck(&props, left.GetMaxLength().ValueNode, right.GetMaxLength().ValueNode,
-        left.GetMaxLength(), right.GetMaxLength(), changes, v3.MaxLengthLabel, true)
+        left.GetMaxLength(), right.GetMaxLength(), changes, v3.MaxLengthLabel, ParameterObject)
 
     // min length
     addPropertyCheck(&props, left.GetMinLength().ValueNode, right.GetMinLength().ValueNode,
-        left.GetMinLength(), right.GetMinLength(), changes, v3.MinLengthLabel, true)
+        left.GetMinLength(), right.GetMinLength(), changes, v3.MinLengthLabel, ParameterObject)
 
     // pattern
     addPropertyCheck(&props, left.GetPattern().ValueNode, right.GetPattern().ValueNode,
-        left.GetPattern(), right.GetPattern(), changes, v3.PatternLabel, true)
+        left.GetPattern(), right.GetPattern(), changes, v3.PatternLabel, ParameterObject)
 
     // max items
     addPropertyCheck(&props, left.GetMaxItems().ValueNode, right.GetMaxItems().ValueNode,
-        left.GetMaxItems(), right.GetMaxItems(), changes, v3.MaxItemsLabel, true)
+        left.GetMaxItems(), right.GetMaxItems(), changes, v3.MaxItemsLabel, ParameterObject)
 
     // min items
     addPropertyCheck(&props, left.GetMinItems().ValueNode, right.GetMinItems().ValueNode,
-        left.GetMinItems(), right.GetMinItems(), changes, v3.MinItemsLabel, true)
+        left.GetMinItems(), right.GetMinItems(), changes, v3.MinItemsLabel, ParameterObject)
 
     // unique items
     addPropertyCheck(&props, left.GetUniqueItems().ValueNode, right.GetUniqueItems().ValueNode,
-        left.GetUniqueItems(), right.GetUniqueItems(), changes, v3.UniqueItemsLabel, true)
+        left.GetUniqueItems(), right.GetUniqueItems(), changes, v3.UniqueItemsLabel, ParameterObject)
 
     // default
     addPropertyCheck(&props, left.GetDefault().ValueNode, right.GetDefault().ValueNode,
-        left.GetDefault(), right.GetDefault(), changes, v3.DefaultLabel, true)
+        left.GetDefault(), right.GetDefault(), changes, v3.DefaultLabel, ParameterObject)
 
     // multiple of
     addPropertyCheck(&props, left.GetMultipleOf().ValueNode, right.GetMultipleOf().ValueNode,
-        left.GetMultipleOf(), right.GetMultipleOf(), changes, v3.MultipleOfLabel, true)
+        left.GetMultipleOf(), right.GetMultipleOf(), changes, v3.MultipleOfLabel, ParameterObject)
 
     return props
 }
@@ -193,23 +193,23 @@ func addCommonParameterProperties(left, right low.SharedParameters, changes *[]*
     var props []*PropertyCheck
 
     addPropertyCheck(&props, left.GetName().ValueNode, right.GetName().ValueNode,
-        left.GetName(), right.GetName(), changes, v3.NameLabel, true)
+        left.GetName(), right.GetName(), changes, v3.NameLabel, ParameterObject)
 
     // in
     addPropertyCheck(&props, left.GetIn().ValueNode, right.GetIn().ValueNode,
-        left.GetIn(), right.GetIn(), changes, v3.InLabel, true)
+        left.GetIn(), right.GetIn(), changes, v3.InLabel, ParameterObject)
 
     // description
     addPropertyCheck(&props, left.GetDescription().ValueNode, right.GetDescription().ValueNode,
-        left.GetDescription(), right.GetDescription(), changes, v3.DescriptionLabel, false)
+        left.GetDescription(), right.GetDescription(), changes, v3.DescriptionLabel, ParameterObject)
 
     // required
     addPropertyCheck(&props, left.GetRequired().ValueNode, right.GetRequired().ValueNode,
-        left.GetRequired(), right.GetRequired(), changes, v3.RequiredLabel, true)
+        left.GetRequired(), right.GetRequired(), changes, v3.RequiredLabel, ParameterObject)
 
     // allow empty value
     addPropertyCheck(&props, left.GetAllowEmptyValue().ValueNode, right.GetAllowEmptyValue().ValueNode,
-        left.GetAllowEmptyValue(), right.GetAllowEmptyValue(), changes, v3.AllowEmptyValueLabel, true)
+        left.GetAllowEmptyValue(), right.GetAllowEmptyValue(), changes, v3.AllowEmptyValueLabel, ParameterObject)
 
     return props
 }
@@ -221,7 +221,16 @@ func CompareParametersV3(l, r *v3.Parameter) *ParameterChanges {
 
 // CompareParameters compares a left and right Swagger or OpenAPI Parameter object for any changes. If found returns
 // a pointer to ParameterChanges. If nothing is found, returns nil.
+//
+// Parameters are sent by a client, so changes that narrow or widen a parameter are classified as a request (see
+// SchemaUsage).
 func CompareParameters(l, r any) *ParameterChanges {
+    return compareParameters(l, r, SchemaUsageRequest, nil)
+}
+
+// compareParameters compares a left and right Swagger or OpenAPI Parameter object, classifying changes using where
+// the parameters are used.
+func compareParameters(l, r any, usage SchemaUsage, rules BreakingRules) *ParameterChanges {
 
     var changes []*Change
     var props []*PropertyCheck
@@ -256,23 +265,23 @@ func CompareParameters(l, r any) *ParameterChanges {
         // items
         if !lParam.Items.IsEmpty() && !rParam.Items.IsEmpty() {
             if lParam.Items.Value.Hash() != rParam.Items.Value.Hash() {
-                pc.ItemsChanges = CompareItems(lParam.Items.Value, rParam.Items.Value)
+                pc.ItemsChanges = compareItems(lParam.Items.Value, rParam.Items.Value, usage, rules)
             }
         }
         if lParam.Items.IsEmpty() && !rParam.Items.IsEmpty() {
             CreateChange(&changes, ObjectAdded, v3.ItemsLabel,
-                nil, rParam.Items.ValueNode, true, nil,
+                nil, rParam.Items.ValueNode, rules.isBreaking(ParameterObject, v3.ItemsLabel, ObjectAdded), nil,
                 rParam.Items.Value)
         }
         if !lParam.Items.IsEmpty() && rParam.Items.IsEmpty() {
-            CreateChange(&changes, ObjectRemoved, v3.ItemsLabel,
-                lParam.Items.ValueNode, nil, true, lParam.Items.Value,
-                nil)
+            CreateChange(&changes, ObjectRemoved, v3.ItemsLabel, lParam.Items.ValueNode, nil,
+                rules.isBreaking(ParameterObject, v3.ItemsLabel, ObjectRemoved), lParam.Items.Value, nil)
         }
 
         // enum
         if len(lParam.Enum.Value) > 0 || len(rParam.Enum.Value) > 0 {
-            ExtractRawValueSliceChanges(lParam.Enum.Value, rParam.Enum.Value, &changes, v3.EnumLabel, true)
+            ExtractRawValueSliceChanges(lParam.Enum.Value, rParam.Enum.Value,
+                &changes, v3.EnumLabel, ParameterObject, rules)
         }
     }
 
@@ -299,55 +308,61 @@ func CompareParameters(l, r any) *ParameterChanges {
         }
 
         // example
-        checkParameterExample(lParam.Example, rParam.Example, changes)
+        checkParameterExample(lParam.Example, rParam.Example, changes, rules)
 
         // examples
         pc.ExamplesChanges = CheckMapForChanges(lParam.Examples.Value, rParam.Examples.Value,
-            &changes, v3.ExamplesLabel, CompareExamples)
+            &changes, v3.ExamplesLabel, ParameterObject, rules, func(l, r *base.Example) *ExampleChanges {
+                return compareExamples(l, r, rules)
+            })
 
         // content
         pc.ContentChanges = CheckMapForChanges(lParam.Content.Value, rParam.Content.Value,
-            &changes, v3.ContentLabel, CompareMediaTypes)
+            &changes, v3.ContentLabel, ParameterObject, rules, func(lm, rm *v3.MediaType) *MediaTypeChanges {
+                return compareMediaTypes(lm, rm, usage, rules)
+            })
     }
-    CheckProperties(props)
+    checkProperties(props, rules)
 
     if lSchema != nil && rSchema != nil {
-        pc.SchemaChanges = CompareSchemas(lSchema, rSchema)
+        pc.SchemaChanges = compareSchemas(lSchema, rSchema, usage, rules)
     }
     if lSchema != nil && rSchema == nil {
         CreateChange(&changes, ObjectRemoved, v3.SchemaLabel,
-            lSchema.GetValueNode(), nil, true, lSchema,
-            nil)
+            lSchema.GetValueNode(), nil,
+            rules.isBreaking(ParameterObject, v3.SchemaLabel, ObjectRemoved), lSchema, nil)
     }
 
     if lSchema == nil && rSchema != nil {
         CreateChange(&changes, ObjectAdded, v3.SchemaLabel,
-            nil, rSchema.GetValueNode(), true, nil,
+            nil, rSchema.GetValueNode(), rules.isBreaking(ParameterObject, v3.SchemaLabel, ObjectAdded), nil,
             rSchema)
     }
 
+    applySchemaUsage(changes, ParameterObject, usage, rules)
     pc.PropertyChanges = NewPropertyChanges(changes)
-    pc.ExtensionChanges = CompareExtensions(lext, rext)
+    pc.ExtensionChanges = compareExtensions(lext, rext, rules)
     return pc
 }
 
-func checkParameterExample(expLeft, expRight low.NodeReference[any], changes []*Change) {
+func checkParameterExample(expLeft, expRight low.NodeReference[any], changes []*Change, rules BreakingRules) {
     if !expLeft.IsEmpty() && !expRight.IsEmpty() {
         if low.GenerateHashString(expLeft.GetValue()) != low.GenerateHashString(expRight.GetValue()) {
             CreateChange(&changes, Modified, v3.ExampleLabel,
-                expLeft.GetValueNode(), expRight.GetValueNode(), false,
+                expLeft.GetValueNode(), expRight.GetValueNode(),
+                rules.isBreaking(ParameterObject, v3.ExampleLabel, Modified),
                 expLeft.GetValue(), expRight.GetValue())
         }
     }
     if expLeft.Value == nil && expRight.Value != nil {
         CreateChange(&changes, PropertyAdded, v3.ExampleLabel,
-            nil, expRight.GetValueNode(), false,
-            nil, expRight.GetValue())
+            nil, expRight.GetValueNode(),
+            rules.isBreaking(ParameterObject, v3.ExampleLabel, PropertyAdded), nil, expRight.GetValue())
 
     }
     if expLeft.Value != nil && expRight.Value == nil {
         CreateChange(&changes, PropertyRemoved, v3.ExampleLabel,
-            expLeft.GetValueNode(), nil, false,
+            expLeft.GetValueNode(), nil, rules.isBreaking(ParameterObject, v3.ExampleLabel, PropertyRemoved),
             expLeft.GetValue(), nil)
 
     }
@@ -154,7 +154,16 @@ func ComparePathItemsV3(l, r *v3.PathItem) *PathItemChanges {
 
 // ComparePathItems compare a left and right Swagger or OpenAPI PathItem object for changes. If found, returns
 // a pointer to PathItemChanges, or returns nil if nothing is found.
+//
+// The requests made to every operation are classified as sent by a client (see SchemaUsage). The path items of
+// callbacks and webhooks are compared by CompareCallback and CompareDocuments, which classify them as sent by a server.
 func ComparePathItems(l, r any) *PathItemChanges {
+	return comparePathItems(l, r, SchemaUsageRequest, nil)
+}
+
+// comparePathItems compares a left and right Swagger or OpenAPI PathItem object, classifying changes made to the
+// parameters, request bodies and responses using usage, the usage of the requests made to the operations.
+func comparePathItems(l, r any, usage SchemaUsage, rules BreakingRules) *PathItemChanges {
 
 	var changes []*Change
 	var props []*PropertyCheck
@@ -173,7 +182,7 @@ func ComparePathItems(l, r any) *PathItemChanges {
 			return nil
 		}
 
-		props = append(props, compareSwaggerPathItem(lPath, rPath, &changes, pc)...)
+		props = append(props, compareSwaggerPathItem(lPath, rPath, &changes, pc, usage, rules)...)
 	}
 
 	// OpenAPI
@@ -194,7 +203,7 @@ func ComparePathItems(l, r any) *PathItemChanges {
 			RightNode: rPath.Description.ValueNode,
 			Label:     v3.DescriptionLabel,
 			Changes:   &changes,
-			Breaking:  false,
+			Object:    PathItemObject,
 			Original:  lPath,
 			New:       lPath,
 		})
@@ -205,20 +214,21 @@ func ComparePathItems(l, r any) *PathItemChanges {
 			RightNode: rPath.Summary.ValueNode,
 			Label:     v3.SummaryLabel,
 			Changes:   &changes,
-			Breaking:  false,
+			Object:    PathItemObject,
 			Original:  lPath,
 			New:       lPath,
 		})
 
-		compareOpenAPIPathItem(lPath, rPath, &changes, pc)
+		compareOpenAPIPathItem(lPath, rPath, &changes, pc, usage, rules)
 	}
 
-	CheckProperties(props)
+	checkProperties(props, rules)
 	pc.PropertyChanges = NewPropertyChanges(changes)
 	return pc
 }
 
-func compareSwaggerPathItem(lPath, rPath *v2.PathItem, changes *[]*Change, pc *PathItemChanges) []*PropertyCheck {
+func compareSwaggerPathItem(lPath, rPath *v2.PathItem, changes *[]*Change, pc *PathItemChanges,
+	usage SchemaUsage, rules BreakingRules) []*PropertyCheck {
 
 	var props []*PropertyCheck
 
@@ -227,99 +237,107 @@ func compareSwaggerPathItem(lPath, rPath *v2.PathItem, changes *[]*Change, pc *P
 	// get
 	if !lPath.Get.IsEmpty() && !rPath.Get.IsEmpty() {
 		totalOps++
-		go checkOperation(lPath.Get.Value, rPath.Get.Value, opChan, v3.GetLabel)
+		go checkOperation(lPath.Get.Value, rPath.Get.Value, opChan, v3.GetLabel, usage, rules)
 	}
 	if !lPath.Get.IsEmpty() && rPath.Get.IsEmpty() {
 		CreateChange(changes, PropertyRemoved, v3.GetLabel,
-			lPath.Get.ValueNode, nil, true, lPath.Get.Value, nil)
+			lPath.Get.ValueNode, nil,
+			rules.isBreaking(PathItemObject, v3.GetLabel, PropertyRemoved), lPath.Get.Value, nil)
 	}
 	if lPath.Get.IsEmpty() && !rPath.Get.IsEmpty() {
 		CreateChange(changes, PropertyAdded, v3.GetLabel,
-			nil, rPath.Get.ValueNode, false, nil, lPath.Get.Value)
+			nil, rPath.Get.ValueNode, rules.isBreaking(PathItemObject, v3.GetLabel, PropertyAdded), nil,
+			lPath.Get.Value)
 	}
 
 	// put
 	if !lPath.Put.IsEmpty() && !rPath.Put.IsEmpty() {
 		totalOps++
-		go checkOperation(lPath.Put.Value, rPath.Put.Value, opChan, v3.PutLabel)
+		go checkOperation(lPath.Put.Value, rPath.Put.Value, opChan, v3.PutLabel, usage, rules)
 	}
 	if !lPath.Put.IsEmpty() && rPath.Put.IsEmpty() {
 		CreateChange(changes, PropertyRemoved, v3.PutLabel,
-			lPath.Put.ValueNode, nil, true, lPath.Put.Value, nil)
+			lPath.Put.ValueNode, nil,
+			rules.isBreaking(PathItemObject, v3.PutLabel, PropertyRemoved), lPath.Put.Value, nil)
 	}
 	if lPath.Put.IsEmpty() && !rPath.Put.IsEmpty() {
 		CreateChange(changes, PropertyAdded, v3.PutLabel,
-			nil, rPath.Put.ValueNode, false, nil, lPath.Put.Value)
+			nil, rPath.Put.ValueNode, rules.isBreaking(PathItemObject, v3.PutLabel, PropertyAdded), nil,
+			lPath.Put.Value)
 	}
 
 	// post
 	if !lPath.Post.IsEmpty() && !rPath.Post.IsEmpty() {
 		totalOps++
-		go checkOperation(lPath.Post.Value, rPath.Post.Value, opChan, v3.PostLabel)
+		go checkOperation(lPath.Post.Value, rPath.Post.Value, opChan, v3.PostLabel, usage, rules)
 	}
 	if !lPath.Post.IsEmpty() && rPath.Post.IsEmpty() {
 		CreateChange(changes, PropertyRemoved, v3.PostLabel,
-			lPath.Post.ValueNode, nil, true, lPath.Post.Value, nil)
+			lPath.Post.ValueNode, nil, rules.isBreaking(PathItemObject, v3.PostLabel, PropertyRemoved),
+			lPath.Post.Value, nil)
 	}
 	if lPath.Post.IsEmpty() && !rPath.Post.IsEmpty() {
 		CreateChange(changes, PropertyAdded, v3.PostLabel,
-			nil, rPath.Post.ValueNode, false, nil, lPath.Post.Value)
+			nil, rPath.Post.ValueNode, rules.isBreaking(PathItemObject, v3.PostLabel, PropertyAdded), nil,
+			lPath.Post.Value)
 	}
 
 	// delete
 	if !lPath.Delete.IsEmpty() && !rPath.Delete.IsEmpty() {
 		totalOps++
-		go checkOperation(lPath.Delete.Value, rPath.Delete.Value, opChan, v3.DeleteLabel)
+		go checkOperation(lPath.Delete.Value, rPath.Delete.Value, opChan, v3.DeleteLabel, usage, rules)
 	}
 	if !lPath.Delete.IsEmpty() && rPath.Delete.IsEmpty() {
-		CreateChange(changes, PropertyRemoved, v3.DeleteLabel,
-			lPath.Delete.ValueNode, nil, true, lPath.Delete.Value, nil)
+		CreateChange(changes, PropertyRemoved, v3.DeleteLabel, lPath.Delete.ValueNode, nil,
+			rules.isBreaking(PathItemObject, v3.DeleteLabel, PropertyRemoved), lPath.Delete.Value, nil)
 	}
 	if lPath.Delete.IsEmpty() && !rPath.Delete.IsEmpty() {
-		CreateChange(changes, PropertyAdded, v3.DeleteLabel,
-			nil, rPath.Delete.ValueNode, false, nil, lPath.Delete.Value)
+		CreateChange(changes, PropertyAdded, v3.DeleteLabel, nil, rPath.Delete.ValueNode,
+			rules.isBreaking(PathItemObject, v3.DeleteLabel, PropertyAdded), nil, lPath.Delete.Value)
 	}
 
 	// options
 	if !lPath.Options.IsEmpty() && !rPath.Options.IsEmpty() {
 		totalOps++
-		go checkOperation(lPath.Options.Value, rPath.Options.Value, opChan, v3.OptionsLabel)
+		go checkOperation(lPath.Options.Value, rPath.Options.Value, opChan, v3.OptionsLabel, usage, rules)
 	}
 	if !lPath.Options.IsEmpty() && rPath.Options.IsEmpty() {
-		CreateChange(changes, PropertyRemoved, v3.OptionsLabel,
-			lPath.Options.ValueNode, nil, true, lPath.Options.Value, nil)
+		CreateChange(changes, PropertyRemoved, v3.OptionsLabel, lPath.Options.ValueNode, nil,
+			rules.isBreaking(PathItemObject, v3.OptionsLabel, PropertyRemoved), lPath.Options.Value, nil)
 	}
 	if lPath.Options.IsEmpty() && !rPath.Options.IsEmpty() {
-		CreateChange(changes, PropertyAdded, v3.OptionsLabel,
-			nil, rPath.Options.ValueNode, false, nil, lPath.Options.Value)
+		CreateChange(changes, PropertyAdded, v3.OptionsLabel, nil, rPath.Options.ValueNode,
+			rules.isBreaking(PathItemObject, v3.OptionsLabel, PropertyAdded), nil, lPath.Options.Value)
 	}
 
 	// head
 	if !lPath.Head.IsEmpty() && !rPath.Head.IsEmpty() {
 		totalOps++
-		go checkOperation(lPath.Head.Value, rPath.Head.Value, opChan, v3.HeadLabel)
+		go checkOperation(lPath.Head.Value, rPath.Head.Value, opChan, v3.HeadLabel, usage, rules)
 	}
 	if !lPath.Head.IsEmpty() && rPath.Head.IsEmpty() {
 		CreateChange(changes, PropertyRemoved, v3.HeadLabel,
-			lPath.Head.ValueNode, nil, true, lPath.Head.Value, nil)
+			lPath.Head.ValueNode, nil, rules.isBreaking(PathItemObject, v3.HeadLabel, PropertyRemoved),
+			lPath.Head.Value, nil)
 	}
 	if lPath.Head.IsEmpty() && !rPath.Head.IsEmpty() {
 		CreateChange(changes, PropertyAdded, v3.HeadLabel,
-			nil, rPath.Head.ValueNode, false, nil, lPath.Head.Value)
+			nil, rPath.Head.ValueNode, rules.isBreaking(PathItemObject, v3.HeadLabel, PropertyAdded), nil,
+			lPath.Head.Value)
 	}
 
 	// patch
 	if !lPath.Patch.IsEmpty() && !rPath.Patch.IsEmpty() {
 		totalOps++
-		go checkOperation(lPath.Patch.Value, rPath.Patch.Value, opChan, v3.PatchLabel)
+		go checkOperation(lPath.Patch.Value, rPath.Patch.Value, opChan, v3.PatchLabel, usage, rules)
 	}
 	if !lPath.Patch.IsEmpty() && rPath.Patch.IsEmpty() {
-		CreateChange(changes, PropertyRemoved, v3.PatchLabel,
-			lPath.Patch.ValueNode, nil, true, lPath.Patch.Value, nil)
+		CreateChange(changes, PropertyRemoved, v3.PatchLabel, lPath.Patch.ValueNode, nil,
+			rules.isBreaking(PathItemObject, v3.PatchLabel, PropertyRemoved), lPath.Patch.Value, nil)
 	}
 	if lPath.Patch.IsEmpty() && !rPath.Patch.IsEmpty() {
-		CreateChange(changes, PropertyAdded, v3.PatchLabel,
-			nil, rPath.Patch.ValueNode, false, nil, lPath.Patch.Value)
+		CreateChange(changes, PropertyAdded, v3.PatchLabel, nil, rPath.Patch.ValueNode,
+			rules.isBreaking(PathItemObject, v3.PatchLabel, PropertyAdded), nil, lPath.Patch.Value)
 	}
 
 	// parameters
@@ -327,16 +345,15 @@ func compareSwaggerPathItem(lPath, rPath *v2.PathItem, changes *[]*Change, pc *P
 		lParams := lPath.Parameters.Value
 		rParams := rPath.Parameters.Value
 		lp, rp := extractV2ParametersIntoInterface(lParams, rParams)
-		checkParameters(lp, rp, changes, pc)
+		checkParameters(lp, rp, changes, pc, usage, rules)
 	}
 	if !lPath.Parameters.IsEmpty() && rPath.Parameters.IsEmpty() {
-		CreateChange(changes, PropertyRemoved, v3.ParametersLabel,
-			lPath.Parameters.ValueNode, nil, true, lPath.Parameters.Value,
-			nil)
+		CreateChange(changes, PropertyRemoved, v3.ParametersLabel, lPath.Parameters.ValueNode, nil,
+			rules.isBreaking(PathItemObject, v3.ParametersLabel, PropertyRemoved), lPath.Parameters.Value, nil)
 	}
 	if lPath.Parameters.IsEmpty() && !rPath.Parameters.IsEmpty() {
 		CreateChange(changes, PropertyAdded, v3.ParametersLabel,
-			nil, rPath.Parameters.ValueNode, true, nil,
+			nil, rPath.Parameters.ValueNode, rules.isBreaking(PathItemObject, v3.ParametersLabel, PropertyAdded), nil,
 			rPath.Parameters.Value)
 	}
 
@@ -371,7 +388,7 @@ func compareSwaggerPathItem(lPath, rPath *v2.PathItem, changes *[]*Change, pc *P
 			completedOperations++
 		}
 	}
-	pc.ExtensionChanges = CompareExtensions(lPath.Extensions, rPath.Extensions)
+	pc.ExtensionChanges = compareExtensions(lPath.Extensions, rPath.Extensions, rules)
 	return props
 }
 
@@ -413,7 +430,8 @@ func extractV3ParametersIntoInterface(l, r []low.ValueReference[*v3.Parameter])
 	return lp, rp
 }
 
-func checkParameters(lParams, rParams []low.ValueReference[low.SharedParameters], changes *[]*Change, pc *PathItemChanges) {
+func checkParameters(lParams, rParams []low.ValueReference[low.SharedParameters], changes *[]*Change,
+	pc *PathItemChanges, usage SchemaUsage, rules BreakingRules) {
 
 	lv := make(map[string]low.SharedParameters, len(lParams))
 	rv := make(map[string]low.SharedParameters, len(rParams))
@@ -431,29 +449,29 @@ func checkParameters(lParams, rParams []low.ValueReference[low.SharedParameters]
 	for n := range lv {
 		if _, ok := rv[n]; ok {
 			if !low.AreEqual(lv[n], rv[n]) {
-				ch := CompareParameters(lv[n], rv[n])
+				ch := compareParameters(lv[n], rv[n], usage, rules)
 				if ch != nil {
 					paramChanges = append(paramChanges, ch)
 				}
 			}
 			continue
 		}
-		CreateChange(changes, ObjectRemoved, v3.ParametersLabel,
-			lv[n].GetName().ValueNode, nil, true, lv[n].GetName().Value,
-			nil)
+		CreateChange(changes, ObjectRemoved, v3.ParametersLabel, lv[n].GetName().ValueNode, nil,
+			rules.isBreaking(PathItemObject, v3.ParametersLabel, ObjectRemoved), lv[n].GetName().Value, nil)
 
 	}
 	for n := range rv {
 		if _, ok := lv[n]; !ok {
 			CreateChange(changes, ObjectAdded, v3.ParametersLabel,
-				nil, rv[n].GetName().ValueNode, true, nil,
+				nil, rv[n].GetName().ValueNode, rules.isBreaking(PathItemObject, v3.ParametersLabel, ObjectAdded), nil,
 				rv[n].GetName().Value)
 		}
 	}
 	pc.ParameterChanges = paramChanges
 }
 
-func compareOpenAPIPathItem(lPath, rPath *v3.PathItem, changes *[]*Change, pc *PathItemChanges) {
+func compareOpenAPIPathItem(lPath, rPath *v3.PathItem, changes *[]*Change, pc *PathItemChanges, usage SchemaUsage,
+	rules BreakingRules) {
 
 	//var props []*PropertyCheck
 
@@ -463,134 +481,141 @@ func compareOpenAPIPathItem(lPath, rPath *v3.PathItem, changes *[]*Change, pc *P
 	// get
 	if !lPath.Get.IsEmpty() && !rPath.Get.IsEmpty() {
 		totalOps++
-		go checkOperation(lPath.Get.Value, rPath.Get.Value, opChan, v3.GetLabel)
+		go checkOperation(lPath.Get.Value, rPath.Get.Value, opChan, v3.GetLabel, usage, rules)
 	}
 	if !lPath.Get.IsEmpty() && rPath.Get.IsEmpty() {
 		CreateChange(changes, PropertyRemoved, v3.GetLabel,
-			lPath.Get.ValueNode, nil, true, lPath.Get.Value, nil)
+			lPath.Get.ValueNode, nil,
+			rules.isBreaking(PathItemObject, v3.GetLabel, PropertyRemoved), lPath.Get.Value, nil)
 	}
 	if lPath.Get.IsEmpty() && !rPath.Get.IsEmpty() {
 		CreateChange(changes, PropertyAdded, v3.GetLabel,
-			nil, rPath.Get.ValueNode, false, nil, lPath.Get.Value)
+			nil, rPath.Get.ValueNode, rules.isBreaking(PathItemObject, v3.GetLabel, PropertyAdded), nil,
+			lPath.Get.Value)
 	}
 
 	// put
 	if !lPath.Put.IsEmpty() && !rPath.Put.IsEmpty() {
 		totalOps++
-		go checkOperation(lPath.Put.Value, rPath.Put.Value, opChan, v3.PutLabel)
+		go checkOperation(lPath.Put.Value, rPath.Put.Value, opChan, v3.PutLabel, usage, rules)
 	}
 	if !lPath.Put.IsEmpty() && rPath.Put.IsEmpty() {
 		CreateChange(changes, PropertyRemoved, v3.PutLabel,
-			lPath.Put.ValueNode, nil, true, lPath.Put.Value, nil)
+			lPath.Put.ValueNode, nil,
+			rules.isBreaking(PathItemObject, v3.PutLabel, PropertyRemoved), lPath.Put.Value, nil)
 	}
 	if lPath.Put.IsEmpty() && !rPath.Put.IsEmpty() {
 		CreateChange(changes, PropertyAdded, v3.PutLabel,
-			nil, rPath.Put.ValueNode, false, nil, lPath.Put.Value)
+			nil, rPath.Put.ValueNode, rules.isBreaking(PathItemObject, v3.PutLabel, PropertyAdded), nil,
+			lPath.Put.Value)
 	}
 
 	// post
 	if !lPath.Post.IsEmpty() && !rPath.Post.IsEmpty() {
 		totalOps++
-		go checkOperation(lPath.Post.Value, rPath.Post.Value, opChan, v3.PostLabel)
+		go checkOperation(lPath.Post.Value, rPath.Post.Value, opChan, v3.PostLabel, usage, rules)
 	}
 	if !lPath.Post.IsEmpty() && rPath.Post.IsEmpty() {
 		CreateChange(changes, PropertyRemoved, v3.PostLabel,
-			lPath.Post.ValueNode, nil, true, lPath.Post.Value, nil)
+			lPath.Post.ValueNode, nil, rules.isBreaking(PathItemObject, v3.PostLabel, PropertyRemoved),
+			lPath.Post.Value, nil)
 	}
 	if lPath.Post.IsEmpty() && !rPath.Post.IsEmpty() {
 		CreateChange(changes, PropertyAdded, v3.PostLabel,
-			nil, rPath.Post.ValueNode, false, nil, lPath.Post.Value)
+			nil, rPath.Post.ValueNode, rules.isBreaking(PathItemObject, v3.PostLabel, PropertyAdded), nil,
+			lPath.Post.Value)
 	}
 
 	// delete
 	if !lPath.Delete.IsEmpty() && !rPath.Delete.IsEmpty() {
 		totalOps++
-		go checkOperation(lPath.Delete.Value, rPath.Delete.Value, opChan, v3.DeleteLabel)
+		go checkOperation(lPath.Delete.Value, rPath.Delete.Value, opChan, v3.DeleteLabel, usage, rules)
 	}
 	if !lPath.Delete.IsEmpty() && rPath.Delete.IsEmpty() {
-		CreateChange(changes, PropertyRemoved, v3.DeleteLabel,
-			lPath.Delete.ValueNode, nil, true, lPath.Delete.Value, nil)
+		CreateChange(changes, PropertyRemoved, v3.DeleteLabel, lPath.Delete.ValueNode, nil,
+			rules.isBreaking(PathItemObject, v3.DeleteLabel, PropertyRemoved), lPath.Delete.Value, nil)
 	}
 	if lPath.Delete.IsEmpty() && !rPath.Delete.IsEmpty() {
-		CreateChange(changes, PropertyAdded, v3.DeleteLabel,
-			nil, rPath.Delete.ValueNode, false, nil, lPath.Delete.Value)
+		CreateChange(changes, PropertyAdded, v3.DeleteLabel, nil, rPath.Delete.ValueNode,
+			rules.isBreaking(PathItemObject, v3.DeleteLabel, PropertyAdded), nil, lPath.Delete.Value)
 	}
 
 	// options
 	if !lPath.Options.IsEmpty() && !rPath.Options.IsEmpty() {
 		totalOps++
-		go checkOperation(lPath.Options.Value, rPath.Options.Value, opChan, v3.OptionsLabel)
+		go checkOperation(lPath.Options.Value, rPath.Options.Value, opChan, v3.OptionsLabel, usage, rules)
 	}
 	if !lPath.Options.IsEmpty() && rPath.Options.IsEmpty() {
-		CreateChange(changes, PropertyRemoved, v3.OptionsLabel,
-			lPath.Options.ValueNode, nil, true, lPath.Options.Value, nil)
+		CreateChange(changes, PropertyRemoved, v3.OptionsLabel, lPath.Options.ValueNode, nil,
+			rules.isBreaking(PathItemObject, v3.OptionsLabel, PropertyRemoved), lPath.Options.Value, nil)
 	}
 	if lPath.Options.IsEmpty() && !rPath.Options.IsEmpty() {
-		CreateChange(changes, PropertyAdded, v3.OptionsLabel,
-			nil, rPath.Options.ValueNode, false, nil, lPath.Options.Value)
+		CreateChange(changes, PropertyAdded, v3.OptionsLabel, nil, rPath.Options.ValueNode,
+			rules.isBreaking(PathItemObject, v3.OptionsLabel, PropertyAdded), nil, lPath.Options.Value)
 	}
 
 	// head
 	if !lPath.Head.IsEmpty() && !rPath.Head.IsEmpty() {
 		totalOps++
-		go checkOperation(lPath.Head.Value, rPath.Head.Value, opChan, v3.HeadLabel)
+		go checkOperation(lPath.Head.Value, rPath.Head.Value, opChan, v3.HeadLabel, usage, rules)
 	}
 	if !lPath.Head.IsEmpty() && rPath.Head.IsEmpty() {
 		CreateChange(changes, PropertyRemoved, v3.HeadLabel,
-			lPath.Head.ValueNode, nil, true, lPath.Head.Value, nil)
+			lPath.Head.ValueNode, nil, rules.isBreaking(PathItemObject, v3.HeadLabel, PropertyRemoved),
+			lPath.Head.Value, nil)
 	}
 	if lPath.Head.IsEmpty() && !rPath.Head.IsEmpty() {
 		CreateChange(changes, PropertyAdded, v3.HeadLabel,
-			nil, rPath.Head.ValueNode, false, nil, lPath.Head.Value)
+			nil, rPath.Head.ValueNode, rules.isBreaking(PathItemObject, v3.HeadLabel, PropertyAdded), nil,
+			lPath.Head.Value)
 	}
 
 	// patch
 	if !lPath.Patch.IsEmpty() && !rPath.Patch.IsEmpty() {
 		totalOps++
-		go checkOperation(lPath.Patch.Value, rPath.Patch.Value, opChan, v3.PatchLabel)
+		go checkOperation(lPath.Patch.Value, rPath.Patch.Value, opChan, v3.PatchLabel, usage, rules)
 	}
 	if !lPath.Patch.IsEmpty() && rPath.Patch.IsEmpty() {
-		CreateChange(changes, PropertyRemoved, v3.PatchLabel,
-			lPath.Patch.ValueNode, nil, true, lPath.Patch.Value, nil)
+		CreateChange(changes, PropertyRemoved, v3.PatchLabel, lPath.Patch.ValueNode, nil,
+			rules.isBreaking(PathItemObject, v3.PatchLabel, PropertyRemoved), lPath.Patch.Value, nil)
 	}
 	if lPath.Patch.IsEmpty() && !rPath.Patch.IsEmpty() {
-		CreateChange(changes, PropertyAdded, v3.PatchLabel,
-			nil, rPath.Patch.ValueNode, false, nil, lPath.Patch.Value)
+		CreateChange(changes, PropertyAdded, v3.PatchLabel, nil, rPath.Patch.ValueNode,
+			rules.isBreaking(PathItemObject, v3.PatchLabel, PropertyAdded), nil, lPath.Patch.Value)
 	}
 
 	// trace
 	if !lPath.Trace.IsEmpty() && !rPath.Trace.IsEmpty() {
 		totalOps++
-		go checkOperation(lPath.Trace.Value, rPath.Trace.Value, opChan, v3.TraceLabel)
+		go checkOperation(lPath.Trace.Value, rPath.Trace.Value, opChan, v3.TraceLabel, usage, rules)
 	}
 	if !lPath.Trace.IsEmpty() && rPath.Trace.IsEmpty() {
-		CreateChange(changes, PropertyRemoved, v3.TraceLabel,
-			lPath.Trace.ValueNode, nil, true, lPath.Trace.Value, nil)
+		CreateChange(changes, PropertyRemoved, v3.TraceLabel, lPath.Trace.ValueNode, nil,
+			rules.isBreaking(PathItemObject, v3.TraceLabel, PropertyRemoved), lPath.Trace.Value, nil)
 	}
 	if lPath.Trace.IsEmpty() && !rPath.Trace.IsEmpty() {
-		CreateChange(changes, PropertyAdded, v3.TraceLabel,
-			nil, rPath.Trace.ValueNode, false, nil, lPath.Trace.Value)
+		CreateChange(changes, PropertyAdded, v3.TraceLabel, nil, rPath.Trace.ValueNode,
+			rules.isBreaking(PathItemObject, v3.TraceLabel, PropertyAdded), nil, lPath.Trace.Value)
 	}
 
 	// servers
-	pc.ServerChanges = checkServers(lPath.Servers, rPath.Servers)
+	pc.ServerChanges = checkServers(lPath.Servers, rPath.Servers, rules)
 
 	// parameters
 	if !lPath.Parameters.IsEmpty() && !rPath.Parameters.IsEmpty() {
 		lParams := lPath.Parameters.Value
 		rParams := rPath.Parameters.Value
 		lp, rp := extractV3ParametersIntoInterface(lParams, rParams)
-		checkParameters(lp, rp, changes, pc)
+		checkParameters(lp, rp, changes, pc, usage, rules)
 	}
 
 	if !lPath.Parameters.IsEmpty() && rPath.Parameters.IsEmpty() {
-		CreateChange(changes, PropertyRemoved, v3.ParametersLabel,
-			lPath.Parameters.ValueNode, nil, true, lPath.Parameters.Value,
-			nil)
+		CreateChange(changes, PropertyRemoved, v3.ParametersLabel, lPath.Parameters.ValueNode, nil,
+			rules.isBreaking(PathItemObject, v3.ParametersLabel, PropertyRemoved), lPath.Parameters.Value, nil)
 	}
 	if lPath.Parameters.IsEmpty() && !rPath.Parameters.IsEmpty() {
 		CreateChange(changes, PropertyAdded, v3.ParametersLabel,
-			nil, rPath.Parameters.ValueNode, true, nil,
+			nil, rPath.Parameters.ValueNode, rules.isBreaking(PathItemObject, v3.ParametersLabel, PropertyAdded), nil,
 			rPath.Parameters.Value)
 	}
 
@@ -628,12 +653,12 @@ func compareOpenAPIPathItem(lPath, rPath *v3.PathItem, changes *[]*Change, pc *P
 			completedOperations++
 		}
 	}
-	pc.ExtensionChanges = CompareExtensions(lPath.Extensions, rPath.Extensions)
+	pc.ExtensionChanges = compareExtensions(lPath.Extensions, rPath.Extensions, rules)
 }
 
-func checkOperation(l, r any, done chan opCheck, method string) {
+func checkOperation(l, r any, done chan opCheck, method string, usage SchemaUsage, rules BreakingRules) {
 	done <- opCheck{
 		label:   method,
-		changes: CompareOperations(l, r),
+		changes: compareOperations(l, r, usage, rules),
 	}
 }
@@ -55,6 +55,11 @@ func (p *PathsChanges) TotalBreakingChanges() int {
 // ComparePaths compares a left and right Swagger or OpenAPI Paths Object for changes. If found, returns a pointer
 // to a PathsChanges instance. Returns nil if nothing is found.
 func ComparePaths(l, r any) *PathsChanges {
+    return comparePaths(l, r, nil)
+}
+
+// comparePaths compares a left and right Swagger or OpenAPI Paths object, using rules before the default BreakingRules.
+func comparePaths(l, r any, rules BreakingRules) *PathsChanges {
 
     var changes []*Change
 
@@ -87,7 +92,7 @@ func ComparePaths(l, r any) *PathsChanges {
         compare := func(path string, pChanges map[string]*PathItemChanges, l, r *v2.PathItem, doneChan chan bool) {
             if !low.AreEqual(l, r) {
                 mLock.Lock()
-                pathChanges[path] = ComparePathItems(l, r)
+                pathChanges[path] = comparePathItems(l, r, SchemaUsageRequest, rules)
                 mLock.Unlock()
             }
             doneChan <- true
@@ -104,7 +109,7 @@ func ComparePaths(l, r any) *PathsChanges {
             }
             g, p := lPath.FindPathAndKey(k)
             CreateChange(&changes, ObjectRemoved, v3.PathLabel,
-                g.KeyNode, nil, true,
+                g.KeyNode, nil, rules.isBreaking(PathsObject, v3.PathLabel, ObjectRemoved),
                 p.Value, nil)
         }
 
@@ -112,7 +117,7 @@ func ComparePaths(l, r any) *PathsChanges {
             if _, ok := lKeys[k]; !ok {
                 g, p := rPath.FindPathAndKey(k)
                 CreateChange(&changes, ObjectAdded, v3.PathLabel,
-                    nil, g.KeyNode, false,
+                    nil, g.KeyNode, rules.isBreaking(PathsObject, v3.PathLabel, ObjectAdded),
                     nil, p.Value)
             }
         }
@@ -129,7 +134,7 @@ func ComparePaths(l, r any) *PathsChanges {
             pc.PathItemsChanges = pathChanges
         }
 
-        pc.ExtensionChanges = CompareExtensions(lPath.Extensions, rPath.Extensions)
+        pc.ExtensionChanges = compareExtensions(lPath.Extensions, rPath.Extensions, rules)
     }
 
     // OpenAPI
@@ -158,7 +163,7 @@ func ComparePaths(l, r any) *PathsChanges {
         compare := func(path string, pChanges map[string]*PathItemChanges, l, r *v3.PathItem, doneChan chan bool) {
             if !low.AreEqual(l, r) {
                 mLock.Lock()
-                pathChanges[path] = ComparePathItems(l, r)
+                pathChanges[path] = comparePathItems(l, r, SchemaUsageRequest, rules)
                 mLock.Unlock()
             }
             doneChan <- true
@@ -175,7 +180,7 @@ func ComparePaths(l, r any) *PathsChanges {
             }
             g, p := lPath.FindPathAndKey(k)
             CreateChange(&changes, ObjectRemoved, v3.PathLabel,
-                g.KeyNode, nil, true,
+                g.KeyNode, nil, rules.isBreaking(PathsObject, v3.PathLabel, ObjectRemoved),
                 p.Value, nil)
         }
 
@@ -183,7 +188,7 @@ func ComparePaths(l, r any) *PathsChanges {
             if _, ok := lKeys[k]; !ok {
                 g, p := rPath.FindPathAndKey(k)
                 CreateChange(&changes, ObjectAdded, v3.PathLabel,
-                    nil, g.KeyNode, false,
+                    nil, g.KeyNode, rules.isBreaking(PathsObject, v3.PathLabel, ObjectAdded),
                     nil, p.Value)
             }
         }
@@ -199,7 +204,7 @@ func ComparePaths(l, r any) *PathsChanges {
             pc.PathItemsChanges = pathChanges
         }
 
-        pc.ExtensionChanges = CompareExtensions(lPath.Extensions, rPath.Extensions)
+        pc.ExtensionChanges = compareExtensions(lPath.Extensions, rPath.Extensions, rules)
     }
     pc.PropertyChanges = NewPropertyChanges(changes)
     return pc
@@ -51,7 +51,16 @@ func (rb *RequestBodyChanges) TotalBreakingChanges() int {
 
 // CompareRequestBodies compares a left and right OpenAPI RequestBody object for changes. If found returns a pointer
 // to a RequestBodyChanges instance. Returns nil if nothing was found.
+//
+// Request bodies are sent by a client, so changes that narrow or widen their schemas are classified as a request
+// (see SchemaUsage).
 func CompareRequestBodies(l, r *v3.RequestBody) *RequestBodyChanges {
+    return compareRequestBodies(l, r, SchemaUsageRequest, nil)
+}
+
+// compareRequestBodies compares a left and right OpenAPI RequestBody object, classifying changes made to the
+// schemas using where the request bodies are used.
+func compareRequestBodies(l, r *v3.RequestBody, usage SchemaUsage, rules BreakingRules) *RequestBodyChanges {
     if low.AreEqual(l, r) {
         return nil
     }
@@ -65,7 +74,7 @@ func CompareRequestBodies(l, r *v3.RequestBody) *RequestBodyChanges {
         RightNode: r.Description.ValueNode,
         Label:     v3.DescriptionLabel,
         Changes:   &changes,
-        Breaking:  false,
+        Object:    RequestBodyObject,
         Original:  l,
         New:       r,
     })
@@ -76,18 +85,19 @@ func CompareRequestBodies(l, r *v3.RequestBody) *RequestBodyChanges {
         RightNode: r.Required.ValueNode,
         Label:     v3.RequiredLabel,
         Changes:   &changes,
-        Breaking:  true,
+        Object:    RequestBodyObject,
         Original:  l,
         New:       r,
     })
 
-    CheckProperties(props)
+    checkProperties(props, rules)
 
     rbc := new(RequestBodyChanges)
     rbc.ContentChanges = CheckMapForChanges(l.Content.Value, r.Content.Value,
-        &changes, v3.ContentLabel, CompareMediaTypes)
-    rbc.ExtensionChanges = CompareExtensions(l.Extensions, r.Extensions)
+        &changes, v3.ContentLabel, RequestBodyObject, rules, func(lm, rm *v3.MediaType) *MediaTypeChanges {
+            return compareMediaTypes(lm, rm, usage, rules)
+        })
+    rbc.ExtensionChanges = compareExtensions(l.Extensions, r.Extensions, rules)
     rbc.PropertyChanges = NewPropertyChanges(changes)
-
     return rbc
 }
@@ -108,7 +108,16 @@ func CompareResponseV3(l, r *v3.Response) *ResponseChanges {
 
 // CompareResponse compares a left and right Swagger or OpenAPI Response object. If anything is found
 // a pointer to a ResponseChanges is returned, otherwise it returns nil.
+//
+// Responses are sent by a server, so changes that narrow or widen their schemas and headers are classified as a
+// response (see SchemaUsage).
 func CompareResponse(l, r any) *ResponseChanges {
+    return compareResponse(l, r, SchemaUsageResponse, nil)
+}
+
+// compareResponse compares a left and right Swagger or OpenAPI Response object, classifying changes made to the
+// schemas and headers using where the responses are used.
+func compareResponse(l, r any, usage SchemaUsage, rules BreakingRules) *ResponseChanges {
 
     var changes []*Change
     var props []*PropertyCheck
@@ -127,41 +136,43 @@ func CompareResponse(l, r any) *ResponseChanges {
 
         // description
         addPropertyCheck(&props, lResponse.Description.ValueNode, rResponse.Description.ValueNode,
-            lResponse.Description.Value, rResponse.Description.Value, &changes, v3.DescriptionLabel, false)
+            lResponse.Description.Value, rResponse.Description.Value, &changes, v3.DescriptionLabel, ResponseObject)
 
         if !lResponse.Schema.IsEmpty() && !rResponse.Schema.IsEmpty() {
-            rc.SchemaChanges = CompareSchemas(lResponse.Schema.Value, rResponse.Schema.Value)
+            rc.SchemaChanges = compareSchemas(lResponse.Schema.Value, rResponse.Schema.Value, usage, rules)
         }
         if !lResponse.Schema.IsEmpty() && rResponse.Schema.IsEmpty() {
             CreateChange(&changes, ObjectRemoved, v3.SchemaLabel,
-                lResponse.Schema.ValueNode, nil, true,
+                lResponse.Schema.ValueNode, nil, rules.isBreaking(ResponseObject, v3.SchemaLabel, ObjectRemoved),
                 lResponse.Schema.Value, nil)
         }
         if lResponse.Schema.IsEmpty() && !rResponse.Schema.IsEmpty() {
             CreateChange(&changes, ObjectAdded, v3.SchemaLabel,
-                nil, rResponse.Schema.ValueNode, true,
-                nil, rResponse.Schema.Value)
+                nil, rResponse.Schema.ValueNode,
+                rules.isBreaking(ResponseObject, v3.SchemaLabel, ObjectAdded), nil, rResponse.Schema.Value)
         }
 
         rc.HeadersChanges =
             CheckMapForChanges(lResponse.Headers.Value, rResponse.Headers.Value,
-                &changes, v3.HeadersLabel, CompareHeadersV2)
+                &changes, v3.HeadersLabel, ResponseObject, rules, func(lh, rh *v2.Header) *HeaderChanges {
+                    return compareHeaders(lh, rh, usage, rules)
+                })
 
         if !lResponse.Examples.IsEmpty() && !rResponse.Examples.IsEmpty() {
-            rc.ExamplesChanges = CompareExamplesV2(lResponse.Examples.Value, rResponse.Examples.Value)
+            rc.ExamplesChanges = compareExamplesV2(lResponse.Examples.Value, rResponse.Examples.Value, rules)
         }
         if !lResponse.Examples.IsEmpty() && rResponse.Examples.IsEmpty() {
             CreateChange(&changes, PropertyRemoved, v3.ExamplesLabel,
-                lResponse.Schema.ValueNode, nil, false,
+                lResponse.Schema.ValueNode, nil, rules.isBreaking(ResponseObject, v3.ExamplesLabel, PropertyRemoved),
                 lResponse.Schema.Value, nil)
         }
         if lResponse.Examples.IsEmpty() && !rResponse.Examples.IsEmpty() {
             CreateChange(&changes, ObjectAdded, v3.ExamplesLabel,
-                nil, rResponse.Schema.ValueNode, false,
-                nil, lResponse.Schema.Value)
+                nil, rResponse.Schema.ValueNode,
+                rules.isBreaking(ResponseObject, v3.ExamplesLabel, ObjectAdded), nil, lResponse.Schema.Value)
         }
 
-        rc.ExtensionChanges = CompareExtensions(lResponse.Extensions, rResponse.Extensions)
+        rc.ExtensionChanges = compareExtensions(lResponse.Extensions, rResponse.Extensions, rules)
     }
 
     if reflect.TypeOf(&v3.Response{}) == reflect.TypeOf(l) && reflect.TypeOf(&v3.Response{}) == reflect.TypeOf(r) {
@@ -176,24 +187,30 @@ func CompareResponse(l, r any) *ResponseChanges {
 
         // description
         addPropertyCheck(&props, lResponse.Description.ValueNode, rResponse.Description.ValueNode,
-            lResponse.Description.Value, lResponse.Description.Value, &changes, v3.DescriptionLabel, false)
+            lResponse.Description.Value, lResponse.Description.Value, &changes, v3.DescriptionLabel, ResponseObject)
 
         rc.HeadersChanges =
             CheckMapForChanges(lResponse.Headers.Value, rResponse.Headers.Value,
-                &changes, v3.HeadersLabel, CompareHeadersV3)
+                &changes, v3.HeadersLabel, ResponseObject, rules, func(lh, rh *v3.Header) *HeaderChanges {
+                    return compareHeaders(lh, rh, usage, rules)
+                })
 
         rc.ContentChanges =
             CheckMapForChanges(lResponse.Content.Value, rResponse.Content.Value,
-                &changes, v3.ContentLabel, CompareMediaTypes)
+                &changes, v3.ContentLabel, ResponseObject, rules, func(lm, rm *v3.MediaType) *MediaTypeChanges {
+                    return compareMediaTypes(lm, rm, usage, rules)
+                })
 
         rc.LinkChanges =
             CheckMapForChanges(lResponse.Links.Value, rResponse.Links.Value,
-                &changes, v3.LinksLabel, CompareLinks)
+                &changes, v3.LinksLabel, ResponseObject, rules, func(l, r *v3.Link) *LinkChanges {
+                    return compareLinks(l, r, rules)
+                })
 
-        rc.ExtensionChanges = CompareExtensions(lResponse.Extensions, rResponse.Extensions)
+        rc.ExtensionChanges = compareExtensions(lResponse.Extensions, rResponse.Extensions, rules)
     }
 
-    CheckProperties(props)
+    checkProperties(props, rules)
     rc.PropertyChanges = NewPropertyChanges(changes)
     return rc
 }
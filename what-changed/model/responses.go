@@ -65,6 +65,12 @@ func (r *ResponsesChanges) TotalBreakingChanges() int {
 // CompareResponses compares a left and right Swagger or OpenAPI Responses object for any changes. If found
 // returns a pointer to ResponsesChanges, or returns nil.
 func CompareResponses(l, r any) *ResponsesChanges {
+    return compareResponses(l, r, SchemaUsageResponse, nil)
+}
+
+// compareResponses compares a left and right Swagger or OpenAPI Responses object, classifying changes made to every
+// response using where the responses are used.
+func compareResponses(l, r any, usage SchemaUsage, rules BreakingRules) *ResponsesChanges {
 
     var changes []*Change
 
@@ -83,23 +89,25 @@ func CompareResponses(l, r any) *ResponsesChanges {
         }
 
         if !lResponses.Default.IsEmpty() && !rResponses.Default.IsEmpty() {
-            rc.DefaultChanges = CompareResponse(lResponses.Default.Value, rResponses.Default.Value)
+            rc.DefaultChanges = compareResponse(lResponses.Default.Value, rResponses.Default.Value, usage, rules)
         }
         if !lResponses.Default.IsEmpty() && rResponses.Default.IsEmpty() {
             CreateChange(&changes, ObjectRemoved, v3.DefaultLabel,
-                lResponses.Default.ValueNode, nil, true,
+                lResponses.Default.ValueNode, nil, rules.isBreaking(ResponsesObject, v3.DefaultLabel, ObjectRemoved),
                 lResponses.Default.Value, nil)
         }
         if lResponses.Default.IsEmpty() && !rResponses.Default.IsEmpty() {
             CreateChange(&changes, ObjectAdded, v3.DefaultLabel,
-                nil, rResponses.Default.ValueNode, false,
-                nil, lResponses.Default.Value)
+                nil, rResponses.Default.ValueNode,
+                rules.isBreaking(ResponsesObject, v3.DefaultLabel, ObjectAdded), nil, lResponses.Default.Value)
         }
 
         rc.ResponseChanges = CheckMapForChanges(lResponses.Codes, rResponses.Codes,
-            &changes, v3.CodesLabel, CompareResponseV2)
+            &changes, v3.CodesLabel, ResponsesObject, rules, func(lr, rr *v2.Response) *ResponseChanges {
+                return compareResponse(lr, rr, usage, rules)
+            })
 
-        rc.ExtensionChanges = CompareExtensions(lResponses.Extensions, rResponses.Extensions)
+        rc.ExtensionChanges = compareExtensions(lResponses.Extensions, rResponses.Extensions, rules)
     }
 
     // openapi
@@ -115,23 +123,25 @@ func CompareResponses(l, r any) *ResponsesChanges {
         }
 
         if !lResponses.Default.IsEmpty() && !rResponses.Default.IsEmpty() {
-            rc.DefaultChanges = CompareResponse(lResponses.Default.Value, rResponses.Default.Value)
+            rc.DefaultChanges = compareResponse(lResponses.Default.Value, rResponses.Default.Value, usage, rules)
         }
         if !lResponses.Default.IsEmpty() && rResponses.Default.IsEmpty() {
             CreateChange(&changes, ObjectRemoved, v3.DefaultLabel,
-                lResponses.Default.ValueNode, nil, true,
+                lResponses.Default.ValueNode, nil, rules.isBreaking(ResponsesObject, v3.DefaultLabel, ObjectRemoved),
                 lResponses.Default.Value, nil)
         }
         if lResponses.Default.IsEmpty() && !rResponses.Default.IsEmpty() {
             CreateChange(&changes, ObjectAdded, v3.DefaultLabel,
-                nil, rResponses.Default.ValueNode, false,
-                nil, lResponses.Default.Value)
+                nil, rResponses.Default.ValueNode,
+                rules.isBreaking(ResponsesObject, v3.DefaultLabel, ObjectAdded), nil, lResponses.Default.Value)
         }
 
         rc.ResponseChanges = CheckMapForChanges(lResponses.Codes, rResponses.Codes,
-            &changes, v3.CodesLabel, CompareResponseV3)
+            &changes, v3.CodesLabel, ResponsesObject, rules, func(lr, rr *v3.Response) *ResponseChanges {
+                return compareResponse(lr, rr, usage, rules)
+            })
 
-        rc.ExtensionChanges = CompareExtensions(lResponses.Extensions, rResponses.Extensions)
+        rc.ExtensionChanges = compareExtensions(lResponses.Extensions, rResponses.Extensions, rules)
 
     }
 
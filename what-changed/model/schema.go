@@ -289,20 +289,33 @@ func (s *SchemaChanges) TotalBreakingChanges() int {
 // CompareSchemas accepts a left and right SchemaProxy and checks for changes. If anything is found, returns
 // a pointer to SchemaChanges, otherwise returns nil
 func CompareSchemas(l, r *base.SchemaProxy) *SchemaChanges {
+    return compareSchemas(l, r, SchemaUsageUnknown, nil)
+}
+
+// CompareSchemasWithUsage works the same as CompareSchemas, but every change that narrows or widens the schemas is
+// classified using where the schemas are used (see SchemaUsage). Changes made inside a 'not' schema keep their
+// default classification, as narrowing a 'not' schema widens the schema that contains it.
+func CompareSchemasWithUsage(l, r *base.SchemaProxy, usage SchemaUsage) *SchemaChanges {
+    return compareSchemas(l, r, usage, nil)
+}
+
+// compareSchemas compares a left and right SchemaProxy, classifying changes using usage, and using rules before the
+// default BreakingRules.
+func compareSchemas(l, r *base.SchemaProxy, usage SchemaUsage, rules BreakingRules) *SchemaChanges {
     sc := new(SchemaChanges)
     var changes []*Change
 
     // Added
     if l == nil && r != nil {
         CreateChange(&changes, ObjectAdded, v3.SchemaLabel,
-            nil, nil, true, nil, r)
+            nil, nil, rules.isBreaking(SchemaObject, v3.SchemaLabel, ObjectAdded), nil, r)
         sc.PropertyChanges = NewPropertyChanges(changes)
     }
 
     // Removed
     if l != nil && r == nil {
         CreateChange(&changes, ObjectRemoved, v3.SchemaLabel,
-            nil, nil, true, l, nil)
+            nil, nil, rules.isBreaking(SchemaObject, v3.SchemaLabel, ObjectRemoved), l, nil)
         sc.PropertyChanges = NewPropertyChanges(changes)
     }
 
@@ -316,8 +329,8 @@ func CompareSchemas(l, r *base.SchemaProxy) *SchemaChanges {
                 return nil
             } else {
                 // references are different, that's all we care to know.
-                CreateChange(&changes, Modified, v3.RefLabel,
-                    l.GetValueNode().Content[1], r.GetValueNode().Content[1], true, l.GetSchemaReference(),
+                CreateChange(&changes, Modified, v3.RefLabel, l.GetValueNode().Content[1], r.GetValueNode().Content[1],
+                    rules.isBreaking(SchemaObject, v3.RefLabel, Modified), l.GetSchemaReference(),
                     r.GetSchemaReference())
                 sc.PropertyChanges = NewPropertyChanges(changes)
                 return sc
@@ -326,16 +339,16 @@ func CompareSchemas(l, r *base.SchemaProxy) *SchemaChanges {
 
         // changed from inline to ref
         if !l.IsSchemaReference() && r.IsSchemaReference() {
-            CreateChange(&changes, Modified, v3.RefLabel,
-                l.GetValueNode(), r.GetValueNode().Content[1], true, l, r.GetSchemaReference())
+            CreateChange(&changes, Modified, v3.RefLabel, l.GetValueNode(), r.GetValueNode().Content[1],
+                rules.isBreaking(SchemaObject, v3.RefLabel, Modified), l, r.GetSchemaReference())
             sc.PropertyChanges = NewPropertyChanges(changes)
             return sc // we're done here
         }
 
         // changed from ref to inline
         if l.IsSchemaReference() && !r.IsSchemaReference() {
-            CreateChange(&changes, Modified, v3.RefLabel,
-                l.GetValueNode().Content[1], r.GetValueNode(), true, l.GetSchemaReference(), r)
+            CreateChange(&changes, Modified, v3.RefLabel, l.GetValueNode().Content[1], r.GetValueNode(),
+                rules.isBreaking(SchemaObject, v3.RefLabel, Modified), l.GetSchemaReference(), r)
             sc.PropertyChanges = NewPropertyChanges(changes)
             return sc // done, nothing else to do.
         }
@@ -349,35 +362,38 @@ func CompareSchemas(l, r *base.SchemaProxy) *SchemaChanges {
         }
 
         // check XML
-        checkSchemaXML(lSchema, rSchema, &changes, sc)
+        checkSchemaXML(lSchema, rSchema, &changes, sc, rules)
 
         // check examples
-        checkExamples(lSchema, rSchema, &changes)
+        checkExamples(lSchema, rSchema, &changes, rules)
 
         // check schema core properties for changes.
-        checkSchemaPropertyChanges(lSchema, rSchema, &changes, sc)
+        checkSchemaPropertyChanges(lSchema, rSchema, &changes, sc, usage, rules)
 
         // now for the confusing part, there is also a schema's 'properties' property to parse.
         // inception, eat your heart out.
         doneChan := make(chan bool)
-        props, totalProperties := checkMappedSchemaOfASchema(lSchema.Properties.Value, rSchema.Properties.Value, &changes, doneChan)
+        props, totalProperties := checkMappedSchemaOfASchema(lSchema.Properties.Value, rSchema.Properties.Value,
+            &changes, doneChan, usage, rules)
         sc.SchemaPropertyChanges = props
 
-        deps, depsTotal := checkMappedSchemaOfASchema(lSchema.DependentSchemas.Value, rSchema.DependentSchemas.Value, &changes, doneChan)
+        deps, depsTotal := checkMappedSchemaOfASchema(lSchema.DependentSchemas.Value, rSchema.DependentSchemas.Value,
+            &changes, doneChan, usage, rules)
         sc.DependentSchemasChanges = deps
 
-        patterns, patternsTotal := checkMappedSchemaOfASchema(lSchema.PatternProperties.Value, rSchema.PatternProperties.Value, &changes, doneChan)
+        patterns, patternsTotal := checkMappedSchemaOfASchema(lSchema.PatternProperties.Value,
+            rSchema.PatternProperties.Value, &changes, doneChan, usage, rules)
         sc.PatternPropertiesChanges = patterns
 
         // check polymorphic and multi-values async for speed.
         go extractSchemaChanges(lSchema.OneOf.Value, rSchema.OneOf.Value, v3.OneOfLabel,
-            &sc.OneOfChanges, &changes, doneChan)
+            &sc.OneOfChanges, &changes, usage, rules, doneChan)
 
         go extractSchemaChanges(lSchema.AllOf.Value, rSchema.AllOf.Value, v3.AllOfLabel,
-            &sc.AllOfChanges, &changes, doneChan)
+            &sc.AllOfChanges, &changes, usage, rules, doneChan)
 
         go extractSchemaChanges(lSchema.AnyOf.Value, rSchema.AnyOf.Value, v3.AnyOfLabel,
-            &sc.AnyOfChanges, &changes, doneChan)
+            &sc.AnyOfChanges, &changes, usage, rules, doneChan)
 
         totalChecks := totalProperties + depsTotal + patternsTotal + 3
         completedChecks := 0
@@ -389,6 +405,7 @@ func CompareSchemas(l, r *base.SchemaProxy) *SchemaChanges {
         }
     }
     // done
+    applySchemaUsage(changes, SchemaObject, usage, rules)
     if changes != nil {
         sc.PropertyChanges = NewPropertyChanges(changes)
     } else {
@@ -400,22 +417,23 @@ func CompareSchemas(l, r *base.SchemaProxy) *SchemaChanges {
     return nil
 }
 
-func checkSchemaXML(lSchema *base.Schema, rSchema *base.Schema, changes *[]*Change, sc *SchemaChanges) {
+func checkSchemaXML(lSchema *base.Schema, rSchema *base.Schema, changes *[]*Change, sc *SchemaChanges,
+    rules BreakingRules) {
     // XML removed
     if lSchema.XML.Value != nil && rSchema.XML.Value == nil {
-        CreateChange(changes, ObjectRemoved, v3.XMLLabel,
-            lSchema.XML.GetValueNode(), nil, true, lSchema.XML.GetValue(), nil)
+        CreateChange(changes, ObjectRemoved, v3.XMLLabel, lSchema.XML.GetValueNode(), nil,
+            rules.isBreaking(SchemaObject, v3.XMLLabel, ObjectRemoved), lSchema.XML.GetValue(), nil)
     }
     // XML added
     if lSchema.XML.Value == nil && rSchema.XML.Value != nil {
-        CreateChange(changes, ObjectAdded, v3.XMLLabel,
-            nil, rSchema.XML.GetValueNode(), false, nil, rSchema.XML.GetValue())
+        CreateChange(changes, ObjectAdded, v3.XMLLabel, nil, rSchema.XML.GetValueNode(),
+            rules.isBreaking(SchemaObject, v3.XMLLabel, ObjectAdded), nil, rSchema.XML.GetValue())
     }
 
     // compare XML
     if lSchema.XML.Value != nil && rSchema.XML.Value != nil {
         if !low.AreEqual(lSchema.XML.Value, rSchema.XML.Value) {
-            sc.XMLChanges = CompareXML(lSchema.XML.Value, rSchema.XML.Value)
+            sc.XMLChanges = compareXML(lSchema.XML.Value, rSchema.XML.Value, rules)
         }
     }
 }
@@ -424,7 +442,8 @@ func checkMappedSchemaOfASchema(
     lSchema,
     rSchema map[low.KeyReference[string]]low.ValueReference[*base.SchemaProxy],
     changes *[]*Change,
-    doneChan chan bool) (map[string]*SchemaChanges, int) {
+    doneChan chan bool,
+    usage SchemaUsage, rules BreakingRules) (map[string]*SchemaChanges, int) {
 
     propChanges := make(map[string]*SchemaChanges)
 
@@ -447,12 +466,14 @@ func checkMappedSchemaOfASchema(
     }
     sort.Strings(lProps)
     sort.Strings(rProps)
-    totalProperties := buildProperty(lProps, rProps, lEntities, rEntities, propChanges, doneChan, changes, rKeyNodes, lKeyNodes)
+    totalProperties := buildProperty(lProps, rProps, lEntities, rEntities, propChanges, doneChan, changes, rKeyNodes,
+        lKeyNodes, usage, rules)
     return propChanges, totalProperties
 }
 
 func buildProperty(lProps, rProps []string, lEntities, rEntities map[string]*base.SchemaProxy,
-    propChanges map[string]*SchemaChanges, doneChan chan bool, changes *[]*Change, rKeyNodes, lKeyNodes map[string]*yaml.Node) int {
+    propChanges map[string]*SchemaChanges, doneChan chan bool, changes *[]*Change,
+    rKeyNodes, lKeyNodes map[string]*yaml.Node, usage SchemaUsage, rules BreakingRules) int {
     var propLock sync.Mutex
     checkProperty := func(key string, lp, rp *base.SchemaProxy, propChanges map[string]*SchemaChanges, done chan bool) {
         if lp != nil && rp != nil {
@@ -460,7 +481,7 @@ func buildProperty(lProps, rProps []string, lEntities, rEntities map[string]*bas
                 done <- true
                 return
             }
-            s := CompareSchemas(lp, rp)
+            s := compareSchemas(lp, rp, usage, rules)
             propLock.Lock()
             propChanges[key] = s
             propLock.Unlock()
@@ -482,11 +503,11 @@ func buildProperty(lProps, rProps []string, lEntities, rEntities map[string]*bas
             if lProps[w] != rProps[w] {
 
                 // old removed, new added.
-                CreateChange(changes, ObjectAdded, v3.PropertiesLabel,
-                    nil, rKeyNodes[rProps[w]], false, nil, rEntities[rProps[w]])
+                CreateChange(changes, ObjectAdded, v3.PropertiesLabel, nil, rKeyNodes[rProps[w]],
+                    rules.isBreaking(SchemaObject, v3.PropertiesLabel, ObjectAdded), nil, rEntities[rProps[w]])
 
-                CreateChange(changes, ObjectRemoved, v3.PropertiesLabel,
-                    lKeyNodes[lProps[w]], nil, true, lEntities[lProps[w]], nil)
+                CreateChange(changes, ObjectRemoved, v3.PropertiesLabel, lKeyNodes[lProps[w]], nil,
+                    rules.isBreaking(SchemaObject, v3.PropertiesLabel, ObjectRemoved), lEntities[lProps[w]], nil)
             }
 
         }
@@ -501,8 +522,8 @@ func buildProperty(lProps, rProps []string, lEntities, rEntities map[string]*bas
                 go checkProperty(lProps[w], lEntities[lProps[w]], rEntities[lProps[w]], propChanges, doneChan)
                 continue
             } else {
-                CreateChange(changes, ObjectRemoved, v3.PropertiesLabel,
-                    lKeyNodes[lProps[w]], nil, true, lEntities[lProps[w]], nil)
+                CreateChange(changes, ObjectRemoved, v3.PropertiesLabel, lKeyNodes[lProps[w]], nil,
+                    rules.isBreaking(SchemaObject, v3.PropertiesLabel, ObjectRemoved), lEntities[lProps[w]], nil)
                 continue
             }
         }
@@ -515,8 +536,8 @@ func buildProperty(lProps, rProps []string, lEntities, rEntities map[string]*bas
                 totalProperties++
                 go checkProperty(rProps[w], lEntities[rProps[w]], rEntities[rProps[w]], propChanges, doneChan)
             } else {
-                CreateChange(changes, ObjectAdded, v3.PropertiesLabel,
-                    nil, rKeyNodes[rProps[w]], false, nil, rEntities[rProps[w]])
+                CreateChange(changes, ObjectAdded, v3.PropertiesLabel, nil, rKeyNodes[rProps[w]],
+                    rules.isBreaking(SchemaObject, v3.PropertiesLabel, ObjectAdded), nil, rEntities[rProps[w]])
                 continue
             }
         }
@@ -527,7 +548,7 @@ func buildProperty(lProps, rProps []string, lEntities, rEntities map[string]*bas
 func checkSchemaPropertyChanges(
     lSchema *base.Schema,
     rSchema *base.Schema,
-    changes *[]*Change, sc *SchemaChanges) {
+    changes *[]*Change, sc *SchemaChanges, usage SchemaUsage, rules BreakingRules) {
 
     var props []*PropertyCheck
 
@@ -537,7 +558,7 @@ func checkSchemaPropertyChanges(
         RightNode: rSchema.SchemaTypeRef.ValueNode,
         Label:     v3.SchemaDialectLabel,
         Changes:   changes,
-        Breaking:  true,
+        Object:    SchemaObject,
         Original:  lSchema,
         New:       rSchema,
     })
@@ -548,7 +569,7 @@ func checkSchemaPropertyChanges(
         RightNode: rSchema.ExclusiveMaximum.ValueNode,
         Label:     v3.ExclusiveMaximumLabel,
         Changes:   changes,
-        Breaking:  true,
+        Object:    SchemaObject,
         Original:  lSchema,
         New:       rSchema,
     })
@@ -559,7 +580,7 @@ func checkSchemaPropertyChanges(
         RightNode: rSchema.ExclusiveMinimum.ValueNode,
         Label:     v3.ExclusiveMinimumLabel,
         Changes:   changes,
-        Breaking:  true,
+        Object:    SchemaObject,
         Original:  lSchema,
         New:       rSchema,
     })
@@ -570,7 +591,7 @@ func checkSchemaPropertyChanges(
         RightNode: rSchema.Type.ValueNode,
         Label:     v3.TypeLabel,
         Changes:   changes,
-        Breaking:  true,
+        Object:    SchemaObject,
         Original:  lSchema,
         New:       rSchema,
     })
@@ -581,7 +602,7 @@ func checkSchemaPropertyChanges(
         RightNode: rSchema.Title.ValueNode,
         Label:     v3.TitleLabel,
         Changes:   changes,
-        Breaking:  false,
+        Object:    SchemaObject,
         Original:  lSchema,
         New:       rSchema,
     })
@@ -592,7 +613,7 @@ func checkSchemaPropertyChanges(
         RightNode: rSchema.MultipleOf.ValueNode,
         Label:     v3.MultipleOfLabel,
         Changes:   changes,
-        Breaking:  true,
+        Object:    SchemaObject,
         Original:  lSchema,
         New:       rSchema,
     })
@@ -603,7 +624,7 @@ func checkSchemaPropertyChanges(
         RightNode: rSchema.Maximum.ValueNode,
         Label:     v3.MaximumLabel,
         Changes:   changes,
-        Breaking:  true,
+        Object:    SchemaObject,
         Original:  lSchema,
         New:       rSchema,
     })
@@ -614,7 +635,7 @@ func checkSchemaPropertyChanges(
         RightNode: rSchema.Minimum.ValueNode,
         Label:     v3.MinimumLabel,
         Changes:   changes,
-        Breaking:  true,
+        Object:    SchemaObject,
         Original:  lSchema,
         New:       rSchema,
     })
@@ -625,7 +646,7 @@ func checkSchemaPropertyChanges(
         RightNode: rSchema.MaxLength.ValueNode,
         Label:     v3.MaxLengthLabel,
         Changes:   changes,
-        Breaking:  true,
+        Object:    SchemaObject,
         Original:  lSchema,
         New:       rSchema,
     })
@@ -636,7 +657,7 @@ func checkSchemaPropertyChanges(
         RightNode: rSchema.MinLength.ValueNode,
         Label:     v3.MinLengthLabel,
         Changes:   changes,
-        Breaking:  true,
+        Object:    SchemaObject,
         Original:  lSchema,
         New:       rSchema,
     })
@@ -647,7 +668,7 @@ func checkSchemaPropertyChanges(
         RightNode: rSchema.Pattern.ValueNode,
         Label:     v3.PatternLabel,
         Changes:   changes,
-        Breaking:  true,
+        Object:    SchemaObject,
         Original:  lSchema,
         New:       rSchema,
     })
@@ -658,7 +679,7 @@ func checkSchemaPropertyChanges(
         RightNode: rSchema.Format.ValueNode,
         Label:     v3.FormatLabel,
         Changes:   changes,
-        Breaking:  true,
+        Object:    SchemaObject,
         Original:  lSchema,
         New:       rSchema,
     })
@@ -669,7 +690,7 @@ func checkSchemaPropertyChanges(
         RightNode: rSchema.MaxItems.ValueNode,
         Label:     v3.MaxItemsLabel,
         Changes:   changes,
-        Breaking:  true,
+        Object:    SchemaObject,
         Original:  lSchema,
         New:       rSchema,
     })
@@ -680,7 +701,7 @@ func checkSchemaPropertyChanges(
         RightNode: rSchema.MinItems.ValueNode,
         Label:     v3.MinItemsLabel,
         Changes:   changes,
-        Breaking:  true,
+        Object:    SchemaObject,
         Original:  lSchema,
         New:       rSchema,
     })
@@ -691,7 +712,7 @@ func checkSchemaPropertyChanges(
         RightNode: rSchema.MaxProperties.ValueNode,
         Label:     v3.MaxPropertiesLabel,
         Changes:   changes,
-        Breaking:  true,
+        Object:    SchemaObject,
         Original:  lSchema,
         New:       rSchema,
     })
@@ -702,7 +723,7 @@ func checkSchemaPropertyChanges(
         RightNode: rSchema.MinProperties.ValueNode,
         Label:     v3.MinPropertiesLabel,
         Changes:   changes,
-        Breaking:  true,
+        Object:    SchemaObject,
         Original:  lSchema,
         New:       rSchema,
     })
@@ -713,7 +734,7 @@ func checkSchemaPropertyChanges(
         RightNode: rSchema.UniqueItems.ValueNode,
         Label:     v3.UniqueItemsLabel,
         Changes:   changes,
-        Breaking:  true,
+        Object:    SchemaObject,
         Original:  lSchema,
         New:       rSchema,
     })
@@ -726,7 +747,7 @@ func checkSchemaPropertyChanges(
             RightNode: rSchema.AdditionalProperties.ValueNode,
             Label:     v3.AdditionalPropertiesLabel,
             Changes:   changes,
-            Breaking:  false,
+            Object:    SchemaObject,
             Original:  lSchema,
             New:       rSchema,
         })
@@ -738,7 +759,7 @@ func checkSchemaPropertyChanges(
         RightNode: rSchema.Description.ValueNode,
         Label:     v3.DescriptionLabel,
         Changes:   changes,
-        Breaking:  false,
+        Object:    SchemaObject,
         Original:  lSchema,
         New:       rSchema,
     })
@@ -749,7 +770,7 @@ func checkSchemaPropertyChanges(
         RightNode: rSchema.ContentEncoding.ValueNode,
         Label:     v3.ContentEncodingLabel,
         Changes:   changes,
-        Breaking:  true,
+        Object:    SchemaObject,
         Original:  lSchema,
         New:       rSchema,
     })
@@ -760,7 +781,7 @@ func checkSchemaPropertyChanges(
         RightNode: rSchema.ContentMediaType.ValueNode,
         Label:     v3.ContentMediaType,
         Changes:   changes,
-        Breaking:  true,
+        Object:    SchemaObject,
         Original:  lSchema,
         New:       rSchema,
     })
@@ -771,7 +792,7 @@ func checkSchemaPropertyChanges(
         RightNode: rSchema.Default.ValueNode,
         Label:     v3.DefaultLabel,
         Changes:   changes,
-        Breaking:  true,
+        Object:    SchemaObject,
         Original:  lSchema,
         New:       rSchema,
     })
@@ -782,7 +803,7 @@ func checkSchemaPropertyChanges(
         RightNode: rSchema.Nullable.ValueNode,
         Label:     v3.NullableLabel,
         Changes:   changes,
-        Breaking:  true,
+        Object:    SchemaObject,
         Original:  lSchema,
         New:       rSchema,
     })
@@ -793,7 +814,7 @@ func checkSchemaPropertyChanges(
         RightNode: rSchema.ReadOnly.ValueNode,
         Label:     v3.ReadOnlyLabel,
         Changes:   changes,
-        Breaking:  true,
+        Object:    SchemaObject,
         Original:  lSchema,
         New:       rSchema,
     })
@@ -804,7 +825,7 @@ func checkSchemaPropertyChanges(
         RightNode: rSchema.WriteOnly.ValueNode,
         Label:     v3.WriteOnlyLabel,
         Changes:   changes,
-        Breaking:  true,
+        Object:    SchemaObject,
         Original:  lSchema,
         New:       rSchema,
     })
@@ -815,7 +836,7 @@ func checkSchemaPropertyChanges(
         RightNode: rSchema.Example.ValueNode,
         Label:     v3.ExampleLabel,
         Changes:   changes,
-        Breaking:  false,
+        Object:    SchemaObject,
         Original:  lSchema,
         New:       rSchema,
     })
@@ -826,7 +847,7 @@ func checkSchemaPropertyChanges(
         RightNode: rSchema.Deprecated.ValueNode,
         Label:     v3.DeprecatedLabel,
         Changes:   changes,
-        Breaking:  false,
+        Object:    SchemaObject,
         Original:  lSchema,
         New:       rSchema,
     })
@@ -842,16 +863,16 @@ func checkSchemaPropertyChanges(
     }
     for g := range k {
         if _, ok := j[g]; !ok {
-            CreateChange(changes, PropertyAdded, v3.RequiredLabel,
-                nil, rSchema.Required.Value[k[g]].GetValueNode(), true, nil,
+            CreateChange(changes, PropertyAdded, v3.RequiredLabel, nil, rSchema.Required.Value[k[g]].GetValueNode(),
+                rules.isBreaking(SchemaObject, v3.RequiredLabel, PropertyAdded), nil,
                 rSchema.Required.Value[k[g]].GetValue)
         }
     }
     for g := range j {
         if _, ok := k[g]; !ok {
-            CreateChange(changes, PropertyRemoved, v3.RequiredLabel,
-                lSchema.Required.Value[j[g]].GetValueNode(), nil, true, lSchema.Required.Value[j[g]].GetValue,
-                nil)
+            CreateChange(changes, PropertyRemoved, v3.RequiredLabel, lSchema.Required.Value[j[g]].GetValueNode(), nil,
+                rules.isBreaking(SchemaObject, v3.RequiredLabel, PropertyRemoved),
+                lSchema.Required.Value[j[g]].GetValue, nil)
         }
     }
 
@@ -866,16 +887,14 @@ func checkSchemaPropertyChanges(
     }
     for g := range k {
         if _, ok := j[g]; !ok {
-            CreateChange(changes, PropertyAdded, v3.EnumLabel,
-                nil, rSchema.Enum.Value[k[g]].GetValueNode(), false, nil,
-                rSchema.Enum.Value[k[g]].GetValue)
+            CreateChange(changes, PropertyAdded, v3.EnumLabel, nil, rSchema.Enum.Value[k[g]].GetValueNode(),
+                rules.isBreaking(SchemaObject, v3.EnumLabel, PropertyAdded), nil, rSchema.Enum.Value[k[g]].GetValue)
         }
     }
     for g := range j {
         if _, ok := k[g]; !ok {
-            CreateChange(changes, PropertyRemoved, v3.EnumLabel,
-                lSchema.Enum.Value[j[g]].GetValueNode(), nil, true, lSchema.Enum.Value[j[g]].GetValue,
-                nil)
+            CreateChange(changes, PropertyRemoved, v3.EnumLabel, lSchema.Enum.Value[j[g]].GetValueNode(), nil,
+                rules.isBreaking(SchemaObject, v3.EnumLabel, PropertyRemoved), lSchema.Enum.Value[j[g]].GetValue, nil)
         }
     }
 
@@ -883,193 +902,207 @@ func checkSchemaPropertyChanges(
     if lSchema.Discriminator.Value != nil && rSchema.Discriminator.Value != nil {
         // check if hash matches, if not then compare.
         if lSchema.Discriminator.Value.Hash() != rSchema.Discriminator.Value.Hash() {
-            sc.DiscriminatorChanges = CompareDiscriminator(lSchema.Discriminator.Value, rSchema.Discriminator.Value)
+            sc.DiscriminatorChanges = compareDiscriminator(lSchema.Discriminator.Value,
+                rSchema.Discriminator.Value, rules)
         }
     }
     // added Discriminator
     if lSchema.Discriminator.Value == nil && rSchema.Discriminator.Value != nil {
-        CreateChange(changes, ObjectAdded, v3.DiscriminatorLabel,
-            nil, rSchema.Discriminator.ValueNode, true, nil, rSchema.Discriminator.Value)
+        CreateChange(changes, ObjectAdded, v3.DiscriminatorLabel, nil, rSchema.Discriminator.ValueNode,
+            rules.isBreaking(SchemaObject, v3.DiscriminatorLabel, ObjectAdded), nil, rSchema.Discriminator.Value)
     }
     // removed Discriminator
     if lSchema.Discriminator.Value != nil && rSchema.Discriminator.Value == nil {
-        CreateChange(changes, ObjectRemoved, v3.DiscriminatorLabel,
-            lSchema.Discriminator.ValueNode, nil, true, lSchema.Discriminator.Value, nil)
+        CreateChange(changes, ObjectRemoved, v3.DiscriminatorLabel, lSchema.Discriminator.ValueNode, nil,
+            rules.isBreaking(SchemaObject, v3.DiscriminatorLabel, ObjectRemoved), lSchema.Discriminator.Value, nil)
     }
 
     // ExternalDocs
     if lSchema.ExternalDocs.Value != nil && rSchema.ExternalDocs.Value != nil {
         // check if hash matches, if not then compare.
         if lSchema.ExternalDocs.Value.Hash() != rSchema.ExternalDocs.Value.Hash() {
-            sc.ExternalDocChanges = CompareExternalDocs(lSchema.ExternalDocs.Value, rSchema.ExternalDocs.Value)
+            sc.ExternalDocChanges = compareExternalDocs(lSchema.ExternalDocs.Value, rSchema.ExternalDocs.Value, rules)
         }
     }
     // added ExternalDocs
     if lSchema.ExternalDocs.Value == nil && rSchema.ExternalDocs.Value != nil {
-        CreateChange(changes, ObjectAdded, v3.ExternalDocsLabel,
-            nil, rSchema.ExternalDocs.ValueNode, false, nil, rSchema.ExternalDocs.Value)
+        CreateChange(changes, ObjectAdded, v3.ExternalDocsLabel, nil, rSchema.ExternalDocs.ValueNode,
+            rules.isBreaking(SchemaObject, v3.ExternalDocsLabel, ObjectAdded), nil, rSchema.ExternalDocs.Value)
     }
     // removed ExternalDocs
     if lSchema.ExternalDocs.Value != nil && rSchema.ExternalDocs.Value == nil {
-        CreateChange(changes, ObjectRemoved, v3.ExternalDocsLabel,
-            lSchema.ExternalDocs.ValueNode, nil, false, lSchema.ExternalDocs.Value, nil)
+        CreateChange(changes, ObjectRemoved, v3.ExternalDocsLabel, lSchema.ExternalDocs.ValueNode, nil,
+            rules.isBreaking(SchemaObject, v3.ExternalDocsLabel, ObjectRemoved), lSchema.ExternalDocs.Value, nil)
     }
 
     // 3.1 properties
     // If
     if lSchema.If.Value != nil && rSchema.If.Value != nil {
         if !low.AreEqual(lSchema.If.Value, rSchema.If.Value) {
-            sc.IfChanges = CompareSchemas(lSchema.If.Value, rSchema.If.Value)
+            sc.IfChanges = compareSchemas(lSchema.If.Value, rSchema.If.Value, usage, rules)
         }
     }
     // added If
     if lSchema.If.Value == nil && rSchema.If.Value != nil {
         CreateChange(changes, ObjectAdded, v3.IfLabel,
-            nil, rSchema.If.ValueNode, true, nil, rSchema.If.Value)
+            nil, rSchema.If.ValueNode, rules.isBreaking(SchemaObject, v3.IfLabel, ObjectAdded), nil, rSchema.If.Value)
     }
     // removed If
     if lSchema.If.Value != nil && rSchema.If.Value == nil {
         CreateChange(changes, ObjectRemoved, v3.IfLabel,
-            lSchema.If.ValueNode, nil, true, lSchema.If.Value, nil)
+            lSchema.If.ValueNode, nil, rules.isBreaking(SchemaObject, v3.IfLabel, ObjectRemoved), lSchema.If.Value, nil)
     }
     // Else
     if lSchema.Else.Value != nil && rSchema.Else.Value != nil {
         if !low.AreEqual(lSchema.Else.Value, rSchema.Else.Value) {
-            sc.ElseChanges = CompareSchemas(lSchema.Else.Value, rSchema.Else.Value)
+            sc.ElseChanges = compareSchemas(lSchema.Else.Value, rSchema.Else.Value, usage, rules)
         }
     }
     // added Else
     if lSchema.Else.Value == nil && rSchema.Else.Value != nil {
         CreateChange(changes, ObjectAdded, v3.ElseLabel,
-            nil, rSchema.Else.ValueNode, true, nil, rSchema.Else.Value)
+            nil, rSchema.Else.ValueNode, rules.isBreaking(SchemaObject, v3.ElseLabel, ObjectAdded), nil,
+            rSchema.Else.Value)
     }
     // removed Else
     if lSchema.Else.Value != nil && rSchema.Else.Value == nil {
         CreateChange(changes, ObjectRemoved, v3.ElseLabel,
-            lSchema.Else.ValueNode, nil, true, lSchema.Else.Value, nil)
+            lSchema.Else.ValueNode, nil, rules.isBreaking(SchemaObject, v3.ElseLabel, ObjectRemoved),
+            lSchema.Else.Value, nil)
     }
     // Then
     if lSchema.Then.Value != nil && rSchema.Then.Value != nil {
         if !low.AreEqual(lSchema.Then.Value, rSchema.Then.Value) {
-            sc.ThenChanges = CompareSchemas(lSchema.Then.Value, rSchema.Then.Value)
+            sc.ThenChanges = compareSchemas(lSchema.Then.Value, rSchema.Then.Value, usage, rules)
         }
     }
     // added Then
     if lSchema.Then.Value == nil && rSchema.Then.Value != nil {
         CreateChange(changes, ObjectAdded, v3.ThenLabel,
-            nil, rSchema.Then.ValueNode, true, nil, rSchema.Then.Value)
+            nil, rSchema.Then.ValueNode, rules.isBreaking(SchemaObject, v3.ThenLabel, ObjectAdded), nil,
+            rSchema.Then.Value)
     }
     // removed Then
     if lSchema.Then.Value != nil && rSchema.Then.Value == nil {
         CreateChange(changes, ObjectRemoved, v3.ThenLabel,
-            lSchema.Then.ValueNode, nil, true, lSchema.Then.Value, nil)
+            lSchema.Then.ValueNode, nil, rules.isBreaking(SchemaObject, v3.ThenLabel, ObjectRemoved),
+            lSchema.Then.Value, nil)
     }
     // PropertyNames
     if lSchema.PropertyNames.Value != nil && rSchema.PropertyNames.Value != nil {
         if !low.AreEqual(lSchema.PropertyNames.Value, rSchema.PropertyNames.Value) {
-            sc.PropertyNamesChanges = CompareSchemas(lSchema.PropertyNames.Value, rSchema.PropertyNames.Value)
+            sc.PropertyNamesChanges = compareSchemas(lSchema.PropertyNames.Value, rSchema.PropertyNames.Value,
+                usage, rules)
         }
     }
     // added PropertyNames
     if lSchema.PropertyNames.Value == nil && rSchema.PropertyNames.Value != nil {
-        CreateChange(changes, ObjectAdded, v3.PropertyNamesLabel,
-            nil, rSchema.PropertyNames.ValueNode, true, nil, rSchema.PropertyNames.Value)
+        CreateChange(changes, ObjectAdded, v3.PropertyNamesLabel, nil, rSchema.PropertyNames.ValueNode,
+            rules.isBreaking(SchemaObject, v3.PropertyNamesLabel, ObjectAdded), nil, rSchema.PropertyNames.Value)
     }
     // removed PropertyNames
     if lSchema.PropertyNames.Value != nil && rSchema.PropertyNames.Value == nil {
-        CreateChange(changes, ObjectRemoved, v3.PropertyNamesLabel,
-            lSchema.PropertyNames.ValueNode, nil, true, lSchema.PropertyNames.Value, nil)
+        CreateChange(changes, ObjectRemoved, v3.PropertyNamesLabel, lSchema.PropertyNames.ValueNode, nil,
+            rules.isBreaking(SchemaObject, v3.PropertyNamesLabel, ObjectRemoved), lSchema.PropertyNames.Value, nil)
     }
     // Contains
     if lSchema.Contains.Value != nil && rSchema.Contains.Value != nil {
         if !low.AreEqual(lSchema.Contains.Value, rSchema.Contains.Value) {
-            sc.ContainsChanges = CompareSchemas(lSchema.Contains.Value, rSchema.Contains.Value)
+            sc.ContainsChanges = compareSchemas(lSchema.Contains.Value, rSchema.Contains.Value, usage, rules)
         }
     }
     // added Contains
     if lSchema.Contains.Value == nil && rSchema.Contains.Value != nil {
-        CreateChange(changes, ObjectAdded, v3.ContainsLabel,
-            nil, rSchema.Contains.ValueNode, true, nil, rSchema.Contains.Value)
+        CreateChange(changes, ObjectAdded, v3.ContainsLabel, nil, rSchema.Contains.ValueNode,
+            rules.isBreaking(SchemaObject, v3.ContainsLabel, ObjectAdded), nil, rSchema.Contains.Value)
     }
     // removed Contains
     if lSchema.Contains.Value != nil && rSchema.Contains.Value == nil {
-        CreateChange(changes, ObjectRemoved, v3.ContainsLabel,
-            lSchema.Contains.ValueNode, nil, true, lSchema.Contains.Value, nil)
+        CreateChange(changes, ObjectRemoved, v3.ContainsLabel, lSchema.Contains.ValueNode, nil,
+            rules.isBreaking(SchemaObject, v3.ContainsLabel, ObjectRemoved), lSchema.Contains.Value, nil)
     }
     // UnevaluatedItems
     if lSchema.UnevaluatedItems.Value != nil && rSchema.UnevaluatedItems.Value != nil {
         if !low.AreEqual(lSchema.UnevaluatedItems.Value, rSchema.UnevaluatedItems.Value) {
-            sc.UnevaluatedItemsChanges = CompareSchemas(lSchema.UnevaluatedItems.Value, rSchema.UnevaluatedItems.Value)
+            sc.UnevaluatedItemsChanges = compareSchemas(lSchema.UnevaluatedItems.Value,
+                rSchema.UnevaluatedItems.Value, usage, rules)
         }
     }
     // added UnevaluatedItems
     if lSchema.UnevaluatedItems.Value == nil && rSchema.UnevaluatedItems.Value != nil {
-        CreateChange(changes, ObjectAdded, v3.UnevaluatedItemsLabel,
-            nil, rSchema.UnevaluatedItems.ValueNode, true, nil, rSchema.UnevaluatedItems.Value)
+        CreateChange(changes, ObjectAdded, v3.UnevaluatedItemsLabel, nil, rSchema.UnevaluatedItems.ValueNode,
+            rules.isBreaking(SchemaObject, v3.UnevaluatedItemsLabel, ObjectAdded), nil, rSchema.UnevaluatedItems.Value)
     }
     // removed UnevaluatedItems
     if lSchema.UnevaluatedItems.Value != nil && rSchema.UnevaluatedItems.Value == nil {
-        CreateChange(changes, ObjectRemoved, v3.UnevaluatedItemsLabel,
-            lSchema.UnevaluatedItems.ValueNode, nil, true, lSchema.UnevaluatedItems.Value, nil)
+        CreateChange(changes, ObjectRemoved, v3.UnevaluatedItemsLabel, lSchema.UnevaluatedItems.ValueNode, nil,
+            rules.isBreaking(SchemaObject, v3.UnevaluatedItemsLabel, ObjectRemoved),
+            lSchema.UnevaluatedItems.Value, nil)
     }
     // UnevaluatedProperties
     if lSchema.UnevaluatedProperties.Value != nil && rSchema.UnevaluatedProperties.Value != nil {
         if !low.AreEqual(lSchema.UnevaluatedProperties.Value, rSchema.UnevaluatedProperties.Value) {
-            sc.UnevaluatedPropertiesChanges = CompareSchemas(lSchema.UnevaluatedProperties.Value, rSchema.UnevaluatedProperties.Value)
+            sc.UnevaluatedPropertiesChanges = compareSchemas(lSchema.UnevaluatedProperties.Value,
+                rSchema.UnevaluatedProperties.Value, usage, rules)
         }
     }
     // added UnevaluatedProperties
     if lSchema.UnevaluatedProperties.Value == nil && rSchema.UnevaluatedProperties.Value != nil {
-        CreateChange(changes, ObjectAdded, v3.UnevaluatedPropertiesLabel,
-            nil, rSchema.UnevaluatedProperties.ValueNode, true, nil, rSchema.UnevaluatedProperties.Value)
+        CreateChange(changes, ObjectAdded, v3.UnevaluatedPropertiesLabel, nil, rSchema.UnevaluatedProperties.ValueNode,
+            rules.isBreaking(SchemaObject, v3.UnevaluatedPropertiesLabel, ObjectAdded), nil,
+            rSchema.UnevaluatedProperties.Value)
     }
     // removed UnevaluatedProperties
     if lSchema.UnevaluatedProperties.Value != nil && rSchema.UnevaluatedProperties.Value == nil {
-        CreateChange(changes, ObjectRemoved, v3.UnevaluatedPropertiesLabel,
-            lSchema.UnevaluatedProperties.ValueNode, nil, true, lSchema.UnevaluatedProperties.Value, nil)
+        CreateChange(changes, ObjectRemoved, v3.UnevaluatedPropertiesLabel, lSchema.UnevaluatedProperties.ValueNode,
+            nil, rules.isBreaking(SchemaObject, v3.UnevaluatedPropertiesLabel, ObjectRemoved),
+            lSchema.UnevaluatedProperties.Value, nil)
     }
 
     // Not
     if lSchema.Not.Value != nil && rSchema.Not.Value != nil {
         if !low.AreEqual(lSchema.Not.Value, rSchema.Not.Value) {
-            sc.NotChanges = CompareSchemas(lSchema.Not.Value, rSchema.Not.Value)
+            // narrowing a 'not' schema widens this schema, so the usage is not used.
+            sc.NotChanges = compareSchemas(lSchema.Not.Value, rSchema.Not.Value, SchemaUsageUnknown, rules)
         }
     }
     // added Not
     if lSchema.Not.Value == nil && rSchema.Not.Value != nil {
         CreateChange(changes, ObjectAdded, v3.NotLabel,
-            nil, rSchema.Not.ValueNode, true, nil, rSchema.Not.Value)
+            nil, rSchema.Not.ValueNode, rules.isBreaking(SchemaObject, v3.NotLabel, ObjectAdded), nil,
+            rSchema.Not.Value)
     }
     // removed not
     if lSchema.Not.Value != nil && rSchema.Not.Value == nil {
         CreateChange(changes, ObjectRemoved, v3.NotLabel,
-            lSchema.Not.ValueNode, nil, true, lSchema.Not.Value, nil)
+            lSchema.Not.ValueNode, nil,
+            rules.isBreaking(SchemaObject, v3.NotLabel, ObjectRemoved), lSchema.Not.Value, nil)
     }
 
     // items
     if lSchema.Items.Value != nil && rSchema.Items.Value != nil {
         if lSchema.Items.Value.IsA() && rSchema.Items.Value.IsA() {
             if !low.AreEqual(lSchema.Items.Value.A, rSchema.Items.Value.A) {
-                sc.ItemsChanges = CompareSchemas(lSchema.Items.Value.A, rSchema.Items.Value.A)
+                sc.ItemsChanges = compareSchemas(lSchema.Items.Value.A, rSchema.Items.Value.A, usage, rules)
             }
         } else {
-            CreateChange(changes, Modified, v3.ItemsLabel,
-                lSchema.Items.ValueNode, rSchema.Items.ValueNode, true, lSchema.Items.Value.B, rSchema.Items.Value.B)
+            CreateChange(changes, Modified, v3.ItemsLabel, lSchema.Items.ValueNode, rSchema.Items.ValueNode,
+                rules.isBreaking(SchemaObject, v3.ItemsLabel, Modified), lSchema.Items.Value.B, rSchema.Items.Value.B)
         }
     }
     // added Items
     if lSchema.Items.Value == nil && rSchema.Items.Value != nil {
-        CreateChange(changes, ObjectAdded, v3.ItemsLabel,
-            nil, rSchema.Items.ValueNode, true, nil, rSchema.Items.Value)
+        CreateChange(changes, ObjectAdded, v3.ItemsLabel, nil, rSchema.Items.ValueNode,
+            rules.isBreaking(SchemaObject, v3.ItemsLabel, ObjectAdded), nil, rSchema.Items.Value)
     }
     // removed Items
     if lSchema.Items.Value != nil && rSchema.Items.Value == nil {
-        CreateChange(changes, ObjectRemoved, v3.ItemsLabel,
-            lSchema.Items.ValueNode, nil, true, lSchema.Items.Value, nil)
+        CreateChange(changes, ObjectRemoved, v3.ItemsLabel, lSchema.Items.ValueNode, nil,
+            rules.isBreaking(SchemaObject, v3.ItemsLabel, ObjectRemoved), lSchema.Items.Value, nil)
     }
 
     // check extensions
-    sc.ExtensionChanges = CompareExtensions(lSchema.Extensions, rSchema.Extensions)
+    sc.ExtensionChanges = compareExtensions(lSchema.Extensions, rSchema.Extensions, rules)
 
     // if additional properties is an object, then hash it
     // AdditionalProperties (only if not an object)
@@ -1079,17 +1112,19 @@ func checkSchemaPropertyChanges(
         lHash := low.GenerateHashString(lSchema.AdditionalProperties.ValueNode)
         rHash := low.GenerateHashString(rSchema.AdditionalProperties.ValueNode)
         if lHash != rHash {
-            CreateChange(changes, Modified, v3.AdditionalPropertiesLabel,
-                lSchema.AdditionalProperties.ValueNode, rSchema.AdditionalProperties.ValueNode, false,
-                lSchema.AdditionalProperties.Value, rSchema.AdditionalProperties.Value)
+            CreateChange(changes, Modified, v3.AdditionalPropertiesLabel, lSchema.AdditionalProperties.ValueNode,
+                rSchema.AdditionalProperties.ValueNode,
+                rules.isBreaking(SchemaObject, v3.AdditionalPropertiesLabel, Modified),
+                lSchema.AdditionalProperties.Value,
+                rSchema.AdditionalProperties.Value)
         }
     }
 
     // check core properties
-    CheckProperties(props)
+    checkProperties(props, rules)
 }
 
-func checkExamples(lSchema *base.Schema, rSchema *base.Schema, changes *[]*Change) {
+func checkExamples(lSchema *base.Schema, rSchema *base.Schema, changes *[]*Change, rules BreakingRules) {
     // check examples (3.1+)
     var lExampKey, rExampKey []string
     lExampN := make(map[string]*yaml.Node)
@@ -1121,7 +1156,8 @@ func checkExamples(lSchema *base.Schema, rSchema *base.Schema, changes *[]*Chang
         for i := range lExampKey {
             if lExampKey[i] != rExampKey[i] {
                 CreateChange(changes, Modified, v3.ExamplesLabel,
-                    lExampN[lExampKey[i]], rExampN[rExampKey[i]], false,
+                    lExampN[lExampKey[i]], rExampN[rExampKey[i]],
+                    rules.isBreaking(SchemaObject, v3.ExamplesLabel, Modified),
                     lExampVal[lExampKey[i]], rExampVal[rExampKey[i]])
             }
         }
@@ -1131,12 +1167,13 @@ func checkExamples(lSchema *base.Schema, rSchema *base.Schema, changes *[]*Chang
         for i := range lExampKey {
             if i < len(rExampKey) && lExampKey[i] != rExampKey[i] {
                 CreateChange(changes, Modified, v3.ExamplesLabel,
-                    lExampN[lExampKey[i]], rExampN[rExampKey[i]], false,
+                    lExampN[lExampKey[i]], rExampN[rExampKey[i]],
+                    rules.isBreaking(SchemaObject, v3.ExamplesLabel, Modified),
                     lExampVal[lExampKey[i]], rExampVal[rExampKey[i]])
             }
             if i >= len(rExampKey) {
                 CreateChange(changes, ObjectRemoved, v3.ExamplesLabel,
-                    lExampN[lExampKey[i]], nil, false,
+                    lExampN[lExampKey[i]], nil, rules.isBreaking(SchemaObject, v3.ExamplesLabel, ObjectRemoved),
                     lExampVal[lExampKey[i]], nil)
             }
         }
@@ -1147,12 +1184,13 @@ func checkExamples(lSchema *base.Schema, rSchema *base.Schema, changes *[]*Chang
         for i := range rExampKey {
             if i < len(lExampKey) && lExampKey[i] != rExampKey[i] {
                 CreateChange(changes, Modified, v3.ExamplesLabel,
-                    lExampN[lExampKey[i]], rExampN[rExampKey[i]], false,
+                    lExampN[lExampKey[i]], rExampN[rExampKey[i]],
+                    rules.isBreaking(SchemaObject, v3.ExamplesLabel, Modified),
                     lExampVal[lExampKey[i]], rExampVal[rExampKey[i]])
             }
             if i >= len(lExampKey) {
                 CreateChange(changes, ObjectAdded, v3.ExamplesLabel,
-                    nil, rExampN[rExampKey[i]], false,
+                    nil, rExampN[rExampKey[i]], rules.isBreaking(SchemaObject, v3.ExamplesLabel, ObjectAdded),
                     nil, rExampVal[rExampKey[i]])
             }
         }
@@ -1165,6 +1203,8 @@ func extractSchemaChanges(
     label string,
     sc *[]*SchemaChanges,
     changes *[]*Change,
+    usage SchemaUsage,
+    rules BreakingRules,
     done chan bool) {
 
     // if there is nothing here, there is nothing to do.
@@ -1203,7 +1243,7 @@ func extractSchemaChanges(
         for w := range lKeys {
             // keys are different, which means there are changes.
             if lKeys[w] != rKeys[w] {
-                *sc = append(*sc, CompareSchemas(lEntities[lKeys[w]], rEntities[rKeys[w]]))
+                *sc = append(*sc, compareSchemas(lEntities[lKeys[w]], rEntities[rKeys[w]], usage, rules))
             }
         }
     }
@@ -1212,11 +1252,11 @@ func extractSchemaChanges(
     if len(lKeys) > len(rKeys) {
         for w := range lKeys {
             if w < len(rKeys) && lKeys[w] != rKeys[w] {
-                *sc = append(*sc, CompareSchemas(lEntities[lKeys[w]], rEntities[rKeys[w]]))
+                *sc = append(*sc, compareSchemas(lEntities[lKeys[w]], rEntities[rKeys[w]], usage, rules))
             }
             if w >= len(rKeys) {
-                CreateChange(changes, ObjectRemoved, label,
-                    lEntities[lKeys[w]].GetValueNode(), nil, true, lEntities[lKeys[w]], nil)
+                CreateChange(changes, ObjectRemoved, label, lEntities[lKeys[w]].GetValueNode(), nil,
+                    rules.isBreaking(SchemaObject, label, ObjectRemoved), lEntities[lKeys[w]], nil)
             }
         }
     }
@@ -1225,11 +1265,11 @@ func extractSchemaChanges(
     if len(rKeys) > len(lKeys) {
         for w := range rKeys {
             if w < len(lKeys) && rKeys[w] != lKeys[w] {
-                *sc = append(*sc, CompareSchemas(lEntities[lKeys[w]], rEntities[rKeys[w]]))
+                *sc = append(*sc, compareSchemas(lEntities[lKeys[w]], rEntities[rKeys[w]], usage, rules))
             }
             if w >= len(lKeys) {
-                CreateChange(changes, ObjectAdded, label,
-                    nil, rEntities[rKeys[w]].GetValueNode(), false, nil, rEntities[rKeys[w]])
+                CreateChange(changes, ObjectAdded, label, nil, rEntities[rKeys[w]].GetValueNode(),
+                    rules.isBreaking(SchemaObject, label, ObjectAdded), nil, rEntities[rKeys[w]])
             }
         }
     }
@@ -0,0 +1,152 @@
+// Copyright 2023 Princess B33f Heavy Industries / Dave Shanley
+// SPDX-License-Identifier: MIT
+
+package model
+
+import (
+	"strconv"
+
+	v3 "github.com/pb33f/libopenapi/datamodel/low/v3"
+)
+
+// SchemaUsage describes where a schema is used, which decides if narrowing or widening a schema is a breaking change.
+type SchemaUsage int
+
+const (
+	// SchemaUsageUnknown is used for schemas that are not (directly) used by a request or a response, like
+	// component schemas. Changes keep their default classification.
+	SchemaUsageUnknown SchemaUsage = iota
+
+	// SchemaUsageRequest is used for schemas that describe data sent by a client, like parameters and request
+	// bodies. Narrowing a request schema is breaking (clients may send data that is no longer accepted),
+	// widening it is not.
+	SchemaUsageRequest
+
+	// SchemaUsageResponse is used for schemas that describe data sent by a server, like responses and response
+	// headers. Widening a response schema is breaking (clients may receive data they can't handle), narrowing it
+	// is not. The requests of callbacks and webhooks are sent by a server, so they use SchemaUsageResponse (and
+	// their responses use SchemaUsageRequest).
+	SchemaUsageResponse
+)
+
+// invert returns the usage of data sent in the opposite direction, like the responses of an operation that
+// receives requests with this usage.
+func (u SchemaUsage) invert() SchemaUsage {
+	switch u {
+	case SchemaUsageRequest:
+		return SchemaUsageResponse
+	case SchemaUsageResponse:
+		return SchemaUsageRequest
+	}
+	return SchemaUsageUnknown
+}
+
+// schemaDirection is the effect a change has on the values a schema accepts.
+type schemaDirection int
+
+const (
+	undirected schemaDirection = iota
+	narrowing
+	widening
+)
+
+// applySchemaUsage classifies every change made to the properties of an object (like a schema) that narrows or
+// widens the values it accepts (like changing maxLength, enum or required), using where the object is used. For
+// example, raising maxLength is not breaking for a request, but is breaking for a response. Changes that have a rule
+// in rules keep their classification.
+func applySchemaUsage(changes []*Change, object string, usage SchemaUsage, rules BreakingRules) {
+	if usage == SchemaUsageUnknown {
+		return
+	}
+	for _, c := range changes {
+		if _, found := rules.IsBreaking(object, c.Property, c.ChangeType); found {
+			continue
+		}
+		switch schemaChangeDirection(c) {
+		case narrowing:
+			c.Breaking = usage == SchemaUsageRequest
+		case widening:
+			c.Breaking = usage == SchemaUsageResponse
+		}
+	}
+}
+
+// schemaChangeDirection returns if a change made to a schema narrows or widens the values it accepts.
+func schemaChangeDirection(c *Change) schemaDirection {
+	switch c.Property {
+	case v3.MaximumLabel, v3.MaxLengthLabel, v3.MaxItemsLabel, v3.MaxPropertiesLabel:
+		return boundDirection(c, true)
+	case v3.MinimumLabel, v3.MinLengthLabel, v3.MinItemsLabel, v3.MinPropertiesLabel:
+		return boundDirection(c, false)
+	case v3.ExclusiveMaximumLabel, v3.ExclusiveMinimumLabel:
+		// OpenAPI 3.0 uses a boolean, OpenAPI 3.1 uses the bound itself.
+		if isBoolOrEmpty(c.Original) && isBoolOrEmpty(c.New) {
+			return flagDirection(c, narrowing)
+		}
+		return boundDirection(c, c.Property == v3.ExclusiveMaximumLabel)
+	case v3.UniqueItemsLabel:
+		return flagDirection(c, narrowing)
+	case v3.NullableLabel:
+		return flagDirection(c, widening)
+	case v3.EnumLabel:
+		return addedOrRemoved(c, widening)
+	case v3.RequiredLabel:
+		return addedOrRemoved(c, narrowing)
+	}
+	return undirected
+}
+
+// boundDirection returns the direction of a change made to an upper (or lower) bound, like maxLength (or minLength).
+func boundDirection(c *Change, upper bool) schemaDirection {
+	if c.ChangeType != Modified {
+		return addedOrRemoved(c, narrowing)
+	}
+	original, errO := strconv.ParseFloat(c.Original, 64)
+	updated, errN := strconv.ParseFloat(c.New, 64)
+	switch {
+	case errO != nil || errN != nil || original == updated:
+		return undirected
+	case (updated < original) == upper:
+		return narrowing
+	}
+	return widening
+}
+
+// flagDirection returns the direction of a change made to a boolean, when setting it to true has the effect enabled.
+func flagDirection(c *Change, enabled schemaDirection) schemaDirection {
+	original, _ := strconv.ParseBool(c.Original)
+	updated, _ := strconv.ParseBool(c.New)
+	switch {
+	case original == updated:
+		return undirected
+	case updated:
+		return enabled
+	}
+	return opposite(enabled)
+}
+
+// addedOrRemoved returns the direction of a change that adds (or removes) a value, when adding it has the effect added.
+func addedOrRemoved(c *Change, added schemaDirection) schemaDirection {
+	switch c.ChangeType {
+	case PropertyAdded, ObjectAdded:
+		return added
+	case PropertyRemoved, ObjectRemoved:
+		return opposite(added)
+	}
+	return undirected
+}
+
+func opposite(direction schemaDirection) schemaDirection {
+	switch direction {
+	case narrowing:
+		return widening
+	case widening:
+		return narrowing
+	}
+	return undirected
+}
+
+func isBoolOrEmpty(value string) bool {
+	_, err := strconv.ParseBool(value)
+	return value == "" || err == nil
+}
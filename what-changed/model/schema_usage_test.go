@@ -0,0 +1,214 @@
+// Copyright 2023 Princess B33f Heavy Industries / Dave Shanley
+// SPDX-License-Identifier: MIT
+
+package model
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/pb33f/libopenapi/datamodel/low"
+	"github.com/pb33f/libopenapi/datamodel/low/base"
+	v3 "github.com/pb33f/libopenapi/datamodel/low/v3"
+	"github.com/pb33f/libopenapi/index"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/yaml.v3"
+)
+
+// compareUsage compares the same change to a schema used by a request body, and by a response.
+func compareUsage(t *testing.T, left, right string) (*RequestBodyChanges, *ResponseChanges) {
+	build := func(schema string, model interface {
+		Build(root *yaml.Node, idx *index.SpecIndex) error
+	}) {
+		var node yaml.Node
+		assert.NoError(t, yaml.Unmarshal([]byte(`description: pets
+content:
+  application/json:
+    schema:
+`+schema), &node))
+		_ = low.BuildModel(node.Content[0], model)
+		_ = model.Build(node.Content[0], nil)
+	}
+	var lBody, rBody v3.RequestBody
+	var lResp, rResp v3.Response
+	build(left, &lBody)
+	build(right, &rBody)
+	build(left, &lResp)
+	build(right, &rResp)
+	return CompareRequestBodies(&lBody, &rBody), CompareResponse(&lResp, &rResp)
+}
+
+func TestSchemaUsage_Widening(t *testing.T) {
+	left := `      type: object
+      required: [name, age]
+      properties:
+        name:
+          type: string
+          maxLength: 10
+          minLength: 5
+        kind:
+          type: string
+          enum: [dog, cat]`
+	right := `      type: object
+      required: [name]
+      properties:
+        name:
+          type: string
+          maxLength: 20
+        kind:
+          type: string
+          enum: [dog, cat, fish]`
+
+	request, response := compareUsage(t, left, right)
+	assert.Equal(t, 4, request.TotalChanges())
+	assert.Equal(t, 0, request.TotalBreakingChanges())
+	assert.Equal(t, 4, response.TotalChanges())
+	assert.Equal(t, 4, response.TotalBreakingChanges())
+}
+
+func TestSchemaUsage_Narrowing(t *testing.T) {
+	left := `      type: array
+      maxItems: 10
+      items:
+        type: integer
+        exclusiveMaximum: 100
+        nullable: true`
+	right := `      type: array
+      maxItems: 5
+      minItems: 1
+      items:
+        type: integer
+        exclusiveMaximum: 50`
+
+	request, response := compareUsage(t, left, right)
+	assert.Equal(t, 4, request.TotalChanges())
+	assert.Equal(t, 4, request.TotalBreakingChanges())
+	assert.Equal(t, 4, response.TotalChanges())
+	assert.Equal(t, 0, response.TotalBreakingChanges())
+}
+
+func TestSchemaUsage_Unchanged(t *testing.T) {
+	// changes without a direction keep their default, and changes made inside 'not' are not classified.
+	left := `      type: string
+      not:
+        maxLength: 3`
+	right := `      type: integer
+      not:
+        maxLength: 5`
+
+	request, response := compareUsage(t, left, right)
+	assert.Equal(t, 2, request.TotalBreakingChanges())
+	assert.Equal(t, 2, response.TotalBreakingChanges())
+}
+
+func TestCompareSchemasWithUsage(t *testing.T) {
+	var lNode, rNode yaml.Node
+	_ = yaml.Unmarshal([]byte(`type: string
+maxLength: 10`), &lNode)
+	_ = yaml.Unmarshal([]byte(`type: string
+maxLength: 5`), &rNode)
+
+	lSchema, rSchema := new(base.SchemaProxy), new(base.SchemaProxy)
+	_ = lSchema.Build(lNode.Content[0], nil)
+	_ = rSchema.Build(rNode.Content[0], nil)
+
+	// schemas that are compared directly keep their default classification.
+	assert.Equal(t, 1, CompareSchemas(lSchema, rSchema).TotalBreakingChanges())
+	assert.Equal(t, 1, CompareSchemasWithUsage(lSchema, rSchema, SchemaUsageUnknown).TotalBreakingChanges())
+	assert.Equal(t, 1, CompareSchemasWithUsage(lSchema, rSchema, SchemaUsageRequest).TotalBreakingChanges())
+	assert.Equal(t, 0, CompareSchemasWithUsage(lSchema, rSchema, SchemaUsageResponse).TotalBreakingChanges())
+}
+
+func TestCompareOperations_SchemaUsage(t *testing.T) {
+	operation := func(limit int) string {
+		return fmt.Sprintf(`parameters:
+  - name: id
+    in: query
+    schema:
+      type: string
+      maxLength: %[1]d
+requestBody:
+  content:
+    application/json:
+      schema:
+        type: string
+        maxLength: %[1]d
+responses:
+  "200":
+    description: OK
+    content:
+      application/json:
+        schema:
+          type: string
+          maxLength: %[1]d`, limit)
+	}
+	callback := func(limit int) string {
+		return fmt.Sprintf("callbacks:\n  hook:\n    '{$request.query.url}':\n      post:\n%s",
+			strings.ReplaceAll("\n"+operation(limit), "\n", "\n        "))
+	}
+	compare := func(left, right string) *OperationChanges {
+		var lNode, rNode yaml.Node
+		_ = yaml.Unmarshal([]byte(left), &lNode)
+		_ = yaml.Unmarshal([]byte(right), &rNode)
+		var lOp, rOp v3.Operation
+		_ = low.BuildModel(lNode.Content[0], &lOp)
+		_ = low.BuildModel(rNode.Content[0], &rOp)
+		_ = lOp.Build(lNode.Content[0], nil)
+		_ = rOp.Build(rNode.Content[0], nil)
+		return CompareOperations(&lOp, &rOp)
+	}
+	// raising maxLength widens the parameter, request body and response.
+	oc := compare(operation(10), operation(20))
+	assert.Equal(t, 3, oc.TotalChanges())
+	assert.Equal(t, 0, oc.ParameterChanges[0].TotalBreakingChanges())
+	assert.Equal(t, 0, oc.RequestBodyChanges.TotalBreakingChanges())
+	assert.Equal(t, 1, oc.ResponsesChanges.TotalBreakingChanges())
+
+	// the requests of a callback are sent by the server, so the direction is inverted.
+	oc = compare(callback(10), callback(20))
+	post := oc.CallbackChanges["hook"].ExpressionChanges["{$request.query.url}"].PostChanges
+	assert.Equal(t, 3, post.TotalChanges())
+	assert.Equal(t, 1, post.ParameterChanges[0].TotalBreakingChanges())
+	assert.Equal(t, 1, post.RequestBodyChanges.TotalBreakingChanges())
+	assert.Equal(t, 0, post.ResponsesChanges.TotalBreakingChanges())
+
+	// callbacks compared directly are classified in the same way.
+	var lNode, rNode yaml.Node
+	_ = yaml.Unmarshal([]byte(callback(10)), &lNode)
+	_ = yaml.Unmarshal([]byte(callback(20)), &rNode)
+	var lCallback, rCallback v3.Callback
+	_ = low.BuildModel(lNode.Content[0].Content[1].Content[1], &lCallback)
+	_ = low.BuildModel(rNode.Content[0].Content[1].Content[1], &rCallback)
+	_ = lCallback.Build(lNode.Content[0].Content[1].Content[1], nil)
+	_ = rCallback.Build(rNode.Content[0].Content[1].Content[1], nil)
+	assert.Equal(t, 2, CompareCallback(&lCallback, &rCallback).TotalBreakingChanges())
+}
+
+func TestSchemaUsage_Invert(t *testing.T) {
+	assert.Equal(t, SchemaUsageResponse, SchemaUsageRequest.invert())
+	assert.Equal(t, SchemaUsageRequest, SchemaUsageResponse.invert())
+	assert.Equal(t, SchemaUsageUnknown, SchemaUsageUnknown.invert())
+}
+
+func TestSchemaChangeDirection(t *testing.T) {
+	for _, tc := range []struct {
+		change    Change
+		direction schemaDirection
+	}{
+		{Change{Property: v3.MinimumLabel, ChangeType: Modified, Original: "1", New: "2"}, narrowing},
+		{Change{Property: v3.MinimumLabel, ChangeType: PropertyRemoved, Original: "1"}, widening},
+		{Change{Property: v3.MaxLengthLabel, ChangeType: PropertyAdded, New: "1"}, narrowing},
+		{Change{Property: v3.MaxLengthLabel, ChangeType: Modified, Original: "1", New: "nope"}, undirected},
+		{Change{Property: v3.ExclusiveMinimumLabel, ChangeType: Modified, Original: "false", New: "true"}, narrowing},
+		{Change{Property: v3.ExclusiveMinimumLabel, ChangeType: Modified, Original: "5", New: "3"}, widening},
+		{Change{Property: v3.NullableLabel, ChangeType: PropertyAdded, New: "true"}, widening},
+		{Change{Property: v3.UniqueItemsLabel, ChangeType: Modified, Original: "true", New: "false"}, widening},
+		{Change{Property: v3.RequiredLabel, ChangeType: PropertyAdded, New: "name"}, narrowing},
+		{Change{Property: v3.EnumLabel, ChangeType: PropertyRemoved, Original: "dog"}, narrowing},
+		{Change{Property: v3.TypeLabel, ChangeType: Modified, Original: "string", New: "integer"}, undirected},
+	} {
+		c := tc.change
+		assert.Equal(t, tc.direction, schemaChangeDirection(&c), "%s %s", c.Property, ChangeTypeName(c.ChangeType))
+	}
+}
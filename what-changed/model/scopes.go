@@ -42,6 +42,11 @@ func (s *ScopesChanges) TotalBreakingChanges() int {
 // CompareScopes compares a left and right Swagger Scopes objects for changes. If anything is found, returns
 // a pointer to ScopesChanges, or returns nil if nothing is found.
 func CompareScopes(l, r *v2.Scopes) *ScopesChanges {
+    return compareScopes(l, r, nil)
+}
+
+// compareScopes compares a left and right Swagger Scopes object, using rules before the default BreakingRules.
+func compareScopes(l, r *v2.Scopes, rules BreakingRules) *ScopesChanges {
     if low.AreEqual(l, r) {
         return nil
     }
@@ -49,28 +54,27 @@ func CompareScopes(l, r *v2.Scopes) *ScopesChanges {
     for v := range l.Values {
         if r != nil && r.FindScope(v.Value) == nil {
             CreateChange(&changes, ObjectRemoved, v3.Scopes,
-                l.Values[v].ValueNode, nil, true,
+                l.Values[v].ValueNode, nil, rules.isBreaking(ScopesObject, v3.Scopes, ObjectRemoved),
                 v.Value, nil)
             continue
         }
         if r != nil && r.FindScope(v.Value) != nil {
             if l.Values[v].Value != r.FindScope(v.Value).Value {
-                CreateChange(&changes, Modified, v3.Scopes,
-                    l.Values[v].ValueNode, r.FindScope(v.Value).ValueNode, true,
-                    l.Values[v].Value, r.FindScope(v.Value).Value)
+                CreateChange(&changes, Modified, v3.Scopes, l.Values[v].ValueNode, r.FindScope(v.Value).ValueNode,
+                    rules.isBreaking(ScopesObject, v3.Scopes, Modified), l.Values[v].Value, r.FindScope(v.Value).Value)
             }
         }
     }
     for v := range r.Values {
         if l != nil && l.FindScope(v.Value) == nil {
             CreateChange(&changes, ObjectAdded, v3.Scopes,
-                nil, r.Values[v].ValueNode, false,
+                nil, r.Values[v].ValueNode, rules.isBreaking(ScopesObject, v3.Scopes, ObjectAdded),
                 nil, v.Value)
         }
     }
 
     sc := new(ScopesChanges)
     sc.PropertyChanges = NewPropertyChanges(changes)
-    sc.ExtensionChanges = CompareExtensions(l.Extensions, r.Extensions)
+    sc.ExtensionChanges = compareExtensions(l.Extensions, r.Extensions, rules)
     return sc
 }
@@ -33,6 +33,12 @@ func (s *SecurityRequirementChanges) TotalBreakingChanges() int {
 // CompareSecurityRequirement compares left and right SecurityRequirement objects for changes. If anything
 // is found, then a pointer to SecurityRequirementChanges is returned, otherwise nil.
 func CompareSecurityRequirement(l, r *base.SecurityRequirement) *SecurityRequirementChanges {
+    return compareSecurityRequirement(l, r, nil)
+}
+
+// compareSecurityRequirement compares a left and right SecurityRequirement object, using rules before the default
+// BreakingRules.
+func compareSecurityRequirement(l, r *base.SecurityRequirement, rules BreakingRules) *SecurityRequirementChanges {
 
     var changes []*Change
     sc := new(SecurityRequirementChanges)
@@ -40,24 +46,24 @@ func CompareSecurityRequirement(l, r *base.SecurityRequirement) *SecurityRequire
     if low.AreEqual(l, r) {
         return nil
     }
-    checkSecurityRequirement(l.Requirements.Value, r.Requirements.Value, &changes)
+    checkSecurityRequirement(l.Requirements.Value, r.Requirements.Value, &changes, rules)
     sc.PropertyChanges = NewPropertyChanges(changes)
     return sc
 }
 
-func removedSecurityRequirement(vn *yaml.Node, name string, changes *[]*Change) {
+func removedSecurityRequirement(vn *yaml.Node, name string, changes *[]*Change, rules BreakingRules) {
     CreateChange(changes, ObjectRemoved, v3.SecurityLabel,
-        vn, nil, true, name, nil)
+        vn, nil, rules.isBreaking(SecurityRequirementObject, v3.SecurityLabel, ObjectRemoved), name, nil)
 }
 
-func addedSecurityRequirement(vn *yaml.Node, name string, changes *[]*Change) {
+func addedSecurityRequirement(vn *yaml.Node, name string, changes *[]*Change, rules BreakingRules) {
     CreateChange(changes, ObjectAdded, v3.SecurityLabel,
-        nil, vn, false, nil, name)
+        nil, vn, rules.isBreaking(SecurityRequirementObject, v3.SecurityLabel, ObjectAdded), nil, name)
 }
 
 // tricky to do this correctly, this is my solution.
 func checkSecurityRequirement(lSec, rSec map[low.KeyReference[string]]low.ValueReference[[]low.ValueReference[string]],
-    changes *[]*Change) {
+    changes *[]*Change, rules BreakingRules) {
 
     lKeys := make([]string, len(lSec))
     rKeys := make([]string, len(rSec))
@@ -78,7 +84,7 @@ func checkSecurityRequirement(lSec, rSec map[low.KeyReference[string]]low.ValueR
     for z = range lKeys {
         if z < len(rKeys) {
             if _, ok := rValues[lKeys[z]]; !ok {
-                removedSecurityRequirement(lValues[lKeys[z]].ValueNode, lKeys[z], changes)
+                removedSecurityRequirement(lValues[lKeys[z]].ValueNode, lKeys[z], changes, rules)
                 continue
             }
 
@@ -105,45 +111,45 @@ func checkSecurityRequirement(lSec, rSec map[low.KeyReference[string]]low.ValueR
             for t = range lRoleKeys {
                 if t < len(rRoleKeys) {
                     if _, ok := rRoleValues[lRoleKeys[t]]; !ok {
-                        removedSecurityRequirement(lRoleValues[lRoleKeys[t]].ValueNode, lRoleKeys[t], changes)
+                        removedSecurityRequirement(lRoleValues[lRoleKeys[t]].ValueNode, lRoleKeys[t], changes, rules)
                         continue
                     }
                 }
                 if t >= len(rRoleKeys) {
                     if _, ok := rRoleValues[lRoleKeys[t]]; !ok {
-                        removedSecurityRequirement(lRoleValues[lRoleKeys[t]].ValueNode, lRoleKeys[t], changes)
+                        removedSecurityRequirement(lRoleValues[lRoleKeys[t]].ValueNode, lRoleKeys[t], changes, rules)
                     }
                 }
             }
             for t = range rRoleKeys {
                 if t < len(lRoleKeys) {
                     if _, ok := lRoleValues[rRoleKeys[t]]; !ok {
-                        addedSecurityRequirement(rRoleValues[rRoleKeys[t]].ValueNode, rRoleKeys[t], changes)
+                        addedSecurityRequirement(rRoleValues[rRoleKeys[t]].ValueNode, rRoleKeys[t], changes, rules)
                         continue
                     }
                 }
                 if t >= len(lRoleKeys) {
-                    addedSecurityRequirement(rRoleValues[rRoleKeys[t]].ValueNode, rRoleKeys[t], changes)
+                    addedSecurityRequirement(rRoleValues[rRoleKeys[t]].ValueNode, rRoleKeys[t], changes, rules)
                 }
             }
 
         }
         if z >= len(rKeys) {
             if _, ok := rValues[lKeys[z]]; !ok {
-                removedSecurityRequirement(lValues[lKeys[z]].ValueNode, lKeys[z], changes)
+                removedSecurityRequirement(lValues[lKeys[z]].ValueNode, lKeys[z], changes, rules)
             }
         }
     }
     for z = range rKeys {
         if z < len(lKeys) {
             if _, ok := lValues[rKeys[z]]; !ok {
-                addedSecurityRequirement(rValues[rKeys[z]].ValueNode, rKeys[z], changes)
+                addedSecurityRequirement(rValues[rKeys[z]].ValueNode, rKeys[z], changes, rules)
                 continue
             }
         }
         if z >= len(lKeys) {
             if _, ok := lValues[rKeys[z]]; !ok {
-                addedSecurityRequirement(rValues[rKeys[z]].ValueNode, rKeys[z], changes)
+                addedSecurityRequirement(rValues[rKeys[z]].ValueNode, rKeys[z], changes, rules)
             }
         }
     }
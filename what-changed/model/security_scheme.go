@@ -78,6 +78,12 @@ func CompareSecuritySchemesV3(l, r *v3.SecurityScheme) *SecuritySchemeChanges {
 // CompareSecuritySchemes compares left and right Swagger or OpenAPI Security Scheme objects for changes.
 // If anything is found, returns a pointer to *SecuritySchemeChanges or nil if nothing is found.
 func CompareSecuritySchemes(l, r any) *SecuritySchemeChanges {
+    return compareSecuritySchemes(l, r, nil)
+}
+
+// compareSecuritySchemes compares a left and right Swagger or OpenAPI SecurityScheme object, using rules before the
+// default BreakingRules.
+func compareSecuritySchemes(l, r any, rules BreakingRules) *SecuritySchemeChanges {
 
     var props []*PropertyCheck
     var changes []*Change
@@ -93,41 +99,42 @@ func CompareSecuritySchemes(l, r any) *SecuritySchemeChanges {
             return nil
         }
         addPropertyCheck(&props, lSS.Type.ValueNode, rSS.Type.ValueNode,
-            lSS.Type.Value, rSS.Type.Value, &changes, v3.TypeLabel, true)
+            lSS.Type.Value, rSS.Type.Value, &changes, v3.TypeLabel, SecuritySchemeObject)
 
         addPropertyCheck(&props, lSS.Description.ValueNode, rSS.Description.ValueNode,
-            lSS.Description.Value, rSS.Description.Value, &changes, v3.DescriptionLabel, false)
+            lSS.Description.Value, rSS.Description.Value, &changes, v3.DescriptionLabel, SecuritySchemeObject)
 
         addPropertyCheck(&props, lSS.Name.ValueNode, rSS.Name.ValueNode,
-            lSS.Name.Value, rSS.Name.Value, &changes, v3.NameLabel, true)
+            lSS.Name.Value, rSS.Name.Value, &changes, v3.NameLabel, SecuritySchemeObject)
 
         addPropertyCheck(&props, lSS.In.ValueNode, rSS.In.ValueNode,
-            lSS.In.Value, rSS.In.Value, &changes, v3.InLabel, true)
+            lSS.In.Value, rSS.In.Value, &changes, v3.InLabel, SecuritySchemeObject)
 
         addPropertyCheck(&props, lSS.Flow.ValueNode, rSS.Flow.ValueNode,
-            lSS.Flow.Value, rSS.Flow.Value, &changes, v3.FlowLabel, true)
+            lSS.Flow.Value, rSS.Flow.Value, &changes, v3.FlowLabel, SecuritySchemeObject)
 
         addPropertyCheck(&props, lSS.AuthorizationUrl.ValueNode, rSS.AuthorizationUrl.ValueNode,
-            lSS.AuthorizationUrl.Value, rSS.AuthorizationUrl.Value, &changes, v3.AuthorizationUrlLabel, true)
+            lSS.AuthorizationUrl.Value, rSS.AuthorizationUrl.Value, &changes, v3.AuthorizationUrlLabel,
+            SecuritySchemeObject)
 
         addPropertyCheck(&props, lSS.TokenUrl.ValueNode, rSS.TokenUrl.ValueNode,
-            lSS.TokenUrl.Value, rSS.TokenUrl.Value, &changes, v3.TokenUrlLabel, true)
+            lSS.TokenUrl.Value, rSS.TokenUrl.Value, &changes, v3.TokenUrlLabel, SecuritySchemeObject)
 
         if !lSS.Scopes.IsEmpty() && !rSS.Scopes.IsEmpty() {
             if !low.AreEqual(lSS.Scopes.Value, rSS.Scopes.Value) {
-                sc.ScopesChanges = CompareScopes(lSS.Scopes.Value, rSS.Scopes.Value)
+                sc.ScopesChanges = compareScopes(lSS.Scopes.Value, rSS.Scopes.Value, rules)
             }
         }
         if lSS.Scopes.IsEmpty() && !rSS.Scopes.IsEmpty() {
-            CreateChange(&changes, ObjectAdded, v3.ScopesLabel,
-                nil, rSS.Scopes.ValueNode, false, nil, rSS.Scopes.Value)
+            CreateChange(&changes, ObjectAdded, v3.ScopesLabel, nil, rSS.Scopes.ValueNode,
+                rules.isBreaking(SecuritySchemeObject, v3.ScopesLabel, ObjectAdded), nil, rSS.Scopes.Value)
         }
         if !lSS.Scopes.IsEmpty() && rSS.Scopes.IsEmpty() {
-            CreateChange(&changes, ObjectRemoved, v3.ScopesLabel,
-                lSS.Scopes.ValueNode, nil, true, lSS.Scopes.Value, nil)
+            CreateChange(&changes, ObjectRemoved, v3.ScopesLabel, lSS.Scopes.ValueNode, nil,
+                rules.isBreaking(SecuritySchemeObject, v3.ScopesLabel, ObjectRemoved), lSS.Scopes.Value, nil)
         }
 
-        sc.ExtensionChanges = CompareExtensions(lSS.Extensions, rSS.Extensions)
+        sc.ExtensionChanges = compareExtensions(lSS.Extensions, rSS.Extensions, rules)
     }
 
     if reflect.TypeOf(&v3.SecurityScheme{}) == reflect.TypeOf(l) &&
@@ -140,42 +147,43 @@ func CompareSecuritySchemes(l, r any) *SecuritySchemeChanges {
             return nil
         }
         addPropertyCheck(&props, lSS.Type.ValueNode, rSS.Type.ValueNode,
-            lSS.Type.Value, rSS.Type.Value, &changes, v3.TypeLabel, true)
+            lSS.Type.Value, rSS.Type.Value, &changes, v3.TypeLabel, SecuritySchemeObject)
 
         addPropertyCheck(&props, lSS.Description.ValueNode, rSS.Description.ValueNode,
-            lSS.Description.Value, rSS.Description.Value, &changes, v3.DescriptionLabel, false)
+            lSS.Description.Value, rSS.Description.Value, &changes, v3.DescriptionLabel, SecuritySchemeObject)
 
         addPropertyCheck(&props, lSS.Name.ValueNode, rSS.Name.ValueNode,
-            lSS.Name.Value, rSS.Name.Value, &changes, v3.NameLabel, true)
+            lSS.Name.Value, rSS.Name.Value, &changes, v3.NameLabel, SecuritySchemeObject)
 
         addPropertyCheck(&props, lSS.In.ValueNode, rSS.In.ValueNode,
-            lSS.In.Value, rSS.In.Value, &changes, v3.InLabel, true)
+            lSS.In.Value, rSS.In.Value, &changes, v3.InLabel, SecuritySchemeObject)
 
         addPropertyCheck(&props, lSS.Scheme.ValueNode, rSS.Scheme.ValueNode,
-            lSS.Scheme.Value, rSS.Scheme.Value, &changes, v3.SchemeLabel, true)
+            lSS.Scheme.Value, rSS.Scheme.Value, &changes, v3.SchemeLabel, SecuritySchemeObject)
 
         addPropertyCheck(&props, lSS.BearerFormat.ValueNode, rSS.BearerFormat.ValueNode,
-            lSS.BearerFormat.Value, rSS.BearerFormat.Value, &changes, v3.SchemeLabel, false)
+            lSS.BearerFormat.Value, rSS.BearerFormat.Value, &changes, v3.BearerFormatLabel, SecuritySchemeObject)
 
         addPropertyCheck(&props, lSS.OpenIdConnectUrl.ValueNode, rSS.OpenIdConnectUrl.ValueNode,
-            lSS.OpenIdConnectUrl.Value, rSS.OpenIdConnectUrl.Value, &changes, v3.OpenIdConnectUrlLabel, false)
+            lSS.OpenIdConnectUrl.Value, rSS.OpenIdConnectUrl.Value, &changes, v3.OpenIdConnectUrlLabel,
+            SecuritySchemeObject)
 
         if !lSS.Flows.IsEmpty() && !rSS.Flows.IsEmpty() {
             if !low.AreEqual(lSS.Flows.Value, rSS.Flows.Value) {
-                sc.OAuthFlowChanges = CompareOAuthFlows(lSS.Flows.Value, rSS.Flows.Value)
+                sc.OAuthFlowChanges = compareOAuthFlows(lSS.Flows.Value, rSS.Flows.Value, rules)
             }
         }
         if lSS.Flows.IsEmpty() && !rSS.Flows.IsEmpty() {
-            CreateChange(&changes, ObjectAdded, v3.FlowsLabel,
-                nil, rSS.Flows.ValueNode, false, nil, rSS.Flows.Value)
+            CreateChange(&changes, ObjectAdded, v3.FlowsLabel, nil, rSS.Flows.ValueNode,
+                rules.isBreaking(SecuritySchemeObject, v3.FlowsLabel, ObjectAdded), nil, rSS.Flows.Value)
         }
         if !lSS.Flows.IsEmpty() && rSS.Flows.IsEmpty() {
-            CreateChange(&changes, ObjectRemoved, v3.ScopesLabel,
-                lSS.Flows.ValueNode, nil, true, lSS.Flows.Value, nil)
+            CreateChange(&changes, ObjectRemoved, v3.ScopesLabel, lSS.Flows.ValueNode, nil,
+                rules.isBreaking(SecuritySchemeObject, v3.ScopesLabel, ObjectRemoved), lSS.Flows.Value, nil)
         }
-        sc.ExtensionChanges = CompareExtensions(lSS.Extensions, rSS.Extensions)
+        sc.ExtensionChanges = compareExtensions(lSS.Extensions, rSS.Extensions, rules)
     }
-    CheckProperties(props)
+    checkProperties(props, rules)
     sc.PropertyChanges = NewPropertyChanges(changes)
     return sc
 }
@@ -45,6 +45,11 @@ func (s *ServerChanges) TotalBreakingChanges() int {
 // CompareServers compares two OpenAPI Server objects for any changes. If anything is found, returns a pointer
 // to a ServerChanges instance, or returns nil if nothing is found.
 func CompareServers(l, r *v3.Server) *ServerChanges {
+    return compareServers(l, r, nil)
+}
+
+// compareServers compares a left and right Server object, using rules before the default BreakingRules.
+func compareServers(l, r *v3.Server, rules BreakingRules) *ServerChanges {
     if low.AreEqual(l, r) {
         return nil
     }
@@ -57,7 +62,7 @@ func CompareServers(l, r *v3.Server) *ServerChanges {
         RightNode: r.URL.ValueNode,
         Label:     v3.URLLabel,
         Changes:   &changes,
-        Breaking:  true,
+        Object:    ServerObject,
         Original:  l,
         New:       r,
     })
@@ -67,16 +72,18 @@ func CompareServers(l, r *v3.Server) *ServerChanges {
         RightNode: r.Description.ValueNode,
         Label:     v3.DescriptionLabel,
         Changes:   &changes,
-        Breaking:  false,
+        Object:    ServerObject,
         Original:  l,
         New:       r,
     })
 
-    CheckProperties(props)
+    checkProperties(props, rules)
     sc := new(ServerChanges)
     sc.PropertyChanges = NewPropertyChanges(changes)
     sc.ServerVariableChanges = CheckMapForChanges(l.Variables.Value, r.Variables.Value,
-        &changes, v3.VariablesLabel, CompareServerVariables)
+        &changes, v3.VariablesLabel, ServerObject, rules, func(l, r *v3.ServerVariable) *ServerVariableChanges {
+            return compareServerVariables(l, r, rules)
+        })
 
     return sc
 }
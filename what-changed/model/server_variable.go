@@ -21,6 +21,11 @@ func (s *ServerVariableChanges) GetAllChanges() []*Change {
 // CompareServerVariables compares a left and right OpenAPI ServerVariable object for changes.
 // If anything is found, returns a pointer to a ServerVariableChanges instance, otherwise returns nil.
 func CompareServerVariables(l, r *v3.ServerVariable) *ServerVariableChanges {
+    return compareServerVariables(l, r, nil)
+}
+
+// compareServerVariables compares a left and right ServerVariable object, using rules before the default BreakingRules.
+func compareServerVariables(l, r *v3.ServerVariable, rules BreakingRules) *ServerVariableChanges {
     if low.AreEqual(l, r) {
         return nil
     }
@@ -39,7 +44,7 @@ func CompareServerVariables(l, r *v3.ServerVariable) *ServerVariableChanges {
     for k := range lValues {
         if _, ok := rValues[k]; !ok {
             CreateChange(&changes, ObjectRemoved, v3.EnumLabel,
-                lValues[k].ValueNode, nil, true,
+                lValues[k].ValueNode, nil, rules.isBreaking(ServerVariableObject, v3.EnumLabel, ObjectRemoved),
                 lValues[k].Value, nil)
             continue
         }
@@ -47,7 +52,8 @@ func CompareServerVariables(l, r *v3.ServerVariable) *ServerVariableChanges {
     for k := range rValues {
         if _, ok := lValues[k]; !ok {
             CreateChange(&changes, ObjectAdded, v3.EnumLabel,
-                lValues[k].ValueNode, rValues[k].ValueNode, false,
+                lValues[k].ValueNode, rValues[k].ValueNode,
+                rules.isBreaking(ServerVariableObject, v3.EnumLabel, ObjectAdded),
                 lValues[k].Value, rValues[k].Value)
         }
     }
@@ -58,7 +64,7 @@ func CompareServerVariables(l, r *v3.ServerVariable) *ServerVariableChanges {
         RightNode: r.Default.ValueNode,
         Label:     v3.DefaultLabel,
         Changes:   &changes,
-        Breaking:  true,
+        Object:    ServerVariableObject,
         Original:  l,
         New:       r,
     })
@@ -69,13 +75,13 @@ func CompareServerVariables(l, r *v3.ServerVariable) *ServerVariableChanges {
         RightNode: r.Description.ValueNode,
         Label:     v3.DescriptionLabel,
         Changes:   &changes,
-        Breaking:  false,
+        Object:    ServerVariableObject,
         Original:  l,
         New:       r,
     })
 
     // check everything.
-    CheckProperties(props)
+    checkProperties(props, rules)
     sc := new(ServerVariableChanges)
     sc.PropertyChanges = NewPropertyChanges(changes)
     return sc
@@ -50,6 +50,11 @@ func (t *TagChanges) TotalBreakingChanges() int {
 // any changes between them. If there are changes, a pointer to TagChanges is returned, if not then
 // nil is returned instead.
 func CompareTags(l, r []low.ValueReference[*base.Tag]) []*TagChanges {
+    return compareTags(l, r, nil)
+}
+
+// compareTags compares a left and right slice of Tag objects, using rules before the default BreakingRules.
+func compareTags(l, r []low.ValueReference[*base.Tag], rules BreakingRules) []*TagChanges {
 
     var tagResults []*TagChanges
 
@@ -72,7 +77,9 @@ func CompareTags(l, r []low.ValueReference[*base.Tag]) []*TagChanges {
         tc := new(TagChanges)
         var changes []*Change
 
-        CheckForObjectAdditionOrRemoval[*base.Tag](seenLeft, seenRight, i, &changes, false, true)
+        CheckForObjectAdditionOrRemoval[*base.Tag](seenLeft, seenRight, i, &changes,
+            rules.isBreaking(TagObject, AnyProperty, ObjectAdded),
+            rules.isBreaking(TagObject, AnyProperty, ObjectRemoved))
 
         // if the existing tag exists, let's check it.
         if seenRight[i] != nil {
@@ -85,7 +92,7 @@ func CompareTags(l, r []low.ValueReference[*base.Tag]) []*TagChanges {
                 RightNode: seenRight[i].Value.Name.ValueNode,
                 Label:     v3.NameLabel,
                 Changes:   &changes,
-                Breaking:  true,
+                Object:    TagObject,
                 Original:  seenLeft[i].Value,
                 New:       seenRight[i].Value,
             })
@@ -96,30 +103,33 @@ func CompareTags(l, r []low.ValueReference[*base.Tag]) []*TagChanges {
                 RightNode: seenRight[i].Value.Description.ValueNode,
                 Label:     v3.DescriptionLabel,
                 Changes:   &changes,
-                Breaking:  false,
+                Object:    TagObject,
                 Original:  seenLeft[i].Value,
                 New:       seenRight[i].Value,
             })
 
             // check properties
-            CheckProperties(props)
+            checkProperties(props, rules)
 
             // compare external docs
             if !seenLeft[i].Value.ExternalDocs.IsEmpty() && !seenRight[i].Value.ExternalDocs.IsEmpty() {
-                tc.ExternalDocs = CompareExternalDocs(seenLeft[i].Value.ExternalDocs.Value,
-                    seenRight[i].Value.ExternalDocs.Value)
+                tc.ExternalDocs = compareExternalDocs(seenLeft[i].Value.ExternalDocs.Value,
+                    seenRight[i].Value.ExternalDocs.Value, rules)
             }
             if seenLeft[i].Value.ExternalDocs.IsEmpty() && !seenRight[i].Value.ExternalDocs.IsEmpty() {
                 CreateChange(&changes, ObjectAdded, v3.ExternalDocsLabel, nil, seenRight[i].GetValueNode(),
-                    false, nil, seenRight[i].Value.ExternalDocs.Value)
+                    rules.isBreaking(TagObject, v3.ExternalDocsLabel, ObjectAdded), nil,
+                    seenRight[i].Value.ExternalDocs.Value)
             }
             if !seenLeft[i].Value.ExternalDocs.IsEmpty() && seenRight[i].Value.ExternalDocs.IsEmpty() {
                 CreateChange(&changes, ObjectRemoved, v3.ExternalDocsLabel, seenLeft[i].GetValueNode(), nil,
-                    false, seenLeft[i].Value.ExternalDocs.Value, nil)
+                    rules.isBreaking(TagObject, v3.ExternalDocsLabel, ObjectRemoved),
+                    seenLeft[i].Value.ExternalDocs.Value,
+                    nil)
             }
 
             // check extensions
-            tc.ExtensionChanges = CompareExtensions(seenLeft[i].Value.Extensions, seenRight[i].Value.Extensions)
+            tc.ExtensionChanges = compareExtensions(seenLeft[i].Value.Extensions, seenRight[i].Value.Extensions, rules)
             tc.PropertyChanges = NewPropertyChanges(changes)
             if tc.TotalChanges() > 0 {
                 tagResults = append(tagResults, tc)
@@ -139,7 +149,7 @@ func CompareTags(l, r []low.ValueReference[*base.Tag]) []*TagChanges {
             var changes []*Change
 
             CreateChange(&changes, ObjectAdded, i, nil, seenRight[i].GetValueNode(),
-                false, nil, seenRight[i].GetValue())
+                rules.isBreaking(TagObject, AnyProperty, ObjectAdded), nil, seenRight[i].GetValue())
 
             tc.PropertyChanges = NewPropertyChanges(changes)
             tagResults = append(tagResults, tc)
@@ -42,6 +42,11 @@ func (x *XMLChanges) TotalBreakingChanges() int {
 // any changes between them. If changes are found, the function returns a pointer to XMLChanges,
 // otherwise, if nothing changed - it will return nil
 func CompareXML(l, r *base.XML) *XMLChanges {
+    return compareXML(l, r, nil)
+}
+
+// compareXML compares a left and right XML object, using rules before the default BreakingRules.
+func compareXML(l, r *base.XML, rules BreakingRules) *XMLChanges {
     xc := new(XMLChanges)
     var changes []*Change
     var props []*PropertyCheck
@@ -52,7 +57,7 @@ func CompareXML(l, r *base.XML) *XMLChanges {
         RightNode: r.Name.ValueNode,
         Label:     v3.NameLabel,
         Changes:   &changes,
-        Breaking:  true,
+        Object:    XMLObject,
         Original:  l,
         New:       r,
     })
@@ -63,7 +68,7 @@ func CompareXML(l, r *base.XML) *XMLChanges {
         RightNode: r.Namespace.ValueNode,
         Label:     v3.NamespaceLabel,
         Changes:   &changes,
-        Breaking:  true,
+        Object:    XMLObject,
         Original:  l,
         New:       r,
     })
@@ -74,7 +79,7 @@ func CompareXML(l, r *base.XML) *XMLChanges {
         RightNode: r.Prefix.ValueNode,
         Label:     v3.PrefixLabel,
         Changes:   &changes,
-        Breaking:  true,
+        Object:    XMLObject,
         Original:  l,
         New:       r,
     })
@@ -85,7 +90,7 @@ func CompareXML(l, r *base.XML) *XMLChanges {
         RightNode: r.Attribute.ValueNode,
         Label:     v3.AttributeLabel,
         Changes:   &changes,
-        Breaking:  true,
+        Object:    XMLObject,
         Original:  l,
         New:       r,
     })
@@ -96,16 +101,16 @@ func CompareXML(l, r *base.XML) *XMLChanges {
         RightNode: r.Wrapped.ValueNode,
         Label:     v3.WrappedLabel,
         Changes:   &changes,
-        Breaking:  true,
+        Object:    XMLObject,
         Original:  l,
         New:       r,
     })
 
     // check properties
-    CheckProperties(props)
+    checkProperties(props, rules)
 
     // check extensions
-    xc.ExtensionChanges = CheckExtensions(l, r)
+    xc.ExtensionChanges = checkExtensions(l, r, rules)
     xc.PropertyChanges = NewPropertyChanges(changes)
     if xc.TotalChanges() <= 0 {
         return nil
@@ -0,0 +1,217 @@
+// Copyright 2023 Princess B33f Heavy Industries / Dave Shanley
+// SPDX-License-Identifier: MIT
+
+package reports
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+
+	v3 "github.com/pb33f/libopenapi/datamodel/low/v3"
+	"github.com/pb33f/libopenapi/what-changed/model"
+)
+
+// ChangeGroup is a group of changes made to the same part of a document, like a path, an operation or the
+// components. Reports render every group as a section.
+type ChangeGroup struct {
+	// Name is the name of the group, like 'GET /pets', '/pets' or 'components'.
+	Name string `json:"name"`
+
+	// Path is the path (or webhook) the changes were made to, if any.
+	Path string `json:"path,omitempty"`
+
+	// Method is the HTTP method of the operation the changes were made to, if any.
+	Method string `json:"method,omitempty"`
+
+	// Changes are the changes made to this part of the document, sorted by their path in the document.
+	Changes []*model.Change `json:"changes"`
+}
+
+// TotalChanges returns the number of changes in the group.
+func (g *ChangeGroup) TotalChanges() int {
+	return len(g.Changes)
+}
+
+// TotalBreakingChanges returns the number of breaking changes in the group.
+func (g *ChangeGroup) TotalBreakingChanges() int {
+	return model.CountBreakingChanges(g.Changes)
+}
+
+// GroupChanges splits every change made to a document into groups, so they can be reported by path and operation.
+// The document and info come first, then servers, security, tags, paths (sorted by path, then operation), webhooks,
+// components and extensions. Groups without changes are left out.
+func GroupChanges(changes *model.DocumentChanges) []*ChangeGroup {
+	if changes == nil {
+		return nil
+	}
+	var groups []*ChangeGroup
+	add := func(g *ChangeGroup, c ...[]*model.Change) {
+		for i := range c {
+			g.Changes = append(g.Changes, c[i]...)
+		}
+		if len(g.Changes) > 0 {
+			sortChanges(g.Changes)
+			groups = append(groups, g)
+		}
+	}
+
+	add(&ChangeGroup{Name: "document"}, changes.Changes)
+	if changes.InfoChanges != nil {
+		add(&ChangeGroup{Name: v3.InfoLabel}, changes.InfoChanges.GetAllChanges())
+	}
+	var servers, security, tags [][]*model.Change
+	for _, s := range changes.ServerChanges {
+		servers = append(servers, s.GetAllChanges())
+	}
+	for _, s := range changes.SecurityRequirementChanges {
+		security = append(security, s.GetAllChanges())
+	}
+	for _, t := range changes.TagChanges {
+		tags = append(tags, t.GetAllChanges())
+	}
+	add(&ChangeGroup{Name: v3.ServersLabel}, servers...)
+	add(&ChangeGroup{Name: v3.SecurityLabel}, security...)
+	add(&ChangeGroup{Name: v3.TagsLabel}, tags...)
+	if changes.ExternalDocChanges != nil {
+		add(&ChangeGroup{Name: v3.ExternalDocsLabel}, changes.ExternalDocChanges.GetAllChanges())
+	}
+
+	if pc := changes.PathsChanges; pc != nil {
+		// paths that were added or removed are reported with the path.
+		added := make(map[string][]*model.Change)
+		var other []*model.Change
+		for _, c := range pc.Changes {
+			path := c.New
+			if c.ChangeType == model.ObjectRemoved {
+				path = c.Original
+			}
+			if c.Property == v3.PathLabel && path != "" {
+				added[path] = append(added[path], c)
+				continue
+			}
+			other = append(other, c)
+		}
+		items := make(map[string]*model.PathItemChanges)
+		for path := range added {
+			items[path] = nil
+		}
+		for path := range pc.PathItemsChanges {
+			items[path] = pc.PathItemsChanges[path]
+		}
+		for _, path := range sortedKeys(items) {
+			groups = append(groups, pathItemGroups(path, path, items[path], added[path])...)
+		}
+		if pc.ExtensionChanges != nil {
+			other = append(other, pc.ExtensionChanges.GetAllChanges()...)
+		}
+		add(&ChangeGroup{Name: v3.PathsLabel}, other)
+	}
+	for _, name := range sortedKeys(changes.WebhookChanges) {
+		groups = append(groups, pathItemGroups("webhook "+name, name, changes.WebhookChanges[name], nil)...)
+	}
+
+	if changes.ComponentsChanges != nil {
+		add(&ChangeGroup{Name: v3.ComponentsLabel}, changes.ComponentsChanges.GetAllChanges())
+	}
+	if changes.ExtensionChanges != nil {
+		add(&ChangeGroup{Name: "extensions"}, changes.ExtensionChanges.GetAllChanges())
+	}
+	return groups
+}
+
+// pathItemGroups returns a group for the changes made to a path item, followed by a group for each operation.
+func pathItemGroups(name, path string, item *model.PathItemChanges, changes []*model.Change) []*ChangeGroup {
+	pathGroup := &ChangeGroup{Name: name, Path: path, Changes: changes}
+	groups := []*ChangeGroup{pathGroup}
+	if item != nil {
+		pathGroup.Changes = append(pathGroup.Changes, item.Changes...)
+		for _, s := range item.ServerChanges {
+			pathGroup.Changes = append(pathGroup.Changes, s.GetAllChanges()...)
+		}
+		for _, p := range item.ParameterChanges {
+			pathGroup.Changes = append(pathGroup.Changes, p.GetAllChanges()...)
+		}
+		if item.ExtensionChanges != nil {
+			pathGroup.Changes = append(pathGroup.Changes, item.ExtensionChanges.GetAllChanges()...)
+		}
+		for _, op := range []struct {
+			method  string
+			changes *model.OperationChanges
+		}{
+			{http.MethodGet, item.GetChanges},
+			{http.MethodPut, item.PutChanges},
+			{http.MethodPost, item.PostChanges},
+			{http.MethodDelete, item.DeleteChanges},
+			{http.MethodOptions, item.OptionsChanges},
+			{http.MethodHead, item.HeadChanges},
+			{http.MethodPatch, item.PatchChanges},
+			{http.MethodTrace, item.TraceChanges},
+		} {
+			if op.changes == nil || op.changes.TotalChanges() == 0 {
+				continue
+			}
+			changes := op.changes.GetAllChanges()
+			sortChanges(changes)
+			groups = append(groups, &ChangeGroup{Name: op.method + " " + name, Path: path,
+				Method: op.method, Changes: changes})
+		}
+	}
+	if len(pathGroup.Changes) == 0 {
+		return groups[1:]
+	}
+	sortChanges(pathGroup.Changes)
+	return groups
+}
+
+// sortChanges sorts changes by their path in the document, so reports are the same every time.
+func sortChanges(changes []*model.Change) {
+	sort.SliceStable(changes, func(i, j int) bool {
+		if pi, pj := changes[i].Path(), changes[j].Path(); pi != pj {
+			return pi < pj
+		}
+		if changes[i].Property != changes[j].Property {
+			return changes[i].Property < changes[j].Property
+		}
+		return changes[i].ChangeType < changes[j].ChangeType
+	})
+}
+
+func sortedKeys[T any](m map[string]T) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// describeChange describes a change in a sentence, like "'maxLength' modified from '10' to '20'".
+func describeChange(c *model.Change) string {
+	switch changeTypeDescription(c.ChangeType) {
+	case "modified":
+		return fmt.Sprintf("'%s' modified from '%s' to '%s'", c.Property, c.Original, c.New)
+	case "added":
+		if c.New != "" {
+			return fmt.Sprintf("'%s' added: '%s'", c.Property, c.New)
+		}
+	case "removed":
+		if c.Original != "" {
+			return fmt.Sprintf("'%s' removed: '%s'", c.Property, c.Original)
+		}
+	}
+	return fmt.Sprintf("'%s' %s", c.Property, changeTypeDescription(c.ChangeType))
+}
+
+// changeTypeDescription describes a type of change in a single word, like 'added' or 'removed'.
+func changeTypeDescription(changeType int) string {
+	switch changeType {
+	case model.PropertyAdded, model.ObjectAdded:
+		return "added"
+	case model.PropertyRemoved, model.ObjectRemoved:
+		return "removed"
+	case model.Modified:
+		return "modified"
+	}
+	return model.ChangeTypeName(changeType)
+}
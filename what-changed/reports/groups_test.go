@@ -0,0 +1,73 @@
+// Copyright 2023 Princess B33f Heavy Industries / Dave Shanley
+// SPDX-License-Identifier: MIT
+
+package reports
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/pb33f/libopenapi/what-changed/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGroupChanges(t *testing.T) {
+	changes := createDiff()
+	groups := GroupChanges(changes)
+
+	var names []string
+	total, breaking := 0, 0
+	for _, g := range groups {
+		names = append(names, g.Name)
+		total += g.TotalChanges()
+		breaking += g.TotalBreakingChanges()
+	}
+	assert.Equal(t, []string{"document", "info", "servers", "security", "tags", "externalDocs", "/burgers",
+		"POST /burgers", "GET /burgers/{burgerId}", "GET /burgers/{burgerId}/dressings", "/dressings/{dressingId}",
+		"GET /dressings/{dressingId}", "paths", "POST webhook someHook", "components", "extensions"}, names)
+	assert.Equal(t, changes.TotalChanges(), total)
+	assert.Equal(t, changes.TotalBreakingChanges(), breaking)
+
+	get := groups[9]
+	assert.Equal(t, "/burgers/{burgerId}/dressings", get.Path)
+	assert.Equal(t, http.MethodGet, get.Method)
+	assert.Equal(t, 3, get.TotalBreakingChanges())
+	for i := 1; i < len(get.Changes); i++ {
+		assert.LessOrEqual(t, get.Changes[i-1].Path(), get.Changes[i].Path())
+	}
+
+	assert.Nil(t, GroupChanges(nil))
+}
+
+func TestGroupChanges_Paths(t *testing.T) {
+	changes := &model.DocumentChanges{
+		PropertyChanges: model.NewPropertyChanges(nil),
+		PathsChanges: &model.PathsChanges{
+			PropertyChanges: model.NewPropertyChanges([]*model.Change{
+				{ChangeType: model.ObjectRemoved, Property: "path", Original: "/pets", Breaking: true},
+				{ChangeType: model.ObjectAdded, Property: "path", New: "/dogs"},
+			}),
+			PathItemsChanges: map[string]*model.PathItemChanges{
+				"/cats": {
+					PropertyChanges: model.NewPropertyChanges([]*model.Change{
+						{ChangeType: model.PropertyRemoved, Property: "get", Breaking: true},
+					}),
+					PostChanges: &model.OperationChanges{
+						PropertyChanges: model.NewPropertyChanges([]*model.Change{
+							{ChangeType: model.Modified, Property: "summary"},
+						}),
+					},
+				},
+			},
+		},
+	}
+
+	groups := GroupChanges(changes)
+	assert.Len(t, groups, 4)
+	assert.Equal(t, "/cats", groups[0].Name)
+	assert.Equal(t, "get", groups[0].Changes[0].Property)
+	assert.Equal(t, "POST /cats", groups[1].Name)
+	assert.Equal(t, "/dogs", groups[2].Name)
+	assert.Equal(t, "/pets", groups[3].Name)
+	assert.Equal(t, 1, groups[3].TotalBreakingChanges())
+}
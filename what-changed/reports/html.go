@@ -0,0 +1,67 @@
+// Copyright 2023 Princess B33f Heavy Industries / Dave Shanley
+// SPDX-License-Identifier: MIT
+
+package reports
+
+import (
+	"bytes"
+	"fmt"
+	"html/template"
+
+	"github.com/pb33f/libopenapi/what-changed/model"
+)
+
+var htmlTemplate = template.Must(template.New("html").Funcs(template.FuncMap{
+	"changeType": changeTypeDescription,
+	"lines":      lineReference,
+}).Parse(`<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<title>{{ .Title }}</title>
+<style>
+body { font-family: sans-serif; margin: 2em; color: #222; }
+table { border-collapse: collapse; width: 100%; margin-bottom: 2em; }
+th, td { border: 1px solid #ccc; padding: 0.3em 0.6em; text-align: left; vertical-align: top; }
+th { background: #f4f4f4; }
+code { font-family: monospace; word-break: break-all; }
+tr.breaking { background: #fdecea; }
+span.breaking { color: #b71c1c; font-weight: bold; }
+</style>
+</head>
+<body>
+<h1>{{ .Title }}</h1>
+{{- if .Groups }}
+<p><strong>{{ .Total }}</strong> changes, <strong>{{ .Breaking }}</strong> breaking.</p>
+<table class="summary">
+<tr><th>Section</th><th>Changes</th><th>Breaking</th></tr>
+{{- range $i, $g := .Groups }}
+<tr{{ if $g.TotalBreakingChanges }} class="breaking"{{ end }}><td><a href="#group-{{ $i }}">{{ $g.Name }}</a></td><td>{{ $g.TotalChanges }}</td><td>{{ $g.TotalBreakingChanges }}</td></tr>
+{{- end }}
+</table>
+{{- range $i, $g := .Groups }}
+<h2 id="group-{{ $i }}">{{ $g.Name }}</h2>
+<table>
+<tr><th>Breaking</th><th>Change</th><th>Property</th><th>Original</th><th>New</th><th>Location</th></tr>
+{{- range $g.Changes }}
+<tr{{ if .IsBreaking }} class="breaking"{{ end }}><td>{{ if .IsBreaking }}<span class="breaking">yes</span>{{ end }}</td><td>{{ changeType .ChangeType }}</td><td><code>{{ .Property }}</code></td><td><code>{{ .Original }}</code></td><td><code>{{ .New }}</code></td><td><code>{{ .Path }}</code> {{ lines . }}</td></tr>
+{{- end }}
+</table>
+{{- end }}
+{{- else }}
+<p>No changes.</p>
+{{- end }}
+</body>
+</html>
+`))
+
+// CreateHTMLReport will render every change made to a document as a standalone HTML page (for release notes, for
+// example). Changes are grouped in the same way as CreateMarkdownReport, with a summary of every group at the top
+// of the page. If title is empty, DefaultReportTitle is used.
+func CreateHTMLReport(changes *model.DocumentChanges, title string) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := htmlTemplate.Execute(&buf, createReport(changes, title)); err != nil {
+		return nil, fmt.Errorf("unable to render html report: %w", err)
+	}
+	return buf.Bytes(), nil
+}
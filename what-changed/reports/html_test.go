@@ -0,0 +1,30 @@
+// Copyright 2023 Princess B33f Heavy Industries / Dave Shanley
+// SPDX-License-Identifier: MIT
+
+package reports
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateHTMLReport(t *testing.T) {
+	report, err := CreateHTMLReport(createDiff(), "Burgers <v2>")
+	assert.NoError(t, err)
+
+	html := string(report)
+	assert.Contains(t, html, "<title>Burgers &lt;v2&gt;</title>")
+	assert.Contains(t, html, "<p><strong>72</strong> changes, <strong>17</strong> breaking.</p>")
+	assert.Contains(t, html, `<tr class="breaking"><td><a href="#group-9">GET /burgers/{burgerId}/dressings</a></td>`+
+		`<td>8</td><td>3</td></tr>`)
+	assert.Contains(t, html, `<h2 id="group-9">GET /burgers/{burgerId}/dressings</h2>`)
+	assert.Contains(t, html, `<tr class="breaking"><td><span class="breaking">yes</span></td><td>modified</td>`+
+		`<td><code>in</code></td><td><code>path</code></td><td><code>query</code></td>`)
+}
+
+func TestCreateHTMLReport_NoChanges(t *testing.T) {
+	report, err := CreateHTMLReport(nil, "")
+	assert.NoError(t, err)
+	assert.Contains(t, string(report), "<h1>What Changed</h1>\n<p>No changes.</p>")
+}
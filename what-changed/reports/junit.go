@@ -0,0 +1,85 @@
+// Copyright 2023 Princess B33f Heavy Industries / Dave Shanley
+// SPDX-License-Identifier: MIT
+
+package reports
+
+import (
+	"encoding/xml"
+	"fmt"
+	"strings"
+
+	"github.com/pb33f/libopenapi/what-changed/model"
+)
+
+type junitTestSuites struct {
+	XMLName  xml.Name         `xml:"testsuites"`
+	Name     string           `xml:"name,attr"`
+	Tests    int              `xml:"tests,attr"`
+	Failures int              `xml:"failures,attr"`
+	Suites   []junitTestSuite `xml:"testsuite"`
+}
+
+type junitTestSuite struct {
+	Name      string          `xml:"name,attr"`
+	Tests     int             `xml:"tests,attr"`
+	Failures  int             `xml:"failures,attr"`
+	TestCases []junitTestCase `xml:"testcase"`
+}
+
+type junitTestCase struct {
+	Name      string        `xml:"name,attr"`
+	ClassName string        `xml:"classname,attr"`
+	Failure   *junitFailure `xml:"failure,omitempty"`
+	SystemOut string        `xml:"system-out,omitempty"`
+}
+
+type junitFailure struct {
+	Message string `xml:"message,attr"`
+	Type    string `xml:"type,attr"`
+	Text    string `xml:",chardata"`
+}
+
+// CreateJUnitReport will render every change made to a document as a JUnit XML report, so changes can be reported
+// by test report tools. Every group of changes (see GroupChanges) is a test case, like 'GET /pets' for an operation,
+// that fails if it contains breaking changes. The breaking changes are listed by the failure, and every other
+// change is listed as output of the test case. If title is empty, DefaultReportTitle is used.
+func CreateJUnitReport(changes *model.DocumentChanges, title string) ([]byte, error) {
+	r := createReport(changes, title)
+	suite := junitTestSuite{Name: r.Title}
+	for _, g := range r.Groups {
+		tc := junitTestCase{Name: g.Name, ClassName: g.Name}
+		if g.Path != "" {
+			tc.ClassName = g.Path
+		}
+		var breaking, other []string
+		for _, c := range g.Changes {
+			line := describeChange(c)
+			if path := c.Path(); path != "" {
+				line += fmt.Sprintf(" (%s)", path)
+			}
+			if c.IsBreaking() {
+				breaking = append(breaking, line)
+			} else {
+				other = append(other, line)
+			}
+		}
+		if len(breaking) > 0 {
+			tc.Failure = &junitFailure{
+				Message: fmt.Sprintf("%d breaking changes", len(breaking)),
+				Type:    "breaking",
+				Text:    strings.Join(breaking, "\n"),
+			}
+			suite.Failures++
+		}
+		tc.SystemOut = strings.Join(other, "\n")
+		suite.TestCases = append(suite.TestCases, tc)
+	}
+	suite.Tests = len(suite.TestCases)
+
+	out, err := xml.MarshalIndent(junitTestSuites{Name: r.Title, Tests: suite.Tests, Failures: suite.Failures,
+		Suites: []junitTestSuite{suite}}, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("unable to render junit report: %w", err)
+	}
+	return append([]byte(xml.Header), out...), nil
+}
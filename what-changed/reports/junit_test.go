@@ -0,0 +1,50 @@
+// Copyright 2023 Princess B33f Heavy Industries / Dave Shanley
+// SPDX-License-Identifier: MIT
+
+package reports
+
+import (
+	"encoding/xml"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateJUnitReport(t *testing.T) {
+	report, err := CreateJUnitReport(createDiff(), "Burger Shop")
+	assert.NoError(t, err)
+	assert.True(t, strings.HasPrefix(string(report), xml.Header))
+
+	var suites junitTestSuites
+	assert.NoError(t, xml.Unmarshal(report, &suites))
+	assert.Equal(t, "Burger Shop", suites.Name)
+	assert.Equal(t, 16, suites.Tests)
+	assert.Equal(t, 6, suites.Failures)
+	assert.Len(t, suites.Suites, 1)
+	assert.Len(t, suites.Suites[0].TestCases, 16)
+
+	cases := make(map[string]junitTestCase)
+	for _, tc := range suites.Suites[0].TestCases {
+		cases[tc.Name] = tc
+	}
+
+	// an operation with breaking changes fails.
+	get := cases["GET /burgers/{burgerId}/dressings"]
+	assert.Equal(t, "/burgers/{burgerId}/dressings", get.ClassName)
+	assert.Equal(t, "3 breaking changes", get.Failure.Message)
+	assert.Contains(t, get.Failure.Text,
+		"'in' modified from 'path' to 'query' (/paths/~1burgers~1{burgerId}~1dressings/get/parameters/0/in)")
+	assert.Contains(t, get.SystemOut, "'example' modified from 'big-mac' to '12345'")
+
+	// changes that are not breaking pass.
+	info := cases["info"]
+	assert.Nil(t, info.Failure)
+	assert.Equal(t, "'name' modified from 'pb33f' to 'pb33f-internal' (/info/license/name)", info.SystemOut)
+}
+
+func TestCreateJUnitReport_NoChanges(t *testing.T) {
+	report, err := CreateJUnitReport(nil, "")
+	assert.NoError(t, err)
+	assert.Contains(t, string(report), `<testsuites name="What Changed" tests="0" failures="0">`)
+}
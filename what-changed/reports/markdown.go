@@ -0,0 +1,98 @@
+// Copyright 2023 Princess B33f Heavy Industries / Dave Shanley
+// SPDX-License-Identifier: MIT
+
+package reports
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"text/template"
+
+	"github.com/pb33f/libopenapi/what-changed/model"
+)
+
+// DefaultReportTitle is the title of a report, when a title is not supplied.
+const DefaultReportTitle = "What Changed"
+
+var markdownTemplate = template.Must(template.New("markdown").Funcs(template.FuncMap{
+	"changeType": changeTypeDescription,
+	"code":       markdownCode,
+	"lines":      lineReference,
+}).Parse(`# {{ .Title }}
+{{ if .Groups }}
+**{{ .Total }}** changes, **{{ .Breaking }}** breaking.
+{{ range .Groups }}
+## {{ .Name }}
+
+{{ .TotalChanges }} changes, {{ .TotalBreakingChanges }} breaking.
+
+| Breaking | Change | Property | Original | New | Location |
+| --- | --- | --- | --- | --- | --- |
+{{ range .Changes }}| {{ if .IsBreaking }}**yes**{{ end }} | {{ changeType .ChangeType }} | {{ code .Property }} | {{ code .Original }} | {{ code .New }} | {{ code .Path }} {{ lines . }} |
+{{ end }}{{ end }}{{ else }}
+No changes.
+{{ end }}`))
+
+// report is the data used to render a report.
+type report struct {
+	Title    string
+	Total    int
+	Breaking int
+	Groups   []*ChangeGroup
+}
+
+func createReport(changes *model.DocumentChanges, title string) *report {
+	if title == "" {
+		title = DefaultReportTitle
+	}
+	r := &report{Title: title, Groups: GroupChanges(changes)}
+	for _, g := range r.Groups {
+		r.Total += g.TotalChanges()
+		r.Breaking += g.TotalBreakingChanges()
+	}
+	return r
+}
+
+// CreateMarkdownReport will render every change made to a document as a Markdown report (for a pull request comment,
+// for example). Changes are grouped by path and operation (see GroupChanges), with the original and new values,
+// where they are in the document, and breaking changes highlighted. If title is empty, DefaultReportTitle is used.
+func CreateMarkdownReport(changes *model.DocumentChanges, title string) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := markdownTemplate.Execute(&buf, createReport(changes, title)); err != nil {
+		return nil, fmt.Errorf("unable to render markdown report: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// markdownCode renders a value as inline code that is safe to use in a table cell.
+func markdownCode(value string) string {
+	if value == "" {
+		return ""
+	}
+	value = strings.ReplaceAll(strings.ReplaceAll(value, "\n", " "), "|", `\|`)
+	if strings.Contains(value, "`") {
+		return "`` " + value + " ``"
+	}
+	return "`" + value + "`"
+}
+
+// lineReference describes the lines of the original and new values of a change, like '(line 4 → 6)'.
+func lineReference(c *model.Change) string {
+	if c.Context == nil {
+		return ""
+	}
+	ctx := c.Context
+	switch {
+	case ctx.OriginalLine != nil && ctx.NewLine != nil:
+		if *ctx.OriginalLine == *ctx.NewLine {
+			return fmt.Sprintf("(line %d)", *ctx.NewLine)
+		}
+		return fmt.Sprintf("(line %d → %d)", *ctx.OriginalLine, *ctx.NewLine)
+	case ctx.OriginalLine != nil:
+		return fmt.Sprintf("(original line %d)", *ctx.OriginalLine)
+	case ctx.NewLine != nil:
+		return fmt.Sprintf("(new line %d)", *ctx.NewLine)
+	}
+	return ""
+}
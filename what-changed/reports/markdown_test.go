@@ -0,0 +1,49 @@
+// Copyright 2023 Princess B33f Heavy Industries / Dave Shanley
+// SPDX-License-Identifier: MIT
+
+package reports
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/pb33f/libopenapi/what-changed/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateMarkdownReport(t *testing.T) {
+	report, err := CreateMarkdownReport(createDiff(), "Burger Shop")
+	assert.NoError(t, err)
+
+	md := string(report)
+	assert.True(t, strings.HasPrefix(md, "# Burger Shop\n\n**72** changes, **17** breaking.\n"))
+	assert.Contains(t, md, "\n## GET /burgers/{burgerId}/dressings\n\n8 changes, 3 breaking.\n")
+	assert.Contains(t, md, "| **yes** | modified | `in` | `path` | `query` | "+
+		"`/paths/~1burgers~1{burgerId}~1dressings/get/parameters/0/in` (line 188 → 191) |\n")
+	assert.Contains(t, md, "|  | removed | `x-nice` | `rice` |  | "+
+		"`/paths/~1burgers~1{burgerId}~1dressings/get/responses/404/content/application~1json/x-nice` "+
+		"(original line 202) |\n")
+}
+
+func TestCreateMarkdownReport_NoChanges(t *testing.T) {
+	report, err := CreateMarkdownReport(nil, "")
+	assert.NoError(t, err)
+	assert.Equal(t, "# What Changed\n\nNo changes.\n", string(report))
+}
+
+func TestMarkdownCode(t *testing.T) {
+	assert.Equal(t, "", markdownCode(""))
+	assert.Equal(t, "`a \\| b c`", markdownCode("a | b\nc"))
+	assert.Equal(t, "`` a`b ``", markdownCode("a`b"))
+}
+
+func TestLineReference(t *testing.T) {
+	one, two := 1, 2
+	assert.Equal(t, "", lineReference(&model.Change{}))
+	assert.Equal(t, "(line 1)", lineReference(&model.Change{
+		Context: &model.ChangeContext{OriginalLine: &one, NewLine: &one}}))
+	assert.Equal(t, "(line 1 → 2)", lineReference(&model.Change{
+		Context: &model.ChangeContext{OriginalLine: &one, NewLine: &two}}))
+	assert.Equal(t, "(new line 2)", lineReference(&model.Change{Context: &model.ChangeContext{NewLine: &two}}))
+	assert.Equal(t, "", lineReference(&model.Change{Context: &model.ChangeContext{}}))
+}
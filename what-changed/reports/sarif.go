@@ -0,0 +1,154 @@
+// Copyright 2023 Princess B33f Heavy Industries / Dave Shanley
+// SPDX-License-Identifier: MIT
+
+package reports
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/pb33f/libopenapi/what-changed/model"
+)
+
+// SARIFVersion is the version of SARIF rendered by CreateSARIFReport.
+const SARIFVersion = "2.1.0"
+
+const sarifSchema = "https://json.schemastore.org/sarif-2.1.0.json"
+
+type sarifLog struct {
+	Schema  string     `json:"$schema"`
+	Version string     `json:"version"`
+	Runs    []sarifRun `json:"runs"`
+}
+
+type sarifRun struct {
+	Tool    sarifTool     `json:"tool"`
+	Results []sarifResult `json:"results"`
+}
+
+type sarifTool struct {
+	Driver sarifDriver `json:"driver"`
+}
+
+type sarifDriver struct {
+	Name           string      `json:"name"`
+	InformationURI string      `json:"informationUri"`
+	Rules          []sarifRule `json:"rules"`
+}
+
+type sarifRule struct {
+	ID               string       `json:"id"`
+	ShortDescription sarifMessage `json:"shortDescription"`
+}
+
+type sarifMessage struct {
+	Text string `json:"text"`
+}
+
+type sarifResult struct {
+	RuleID     string          `json:"ruleId"`
+	Level      string          `json:"level"`
+	Message    sarifMessage    `json:"message"`
+	Locations  []sarifLocation `json:"locations,omitempty"`
+	Properties map[string]any  `json:"properties,omitempty"`
+}
+
+type sarifLocation struct {
+	PhysicalLocation *sarifPhysicalLocation `json:"physicalLocation,omitempty"`
+	LogicalLocations []sarifLogicalLocation `json:"logicalLocations,omitempty"`
+}
+
+type sarifPhysicalLocation struct {
+	ArtifactLocation sarifArtifactLocation `json:"artifactLocation"`
+	Region           *sarifRegion          `json:"region,omitempty"`
+}
+
+type sarifArtifactLocation struct {
+	URI string `json:"uri"`
+}
+
+type sarifRegion struct {
+	StartLine   int `json:"startLine"`
+	StartColumn int `json:"startColumn,omitempty"`
+}
+
+type sarifLogicalLocation struct {
+	Name               string `json:"name,omitempty"`
+	FullyQualifiedName string `json:"fullyQualifiedName"`
+}
+
+// sarifRules are the rules every result refers to, one for each type of change.
+var sarifRules = []sarifRule{
+	{ID: model.ChangeTypeName(model.Modified), ShortDescription: sarifMessage{"A value was modified"}},
+	{ID: model.ChangeTypeName(model.PropertyAdded), ShortDescription: sarifMessage{"A property was added"}},
+	{ID: model.ChangeTypeName(model.ObjectAdded), ShortDescription: sarifMessage{"An object was added"}},
+	{ID: model.ChangeTypeName(model.ObjectRemoved), ShortDescription: sarifMessage{"An object was removed"}},
+	{ID: model.ChangeTypeName(model.PropertyRemoved), ShortDescription: sarifMessage{"A property was removed"}},
+}
+
+// CreateSARIFReport will render every change made to a document as a SARIF 2.1.0 log, so changes can be reported
+// by code scanning tools. Breaking changes are errors and every other change is a note. The location of a change is
+// where the new value is in the document at newURI, or where the original value was in the document at originalURI,
+// if it was removed. The JSON Pointer to the value is used as the logical location. Changes made in referenced files
+// have no location.
+func CreateSARIFReport(changes *model.DocumentChanges, originalURI, newURI string) ([]byte, error) {
+	run := sarifRun{
+		Tool: sarifTool{Driver: sarifDriver{
+			Name:           "libopenapi",
+			InformationURI: "https://pb33f.io/libopenapi/what-changed/",
+			Rules:          sarifRules,
+		}},
+		Results: []sarifResult{},
+	}
+	for _, g := range GroupChanges(changes) {
+		for _, c := range g.Changes {
+			result := sarifResult{
+				RuleID:     model.ChangeTypeName(c.ChangeType),
+				Level:      "note",
+				Message:    sarifMessage{fmt.Sprintf("%s: %s", g.Name, describeChange(c))},
+				Properties: map[string]any{"breaking": c.IsBreaking(), "group": g.Name},
+			}
+			if c.IsBreaking() {
+				result.Level = "error"
+			}
+			if location := sarifChangeLocation(c, originalURI, newURI); location != nil {
+				result.Locations = []sarifLocation{*location}
+			}
+			run.Results = append(run.Results, result)
+		}
+	}
+	out, err := json.MarshalIndent(sarifLog{Schema: sarifSchema, Version: SARIFVersion, Runs: []sarifRun{run}}, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("unable to render sarif report: %w", err)
+	}
+	return out, nil
+}
+
+// sarifChangeLocation returns the location of the new value of a change, or the original value if there is no new
+// value in the document. A value without a path is not in the document (it's in a referenced file), so the line
+// numbers of the value can't be used with the URI of the document. Nil is returned if neither value is in the document.
+func sarifChangeLocation(c *model.Change, originalURI, newURI string) *sarifLocation {
+	ctx := c.Context
+	if ctx == nil {
+		ctx = new(model.ChangeContext)
+	}
+	uri, path, line, column := newURI, c.NewPath, ctx.NewLine, ctx.NewColumn
+	if path == "" {
+		uri, path, line, column = originalURI, c.OriginalPath, ctx.OriginalLine, ctx.OriginalColumn
+	}
+	if path == "" {
+		return nil
+	}
+	location := &sarifLocation{
+		LogicalLocations: []sarifLogicalLocation{{Name: c.Property, FullyQualifiedName: path}},
+	}
+	if line != nil {
+		region := &sarifRegion{StartLine: *line}
+		if column != nil {
+			region.StartColumn = *column
+		}
+		location.PhysicalLocation = &sarifPhysicalLocation{ArtifactLocation: sarifArtifactLocation{URI: uri},
+			Region: region}
+	}
+	return location
+}
@@ -0,0 +1,83 @@
+// Copyright 2023 Princess B33f Heavy Industries / Dave Shanley
+// SPDX-License-Identifier: MIT
+
+package reports
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/pb33f/libopenapi/what-changed/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateSARIFReport(t *testing.T) {
+	changes := createDiff()
+	report, err := CreateSARIFReport(changes, "original.yaml", "new.yaml")
+	assert.NoError(t, err)
+
+	var log sarifLog
+	assert.NoError(t, json.Unmarshal(report, &log))
+	assert.Equal(t, SARIFVersion, log.Version)
+	assert.Len(t, log.Runs, 1)
+	assert.Len(t, log.Runs[0].Tool.Driver.Rules, 5)
+
+	results := log.Runs[0].Results
+	assert.Len(t, results, changes.TotalChanges())
+	errors := 0
+	for _, r := range results {
+		if r.Level == "error" {
+			errors++
+		}
+	}
+	assert.Equal(t, changes.TotalBreakingChanges(), errors)
+
+	var in, removed *sarifResult
+	for i := range results {
+		switch results[i].Message.Text {
+		case "GET /burgers/{burgerId}/dressings: 'in' modified from 'path' to 'query'":
+			in = &results[i]
+		case "POST /burgers: 'codes' removed: '422'":
+			removed = &results[i]
+		}
+	}
+	assert.NotNil(t, in)
+	assert.Equal(t, "error", in.Level)
+	assert.Equal(t, "modified", in.RuleID)
+	assert.Equal(t, "new.yaml", in.Locations[0].PhysicalLocation.ArtifactLocation.URI)
+	assert.Equal(t, 191, in.Locations[0].PhysicalLocation.Region.StartLine)
+	assert.Equal(t, "/paths/~1burgers~1{burgerId}~1dressings/get/parameters/0/in",
+		in.Locations[0].LogicalLocations[0].FullyQualifiedName)
+
+	// removed changes are found in the original document.
+	assert.NotNil(t, removed)
+	assert.Equal(t, "objectRemoved", removed.RuleID)
+	assert.Equal(t, "original.yaml", removed.Locations[0].PhysicalLocation.ArtifactLocation.URI)
+	assert.Equal(t, "/paths/~1burgers/post/responses/422", removed.Locations[0].LogicalLocations[0].FullyQualifiedName)
+}
+
+func TestCreateSARIFReport_NoChanges(t *testing.T) {
+	report, err := CreateSARIFReport(nil, "", "")
+	assert.NoError(t, err)
+	assert.Contains(t, string(report), `"results": []`)
+}
+
+func TestSARIFChangeLocation(t *testing.T) {
+	assert.Nil(t, sarifChangeLocation(&model.Change{}, "a.yaml", "b.yaml"))
+
+	location := sarifChangeLocation(&model.Change{Property: "name", NewPath: "/info/name"}, "a.yaml", "b.yaml")
+	assert.Nil(t, location.PhysicalLocation)
+	assert.Equal(t, "/info/name", location.LogicalLocations[0].FullyQualifiedName)
+
+	// a new value in a referenced file has a line, but no path, so the original value in the document is used.
+	newLine, originalLine := 4, 12
+	location = sarifChangeLocation(&model.Change{Property: "description", OriginalPath: "/info/description",
+		Context: &model.ChangeContext{NewLine: &newLine, OriginalLine: &originalLine}}, "a.yaml", "b.yaml")
+	assert.Equal(t, "a.yaml", location.PhysicalLocation.ArtifactLocation.URI)
+	assert.Equal(t, 12, location.PhysicalLocation.Region.StartLine)
+	assert.Equal(t, "/info/description", location.LogicalLocations[0].FullyQualifiedName)
+
+	// neither value is in the document, so there is no location, even though there are lines.
+	assert.Nil(t, sarifChangeLocation(&model.Change{Property: "description",
+		Context: &model.ChangeContext{NewLine: &newLine, OriginalLine: &originalLine}}, "a.yaml", "b.yaml"))
+}
@@ -28,9 +28,21 @@ func CompareOpenAPIDocuments(original, updated *v3.Document) *model.DocumentChan
 	return model.CompareDocuments(original, updated)
 }
 
+// CompareOpenAPIDocumentsWithRules is the same as CompareOpenAPIDocuments, except the supplied rules decide which
+// changes are breaking. Changes that don't match a rule keep the default.
+func CompareOpenAPIDocumentsWithRules(original, updated *v3.Document, rules model.BreakingRules) *model.DocumentChanges {
+	return model.CompareDocumentsWithRules(original, updated, rules)
+}
+
 // CompareSwaggerDocuments will compare left (original) and a right (updated) Swagger documents and extract every change
 // made across the entire specification. The report outlines every property changes, everything that was added,
 // or removed and which of those changes were breaking.
 func CompareSwaggerDocuments(original, updated *v2.Swagger) *model.DocumentChanges {
 	return model.CompareDocuments(original, updated)
-}
\ No newline at end of file
+}
+
+// CompareSwaggerDocumentsWithRules is the same as CompareSwaggerDocuments, except the supplied rules decide which
+// changes are breaking. Changes that don't match a rule keep the default.
+func CompareSwaggerDocumentsWithRules(original, updated *v2.Swagger, rules model.BreakingRules) *model.DocumentChanges {
+	return model.CompareDocumentsWithRules(original, updated, rules)
+}
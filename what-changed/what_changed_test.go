@@ -8,6 +8,7 @@ import (
 	"github.com/pb33f/libopenapi/datamodel"
 	v2 "github.com/pb33f/libopenapi/datamodel/low/v2"
 	v3 "github.com/pb33f/libopenapi/datamodel/low/v3"
+	"github.com/pb33f/libopenapi/what-changed/model"
 	"github.com/stretchr/testify/assert"
 	"io/ioutil"
 	"testing"
@@ -30,6 +31,65 @@ func TestCompareOpenAPIDocuments(t *testing.T) {
 	//_ = ioutil.WriteFile("outputv3.json", out, 0776)
 }
 
+func TestCompareOpenAPIDocuments_Paths(t *testing.T) {
+
+	original, _ := ioutil.ReadFile("../test_specs/burgershop.openapi.yaml")
+	modified, _ := ioutil.ReadFile("../test_specs/burgershop.openapi-modified.yaml")
+	infoOrig, _ := datamodel.ExtractSpecInfo(original)
+	infoMod, _ := datamodel.ExtractSpecInfo(modified)
+
+	origDoc, _ := v3.CreateDocument(infoOrig)
+	modDoc, _ := v3.CreateDocument(infoMod)
+
+	changes := CompareOpenAPIDocuments(origDoc, modDoc)
+	all := changes.GetAllChanges()
+	assert.Len(t, all, changes.TotalChanges())
+
+	paths := make(map[string]*model.Change)
+	for _, c := range all {
+		assert.NotEmpty(t, c.Path(), c.Property)
+		paths[c.Path()] = c
+	}
+	c := paths["/paths/~1burgers~1{burgerId}~1dressings/get/parameters/0/schema/type"]
+	assert.NotNil(t, c)
+	assert.Equal(t, c.OriginalPath, c.NewPath)
+	c = paths["/paths/~1burgers/post/responses/422"]
+	assert.Equal(t, model.ObjectRemoved, c.ChangeType)
+	assert.Empty(t, c.NewPath)
+	c = paths["/tags/1/description"]
+	assert.Equal(t, "/tags/0/description", c.OriginalPath)
+}
+
+func TestCompareOpenAPIDocumentsWithRules(t *testing.T) {
+
+	original, _ := ioutil.ReadFile("../test_specs/burgershop.openapi.yaml")
+	modified, _ := ioutil.ReadFile("../test_specs/burgershop.openapi-modified.yaml")
+	infoOrig, _ := datamodel.ExtractSpecInfo(original)
+	infoMod, _ := datamodel.ExtractSpecInfo(modified)
+
+	origDoc, _ := v3.CreateDocument(infoOrig)
+	modDoc, _ := v3.CreateDocument(infoMod)
+
+	// nothing is breaking.
+	rules := model.BreakingRules{}
+	for _, object := range []string{"document", "info", "paths", "pathItem", "operation", "parameter", "requestBody",
+		"responses", "response", "mediaType", "header", "link", "schema", "components", "securityScheme",
+		"securityRequirement", "callback", "example", "server", "serverVariable", "tag", "externalDoc", "encoding",
+		"discriminator", "extension", "oauthFlows", "oauthFlow"} {
+		for _, changeType := range []int{model.Modified, model.PropertyAdded, model.PropertyRemoved,
+			model.ObjectAdded, model.ObjectRemoved} {
+			rules.Set(object, model.AnyProperty, changeType, false)
+		}
+	}
+
+	changes := CompareOpenAPIDocumentsWithRules(origDoc, modDoc, rules)
+	assert.Equal(t, 72, changes.TotalChanges())
+	assert.Equal(t, 0, changes.TotalBreakingChanges())
+
+	changes = CompareOpenAPIDocumentsWithRules(origDoc, modDoc, nil)
+	assert.Equal(t, 17, changes.TotalBreakingChanges())
+}
+
 func TestCompareSwaggerDocuments(t *testing.T) {
 
 	original, _ := ioutil.ReadFile("../test_specs/petstorev2-complete.yaml")
@@ -42,7 +102,14 @@ func TestCompareSwaggerDocuments(t *testing.T) {
 
 	changes := CompareSwaggerDocuments(origDoc, modDoc)
 	assert.Equal(t, 52, changes.TotalChanges())
-	assert.Equal(t, 27, changes.TotalBreakingChanges())
+	// raising the maximum of a path parameter is not breaking, the parameter accepts more values.
+	assert.Equal(t, 26, changes.TotalBreakingChanges())
+
+	// removing an operation is no longer breaking.
+	rules, _ := model.LoadBreakingRules([]byte(`pathItem: {"*": {propertyRemoved: false}}`))
+	changes = CompareSwaggerDocumentsWithRules(origDoc, modDoc, rules)
+	assert.Equal(t, 52, changes.TotalChanges())
+	assert.Less(t, changes.TotalBreakingChanges(), 26)
 
 	//out, _ := json.MarshalIndent(changes, "", "  ")
 	//_ = ioutil.WriteFile("output.json", out, 0776)